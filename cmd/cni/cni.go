@@ -19,6 +19,10 @@ import (
 	"github.com/kubeovn/kube-ovn/versions"
 )
 
+// errPluginNotAvailable is the error code defined by the CNI spec for a STATUS
+// call when the plugin is not able to service ADD requests
+const errPluginNotAvailable uint = 50
+
 func main() {
 	// this ensures that main runs only on main thread (thread group leader).
 	// since namespace ops (unshare, setns) are done for a single thread, we
@@ -26,8 +30,11 @@ func main() {
 	runtime.LockOSThread()
 
 	funcs := skel.CNIFuncs{
-		Add: cmdAdd,
-		Del: cmdDel,
+		Add:    cmdAdd,
+		Del:    cmdDel,
+		Check:  cmdCheck,
+		GC:     cmdGC,
+		Status: cmdStatus,
 	}
 	about := "CNI kube-ovn plugin " + versions.VERSION
 	skel.PluginMainFuncs(funcs, version.All, about)
@@ -164,6 +171,72 @@ func cmdDel(args *skel.CmdArgs) error {
 	return nil
 }
 
+func cmdCheck(args *skel.CmdArgs) error {
+	netConf, _, err := loadNetConf(args.StdinData)
+	if err != nil {
+		return err
+	}
+	podName, err := parseValueFromArgs("K8S_POD_NAME", args.Args)
+	if err != nil {
+		return err
+	}
+	podNamespace, err := parseValueFromArgs("K8S_POD_NAMESPACE", args.Args)
+	if err != nil {
+		return err
+	}
+	applyDefaultProvider(netConf, args)
+
+	client := request.NewCniServerClient(netConf.ServerSocket)
+	err = client.Check(request.CniRequest{
+		CniType:                    netConf.Type,
+		PodName:                    podName,
+		PodNamespace:               podNamespace,
+		ContainerID:                args.ContainerID,
+		NetNs:                      args.Netns,
+		IfName:                     args.IfName,
+		Provider:                   netConf.Provider,
+		Routes:                     netConf.Routes,
+		DeviceID:                   netConf.DeviceID,
+		VhostUserSocketVolumeName:  netConf.VhostUserSocketVolumeName,
+		VhostUserSocketConsumption: netConf.VhostUserSocketConsumption,
+	})
+	if err != nil {
+		return types.NewError(types.ErrInternal, "check failed", err.Error())
+	}
+	return nil
+}
+
+func cmdGC(args *skel.CmdArgs) error {
+	netConf, _, err := loadNetConf(args.StdinData)
+	if err != nil {
+		return err
+	}
+
+	client := request.NewCniServerClient(netConf.ServerSocket)
+	err = client.GC(request.CniGCRequest{
+		CniType:          netConf.Type,
+		Provider:         netConf.Provider,
+		ValidAttachments: netConf.ValidAttachments,
+	})
+	if err != nil {
+		return types.NewError(types.ErrTryAgainLater, "RPC failed", err.Error())
+	}
+	return nil
+}
+
+func cmdStatus(args *skel.CmdArgs) error {
+	netConf, _, err := loadNetConf(args.StdinData)
+	if err != nil {
+		return err
+	}
+
+	client := request.NewCniServerClient(netConf.ServerSocket)
+	if err = client.Status(); err != nil {
+		return types.NewError(errPluginNotAvailable, "plugin not available", err.Error())
+	}
+	return nil
+}
+
 func applyDefaultProvider(netConf *netconf.NetConf, args *skel.CmdArgs) {
 	if netConf.Provider == "" && netConf.Type == util.CniTypeName && args.IfName == "eth0" {
 		netConf.Provider = util.OvnProvider
@@ -24,6 +24,7 @@ import (
 	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
 	"github.com/kubeovn/kube-ovn/pkg/controller"
 	"github.com/kubeovn/kube-ovn/pkg/metrics"
+	"github.com/kubeovn/kube-ovn/pkg/tracing"
 	"github.com/kubeovn/kube-ovn/pkg/util"
 	"github.com/kubeovn/kube-ovn/versions"
 )
@@ -56,8 +57,16 @@ func CmdMain() {
 
 	ctrl.SetLogger(klog.NewKlogr())
 	ctx := signals.SetupSignalHandler()
+	shutdownTracing := func(context.Context) error { return nil }
+	if config.EnableTracing {
+		if shutdownTracing, err = tracing.Init(ctx, "kube-ovn-controller"); err != nil {
+			util.LogFatalAndExit(err, "failed to init tracing")
+		}
+	}
 	go func() {
 		metricsAddrs := util.GetDefaultListenAddr()
+		metrics.RegisterHandler("/drift", controller.DriftHandler())
+		metrics.RegisterHandler("/trace", controller.TraceHandler())
 		servePprofInMetricsServer := config.EnableMetrics && slices.Contains(metricsAddrs, "0.0.0.0")
 		metrics.StartPprofServerIfNeeded(ctx, config.EnablePprof, servePprofInMetricsServer, "127.0.0.1", int(config.PprofPort))
 		metrics.StartMetricsOrHealthServer(ctx, config.EnableMetrics, metricsAddrs, int(config.PprofPort), config.KubeRestConfig, config.SecureServing, servePprofInMetricsServer, config.TLSMinVersion, config.TLSMaxVersion, config.TLSCipherSuites)
@@ -92,6 +101,10 @@ func CmdMain() {
 				controller.Run(ctx, config)
 			},
 			OnStoppedLeading: func() {
+				// flush the pending spans before exiting
+				if err := shutdownTracing(context.Background()); err != nil {
+					klog.Errorf("failed to shutdown tracing: %v", err)
+				}
 				select {
 				case <-ctx.Done():
 					klog.InfoS("Requested to terminate, exiting")
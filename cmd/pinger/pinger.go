@@ -48,7 +48,7 @@ func CmdMain() {
 			}()
 		}
 
-		if config.EnableVerboseConnCheck {
+		if config.EnableVerboseConnCheck || config.EnableMeshCheck {
 			addr := util.JoinHostPort("0.0.0.0", config.UDPConnCheckPort)
 			if err = util.UDPConnectivityListen(addr); err != nil {
 				util.LogFatalAndExit(err, "failed to start UDP listen on addr %s", addr)
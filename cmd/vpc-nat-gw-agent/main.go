@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeovn/kube-ovn/pkg/client/clientset/versioned"
+	"github.com/kubeovn/kube-ovn/pkg/natgwagent"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+func main() {
+	klog.InitFlags(nil)
+	namespace := flag.String("namespace", os.Getenv(natgwagent.NamespaceEnv), "Namespace of the desired rules ConfigMap")
+	configMap := flag.String("configmap", os.Getenv(natgwagent.ConfigMapEnv), "Name of the desired rules ConfigMap")
+	script := flag.String("script", natgwagent.ScriptPath, "Path to the NAT gateway script")
+	stateFile := flag.String("state-file", natgwagent.StateFile, "Path to the file recording the applied rules")
+	resyncPeriod := flag.Duration("resync-period", 5*time.Minute, "Interval at which the rule status is reported again")
+	identity := flag.String("identity", os.Getenv(natgwagent.PodNameEnv), "Identity of the replica in the election of the replica reporting the rule status")
+	flag.Parse()
+	if *namespace == "" || *configMap == "" {
+		klog.Fatal("NAT gateway agent requires --namespace and --configmap")
+	}
+
+	cfg, err := rest.InClusterConfig()
+	if err != nil {
+		klog.Fatalf("failed to build in-cluster config: %v", err)
+	}
+	kubeOvnClient, err := versioned.NewForConfig(cfg)
+	if err != nil {
+		klog.Fatalf("failed to init kubeovn client: %v", err)
+	}
+	cfg.ContentType = util.ContentTypeProtobuf
+	cfg.AcceptContentTypes = util.AcceptContentTypes
+	kubeClient, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		klog.Fatalf("failed to init kubernetes client: %v", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+	if err = natgwagent.Run(ctx, natgwagent.Options{
+		KubeClient: kubeClient, KubeOvnClient: kubeOvnClient, Executor: natgwagent.NewScriptExecutor(*script),
+		Namespace: *namespace, ConfigMap: *configMap, StateFile: *stateFile, ResyncPeriod: *resyncPeriod, Identity: *identity,
+	}); err != nil {
+		klog.Fatalf("NAT gateway agent failed: %v", err)
+	}
+}
@@ -28,7 +28,18 @@ LS_CT_SKIP_DST_LPORT_IPS=${LS_CT_SKIP_DST_LPORT_IPS:-true}
 ENABLE_EXTERNAL_VPC=${ENABLE_EXTERNAL_VPC:-false}
 CNI_CONFIG_PRIORITY=${CNI_CONFIG_PRIORITY:-01}
 ENABLE_LB_SVC=${ENABLE_LB_SVC:-false}
+ENABLE_TRACING=${ENABLE_TRACING:-false}
 ENABLE_NAT_GW=${ENABLE_NAT_GW:-true}
+# program the NAT gateway rules with the agent running in the gateway, which reaches the API server
+# through the NetworkAttachmentDefinition provider NAT_GW_API_NAD_PROVIDER
+ENABLE_NAT_GW_AGENT=${ENABLE_NAT_GW_AGENT:-false}
+NAT_GW_API_NAD_PROVIDER=${NAT_GW_API_NAD_PROVIDER:-}
+# interval in seconds to collect the traffic counters of the iptables EIPs and NAT rules from the VPC NAT gateways
+# and of the OVN EIPs from the gateway chassis, 0 disables the collection
+NAT_GW_COUNTER_INTERVAL=${NAT_GW_COUNTER_INTERVAL:-0}
+OVN_EIP_COUNTER_INTERVAL=${OVN_EIP_COUNTER_INTERVAL:-0}
+# summarize the traffic counters in the status of the iptables EIPs and NAT rules
+ENABLE_NAT_COUNTER_STATUS=${ENABLE_NAT_COUNTER_STATUS:-false}
 ENABLE_KEEP_VM_IP=${ENABLE_KEEP_VM_IP:-true}
 ENABLE_ARP_DETECT_IP_CONFLICT=${ENABLE_ARP_DETECT_IP_CONFLICT:-true}
 ENABLE_METRICS=${ENABLE_METRICS:-true}
@@ -172,6 +183,7 @@ DPDK_MEMORY="2Gi"                       # Default Memory configuration for it --
 # performance
 GC_INTERVAL=360
 INSPECT_INTERVAL=20
+DRIFT_CHECK_INTERVAL=300
 
 display_help() {
     echo "Usage: $0 [option...]"
@@ -481,6 +493,422 @@ spec:
 ---
 apiVersion: apiextensions.k8s.io/v1
 kind: CustomResourceDefinition
+metadata:
+  annotations:
+    controller-gen.kubebuilder.io/version: v0.20.1
+  name: bgp-speaker-statuses.kubeovn.io
+spec:
+  group: kubeovn.io
+  names:
+    kind: BgpSpeakerStatus
+    listKind: BgpSpeakerStatusList
+    plural: bgp-speaker-statuses
+    singular: bgp-speaker-status
+  scope: Cluster
+  versions:
+  - additionalPrinterColumns:
+    - jsonPath: .status.nodeName
+      name: Node
+      type: string
+    - jsonPath: .status.routerID
+      name: RouterID
+      type: string
+    - jsonPath: .status.localASN
+      name: ASN
+      type: integer
+    - jsonPath: .status.establishedNeighbors
+      name: Established
+      type: integer
+    - jsonPath: .status.totalNeighbors
+      name: Neighbors
+      type: integer
+    - jsonPath: .status.announcedPrefixCount
+      name: Announced
+      type: integer
+    - jsonPath: .status.lastError
+      name: LastError
+      priority: 1
+      type: string
+    - jsonPath: .metadata.creationTimestamp
+      name: Age
+      type: date
+    name: v1
+    schema:
+      openAPIV3Schema:
+        description: |-
+          BgpSpeakerStatus reports the BGP session state of the kube-ovn-speaker running on a node.
+          It is named after the node and written by the speaker itself.
+        properties:
+          apiVersion:
+            description: |-
+              APIVersion defines the versioned schema of this representation of an object.
+              Servers should convert recognized schemas to the latest internal value, and
+              may reject unrecognized values.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+            type: string
+          kind:
+            description: |-
+              Kind is a string value representing the REST resource this object represents.
+              Servers may infer this from the endpoint the client submits requests to.
+              Cannot be updated.
+              In CamelCase.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+            type: string
+          metadata:
+            type: object
+          status:
+            properties:
+              announcedPrefixCount:
+                description: AnnouncedPrefixCount is the number of prefixes originated
+                  by this speaker
+                type: integer
+              announcedPrefixes:
+                description: AnnouncedPrefixes lists the prefixes originated by this
+                  speaker, truncated when it grows too large
+                items:
+                  type: string
+                type: array
+              establishedNeighbors:
+                type: integer
+              lastError:
+                type: string
+              lastErrorTime:
+                format: date-time
+                type: string
+              lastUpdateTime:
+                format: date-time
+                type: string
+              localASN:
+                format: int32
+                type: integer
+              neighbors:
+                items:
+                  properties:
+                    acceptedPrefixes:
+                      format: int64
+                      type: integer
+                    address:
+                      type: string
+                    advertisedPrefixes:
+                      format: int64
+                      type: integer
+                    bfdState:
+                      description: BFDState is the local BFD session state, empty
+                        when BFD is disabled
+                      type: string
+                    disconnectReason:
+                      type: string
+                    establishedTime:
+                      description: EstablishedTime is the time the session was last
+                        established, empty when the session is down
+                      format: date-time
+                      type: string
+                    flaps:
+                      format: int32
+                      type: integer
+                    localAddress:
+                      type: string
+                    peerASN:
+                      format: int32
+                      type: integer
+                    receivedPrefixes:
+                      format: int64
+                      type: integer
+                    state:
+                      description: State is the BGP FSM state of the session, e.g.
+                        Established, Active or Idle
+                      type: string
+                  type: object
+                type: array
+              nodeName:
+                type: string
+              routerID:
+                type: string
+              totalNeighbors:
+                type: integer
+            type: object
+        type: object
+    served: true
+    storage: true
+    subresources:
+      status: {}
+---
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    controller-gen.kubebuilder.io/version: v0.20.1
+  name: connectivity-matrices.kubeovn.io
+spec:
+  group: kubeovn.io
+  names:
+    kind: ConnectivityMatrix
+    listKind: ConnectivityMatrixList
+    plural: connectivity-matrices
+    singular: connectivity-matrix
+  scope: Cluster
+  versions:
+  - additionalPrinterColumns:
+    - jsonPath: .status.conditions[?(@.type=="Connected")].status
+      name: Connected
+      type: string
+    - jsonPath: .status.nodes
+      name: Nodes
+      type: integer
+    - jsonPath: .status.reportingNodes
+      name: Reporting
+      type: integer
+    - jsonPath: .status.partitionedPairCount
+      name: Partitioned
+      type: integer
+    - jsonPath: .metadata.creationTimestamp
+      name: Age
+      type: date
+    name: v1
+    schema:
+      openAPIV3Schema:
+        description: |-
+          ConnectivityMatrix aggregates the ConnectivityReports of all nodes.
+          It is maintained by kube-ovn-controller as a single object named cluster.
+        properties:
+          apiVersion:
+            description: |-
+              APIVersion defines the versioned schema of this representation of an object.
+              Servers should convert recognized schemas to the latest internal value, and
+              may reject unrecognized values.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+            type: string
+          kind:
+            description: |-
+              Kind is a string value representing the REST resource this object represents.
+              Servers may infer this from the endpoint the client submits requests to.
+              Cannot be updated.
+              In CamelCase.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+            type: string
+          metadata:
+            type: object
+          status:
+            properties:
+              conditions:
+                items:
+                  description: Condition describes the state of an object at a certain
+                    point.
+                  properties:
+                    lastTransitionTime:
+                      description: Last time the condition transitioned from one status
+                        to another.
+                      format: date-time
+                      type: string
+                    lastUpdateTime:
+                      description: Last time the condition was probed
+                      format: date-time
+                      type: string
+                    message:
+                      description: A human readable message indicating details about
+                        the transition.
+                      type: string
+                    observedGeneration:
+                      description: |-
+                        ObservedGeneration represents the .metadata.generation that the condition was set based upon.
+                        For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9,
+                        the condition is out of date with respect to the current state of the instance.
+                      format: int64
+                      type: integer
+                    reason:
+                      description: The reason for the condition's last transition.
+                      type: string
+                    status:
+                      description: Status of the condition, one of True, False, Unknown.
+                      type: string
+                    type:
+                      description: Type of condition.
+                      type: string
+                  type: object
+                type: array
+              lastUpdateTime:
+                format: date-time
+                type: string
+              nodes:
+                type: integer
+              partitionedPairCount:
+                type: integer
+              partitionedPairs:
+                description: PartitionedPairs lists the node pairs where at least
+                  one pod or node IP of the target node is unreachable from the source
+                  node
+                items:
+                  properties:
+                    lossPercent:
+                      type: integer
+                    source:
+                      type: string
+                    target:
+                      type: string
+                  type: object
+                type: array
+              reportingNodes:
+                type: integer
+              staleNodes:
+                description: StaleNodes lists the nodes whose report is missing or
+                  has not been updated recently
+                items:
+                  type: string
+                type: array
+            type: object
+        type: object
+    served: true
+    storage: true
+    subresources:
+      status: {}
+---
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    controller-gen.kubebuilder.io/version: v0.20.1
+  name: connectivity-reports.kubeovn.io
+spec:
+  group: kubeovn.io
+  names:
+    kind: ConnectivityReport
+    listKind: ConnectivityReportList
+    plural: connectivity-reports
+    singular: connectivity-report
+  scope: Cluster
+  versions:
+  - additionalPrinterColumns:
+    - jsonPath: .status.nodeName
+      name: Node
+      type: string
+    - jsonPath: .status.reachablePeers
+      name: Reachable
+      type: integer
+    - jsonPath: .status.unreachablePeers
+      name: Unreachable
+      type: integer
+    - jsonPath: .status.apiServer.healthy
+      name: APIServer
+      type: boolean
+    - jsonPath: .status.internalDNS.healthy
+      name: DNS
+      type: boolean
+    - jsonPath: .status.lastUpdateTime
+      name: LastUpdate
+      type: date
+    name: v1
+    schema:
+      openAPIV3Schema:
+        description: |-
+          ConnectivityReport holds the latest check results of the kube-ovn-pinger running on a node.
+          It is named after the node and written by the pinger itself.
+        properties:
+          apiVersion:
+            description: |-
+              APIVersion defines the versioned schema of this representation of an object.
+              Servers should convert recognized schemas to the latest internal value, and
+              may reject unrecognized values.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+            type: string
+          kind:
+            description: |-
+              Kind is a string value representing the REST resource this object represents.
+              Servers may infer this from the endpoint the client submits requests to.
+              Cannot be updated.
+              In CamelCase.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+            type: string
+          metadata:
+            type: object
+          status:
+            properties:
+              apiServer:
+                properties:
+                  error:
+                    type: string
+                  healthy:
+                    type: boolean
+                  latency:
+                    type: string
+                  target:
+                    type: string
+                type: object
+              externalDNS:
+                properties:
+                  error:
+                    type: string
+                  healthy:
+                    type: boolean
+                  latency:
+                    type: string
+                  target:
+                    type: string
+                type: object
+              internalDNS:
+                properties:
+                  error:
+                    type: string
+                  healthy:
+                    type: boolean
+                  latency:
+                    type: string
+                  target:
+                    type: string
+                type: object
+              lastUpdateTime:
+                format: date-time
+                type: string
+              nodeName:
+                type: string
+              peers:
+                items:
+                  properties:
+                    avgRTT:
+                      type: string
+                    ip:
+                      type: string
+                    lastFailureTime:
+                      description: LastFailureTime is the last time a check of this
+                        peer lost packets or failed
+                      format: date-time
+                      type: string
+                    lossPercent:
+                      type: integer
+                    nodeName:
+                      type: string
+                    reachable:
+                      type: boolean
+                    subnet:
+                      type: string
+                    type:
+                      description: Type is the kind of the checked peer, Pod or Node
+                      type: string
+                    vpc:
+                      description: Vpc and Subnet are set for peers checked over a
+                        subnet attached to the pinger pods via Multus
+                      type: string
+                  type: object
+                type: array
+              podIP:
+                type: string
+              podName:
+                type: string
+              reachablePeers:
+                type: integer
+              unreachablePeers:
+                type: integer
+            type: object
+        type: object
+    served: true
+    storage: true
+    subresources:
+      status: {}
+---
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
 metadata:
   annotations:
     controller-gen.kubebuilder.io/version: v0.20.1
@@ -745,6 +1173,259 @@ spec:
 ---
 apiVersion: apiextensions.k8s.io/v1
 kind: CustomResourceDefinition
+metadata:
+  annotations:
+    controller-gen.kubebuilder.io/version: v0.20.1
+  name: interconnection-configs.kubeovn.io
+spec:
+  group: kubeovn.io
+  names:
+    kind: InterconnectionConfig
+    listKind: InterconnectionConfigList
+    plural: interconnection-configs
+    shortNames:
+    - ic-config
+    singular: interconnection-config
+  scope: Cluster
+  versions:
+  - additionalPrinterColumns:
+    - jsonPath: .spec.enabled
+      name: Enabled
+      type: boolean
+    - jsonPath: .spec.azName
+      name: AZ
+      type: string
+    - jsonPath: .spec.autoRoute
+      name: AutoRoute
+      type: boolean
+    - jsonPath: .status.conditions[?(@.type=="Ready")].status
+      name: Ready
+      type: string
+    - jsonPath: .metadata.creationTimestamp
+      name: Age
+      type: date
+    name: v1
+    schema:
+      openAPIV3Schema:
+        description: |-
+          InterconnectionConfig configures the OVN interconnection of this cluster.
+          Only the object named ovn-ic-config is handled by ovn-ic-controller,
+          and it takes precedence over the ovn-ic-config ConfigMap.
+        properties:
+          apiVersion:
+            description: |-
+              APIVersion defines the versioned schema of this representation of an object.
+              Servers should convert recognized schemas to the latest internal value, and
+              may reject unrecognized values.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+            type: string
+          kind:
+            description: |-
+              Kind is a string value representing the REST resource this object represents.
+              Servers may infer this from the endpoint the client submits requests to.
+              Cannot be updated.
+              In CamelCase.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+            type: string
+          metadata:
+            type: object
+          spec:
+            properties:
+              autoRoute:
+                description: AutoRoute advertises and learns routes automatically
+                type: boolean
+              azName:
+                description: AzName is the name of the availability zone of this cluster
+                maxLength: 63
+                minLength: 1
+                pattern: ^[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?$
+                type: string
+              enabled:
+                default: true
+                description: Enabled turns the interconnection on or off
+                type: boolean
+              gatewayNodes:
+                description: GatewayNodes are the nodes acting as interconnection
+                  gateways
+                items:
+                  type: string
+                type: array
+                x-kubernetes-list-type: set
+              icDBHosts:
+                description: ICDBHosts are the addresses of the OVN-IC database servers
+                items:
+                  minLength: 1
+                  type: string
+                minItems: 1
+                type: array
+              icNbPort:
+                default: 6645
+                description: ICNbPort is the port of the OVN-IC northbound database
+                format: int32
+                maximum: 65535
+                minimum: 1
+                type: integer
+              icSbPort:
+                default: 6646
+                description: ICSbPort is the port of the OVN-IC southbound database
+                format: int32
+                maximum: 65535
+                minimum: 1
+                type: integer
+              routeFilter:
+                description: RouteFilter filters the routes exchanged with other AZs
+                  when AutoRoute is enabled
+                properties:
+                  advertise:
+                    description: Advertise filters the routes advertised to other
+                      AZs
+                    properties:
+                      allow:
+                        description: Allow only the prefixes contained in these CIDRs,
+                          all prefixes are allowed if empty
+                        items:
+                          type: string
+                        type: array
+                      deny:
+                        description: Deny the prefixes overlapping with these CIDRs,
+                          takes precedence over Allow
+                        items:
+                          type: string
+                        type: array
+                    type: object
+                  learn:
+                    description: Learn filters the routes learned from other AZs
+                    items:
+                      properties:
+                        allow:
+                          description: Allow only the prefixes contained in these
+                            CIDRs, all prefixes are allowed if empty
+                          items:
+                            type: string
+                          type: array
+                        az:
+                          description: Az is the name of the remote availability zone,
+                            the rule applies to all remote AZs if empty
+                          type: string
+                        deny:
+                          description: Deny the prefixes overlapping with these CIDRs,
+                            takes precedence over Allow
+                          items:
+                            type: string
+                          type: array
+                      type: object
+                    type: array
+                type: object
+            required:
+            - azName
+            - icDBHosts
+            type: object
+          status:
+            properties:
+              conditions:
+                items:
+                  description: Condition describes the state of an object at a certain
+                    point.
+                  properties:
+                    lastTransitionTime:
+                      description: Last time the condition transitioned from one status
+                        to another.
+                      format: date-time
+                      type: string
+                    lastUpdateTime:
+                      description: Last time the condition was probed
+                      format: date-time
+                      type: string
+                    message:
+                      description: A human readable message indicating details about
+                        the transition.
+                      type: string
+                    observedGeneration:
+                      description: |-
+                        ObservedGeneration represents the .metadata.generation that the condition was set based upon.
+                        For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9,
+                        the condition is out of date with respect to the current state of the instance.
+                      format: int64
+                      type: integer
+                    reason:
+                      description: The reason for the condition's last transition.
+                      type: string
+                    status:
+                      description: Status of the condition, one of True, False, Unknown.
+                      type: string
+                    type:
+                      description: Type of condition.
+                      type: string
+                  type: object
+                type: array
+              filteredRoutes:
+                description: FilteredRoutes are the route prefixes neither advertised
+                  nor learned
+                items:
+                  properties:
+                    az:
+                      description: Az is the remote availability zone advertising
+                        the prefix, empty for local prefixes
+                      type: string
+                    direction:
+                      description: Direction is Advertise or Learn
+                      type: string
+                    prefix:
+                      type: string
+                    reason:
+                      description: Reason is one of Denied, NotAllowed, SubnetPolicy
+                        and Conflict
+                      type: string
+                  type: object
+                type: array
+              gateways:
+                description: Gateways are the states of the interconnection gateway
+                  nodes
+                items:
+                  properties:
+                    chassis:
+                      type: string
+                    node:
+                      type: string
+                    ready:
+                      type: boolean
+                  type: object
+                type: array
+              lastUpdateTime:
+                format: date-time
+                type: string
+              observedGeneration:
+                format: int64
+                type: integer
+              remoteAzs:
+                description: RemoteAzs are the other availability zones in the OVN-IC
+                  databases
+                items:
+                  properties:
+                    learnedRoutes:
+                      description: LearnedRoutes is the number of routes learned from
+                        the AZ
+                      type: integer
+                    name:
+                      type: string
+                  type: object
+                type: array
+              transitSwitches:
+                description: TransitSwitches are the transit switches this AZ is attached
+                  to
+                items:
+                  type: string
+                type: array
+            type: object
+        type: object
+    served: true
+    storage: true
+    subresources:
+      status: {}
+---
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
 metadata:
   annotations:
     controller-gen.kubebuilder.io/version: v0.20.1
@@ -1002,10 +1683,42 @@ spec:
                 description: IPv6 address
                 type: string
             type: object
+          status:
+            properties:
+              timeline:
+                description: Timestamps of the pod network setup phases, a phase without
+                  timestamp has not been reached yet
+                properties:
+                  allocated:
+                    description: Time when the address is allocated by kube-ovn-controller
+                    format: date-time
+                    type: string
+                  gatewayReachable:
+                    description: Time when the gateway is reachable from the pod
+                    format: date-time
+                    type: string
+                  lspCreated:
+                    description: Time when the logical switch port is created in OVN
+                      northbound database
+                    format: date-time
+                    type: string
+                  portBound:
+                    description: Time when the ovs port is bound and installed by
+                      ovn-controller
+                    format: date-time
+                    type: string
+                  routeReady:
+                    description: Time when the routes of the pod are configured by
+                      kube-ovn-controller
+                    format: date-time
+                    type: string
+                type: object
+            type: object
         type: object
     served: true
     storage: true
-    subresources: {}
+    subresources:
+      status: {}
 ---
 ---
 apiVersion: apiextensions.k8s.io/v1
@@ -1151,6 +1864,9 @@ spec:
                       type: string
                   type: object
                 type: array
+                x-kubernetes-list-map-keys:
+                - type
+                x-kubernetes-list-type: map
               externalPort:
                 description: External port configured in the DNAT rule
                 type: string
@@ -1172,6 +1888,30 @@ spec:
               redo:
                 description: Redo operation status
                 type: string
+              traffic:
+                description: Traffic of the connections translated by the DNAT rule
+                properties:
+                  rxBytes:
+                    description: Bytes received from the external network
+                    format: int64
+                    type: integer
+                  rxPackets:
+                    description: Packets received from the external network
+                    format: int64
+                    type: integer
+                  txBytes:
+                    description: Bytes sent to the external network
+                    format: int64
+                    type: integer
+                  txPackets:
+                    description: Packets sent to the external network
+                    format: int64
+                    type: integer
+                  updateTime:
+                    description: Time at which the counters were collected
+                    format: date-time
+                    type: string
+                type: object
               v4ip:
                 description: V4ip is the IPv4 address of the DNAT rule
                 type: string
@@ -1311,6 +2051,9 @@ spec:
                       type: string
                   type: object
                 type: array
+                x-kubernetes-list-map-keys:
+                - type
+                x-kubernetes-list-type: map
               ip:
                 description: IPv4 address of the EIP
                 type: string
@@ -1326,6 +2069,30 @@ spec:
               redo:
                 description: Redo operation status
                 type: string
+              traffic:
+                description: Traffic of the connections translated by the EIP
+                properties:
+                  rxBytes:
+                    description: Bytes received from the external network
+                    format: int64
+                    type: integer
+                  rxPackets:
+                    description: Packets received from the external network
+                    format: int64
+                    type: integer
+                  txBytes:
+                    description: Bytes sent to the external network
+                    format: int64
+                    type: integer
+                  txPackets:
+                    description: Packets sent to the external network
+                    format: int64
+                    type: integer
+                  updateTime:
+                    description: Time at which the counters were collected
+                    format: date-time
+                    type: string
+                type: object
             type: object
         type: object
     served: true
@@ -1439,6 +2206,9 @@ spec:
                       type: string
                   type: object
                 type: array
+                x-kubernetes-list-map-keys:
+                - type
+                x-kubernetes-list-type: map
               internalIp:
                 description: Internal IP address mapped to the FIP
                 type: string
@@ -1451,6 +2221,30 @@ spec:
               redo:
                 description: Redo operation status
                 type: string
+              traffic:
+                description: Traffic of the connections translated by the FIP
+                properties:
+                  rxBytes:
+                    description: Bytes received from the external network
+                    format: int64
+                    type: integer
+                  rxPackets:
+                    description: Packets received from the external network
+                    format: int64
+                    type: integer
+                  txBytes:
+                    description: Bytes sent to the external network
+                    format: int64
+                    type: integer
+                  txPackets:
+                    description: Packets sent to the external network
+                    format: int64
+                    type: integer
+                  updateTime:
+                    description: Time at which the counters were collected
+                    format: date-time
+                    type: string
+                type: object
               v4ip:
                 description: IPv4 address of the EIP
                 type: string
@@ -1530,6 +2324,34 @@ spec:
               internalCIDR:
                 description: Internal CIDR to be translated via SNAT
                 type: string
+              portBlock:
+                description: Deterministic external port block allocation for the
+                  internal IPv4 addresses
+                properties:
+                  blockSize:
+                    description: Number of external ports allocated to each internal
+                      address
+                    format: int32
+                    maximum: 64512
+                    minimum: 16
+                    type: integer
+                  portRangeEnd:
+                    default: 65535
+                    description: Last external port of the allocation range
+                    format: int32
+                    maximum: 65535
+                    minimum: 1
+                    type: integer
+                  portRangeStart:
+                    default: 1024
+                    description: First external port of the allocation range
+                    format: int32
+                    maximum: 65535
+                    minimum: 1
+                    type: integer
+                required:
+                - blockSize
+                type: object
             type: object
           status:
             properties:
@@ -1570,18 +2392,73 @@ spec:
                       type: string
                   type: object
                 type: array
+                x-kubernetes-list-map-keys:
+                - type
+                x-kubernetes-list-type: map
               internalCIDR:
                 description: InternalCIDR is the internal CIDR of the SNAT rule
                 type: string
               natGwDp:
                 description: NatGwDp is the NAT gateway data path
                 type: string
+              portBlock:
+                description: PortBlock is the port block allocation programmed into
+                  the NAT gateway
+                properties:
+                  blockSize:
+                    description: Number of external ports allocated to each internal
+                      address
+                    format: int32
+                    maximum: 64512
+                    minimum: 16
+                    type: integer
+                  portRangeEnd:
+                    default: 65535
+                    description: Last external port of the allocation range
+                    format: int32
+                    maximum: 65535
+                    minimum: 1
+                    type: integer
+                  portRangeStart:
+                    default: 1024
+                    description: First external port of the allocation range
+                    format: int32
+                    maximum: 65535
+                    minimum: 1
+                    type: integer
+                required:
+                - blockSize
+                type: object
               ready:
                 description: Indicates whether the SNAT rule is ready
                 type: boolean
               redo:
                 description: Redo operation status
                 type: string
+              traffic:
+                description: Traffic of the connections translated by the SNAT rule
+                properties:
+                  rxBytes:
+                    description: Bytes received from the external network
+                    format: int64
+                    type: integer
+                  rxPackets:
+                    description: Packets received from the external network
+                    format: int64
+                    type: integer
+                  txBytes:
+                    description: Bytes sent to the external network
+                    format: int64
+                    type: integer
+                  txPackets:
+                    description: Packets sent to the external network
+                    format: int64
+                    type: integer
+                  updateTime:
+                    description: Time at which the counters were collected
+                    format: date-time
+                    type: string
+                type: object
               v4ip:
                 description: V4ip is the IPv4 address of the SNAT rule
                 type: string
@@ -2141,6 +3018,34 @@ spec:
               ovnEip:
                 description: OVN EIP name for SNAT rule
                 type: string
+              portBlock:
+                description: Deterministic external port block allocation for the
+                  internal IPv4 addresses
+                properties:
+                  blockSize:
+                    description: Number of external ports allocated to each internal
+                      address
+                    format: int32
+                    maximum: 64512
+                    minimum: 16
+                    type: integer
+                  portRangeEnd:
+                    default: 65535
+                    description: Last external port of the allocation range
+                    format: int32
+                    maximum: 65535
+                    minimum: 1
+                    type: integer
+                  portRangeStart:
+                    default: 1024
+                    description: First external port of the allocation range
+                    format: int32
+                    maximum: 65535
+                    minimum: 1
+                    type: integer
+                required:
+                - blockSize
+                type: object
               v4IpCidr:
                 description: IPv4 CIDR for SNAT
                 type: string
@@ -2193,6 +3098,34 @@ spec:
                       type: string
                   type: object
                 type: array
+              portBlock:
+                description: PortBlock is the port block allocation programmed into
+                  the logical router
+                properties:
+                  blockSize:
+                    description: Number of external ports allocated to each internal
+                      address
+                    format: int32
+                    maximum: 64512
+                    minimum: 16
+                    type: integer
+                  portRangeEnd:
+                    default: 65535
+                    description: Last external port of the allocation range
+                    format: int32
+                    maximum: 65535
+                    minimum: 1
+                    type: integer
+                  portRangeStart:
+                    default: 1024
+                    description: First external port of the allocation range
+                    format: int32
+                    maximum: 65535
+                    minimum: 1
+                    type: integer
+                required:
+                - blockSize
+                type: object
               ready:
                 description: Indicates whether the SNAT rule is ready
                 type: boolean
@@ -2755,7 +3688,14 @@ spec:
     singular: security-group
   scope: Cluster
   versions:
-  - name: v1
+  - additionalPrinterColumns:
+    - jsonPath: .status.ports
+      name: Ports
+      type: integer
+    - jsonPath: .status.selectedPorts
+      name: SelectedPorts
+      type: integer
+    name: v1
     schema:
       openAPIV3Schema:
         properties:
@@ -2809,11 +3749,20 @@ spec:
                     remoteAddress:
                       description: Remote address or CIDR
                       type: string
+                    remoteFQDN:
+                      description: Remote domain name resolved by a DNSNameResolver,
+                        requires the DNSNameResolver support to be enabled
+                      type: string
+                    remoteIPPool:
+                      description: Remote IPPool name, the IPPool must have the address
+                        set enabled and hold addresses of the IP version of the rule
+                      type: string
                     remoteSecurityGroup:
                       description: Remote security group name
                       type: string
                     remoteType:
-                      description: Type of remote (address, cidr, or securityGroup)
+                      description: Type of remote (address, cidr, securityGroup, fqdn
+                        or ipPool)
                       type: string
                     sourcePortRangeMax:
                       description: End of source port range (1-65535)
@@ -2851,11 +3800,20 @@ spec:
                     remoteAddress:
                       description: Remote address or CIDR
                       type: string
+                    remoteFQDN:
+                      description: Remote domain name resolved by a DNSNameResolver,
+                        requires the DNSNameResolver support to be enabled
+                      type: string
+                    remoteIPPool:
+                      description: Remote IPPool name, the IPPool must have the address
+                        set enabled and hold addresses of the IP version of the rule
+                      type: string
                     remoteSecurityGroup:
                       description: Remote security group name
                       type: string
                     remoteType:
-                      description: Type of remote (address, cidr, or securityGroup)
+                      description: Type of remote (address, cidr, securityGroup, fqdn
+                        or ipPool)
                       type: string
                     sourcePortRangeMax:
                       description: End of source port range (1-65535)
@@ -2865,6 +3823,106 @@ spec:
                       type: integer
                   type: object
                 type: array
+              namespaceSelector:
+                description: Select the namespaces whose pods' logical switch ports
+                  join the security group without the security group annotation.
+                properties:
+                  matchExpressions:
+                    description: matchExpressions is a list of label selector requirements.
+                      The requirements are ANDed.
+                    items:
+                      description: |-
+                        A label selector requirement is a selector that contains values, a key, and an operator that
+                        relates the key and values.
+                      properties:
+                        key:
+                          description: key is the label key that the selector applies
+                            to.
+                          type: string
+                        operator:
+                          description: |-
+                            operator represents a key's relationship to a set of values.
+                            Valid operators are In, NotIn, Exists and DoesNotExist.
+                          type: string
+                        values:
+                          description: |-
+                            values is an array of string values. If the operator is In or NotIn,
+                            the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                            the values array must be empty. This array is replaced during a strategic
+                            merge patch.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      required:
+                      - key
+                      - operator
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  matchLabels:
+                    additionalProperties:
+                      type: string
+                    description: |-
+                      matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                      map is equivalent to an element of matchExpressions, whose key field is "key", the
+                      operator is "In", and the values array contains only "value". The requirements are ANDed.
+                    type: object
+                type: object
+                x-kubernetes-map-type: atomic
+              podSelector:
+                description: |-
+                  Select the pods whose logical switch ports join the security group without the security group annotation.
+                  When both podSelector and namespaceSelector are set, a pod must match both of them.
+                properties:
+                  matchExpressions:
+                    description: matchExpressions is a list of label selector requirements.
+                      The requirements are ANDed.
+                    items:
+                      description: |-
+                        A label selector requirement is a selector that contains values, a key, and an operator that
+                        relates the key and values.
+                      properties:
+                        key:
+                          description: key is the label key that the selector applies
+                            to.
+                          type: string
+                        operator:
+                          description: |-
+                            operator represents a key's relationship to a set of values.
+                            Valid operators are In, NotIn, Exists and DoesNotExist.
+                          type: string
+                        values:
+                          description: |-
+                            values is an array of string values. If the operator is In or NotIn,
+                            the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                            the values array must be empty. This array is replaced during a strategic
+                            merge patch.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      required:
+                      - key
+                      - operator
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  matchLabels:
+                    additionalProperties:
+                      type: string
+                    description: |-
+                      matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                      map is equivalent to an element of matchExpressions, whose key field is "key", the
+                      operator is "In", and the values array contains only "value". The requirements are ANDed.
+                    type: object
+                type: object
+                x-kubernetes-map-type: atomic
+              stateless:
+                description: |-
+                  Generate allow-stateless ACLs which bypass connection tracking, the ACLs passing the replies
+                  of the allowed traffic are generated automatically in the reverse direction
+                type: boolean
               tier:
                 description: ACL tier to which the rules are added
                 type: integer
@@ -2889,6 +3947,16 @@ spec:
               portGroup:
                 description: OVN port group name
                 type: string
+              ports:
+                description: Number of logical switch ports in the security group
+                type: integer
+              selectedPorts:
+                description: Number of logical switch ports selected by the pod and
+                  namespace selectors
+                type: integer
+              stateless:
+                description: Current stateless setting
+                type: boolean
             type: object
         type: object
     served: true
@@ -3110,6 +4178,20 @@ spec:
               gatewayType:
                 description: Gateway type (distributed or centralized).
                 type: string
+              interConnectionPolicy:
+                description: Interconnection policy of the subnet, takes precedence
+                  over DisableInterConnection.
+                properties:
+                  advertise:
+                    default: true
+                    description: Advertise the subnet CIDR to other AZs
+                    type: boolean
+                  ignoreConflicts:
+                    description: |-
+                      IgnoreConflicts stops treating the routes learned from other AZs overlapping with the subnet CIDR as conflicts,
+                      so that the subnet keeps being advertised. The overlapping routes are then only filtered by the route filters.
+                    type: boolean
+                type: object
               ipv6RAConfigs:
                 description: IPv6 RA configuration options.
                 type: string
@@ -4070,6 +5152,24 @@ spec:
                   optional internal subnet used to create the workload
                   if not specified, the workload will be created in the default subnet of the VPC
                 type: string
+              loadBalanceMode:
+                default: ECMP
+                description: |-
+                  optional load balance mode used to distribute traffic across workload replicas
+                  if not specified, the default mode "ECMP" will be used
+                  if set to "Pinned", each selected pod IP and policy CIDR is pinned to one external IP; new sources are
+                  spread over the replicas using rendezvous hashing, and a source keeps its external IP while the IP is
+                  owned by a ready replica, listed in externalIPs or still allocated to a failed replica.
+                  when the replica owning an external IP fails, a standby replica adds the external IP to its external
+                  interface and forwards its sources. with BFD enabled, the standby replica also has a standby route and
+                  SNATs the sources to the same external IP, so that it takes over as soon as the BFD session is down;
+                  without BFD, it takes over once the failed pod is not ready.
+                  use it with externalIPs so that a recreated replica reclaims the same external IP, the standby replica
+                  releases the IP on the next reconciliation.
+                enum:
+                - ECMP
+                - Pinned
+                type: string
               nodeSelector:
                 description: optional node selector used to select the nodes where
                   the workload will be running
@@ -4151,8 +5251,12 @@ spec:
                     properties:
                       log:
                         description: VpcEgressGatewayConntrackLog configures JSON
-                          Lines flow logging to stdout.
+                          Lines flow logging to stdout and external sinks.
                         properties:
+                          disableStdout:
+                            description: stop writing flow log records to the container
+                              stdout, e.g. when sinks are configured
+                            type: boolean
                           enabled:
                             type: boolean
                           events:
@@ -4510,6 +5614,85 @@ spec:
                                 minimum: 1
                                 type: integer
                             type: object
+                          sinks:
+                            description: external sinks the flow log records are exported
+                              to
+                            items:
+                              description: VpcEgressGatewayFlowLogSink exports flow
+                                log records to an external collector.
+                              properties:
+                                batch:
+                                  description: batching and buffering of the exported
+                                    records
+                                  properties:
+                                    flushIntervalMilliseconds:
+                                      description: maximum time a record waits for
+                                        its batch to fill, 1000 when omitted
+                                      format: int32
+                                      maximum: 60000
+                                      minimum: 10
+                                      type: integer
+                                    maxRecords:
+                                      description: maximum records in one export,
+                                        100 when omitted
+                                      format: int32
+                                      maximum: 10000
+                                      minimum: 1
+                                      type: integer
+                                    queueSize:
+                                      description: maximum records buffered for the
+                                        sink, 4096 when omitted
+                                      format: int32
+                                      maximum: 1000000
+                                      minimum: 1
+                                      type: integer
+                                  type: object
+                                endpoint:
+                                  description: |-
+                                    collector address in host:port format, or the OTLP/HTTP endpoint URL such as http://collector:4318
+                                    otlp sinks have no TLS or authentication options, so https endpoints and credentials in the URL are rejected
+                                  minLength: 1
+                                  type: string
+                                name:
+                                  description: sink name, used in metrics
+                                  maxLength: 63
+                                  pattern: ^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+                                  type: string
+                                transport:
+                                  description: |-
+                                    transport of ipfix, netflowV9 and syslog sinks
+                                    udp is used for ipfix and netflowV9 sinks and tcp is used for syslog sinks when omitted
+                                  enum:
+                                  - udp
+                                  - tcp
+                                  type: string
+                                type:
+                                  description: 'sink type: IPFIX, NetFlow v9, JSON
+                                    over syslog, or OTLP logs over HTTP'
+                                  enum:
+                                  - ipfix
+                                  - netflowV9
+                                  - syslog
+                                  - otlp
+                                  type: string
+                              required:
+                              - endpoint
+                              - name
+                              - type
+                              type: object
+                              x-kubernetes-validations:
+                              - message: netflowV9 sinks only support the udp transport
+                                rule: self.type != 'netflowV9' || !has(self.transport)
+                                  || self.transport == 'udp'
+                              - message: otlp sinks do not support the transport field
+                                rule: self.type != 'otlp' || !has(self.transport)
+                              - message: otlp sinks only support plain http endpoints
+                                rule: self.type != 'otlp' || self.endpoint.startsWith('http://')
+                            maxItems: 8
+                            type: array
+                            x-kubernetes-list-map-keys:
+                            - name
+                            x-kubernetes-list-type: map
                         type: object
                       metrics:
                         description: VpcEgressGatewayObservabilityFeature enables
@@ -4616,6 +5799,22 @@ spec:
                   at least one policy or selector must be specified
                 items:
                   properties:
+                    destinationFQDNs:
+                      description: optional destination domain names resolved by DNSNameResolver,
+                        requires the DNSNameResolver support to be enabled
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: set
+                    destinations:
+                      description: |-
+                        optional destination CIDRs
+                        when destinations or destinationFQDNs are specified, only the traffic to these destinations
+                        is forwarded through the egress gateway, and the rest uses the default path
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: set
                     ipBlocks:
                       description: CIDRs/subnets targeted by the egress traffic policy
                       items:
@@ -4905,6 +6104,10 @@ spec:
             - message: Each VPC Egress Gateway MUST have at least one policy or selector
               rule: (has(self.policies) && size(self.policies) != 0) || (has(self.selectors)
                 && size(self.selectors) != 0)
+            - fieldPath: .loadBalanceMode
+              message: loadBalanceMode Pinned cannot be used with trafficPolicy Local
+              rule: '!has(self.loadBalanceMode) || self.loadBalanceMode != ''Pinned''
+                || !has(self.trafficPolicy) || self.trafficPolicy != ''Local'''
           status:
             properties:
               conditions:
@@ -4967,6 +6170,29 @@ spec:
                 - Processing
                 - Completed
                 type: string
+              pinning:
+                description: source to external IP assignments, only populated when
+                  loadBalanceMode is Pinned
+                items:
+                  description: VpcEgressGatewaySourcePinning records the external
+                    IP a source is pinned to.
+                  properties:
+                    externalIP:
+                      description: external IP(s) the source is translated to
+                      type: string
+                    replica:
+                      description: name of the replica pod currently holding the external
+                        IP
+                      type: string
+                    source:
+                      description: pod IP or policy CIDR
+                      type: string
+                    standbyReplica:
+                      description: name of the replica pod taking over the external
+                        IP on failure
+                      type: string
+                  type: object
+                type: array
               ready:
                 default: false
                 description: whether the egress gateway is ready
@@ -6080,6 +7306,38 @@ spec:
                     description: BGP router ID
                     type: string
                 type: object
+              conntrackSync:
+                description: Conntrack state synchronization between gateway replicas
+                  so that established connections survive failover
+                properties:
+                  enabled:
+                    default: false
+                    description: Enable conntrack state synchronization
+                    type: boolean
+                  multicastAddress:
+                    description: |-
+                      IPv4 multicast address used to exchange the conntrack updates. Defaults to an address in 239.192.0.0/14
+                      derived from the name of the gateway, so that the gateways sharing a network do not receive the updates of each other.
+                    type: string
+                  networkAttachment:
+                    description: |-
+                      NetworkAttachmentDefinition in the form of namespace/name, attached to the gateway pods as the interface
+                      carrying the conntrack updates. The interface must have an IPv4 address.
+                    type: string
+                  port:
+                    default: 3780
+                    description: UDP port of the multicast group used to exchange
+                      the conntrack updates
+                    format: int32
+                    maximum: 65535
+                    minimum: 1
+                    type: integer
+                type: object
+                x-kubernetes-validations:
+                - message: networkAttachment is required when conntrack synchronization
+                    is enabled
+                  rule: '!self.enabled || (has(self.networkAttachment) && self.networkAttachment
+                    != '''')'
               externalSubnets:
                 description: External subnets accessible through the NAT gateway
                 items:
@@ -7105,6 +8363,35 @@ spec:
                         x-kubernetes-list-type: atomic
                     type: object
                 type: object
+              conntrackSync:
+                description: Conntrack state synchronization between the gateway replicas
+                properties:
+                  enabled:
+                    description: Whether conntrack state synchronization is enabled
+                    type: boolean
+                  multicastAddress:
+                    description: Multicast address the synchronization has been configured
+                      with
+                    type: string
+                  networkAttachment:
+                    description: Network attachment the synchronization has been configured
+                      with
+                    type: string
+                  port:
+                    description: Port the synchronization has been configured with
+                    format: int32
+                    type: integer
+                  readyReplicas:
+                    description: Number of replicas running a ready conntrack synchronization
+                      daemon
+                    format: int32
+                    type: integer
+                  syncedPods:
+                    description: Names of the pods synchronizing their conntrack state
+                    items:
+                      type: string
+                    type: array
+                type: object
               externalSubnets:
                 description: External subnets configured for the NAT gateway
                 items:
@@ -7348,6 +8635,21 @@ spec:
                 items:
                   type: string
                 type: array
+              interconnection:
+                description: Interconnection stretches the VPC across the clusters
+                  connected via OVN-IC
+                properties:
+                  enabled:
+                    default: false
+                    description: |-
+                      Enable connecting the VPC router to the transit switch ts-<vpc name> dedicated to the VPC.
+                      The VPC must have the same name in all the clusters it spans.
+                    type: boolean
+                  subnet:
+                    description: CIDR of the transit switch, must be the same in all
+                      the clusters and must not overlap with the VPC subnets
+                    type: string
+                type: object
               namespaces:
                 description: List of namespaces that can use this VPC
                 items:
@@ -7590,6 +8892,7 @@ rules:
       - ippools
       - ippools/status
       - ips
+      - ips/status
       - vips
       - vips/status
       - vlans
@@ -7625,6 +8928,14 @@ rules:
       - qos-policies
       - qos-policies/status
       - bgp-confs
+      - bgp-speaker-statuses
+      - bgp-speaker-statuses/status
+      - connectivity-reports
+      - connectivity-reports/status
+      - connectivity-matrices
+      - connectivity-matrices/status
+      - interconnection-configs
+      - interconnection-configs/status
       - evpn-confs
     verbs:
       - create
@@ -7953,6 +9264,12 @@ rules:
     verbs:
       - get
       - update
+  - apiGroups:
+      - "kubeovn.io"
+    resources:
+      - ips/status
+    verbs:
+      - patch
   - apiGroups:
       - ""
     resources:
@@ -8089,6 +9406,7 @@ rules:
     resources:
       - pods
       - nodes
+      - services
     verbs:
       - get
       - list
@@ -8098,6 +9416,21 @@ rules:
       - daemonsets
     verbs:
       - get
+  - apiGroups:
+      - "kubeovn.io"
+    resources:
+      - connectivity-reports
+      - connectivity-reports/status
+    verbs:
+      - get
+      - create
+      - update
+  - apiGroups:
+      - "kubeovn.io"
+    resources:
+      - subnets
+    verbs:
+      - get
   - apiGroups:
       - authentication.k8s.io
     resources:
@@ -8145,6 +9478,74 @@ kubectl apply -f kube-ovn-sa.yaml
 kubectl apply -f kube-ovn-cni-sa.yaml
 kubectl apply -f kube-ovn-app-sa.yaml
 
+if [ "$ENABLE_NAT_GW_AGENT" = "true" ]; then
+cat <<EOF > vpc-nat-gw-sa.yaml
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: vpc-nat-gw
+  namespace: kube-system
+automountServiceAccountToken: false
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  annotations:
+    rbac.authorization.k8s.io/system-only: "true"
+  name: system:vpc-nat-gw-agent
+rules:
+  - apiGroups:
+      - ""
+    resources:
+      - configmaps
+    verbs:
+      - list
+      - watch
+  - apiGroups:
+      - kubeovn.io
+    resources:
+      - iptables-eips
+      - iptables-fip-rules
+      - iptables-dnat-rules
+      - iptables-snat-rules
+    verbs:
+      - get
+  - apiGroups:
+      - kubeovn.io
+    resources:
+      - iptables-eips/status
+      - iptables-fip-rules/status
+      - iptables-dnat-rules/status
+      - iptables-snat-rules/status
+    verbs:
+      - patch
+  - apiGroups:
+      - coordination.k8s.io
+    resources:
+      - leases
+    verbs:
+      - get
+      - create
+      - update
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: vpc-nat-gw-agent
+roleRef:
+  name: system:vpc-nat-gw-agent
+  kind: ClusterRole
+  apiGroup: rbac.authorization.k8s.io
+subjects:
+  - kind: ServiceAccount
+    name: vpc-nat-gw
+    namespace: kube-system
+EOF
+
+kubectl apply -f vpc-nat-gw-sa.yaml
+fi
+
 cat <<EOF > ovn.yaml
 ${OVN_CENTRAL_PVC_BLOCK}
 ---
@@ -8772,6 +10173,8 @@ metadata:
       kube-ovn vpc-nat common config
 data:
   image: $REGISTRY/$VPC_NAT_IMAGE:$VERSION
+  enableAgent: "$ENABLE_NAT_GW_AGENT"
+  apiNadProvider: "$NAT_GW_API_NAD_PROVIDER"
 ---
 kind: ConfigMap
 apiVersion: v1
@@ -8884,9 +10287,13 @@ spec:
           - --alsologtostderr=true
           - --gc-interval=$GC_INTERVAL
           - --inspect-interval=$INSPECT_INTERVAL
+          - --drift-check-interval=$DRIFT_CHECK_INTERVAL
+          - --nat-gw-counter-interval=$NAT_GW_COUNTER_INTERVAL
+          - --nat-counter-status=$ENABLE_NAT_COUNTER_STATUS
           - --log_file=/var/log/kube-ovn/kube-ovn-controller.log
           - --log_file_max_size=200
           - --enable-lb-svc=$ENABLE_LB_SVC
+          - --enable-tracing=$ENABLE_TRACING
           - --keep-vm-ip=$ENABLE_KEEP_VM_IP
           - --enable-metrics=$ENABLE_METRICS
           - --node-local-dns-ip=$NODE_LOCAL_DNS_IP
@@ -9097,6 +10504,7 @@ spec:
           - --kubelet-dir=$KUBELET_DIR
           - --enable-tproxy=$ENABLE_TPROXY
           - --ovs-vsctl-concurrency=$OVS_VSCTL_CONCURRENCY
+          - --ovn-eip-counter-interval=$OVN_EIP_COUNTER_INTERVAL
           - --secure-serving=${SECURE_SERVING}
           - --enable-ovn-ipsec=$ENABLE_OVN_IPSEC
           - --cert-manager-ipsec-cert=$CERT_MANAGER_IPSEC_CERT
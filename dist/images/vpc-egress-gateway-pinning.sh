@@ -0,0 +1,96 @@
+#!/bin/bash
+# Configures the pinned external IPs a vpc egress gateway replica takes over from failed replicas.
+#
+# Usage: vpc-egress-gateway-pinning.sh <own external IPs> [hold,<ip>]... [snat,<source>,<ip>]...
+#   hold,<ip>           adds the external IP to the external interface and announces it
+#   snat,<source>,<ip>  translates the source to the external IP instead of masquerading it
+# Held IPs and SNAT rules missing from the arguments are removed.
+
+set -e
+
+masquerade_chain="VEG-MASQUERADE"
+pinning_chain="VEG-PINNING"
+
+own_ips=(${1//,/ })
+shift
+
+declare -A holds
+snat_ipv4=()
+snat_ipv6=()
+for arg in "$@"; do
+  IFS=, read -r kind first second <<< "${arg}"
+  case "${kind}" in
+    hold)
+      holds["${first}"]=1
+      ;;
+    snat)
+      if [[ "${second}" == *:* ]]; then
+        snat_ipv6+=("${first},${second}")
+      else
+        snat_ipv4+=("${first},${second}")
+      fi
+      ;;
+    *)
+      echo "invalid argument ${arg}" >&2
+      exit 1
+      ;;
+  esac
+done
+
+external_iface=""
+prefix_ipv4=""
+prefix_ipv6=""
+for own_ip in ${own_ips[*]}; do
+  addr=`ip -o addr show scope global | awk -v ip="${own_ip}" '{split($4, a, "/")} a[1] == ip {print $2, a[2]; exit}'`
+  if [ -z "${addr}" ]; then
+    echo "external IP ${own_ip} is not found" >&2
+    exit 1
+  fi
+  external_iface=${addr% *}
+  external_iface=${external_iface%@*}
+  if [[ "${own_ip}" == *:* ]]; then
+    prefix_ipv6=${addr#* }
+  else
+    prefix_ipv4=${addr#* }
+  fi
+done
+
+for addr in `ip -o addr show dev "${external_iface}" scope global | awk '{print $4}'`; do
+  held_ip=${addr%/*}
+  if [[ " ${own_ips[*]} " != *" ${held_ip} "* ]] && [ -z "${holds[${held_ip}]}" ]; then
+    ip addr del "${addr}" dev "${external_iface}"
+  fi
+done
+
+for held_ip in "${!holds[@]}"; do
+  if [[ "${held_ip}" == *:* ]]; then
+    ip -6 addr replace "${held_ip}/${prefix_ipv6:?no own IPv6 external IP}" dev "${external_iface}" nodad
+  else
+    ip -4 addr replace "${held_ip}/${prefix_ipv4:?no own IPv4 external IP}" dev "${external_iface}"
+    arping -I "${external_iface}" -c 3 -U "${held_ip}"
+  fi
+done
+
+configure_snat() {
+  local iptables=$1
+  shift
+  # the masquerade chain is not created when the sources are advertised with BGP
+  if ! ${iptables} -t nat -S ${masquerade_chain} 1 &>/dev/null; then
+    return 0
+  fi
+
+  {
+    echo "*nat"
+    echo ":${pinning_chain} - [0:0]"
+    for entry in "$@"; do
+      echo "-A ${pinning_chain} -s ${entry%,*} -o ${external_iface} -j SNAT --to-source ${entry#*,}"
+    done
+    echo "COMMIT"
+  } | ${iptables}-restore --noflush
+  if ! ${iptables} -t nat -C ${masquerade_chain} -j ${pinning_chain} &>/dev/null; then
+    ${iptables} -t nat -I ${masquerade_chain} 2 -j ${pinning_chain}
+  fi
+}
+
+configure_snat iptables "${snat_ipv4[@]}"
+configure_snat ip6tables "${snat_ipv6[@]}"
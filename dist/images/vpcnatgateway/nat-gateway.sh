@@ -47,10 +47,12 @@ QOS_DEBUG=${QOS_DEBUG:-"false"}
 
 iptables_cmd=$(which iptables)
 iptables_save_cmd=$(which iptables-save)
+iptables_restore_cmd=$(which iptables-restore)
 if iptables-legacy -t nat -S INPUT 1 2>/dev/null; then
     # use iptables-legacy for centos 7
     iptables_cmd=$(which iptables-legacy)
     iptables_save_cmd=$(which iptables-legacy-save)
+    iptables_restore_cmd=$(which iptables-legacy-restore)
 fi
 
 function show_help() {
@@ -77,6 +79,8 @@ function show_help() {
     echo "  nft-dnat-map-del         - Delete nft map-based DNAT rule (Share type)"
     echo "  snat-add                 - Add SNAT rule"
     echo "  snat-del                 - Delete SNAT rule"
+    echo "  snat-port-block-add      - Add deterministic SNAT port blocks"
+    echo "  snat-port-block-del      - Delete deterministic SNAT port blocks"
     echo "  qos-add                  - Add QoS rule"
     echo "  qos-del                  - Delete QoS rule"
     echo "  eip-ingress-qos-add      - Add EIP ingress QoS"
@@ -84,6 +88,7 @@ function show_help() {
     echo "  eip-ingress-qos-del      - Delete EIP ingress QoS"
     echo "  eip-egress-qos-del       - Delete EIP egress QoS"
     echo "  get-iptables-version     - Show iptables version"
+    echo "  get-counters             - Show traffic counters of EIPs and NAT rules"
     echo ""
     echo "Examples:"
     echo "  # Use custom interfaces"
@@ -254,6 +259,62 @@ function del_vpc_external_route() {
     # done
 }
 
+# Traffic counters: the connections translated by an EIP or a NAT rule are counted in the mangle table
+# on the external interface, matched by their conntrack tuples so that both the original and the reply
+# packets are counted whatever the NAT applied to them. Every counter has a rule per direction:
+#   -A NAT_COUNTER_IN <match> -m comment --comment <id>
+#   -A NAT_COUNTER_OUT <match> -m comment --comment <id>
+# The counter ids are eip:<eip>, fip:<eip>, dnat:<eip>:<protocol>:<port> and snat:<eip>:<internalCIDR>.
+# A counter id matching several conntrack tuples has disjoint rules, so that a packet is counted once per id.
+# The first packet of an outgoing connection is not counted as it is translated after the mangle table.
+function ensure_counter_chains() {
+    $iptables_cmd -t mangle -N NAT_COUNTER_IN >/dev/null 2>&1
+    $iptables_cmd -t mangle -N NAT_COUNTER_OUT >/dev/null 2>&1
+    if ! $iptables_cmd -t mangle -C PREROUTING -i $EXTERNAL_INTERFACE -j NAT_COUNTER_IN >/dev/null 2>&1; then
+        exec_cmd "$iptables_cmd -t mangle -A PREROUTING -i $EXTERNAL_INTERFACE -j NAT_COUNTER_IN"
+    fi
+    if ! $iptables_cmd -t mangle -C POSTROUTING -o $EXTERNAL_INTERFACE -j NAT_COUNTER_OUT >/dev/null 2>&1; then
+        exec_cmd "$iptables_cmd -t mangle -A POSTROUTING -o $EXTERNAL_INTERFACE -j NAT_COUNTER_OUT"
+    fi
+}
+
+function add_counter() {
+    # add_counter <id> <match...>
+    local id=$1
+    shift
+    ensure_counter_chains
+    for chain in NAT_COUNTER_IN NAT_COUNTER_OUT; do
+        if ! $iptables_cmd -t mangle -C $chain "$@" -m comment --comment "$id" >/dev/null 2>&1; then
+            exec_cmd "$iptables_cmd -t mangle -A $chain $* -m comment --comment $id"
+        fi
+    done
+}
+
+function del_counter() {
+    # del_counter <id> <match...>
+    local id=$1
+    shift
+    for chain in NAT_COUNTER_IN NAT_COUNTER_OUT; do
+        while $iptables_cmd -t mangle -C $chain "$@" -m comment --comment "$id" >/dev/null 2>&1; do
+            exec_cmd "$iptables_cmd -t mangle -D $chain $* -m comment --comment $id"
+        done
+    done
+}
+
+function get_counters() {
+    # prints a "<chain> <packets> <bytes> <id>" line per counter rule
+    $iptables_save_cmd -c -t mangle | awk '$2 == "-A" && ($3 == "NAT_COUNTER_IN" || $3 == "NAT_COUNTER_OUT") {
+        split(substr($1, 2, length($1) - 2), counters, ":")
+        for (i = 4; i < NF; i++) {
+            if ($i == "--comment") {
+                id = $(i + 1)
+                gsub(/"/, "", id)
+                print $3, counters[1], counters[2], id
+            }
+        }
+    }'
+}
+
 function add_eip() {
     # make sure inited
     check_inited
@@ -263,6 +324,10 @@ function add_eip() {
         eip_without_prefix=(${eip//\// })
         exec_cmd "ip addr replace $eip dev $EXTERNAL_INTERFACE"
         exec_cmd "arping -I $EXTERNAL_INTERFACE -c 3 -U $eip_without_prefix"
+        # connections to the EIP and connections translated to the EIP, the connections of a hairpin
+        # are both to and translated to the EIP and must only be counted once
+        add_counter "eip:$eip_without_prefix" -m conntrack --ctorigdst $eip_without_prefix
+        add_counter "eip:$eip_without_prefix" -m conntrack --ctrepldst $eip_without_prefix ! --ctorigdst $eip_without_prefix
 
         # Add hairpin SNAT rule for this EIP
         # This rule SNATs traffic originating from the VPC and targeting an EIP back to the same EIP
@@ -300,6 +365,8 @@ function del_eip() {
         if [ -n "$ipCidr" ]; then
             exec_cmd "ip addr del $ipCidr dev $EXTERNAL_INTERFACE"
         fi
+        del_counter "eip:$eip_without_prefix" -m conntrack --ctorigdst $eip_without_prefix
+        del_counter "eip:$eip_without_prefix" -m conntrack --ctrepldst $eip_without_prefix ! --ctorigdst $eip_without_prefix
 
         # Remove hairpin SNAT rule for this EIP
         local hairpin_rule="-m mark --mark 0x1/0x1 -o $VPC_INTERFACE -m conntrack --ctstate DNAT --ctorigdst $eip_without_prefix -j SNAT --to-source $eip_without_prefix"
@@ -329,6 +396,9 @@ function add_floating_ip() {
         arr=(${rule//,/ })
         eip=(${arr[0]//\// })
         internalIp=${arr[1]}
+        # the EIP of a FIP is not shared, all the connections of the EIP are counted
+        add_counter "fip:$eip" -m conntrack --ctorigdst $eip
+        add_counter "fip:$eip" -m conntrack --ctrepldst $eip ! --ctorigdst $eip
         # check if DNAT rule already exists for this eip: match "-d <eip>/32"
         existingRule=$($iptables_save_cmd | grep EXCLUSIVE_DNAT | grep -w -- "-d $eip/32")
         if [ -n "$existingRule" ]; then
@@ -358,6 +428,8 @@ function del_floating_ip() {
     check_inited
     for eip in "$@"
     do
+        del_counter "fip:$eip" -m conntrack --ctorigdst $eip
+        del_counter "fip:$eip" -m conntrack --ctrepldst $eip ! --ctorigdst $eip
         # delete DNAT rule: match "-d <eip>/32" (/32 suffix prevents prefix match)
         # head -1: FIP is 1:1, at most one rule per EIP; guard against unexpected duplicates
         dnatRule=$($iptables_save_cmd | grep EXCLUSIVE_DNAT | grep -w -- "-d $eip/32" | head -1)
@@ -376,6 +448,26 @@ function del_floating_ip() {
     done
 }
 
+# shared_snat_position prints the position to insert a SHARED_SNAT rule of the given source prefix length at:
+# 1 + number of existing SHARED_SNAT rules (SNAT rules and port block jumps) whose source CIDR prefix length is
+# greater than ("gt") or greater than or equal to ("ge") the new one. This keeps the chain sorted by descending
+# prefix length so longer/more-specific matches are evaluated first.
+function shared_snat_position() {
+    local rules=$1
+    local prefix=$2
+    local op=$3
+    echo "$rules" | awk -v p="$prefix" -v op="$op" '
+        /^-A SHARED_SNAT / {
+            if (match($0, /-s [0-9.]+\/[0-9]+/)) {
+                s = substr($0, RSTART, RLENGTH)
+                sub(/.*\//, "", s)
+                if (s + 0 > p + 0 || (op == "ge" && s + 0 == p + 0)) n++
+            }
+        }
+        END { print n + 1 }
+    '
+}
+
 function add_snat() {
     # Validation before adding (SNAT identity = (EIP, InternalCIDR), 1:N model):
     # One EIP can serve multiple CIDRs, and one CIDR can have multiple EIPs
@@ -405,29 +497,18 @@ function add_snat() {
         eip=(${arr[0]//\// })
         internalCIDR=${arr[1]}
         randomFullyOption=${arr[2]}
+        add_counter "snat:$eip:$internalCIDR" -m conntrack --ctorigsrc $internalCIDR --ctrepldst $eip
         # check if exact (eip, internalCIDR) pair already exists (idempotent)
         ruleMatch=$(echo "$all_shared_snat_rules" | grep -w -- "-s $internalCIDR" | grep -E -- "--to-source $eip(\$| )")
         if [ -n "$ruleMatch" ]; then
             continue
         fi
-        # Compute insert position: 1 + number of existing SHARED_SNAT rules whose
-        # source CIDR prefix length is >= new rule's prefix length. This keeps the
-        # chain sorted by descending prefix length so longer/more-specific matches
-        # are evaluated first. The controller normalizes bare IPv4 inputs to
-        # "<ip>/32" before sending (see normalizeSnatInternalCIDR), so every
-        # internalCIDR here is guaranteed to carry an explicit prefix length.
-        local new_prefix=${internalCIDR##*/}
+        # Insert after all existing rules whose prefix length is >= the new one.
+        # The controller normalizes bare IPv4 inputs to "<ip>/32" before sending
+        # (see normalizeSnatInternalCIDR), so every internalCIDR here is
+        # guaranteed to carry an explicit prefix length.
         local pos
-        pos=$(echo "$all_shared_snat_rules" | awk -v p="$new_prefix" '
-            /^-A SHARED_SNAT / {
-                if (match($0, /-s [0-9.]+\/[0-9]+/)) {
-                    s = substr($0, RSTART, RLENGTH)
-                    sub(/.*\//, "", s)
-                    if (s + 0 >= p + 0) n++
-                }
-            }
-            END { print n + 1 }
-        ')
+        pos=$(shared_snat_position "$all_shared_snat_rules" "${internalCIDR##*/}" ge)
         exec_cmd "$iptables_cmd -t nat -I SHARED_SNAT $pos -o $EXTERNAL_INTERFACE -s $internalCIDR -j SNAT --to-source $eip $randomFullyOption"
         # Keep the local snapshot in sync so subsequent iterations in this
         # invocation compute position correctly.
@@ -448,6 +529,7 @@ function del_snat() {
         arr=(${rule//,/ })
         eip=(${arr[0]//\// })
         internalCIDR=${arr[1]}
+        del_counter "snat:$eip:$internalCIDR" -m conntrack --ctorigsrc $internalCIDR --ctrepldst $eip
         # check if already exist
         ruleMatch=$(echo "$all_shared_snat_rules" | grep -w -- "-s $internalCIDR" | grep -E -- "--to-source $eip(\$| )" | head -1)
         if [ -n "$ruleMatch" ]; then
@@ -457,6 +539,110 @@ function del_snat() {
     done
 }
 
+function ip_to_int() {
+    local a b c d
+    IFS=. read -r a b c d <<< "$1"
+    echo $(( (a << 24) + (b << 16) + (c << 8) + d ))
+}
+
+function int_to_ip() {
+    local n=$1
+    echo "$(( (n >> 24) & 255 )).$(( (n >> 16) & 255 )).$(( (n >> 8) & 255 )).$(( n & 255 ))"
+}
+
+# The port block rules of a SNAT rule live in a dedicated chain named after its identity (EIP, InternalCIDR),
+# iptables chain names are limited to 28 characters.
+function snat_port_block_chain() {
+    echo "SNAT_PB_$(echo -n "$1,$2" | md5sum | cut -c1-16)"
+}
+
+function add_snat_port_block() {
+    # Deterministic port block allocation (CGNAT style, see RFC 7422):
+    # address i of internalCIDR is translated to eip:[portStart + i*blockSize, portStart + (i+1)*blockSize - 1]
+    # for TCP, UDP and SCTP. The controller validates that the port range holds a block for every address and
+    # computes the same mapping for the compliance records, both sides must stay in sync.
+    # Other protocols (ICMP) fall through to the SHARED_SNAT rule of the same (eip, internalCIDR).
+    #
+    # iptables-save output format:
+    #   -A SHARED_SNAT -s <internalCIDR> -o <ext_iface> -m comment --comment "port-block:<start>:<size>" -j SNAT_PB_<hash>
+    #   -A SNAT_PB_<hash> -s <ip>/32 -p tcp -j SNAT --to-source <eip>:<start>-<end>
+    check_inited
+    for rule in "$@"
+    do
+        arr=(${rule//,/ })
+        eip=(${arr[0]//\// })
+        internalCIDR=${arr[1]}
+        portStart=${arr[2]}
+        blockSize=${arr[3]}
+        chain=$(snat_port_block_chain "$eip" "$internalCIDR")
+        local comment="port-block:$portStart:$blockSize"
+        local jump
+        jump=$($iptables_save_cmd -t nat | grep -- "-A SHARED_SNAT .*-j $chain\$")
+        if [ -n "$jump" ]; then
+            if echo "$jump" | grep -qE -- "--comment \"?$comment\"? "; then
+                continue
+            fi
+            # the port range or the block size changed, re-create the port blocks
+            del_snat_port_block "$eip,$internalCIDR"
+        fi
+
+        local network=${internalCIDR%/*}
+        local prefix=${internalCIDR##*/}
+        local count=$(( 1 << (32 - prefix) ))
+        local base=$(( $(ip_to_int "$network") & ~(count - 1) & 0xffffffff ))
+        local rules="*nat
+:$chain - [0:0]"
+        for (( i = 0; i < count; i++ )); do
+            local ip
+            ip=$(int_to_ip $(( base + i )))
+            local lo=$(( portStart + i * blockSize ))
+            local hi=$(( lo + blockSize - 1 ))
+            for proto in tcp udp sctp; do
+                rules="$rules
+-A $chain -s $ip/32 -p $proto -j SNAT --to-source $eip:$lo-$hi"
+            done
+        done
+        # evaluated before the SHARED_SNAT rule of the same internalCIDR so that the port blocks take precedence,
+        # but after the rules of more specific CIDRs
+        local pos
+        pos=$(shared_snat_position "$($iptables_save_cmd -t nat | grep SHARED_SNAT)" "$prefix" gt)
+        rules="$rules
+-I SHARED_SNAT $pos -o $EXTERNAL_INTERFACE -s $internalCIDR -m comment --comment \"$comment\" -j $chain
+COMMIT"
+        echo "$rules" | $iptables_restore_cmd --noflush
+        ret=$?
+        if [ $ret -ne 0 ]; then
+            >&2 echo "failed to add snat port blocks of $eip,$internalCIDR"
+            exit $ret
+        fi
+    done
+}
+
+function del_snat_port_block() {
+    check_inited
+    for rule in "$@"
+    do
+        arr=(${rule//,/ })
+        eip=(${arr[0]//\// })
+        internalCIDR=${arr[1]}
+        chain=$(snat_port_block_chain "$eip" "$internalCIDR")
+        ruleMatch=$($iptables_save_cmd -t nat | grep -- "-A SHARED_SNAT .*-j $chain\$" | head -1)
+        if [ -n "$ruleMatch" ]; then
+            ruleMatch=$(echo "$ruleMatch" | sed 's/^-A //')
+            exec_cmd "$iptables_cmd -t nat -D $ruleMatch"
+        fi
+        if $iptables_cmd -t nat -S "$chain" >/dev/null 2>&1; then
+            exec_cmd "$iptables_cmd -t nat -F $chain"
+            exec_cmd "$iptables_cmd -t nat -X $chain"
+        fi
+        # drop the connections translated to the released port blocks so that the records stay accurate
+        local prefix=${internalCIDR##*/}
+        local mask
+        mask=$(int_to_ip $(( (0xffffffff << (32 - prefix)) & 0xffffffff )))
+        conntrack -D -s "${internalCIDR%/*}" --mask-src "$mask" --src-nat 2>/dev/null || true
+    done
+}
+
 # Hairpin SNAT: Enables internal VM to access another internal VM's EIP/FIP
 # Packet flow when VM A (internal) accesses VM B's EIP (external IP):
 # 1. VM A (10.0.1.6) -> EIP (10.1.69.216) arrives at NAT GW via VPC_INTERFACE
@@ -486,6 +672,7 @@ function add_dnat() {
         protocol=${arr[2]}
         internalIp=${arr[3]}
         internalPort=${arr[4]}
+        add_counter "dnat:$eip:${protocol,,}:$dport" -m conntrack --ctproto ${protocol,,} --ctorigdst $eip --ctorigdstport $dport
         # check if identity triplet (eip, dport, protocol) already exists
         existingRule=$($iptables_save_cmd | grep SHARED_DNAT | grep -w -- "-d $eip/32" | grep -w -- "-p $protocol" | grep -w "dport $dport")
         if [ -n "$existingRule" ]; then
@@ -516,6 +703,7 @@ function del_dnat() {
         eip=(${arr[0]//\// })
         dport=${arr[1]}
         protocol=${arr[2]}
+        del_counter "dnat:$eip:${protocol,,}:$dport" -m conntrack --ctproto ${protocol,,} --ctorigdst $eip --ctorigdstport $dport
         # match by identity triplet; head -1 guards against unexpected duplicates
         existingRule=$($iptables_save_cmd | grep SHARED_DNAT | grep -w -- "-d $eip/32" | grep -w -- "-p $protocol" | grep -w "dport $dport" | head -1)
         if [ -n "$existingRule" ]; then
@@ -645,6 +833,7 @@ function add_nft_dnat_map() {
         # Map protocol name to nft inet_proto keyword
         local nft_proto
         nft_proto=$(echo "$protocol" | tr '[:upper:]' '[:lower:]')
+        add_counter "dnat:$eip:$nft_proto:$dport" -m conntrack --ctproto $nft_proto --ctorigdst $eip --ctorigdstport $dport
 
         # Atomic transaction:
         # 1. Ensure table, base chain, vmap exist (idempotent)
@@ -716,6 +905,7 @@ function del_nft_dnat_map() {
         local identity_chain nft_proto
         identity_chain=$(nft_identity_chain_name "$eip" "$dport" "$protocol")
         nft_proto=$(echo "$protocol" | tr '[:upper:]' '[:lower:]')
+        del_counter "dnat:$eip:$nft_proto:$dport" -m conntrack --ctproto $nft_proto --ctorigdst $eip --ctorigdstport $dport
 
         # Delete vmap element and per-identity chain.
         # These are separate operations because nft -f is transactional (all-or-nothing):
@@ -1795,6 +1985,14 @@ case $opt in
         echo "snat-del $*"
         del_snat "$@"
         ;;
+    snat-port-block-add)
+        echo "snat-port-block-add $*"
+        add_snat_port_block "$@"
+        ;;
+    snat-port-block-del)
+        echo "snat-port-block-del $*"
+        del_snat_port_block "$@"
+        ;;
     floating-ip-add)
         echo "floating-ip-add $*"
         add_floating_ip "$@"
@@ -1807,6 +2005,9 @@ case $opt in
         echo "get-iptables-version $*"
         get_iptables_version "$@"
         ;;
+    get-counters)
+        get_counters
+        ;;
     help|--help|-h)
         show_help
         ;;
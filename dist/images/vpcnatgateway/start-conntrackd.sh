@@ -0,0 +1,72 @@
+#!/bin/bash
+
+set -ex
+
+CONNTRACKD_CONF=${CONNTRACKD_CONF:-/etc/conntrackd/conntrackd.conf}
+# the interface of the network attachment dedicated to the synchronization
+CONNTRACKD_INTERFACE=${CONNTRACKD_INTERFACE:-ctsync0}
+CONNTRACKD_PORT=${CONNTRACKD_PORT:-3780}
+# the multicast group of the gateway, each gateway has its own group
+CONNTRACKD_MCAST_ADDRESS=${CONNTRACKD_MCAST_ADDRESS:?the multicast address of the gateway is required}
+
+# wait for the interface to be configured by the CNI
+for i in $(seq 1 30); do
+  INTERFACE_IP=$(ip -4 -o addr show dev "${CONNTRACKD_INTERFACE}" 2>/dev/null | awk '{print $4}' | cut -d/ -f1 | head -n1)
+  if [ -n "${INTERFACE_IP}" ]; then
+    break
+  fi
+  sleep 1
+done
+if [ -z "${INTERFACE_IP}" ]; then
+  echo "no IPv4 address found on interface ${CONNTRACKD_INTERFACE}"
+  exit 1
+fi
+
+# accept TCP sessions picked up in the middle of the stream after a failover
+sysctl -w net.netfilter.nf_conntrack_tcp_be_liberal=1
+
+mkdir -p "$(dirname "${CONNTRACKD_CONF}")"
+cat > "${CONNTRACKD_CONF}" <<EOF
+Sync {
+    Mode FTFW {
+        # all the replicas forward traffic, inject the updates of the peers into the kernel directly
+        DisableExternalCache on
+    }
+    Multicast {
+        IPv4_address ${CONNTRACKD_MCAST_ADDRESS}
+        Group ${CONNTRACKD_PORT}
+        IPv4_interface ${INTERFACE_IP}
+        Interface ${CONNTRACKD_INTERFACE}
+        SndSocketBuffer 1249280
+        RcvSocketBuffer 1249280
+        Checksum on
+    }
+}
+
+General {
+    HashSize 32768
+    HashLimit 131072
+    LogFile off
+    Syslog off
+    LockFile /var/run/conntrackd.lock
+    UNIX {
+        Path /var/run/conntrackd.ctl
+    }
+    NetlinkBufferSize 2097152
+    NetlinkBufferSizeMaxGrowth 8388608
+    Filter From Userspace {
+        Protocol Accept {
+            TCP SCTP DCCP UDP ICMP IPv6-ICMP
+        }
+        Address Ignore {
+            IPv4_address 127.0.0.1
+            IPv4_address ${INTERFACE_IP}
+        }
+    }
+}
+EOF
+
+# request the state of the peers once the daemon is running
+(sleep 3 && conntrackd -C "${CONNTRACKD_CONF}" -n) &
+
+exec conntrackd -C "${CONNTRACKD_CONF}"
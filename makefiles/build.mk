@@ -109,8 +109,16 @@ image-kube-ovn-debug: gen-crd
 image-kube-ovn-dpdk: gen-crd build-go
 	docker buildx build $(IMAGE_LABELS) --platform linux/amd64 -t $(REGISTRY)/kube-ovn:$(RELEASE_TAG)-dpdk --build-arg VERSION=$(RELEASE_TAG) --build-arg BASE_TAG=$(RELEASE_TAG)-dpdk -o type=docker -f dist/images/Dockerfile dist/images/
 
+.PHONY: build-vpc-nat-gw-agent
+build-vpc-nat-gw-agent:
+	CGO_ENABLED=0 GOOS=linux GOARCH=amd64 go build $(GO_BUILD_FLAGS) -buildmode=pie -o $(CURDIR)/dist/images/vpcnatgateway/vpc-nat-gw-agent -v ./cmd/vpc-nat-gw-agent
+
+.PHONY: build-vpc-nat-gw-agent-arm
+build-vpc-nat-gw-agent-arm:
+	CGO_ENABLED=0 GOOS=linux GOARCH=arm64 go build $(GO_BUILD_FLAGS) -buildmode=pie -o $(CURDIR)/dist/images/vpcnatgateway/vpc-nat-gw-agent -v ./cmd/vpc-nat-gw-agent
+
 .PHONY: image-vpc-nat-gateway
-image-vpc-nat-gateway:
+image-vpc-nat-gateway: build-vpc-nat-gw-agent
 	docker buildx build $(IMAGE_LABELS) --platform linux/amd64 -t $(REGISTRY)/vpc-nat-gateway:$(RELEASE_TAG) -o type=docker -f dist/images/vpcnatgateway/Dockerfile dist/images/vpcnatgateway
 
 .PHONY: image-test
@@ -121,7 +129,7 @@ image-test: build-go
 release: lint image-kube-ovn image-vpc-nat-gateway
 
 .PHONY: release-arm
-release-arm: release-arm-debug image-kube-ovn-arm64
+release-arm: release-arm-debug image-kube-ovn-arm64 build-vpc-nat-gw-agent-arm
 	docker buildx build $(IMAGE_LABELS) --platform linux/arm64 -t $(REGISTRY)/vpc-nat-gateway:$(RELEASE_TAG) -o type=docker -f dist/images/vpcnatgateway/Dockerfile dist/images/vpcnatgateway
 
 .PHONY: release-arm-debug
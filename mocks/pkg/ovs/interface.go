@@ -50,6 +50,34 @@ func (m *MockVswitch) EXPECT() *MockVswitchMockRecorder {
 	return m.recorder
 }
 
+// CleanDuplicatePort mocks base method.
+func (m *MockVswitch) CleanDuplicatePort(ifaceID, portName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanDuplicatePort", ifaceID, portName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CleanDuplicatePort indicates an expected call of CleanDuplicatePort.
+func (mr *MockVswitchMockRecorder) CleanDuplicatePort(ifaceID, portName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanDuplicatePort", reflect.TypeOf((*MockVswitch)(nil).CleanDuplicatePort), ifaceID, portName)
+}
+
+// ClearPodBandwidth mocks base method.
+func (m *MockVswitch) ClearPodBandwidth(podName, podNamespace string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearPodBandwidth", podName, podNamespace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearPodBandwidth indicates an expected call of ClearPodBandwidth.
+func (mr *MockVswitchMockRecorder) ClearPodBandwidth(podName, podNamespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearPodBandwidth", reflect.TypeOf((*MockVswitch)(nil).ClearPodBandwidth), podName, podNamespace)
+}
+
 // Close mocks base method.
 func (m *MockVswitch) Close() {
 	m.ctrl.T.Helper()
@@ -62,6 +90,62 @@ func (mr *MockVswitchMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockVswitch)(nil).Close))
 }
 
+// ConfigInterfaceMirror mocks base method.
+func (m *MockVswitch) ConfigInterfaceMirror(globalMirror bool, open, ifaceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigInterfaceMirror", globalMirror, open, ifaceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfigInterfaceMirror indicates an expected call of ConfigInterfaceMirror.
+func (mr *MockVswitchMockRecorder) ConfigInterfaceMirror(globalMirror, open, ifaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigInterfaceMirror", reflect.TypeOf((*MockVswitch)(nil).ConfigInterfaceMirror), globalMirror, open, ifaceID)
+}
+
+// CreateMirror mocks base method.
+func (m *MockVswitch) CreateMirror(bridgeName, portName string, internal, selectAll bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMirror", bridgeName, portName, internal, selectAll)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMirror indicates an expected call of CreateMirror.
+func (mr *MockVswitchMockRecorder) CreateMirror(bridgeName, portName, internal, selectAll any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMirror", reflect.TypeOf((*MockVswitch)(nil).CreateMirror), bridgeName, portName, internal, selectAll)
+}
+
+// CreatePort mocks base method.
+func (m *MockVswitch) CreatePort(bridgeName, portName string, iface *vswitch.Interface) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePort", bridgeName, portName, iface)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePort indicates an expected call of CreatePort.
+func (mr *MockVswitchMockRecorder) CreatePort(bridgeName, portName, iface any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePort", reflect.TypeOf((*MockVswitch)(nil).CreatePort), bridgeName, portName, iface)
+}
+
+// DeletePort mocks base method.
+func (m *MockVswitch) DeletePort(bridgeName, portName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePort", bridgeName, portName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePort indicates an expected call of DeletePort.
+func (mr *MockVswitchMockRecorder) DeletePort(bridgeName, portName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePort", reflect.TypeOf((*MockVswitch)(nil).DeletePort), bridgeName, portName)
+}
+
 // Echo mocks base method.
 func (m *MockVswitch) Echo(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -76,6 +160,21 @@ func (mr *MockVswitchMockRecorder) Echo(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Echo", reflect.TypeOf((*MockVswitch)(nil).Echo), arg0)
 }
 
+// GetBridge mocks base method.
+func (m *MockVswitch) GetBridge(name string, ignoreNotFound bool) (*vswitch.Bridge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBridge", name, ignoreNotFound)
+	ret0, _ := ret[0].(*vswitch.Bridge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBridge indicates an expected call of GetBridge.
+func (mr *MockVswitchMockRecorder) GetBridge(name, ignoreNotFound any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBridge", reflect.TypeOf((*MockVswitch)(nil).GetBridge), name, ignoreNotFound)
+}
+
 // GetEntityInfo mocks base method.
 func (m *MockVswitch) GetEntityInfo(entity any) error {
 	m.ctrl.T.Helper()
@@ -90,6 +189,66 @@ func (mr *MockVswitchMockRecorder) GetEntityInfo(entity any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityInfo", reflect.TypeOf((*MockVswitch)(nil).GetEntityInfo), entity)
 }
 
+// GetInterface mocks base method.
+func (m *MockVswitch) GetInterface(name string, ignoreNotFound bool) (*vswitch.Interface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInterface", name, ignoreNotFound)
+	ret0, _ := ret[0].(*vswitch.Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInterface indicates an expected call of GetInterface.
+func (mr *MockVswitchMockRecorder) GetInterface(name, ignoreNotFound any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInterface", reflect.TypeOf((*MockVswitch)(nil).GetInterface), name, ignoreNotFound)
+}
+
+// GetPort mocks base method.
+func (m *MockVswitch) GetPort(name string, ignoreNotFound bool) (*vswitch.Port, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPort", name, ignoreNotFound)
+	ret0, _ := ret[0].(*vswitch.Port)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPort indicates an expected call of GetPort.
+func (mr *MockVswitchMockRecorder) GetPort(name, ignoreNotFound any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPort", reflect.TypeOf((*MockVswitch)(nil).GetPort), name, ignoreNotFound)
+}
+
+// IsHtbQos mocks base method.
+func (m *MockVswitch) IsHtbQos(ifaceID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsHtbQos", ifaceID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsHtbQos indicates an expected call of IsHtbQos.
+func (mr *MockVswitchMockRecorder) IsHtbQos(ifaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsHtbQos", reflect.TypeOf((*MockVswitch)(nil).IsHtbQos), ifaceID)
+}
+
+// IsUserspaceDataPath mocks base method.
+func (m *MockVswitch) IsUserspaceDataPath() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUserspaceDataPath")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUserspaceDataPath indicates an expected call of IsUserspaceDataPath.
+func (mr *MockVswitchMockRecorder) IsUserspaceDataPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserspaceDataPath", reflect.TypeOf((*MockVswitch)(nil).IsUserspaceDataPath))
+}
+
 // ListBridge mocks base method.
 func (m *MockVswitch) ListBridge(needVendorFilter bool, filter func(*vswitch.Bridge) bool) ([]vswitch.Bridge, error) {
 	m.ctrl.T.Helper()
@@ -135,6 +294,64 @@ func (mr *MockVswitchMockRecorder) ListPort(filter any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPort", reflect.TypeOf((*MockVswitch)(nil).ListPort), filter)
 }
 
+// ListQos mocks base method.
+func (m *MockVswitch) ListQos(filter func(*vswitch.QoS) bool) ([]vswitch.QoS, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQos", filter)
+	ret0, _ := ret[0].([]vswitch.QoS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQos indicates an expected call of ListQos.
+func (mr *MockVswitchMockRecorder) ListQos(filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQos", reflect.TypeOf((*MockVswitch)(nil).ListQos), filter)
+}
+
+// ListQueue mocks base method.
+func (m *MockVswitch) ListQueue(filter func(*vswitch.Queue) bool) ([]vswitch.Queue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQueue", filter)
+	ret0, _ := ret[0].([]vswitch.Queue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueue indicates an expected call of ListQueue.
+func (mr *MockVswitchMockRecorder) ListQueue(filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueue", reflect.TypeOf((*MockVswitch)(nil).ListQueue), filter)
+}
+
+// SetInterfaceBandwidth mocks base method.
+func (m *MockVswitch) SetInterfaceBandwidth(podName, podNamespace, ifaceID, ingress, egress, ingressBurst, egressBurst string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetInterfaceBandwidth", podName, podNamespace, ifaceID, ingress, egress, ingressBurst, egressBurst)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetInterfaceBandwidth indicates an expected call of SetInterfaceBandwidth.
+func (mr *MockVswitchMockRecorder) SetInterfaceBandwidth(podName, podNamespace, ifaceID, ingress, egress, ingressBurst, egressBurst any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInterfaceBandwidth", reflect.TypeOf((*MockVswitch)(nil).SetInterfaceBandwidth), podName, podNamespace, ifaceID, ingress, egress, ingressBurst, egressBurst)
+}
+
+// SetNetemQos mocks base method.
+func (m *MockVswitch) SetNetemQos(podName, podNamespace, ifaceID, latency, limit, loss, jitter string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNetemQos", podName, podNamespace, ifaceID, latency, limit, loss, jitter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNetemQos indicates an expected call of SetNetemQos.
+func (mr *MockVswitchMockRecorder) SetNetemQos(podName, podNamespace, ifaceID, latency, limit, loss, jitter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNetemQos", reflect.TypeOf((*MockVswitch)(nil).SetNetemQos), podName, podNamespace, ifaceID, latency, limit, loss, jitter)
+}
+
 // Transact mocks base method.
 func (m *MockVswitch) Transact(method string, operations []ovsdb.Operation) error {
 	m.ctrl.T.Helper()
@@ -2269,6 +2486,36 @@ func (mr *MockACLMockRecorder) DeleteAclsOps(parentName, parentType, direction,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAclsOps", reflect.TypeOf((*MockACL)(nil).DeleteAclsOps), parentName, parentType, direction, externalIDs)
 }
 
+// DeleteNetpolAclsOps mocks base method.
+func (m *MockACL) DeleteNetpolAclsOps(pgName, direction string) ([]ovsdb.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetpolAclsOps", pgName, direction)
+	ret0, _ := ret[0].([]ovsdb.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetpolAclsOps indicates an expected call of DeleteNetpolAclsOps.
+func (mr *MockACLMockRecorder) DeleteNetpolAclsOps(pgName, direction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetpolAclsOps", reflect.TypeOf((*MockACL)(nil).DeleteNetpolAclsOps), pgName, direction)
+}
+
+// ListPortGroupAcls mocks base method.
+func (m *MockACL) ListPortGroupAcls(pgName, direction string) ([]ovnnb.ACL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPortGroupAcls", pgName, direction)
+	ret0, _ := ret[0].([]ovnnb.ACL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPortGroupAcls indicates an expected call of ListPortGroupAcls.
+func (mr *MockACLMockRecorder) ListPortGroupAcls(pgName, direction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortGroupAcls", reflect.TypeOf((*MockACL)(nil).ListPortGroupAcls), pgName, direction)
+}
+
 // MigrateACLTier mocks base method.
 func (m *MockACL) MigrateACLTier() error {
 	m.ctrl.T.Helper()
@@ -2387,63 +2634,63 @@ func (mr *MockACLMockRecorder) UpdateDefaultBlockExceptionsACLOps(npName, pgName
 }
 
 // UpdateEgressACLOps mocks base method.
-func (m *MockACL) UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName string, npp []v10.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo) ([]ovsdb.Operation, error) {
+func (m *MockACL) UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName string, npp []v10.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo, stateless bool) ([]ovsdb.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEgressACLOps", pgName, asEgressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap)
+	ret := m.ctrl.Call(m, "UpdateEgressACLOps", pgName, asEgressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap, stateless)
 	ret0, _ := ret[0].([]ovsdb.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateEgressACLOps indicates an expected call of UpdateEgressACLOps.
-func (mr *MockACLMockRecorder) UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap any) *gomock.Call {
+func (mr *MockACLMockRecorder) UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap, stateless any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEgressACLOps", reflect.TypeOf((*MockACL)(nil).UpdateEgressACLOps), pgName, asEgressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEgressACLOps", reflect.TypeOf((*MockACL)(nil).UpdateEgressACLOps), pgName, asEgressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap, stateless)
 }
 
 // UpdateEgressIPBlockACLOps mocks base method.
-func (m *MockACL) UpdateEgressIPBlockACLOps(pgName, protocol, aclName string, ipBlocks []v10.IPBlock, npp []v10.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo) ([]ovsdb.Operation, error) {
+func (m *MockACL) UpdateEgressIPBlockACLOps(pgName, protocol, aclName string, ipBlocks []v10.IPBlock, npp []v10.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo, stateless bool) ([]ovsdb.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEgressIPBlockACLOps", pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap)
+	ret := m.ctrl.Call(m, "UpdateEgressIPBlockACLOps", pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap, stateless)
 	ret0, _ := ret[0].([]ovsdb.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateEgressIPBlockACLOps indicates an expected call of UpdateEgressIPBlockACLOps.
-func (mr *MockACLMockRecorder) UpdateEgressIPBlockACLOps(pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap any) *gomock.Call {
+func (mr *MockACLMockRecorder) UpdateEgressIPBlockACLOps(pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap, stateless any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEgressIPBlockACLOps", reflect.TypeOf((*MockACL)(nil).UpdateEgressIPBlockACLOps), pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEgressIPBlockACLOps", reflect.TypeOf((*MockACL)(nil).UpdateEgressIPBlockACLOps), pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap, stateless)
 }
 
 // UpdateIngressACLOps mocks base method.
-func (m *MockACL) UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName string, npp []v10.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo) ([]ovsdb.Operation, error) {
+func (m *MockACL) UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName string, npp []v10.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo, stateless bool) ([]ovsdb.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateIngressACLOps", pgName, asIngressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap)
+	ret := m.ctrl.Call(m, "UpdateIngressACLOps", pgName, asIngressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap, stateless)
 	ret0, _ := ret[0].([]ovsdb.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateIngressACLOps indicates an expected call of UpdateIngressACLOps.
-func (mr *MockACLMockRecorder) UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap any) *gomock.Call {
+func (mr *MockACLMockRecorder) UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap, stateless any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngressACLOps", reflect.TypeOf((*MockACL)(nil).UpdateIngressACLOps), pgName, asIngressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngressACLOps", reflect.TypeOf((*MockACL)(nil).UpdateIngressACLOps), pgName, asIngressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap, stateless)
 }
 
 // UpdateIngressIPBlockACLOps mocks base method.
-func (m *MockACL) UpdateIngressIPBlockACLOps(pgName, protocol, aclName string, ipBlocks []v10.IPBlock, npp []v10.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo) ([]ovsdb.Operation, error) {
+func (m *MockACL) UpdateIngressIPBlockACLOps(pgName, protocol, aclName string, ipBlocks []v10.IPBlock, npp []v10.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo, stateless bool) ([]ovsdb.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateIngressIPBlockACLOps", pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap)
+	ret := m.ctrl.Call(m, "UpdateIngressIPBlockACLOps", pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap, stateless)
 	ret0, _ := ret[0].([]ovsdb.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateIngressIPBlockACLOps indicates an expected call of UpdateIngressIPBlockACLOps.
-func (mr *MockACLMockRecorder) UpdateIngressIPBlockACLOps(pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap any) *gomock.Call {
+func (mr *MockACLMockRecorder) UpdateIngressIPBlockACLOps(pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap, stateless any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngressIPBlockACLOps", reflect.TypeOf((*MockACL)(nil).UpdateIngressIPBlockACLOps), pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngressIPBlockACLOps", reflect.TypeOf((*MockACL)(nil).UpdateIngressIPBlockACLOps), pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap, stateless)
 }
 
 // UpdateLogicalSwitchACL mocks base method.
@@ -3132,6 +3379,20 @@ func (mr *MockNATMockRecorder) UpdateDnatAndSnat(lrName, externalIP, logicalIP,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDnatAndSnat", reflect.TypeOf((*MockNAT)(nil).UpdateDnatAndSnat), lrName, externalIP, logicalIP, lspName, externalMac, gatewayType)
 }
 
+// UpdateSnatPortBlocks mocks base method.
+func (m *MockNAT) UpdateSnatPortBlocks(lrName, owner string, mappings []util.SnatPortBlockMapping) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSnatPortBlocks", lrName, owner, mappings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSnatPortBlocks indicates an expected call of UpdateSnatPortBlocks.
+func (mr *MockNATMockRecorder) UpdateSnatPortBlocks(lrName, owner, mappings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSnatPortBlocks", reflect.TypeOf((*MockNAT)(nil).UpdateSnatPortBlocks), lrName, owner, mappings)
+}
+
 // MockDHCPOptions is a mock of DHCPOptions interface.
 type MockDHCPOptions struct {
 	ctrl     *gomock.Controller
@@ -4400,6 +4661,21 @@ func (mr *MockNbClientMockRecorder) DeleteNats(lrName, natType, logicalIP any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNats", reflect.TypeOf((*MockNbClient)(nil).DeleteNats), lrName, natType, logicalIP)
 }
 
+// DeleteNetpolAclsOps mocks base method.
+func (m *MockNbClient) DeleteNetpolAclsOps(pgName, direction string) ([]ovsdb.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetpolAclsOps", pgName, direction)
+	ret0, _ := ret[0].([]ovsdb.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetpolAclsOps indicates an expected call of DeleteNetpolAclsOps.
+func (mr *MockNbClientMockRecorder) DeleteNetpolAclsOps(pgName, direction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetpolAclsOps", reflect.TypeOf((*MockNbClient)(nil).DeleteNetpolAclsOps), pgName, direction)
+}
+
 // DeletePortGroup mocks base method.
 func (m *MockNbClient) DeletePortGroup(pgName ...string) error {
 	m.ctrl.T.Helper()
@@ -4489,6 +4765,21 @@ func (mr *MockNbClientMockRecorder) FindBFD(externalIDs any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBFD", reflect.TypeOf((*MockNbClient)(nil).FindBFD), externalIDs)
 }
 
+// GetEntityByUUIDPrefix mocks base method.
+func (m *MockNbClient) GetEntityByUUIDPrefix(prefix string) (*ovs.NbEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntityByUUIDPrefix", prefix)
+	ret0, _ := ret[0].(*ovs.NbEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntityByUUIDPrefix indicates an expected call of GetEntityByUUIDPrefix.
+func (mr *MockNbClientMockRecorder) GetEntityByUUIDPrefix(prefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityByUUIDPrefix", reflect.TypeOf((*MockNbClient)(nil).GetEntityByUUIDPrefix), prefix)
+}
+
 // GetEntityInfo mocks base method.
 func (m *MockNbClient) GetEntityInfo(entity any) error {
 	m.ctrl.T.Helper()
@@ -4999,6 +5290,21 @@ func (mr *MockNbClientMockRecorder) ListNormalLogicalSwitchPorts(needVendorFilte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNormalLogicalSwitchPorts", reflect.TypeOf((*MockNbClient)(nil).ListNormalLogicalSwitchPorts), needVendorFilter, externalIDs)
 }
 
+// ListPortGroupAcls mocks base method.
+func (m *MockNbClient) ListPortGroupAcls(pgName, direction string) ([]ovnnb.ACL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPortGroupAcls", pgName, direction)
+	ret0, _ := ret[0].([]ovnnb.ACL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPortGroupAcls indicates an expected call of ListPortGroupAcls.
+func (mr *MockNbClientMockRecorder) ListPortGroupAcls(pgName, direction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortGroupAcls", reflect.TypeOf((*MockNbClient)(nil).ListPortGroupAcls), pgName, direction)
+}
+
 // ListPortGroups mocks base method.
 func (m *MockNbClient) ListPortGroups(externalIDs map[string]string) ([]ovnnb.PortGroup, error) {
 	m.ctrl.T.Helper()
@@ -6011,33 +6317,33 @@ func (mr *MockNbClientMockRecorder) UpdateDnatAndSnat(lrName, externalIP, logica
 }
 
 // UpdateEgressACLOps mocks base method.
-func (m *MockNbClient) UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName string, npp []v10.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo) ([]ovsdb.Operation, error) {
+func (m *MockNbClient) UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName string, npp []v10.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo, stateless bool) ([]ovsdb.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEgressACLOps", pgName, asEgressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap)
+	ret := m.ctrl.Call(m, "UpdateEgressACLOps", pgName, asEgressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap, stateless)
 	ret0, _ := ret[0].([]ovsdb.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateEgressACLOps indicates an expected call of UpdateEgressACLOps.
-func (mr *MockNbClientMockRecorder) UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap any) *gomock.Call {
+func (mr *MockNbClientMockRecorder) UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap, stateless any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEgressACLOps", reflect.TypeOf((*MockNbClient)(nil).UpdateEgressACLOps), pgName, asEgressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEgressACLOps", reflect.TypeOf((*MockNbClient)(nil).UpdateEgressACLOps), pgName, asEgressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap, stateless)
 }
 
 // UpdateEgressIPBlockACLOps mocks base method.
-func (m *MockNbClient) UpdateEgressIPBlockACLOps(pgName, protocol, aclName string, ipBlocks []v10.IPBlock, npp []v10.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo) ([]ovsdb.Operation, error) {
+func (m *MockNbClient) UpdateEgressIPBlockACLOps(pgName, protocol, aclName string, ipBlocks []v10.IPBlock, npp []v10.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo, stateless bool) ([]ovsdb.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEgressIPBlockACLOps", pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap)
+	ret := m.ctrl.Call(m, "UpdateEgressIPBlockACLOps", pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap, stateless)
 	ret0, _ := ret[0].([]ovsdb.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateEgressIPBlockACLOps indicates an expected call of UpdateEgressIPBlockACLOps.
-func (mr *MockNbClientMockRecorder) UpdateEgressIPBlockACLOps(pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap any) *gomock.Call {
+func (mr *MockNbClientMockRecorder) UpdateEgressIPBlockACLOps(pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap, stateless any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEgressIPBlockACLOps", reflect.TypeOf((*MockNbClient)(nil).UpdateEgressIPBlockACLOps), pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEgressIPBlockACLOps", reflect.TypeOf((*MockNbClient)(nil).UpdateEgressIPBlockACLOps), pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap, stateless)
 }
 
 // UpdateGatewayChassis mocks base method.
@@ -6060,33 +6366,33 @@ func (mr *MockNbClientMockRecorder) UpdateGatewayChassis(gwChassis any, fields .
 }
 
 // UpdateIngressACLOps mocks base method.
-func (m *MockNbClient) UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName string, npp []v10.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo) ([]ovsdb.Operation, error) {
+func (m *MockNbClient) UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName string, npp []v10.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo, stateless bool) ([]ovsdb.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateIngressACLOps", pgName, asIngressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap)
+	ret := m.ctrl.Call(m, "UpdateIngressACLOps", pgName, asIngressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap, stateless)
 	ret0, _ := ret[0].([]ovsdb.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateIngressACLOps indicates an expected call of UpdateIngressACLOps.
-func (mr *MockNbClientMockRecorder) UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap any) *gomock.Call {
+func (mr *MockNbClientMockRecorder) UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap, stateless any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngressACLOps", reflect.TypeOf((*MockNbClient)(nil).UpdateIngressACLOps), pgName, asIngressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngressACLOps", reflect.TypeOf((*MockNbClient)(nil).UpdateIngressACLOps), pgName, asIngressName, asExceptName, protocol, aclName, npp, logEnable, logACLActions, logRate, namedPortMap, stateless)
 }
 
 // UpdateIngressIPBlockACLOps mocks base method.
-func (m *MockNbClient) UpdateIngressIPBlockACLOps(pgName, protocol, aclName string, ipBlocks []v10.IPBlock, npp []v10.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo) ([]ovsdb.Operation, error) {
+func (m *MockNbClient) UpdateIngressIPBlockACLOps(pgName, protocol, aclName string, ipBlocks []v10.IPBlock, npp []v10.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo, stateless bool) ([]ovsdb.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateIngressIPBlockACLOps", pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap)
+	ret := m.ctrl.Call(m, "UpdateIngressIPBlockACLOps", pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap, stateless)
 	ret0, _ := ret[0].([]ovsdb.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateIngressIPBlockACLOps indicates an expected call of UpdateIngressIPBlockACLOps.
-func (mr *MockNbClientMockRecorder) UpdateIngressIPBlockACLOps(pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap any) *gomock.Call {
+func (mr *MockNbClientMockRecorder) UpdateIngressIPBlockACLOps(pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap, stateless any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngressIPBlockACLOps", reflect.TypeOf((*MockNbClient)(nil).UpdateIngressIPBlockACLOps), pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngressIPBlockACLOps", reflect.TypeOf((*MockNbClient)(nil).UpdateIngressIPBlockACLOps), pgName, protocol, aclName, ipBlocks, npp, logEnable, logACLActions, logRate, namedPortMap, stateless)
 }
 
 // UpdateLogicalRouter mocks base method.
@@ -6235,6 +6541,34 @@ func (mr *MockNbClientMockRecorder) UpdateSgACL(sg, direction any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSgACL", reflect.TypeOf((*MockNbClient)(nil).UpdateSgACL), sg, direction)
 }
 
+// UpdateSnatPortBlocks mocks base method.
+func (m *MockNbClient) UpdateSnatPortBlocks(lrName, owner string, mappings []util.SnatPortBlockMapping) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSnatPortBlocks", lrName, owner, mappings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSnatPortBlocks indicates an expected call of UpdateSnatPortBlocks.
+func (mr *MockNbClientMockRecorder) UpdateSnatPortBlocks(lrName, owner, mappings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSnatPortBlocks", reflect.TypeOf((*MockNbClient)(nil).UpdateSnatPortBlocks), lrName, owner, mappings)
+}
+
+// WithContext mocks base method.
+func (m *MockNbClient) WithContext(ctx context.Context) ovs.NbClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithContext", ctx)
+	ret0, _ := ret[0].(ovs.NbClient)
+	return ret0
+}
+
+// WithContext indicates an expected call of WithContext.
+func (mr *MockNbClientMockRecorder) WithContext(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithContext", reflect.TypeOf((*MockNbClient)(nil).WithContext), ctx)
+}
+
 // MockSbClient is a mock of SbClient interface.
 type MockSbClient struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,73 @@
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type BgpSpeakerStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []BgpSpeakerStatus `json:"items"`
+}
+
+// BgpSpeakerStatus reports the BGP session state of the kube-ovn-speaker running on a node.
+// It is named after the node and written by the speaker itself.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient:nonNamespaced
+// +resourceName=bgp-speaker-statuses
+// +kubebuilder:resource:scope="Cluster",path="bgp-speaker-statuses",singular="bgp-speaker-status"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Node",type="string",JSONPath=".status.nodeName"
+// +kubebuilder:printcolumn:name="RouterID",type="string",JSONPath=".status.routerID"
+// +kubebuilder:printcolumn:name="ASN",type="integer",JSONPath=".status.localASN"
+// +kubebuilder:printcolumn:name="Established",type="integer",JSONPath=".status.establishedNeighbors"
+// +kubebuilder:printcolumn:name="Neighbors",type="integer",JSONPath=".status.totalNeighbors"
+// +kubebuilder:printcolumn:name="Announced",type="integer",JSONPath=".status.announcedPrefixCount"
+// +kubebuilder:printcolumn:name="LastError",type="string",JSONPath=".status.lastError",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type BgpSpeakerStatus struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Status BgpSpeakerStatusInfo `json:"status"`
+}
+
+type BgpSpeakerStatusInfo struct {
+	NodeName string `json:"nodeName,omitempty"`
+	RouterID string `json:"routerID,omitempty"`
+	LocalASN uint32 `json:"localASN,omitempty"`
+
+	TotalNeighbors       int                 `json:"totalNeighbors"`
+	EstablishedNeighbors int                 `json:"establishedNeighbors"`
+	Neighbors            []BgpNeighborStatus `json:"neighbors,omitempty"`
+
+	// AnnouncedPrefixCount is the number of prefixes originated by this speaker
+	AnnouncedPrefixCount int `json:"announcedPrefixCount"`
+	// AnnouncedPrefixes lists the prefixes originated by this speaker, truncated when it grows too large
+	AnnouncedPrefixes []string `json:"announcedPrefixes,omitempty"`
+
+	LastError      string      `json:"lastError,omitempty"`
+	LastErrorTime  metav1.Time `json:"lastErrorTime,omitempty"`
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+type BgpNeighborStatus struct {
+	Address      string `json:"address"`
+	PeerASN      uint32 `json:"peerASN,omitempty"`
+	LocalAddress string `json:"localAddress,omitempty"`
+	// State is the BGP FSM state of the session, e.g. Established, Active or Idle
+	State string `json:"state"`
+	// EstablishedTime is the time the session was last established, empty when the session is down
+	EstablishedTime metav1.Time `json:"establishedTime,omitempty"`
+	Flaps           uint32      `json:"flaps,omitempty"`
+
+	ReceivedPrefixes   uint64 `json:"receivedPrefixes"`
+	AcceptedPrefixes   uint64 `json:"acceptedPrefixes"`
+	AdvertisedPrefixes uint64 `json:"advertisedPrefixes"`
+
+	// BFDState is the local BFD session state, empty when BFD is disabled
+	BFDState string `json:"bfdState,omitempty"`
+
+	DisconnectReason string `json:"disconnectReason,omitempty"`
+}
@@ -0,0 +1,127 @@
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+const (
+	// ConnectivityConnected is the condition of ConnectivityMatrix telling whether all node pairs are reachable
+	ConnectivityConnected ConditionType = "Connected"
+
+	ConnectivityPeerTypePod  = "Pod"
+	ConnectivityPeerTypeNode = "Node"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ConnectivityReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ConnectivityReport `json:"items"`
+}
+
+// ConnectivityReport holds the latest check results of the kube-ovn-pinger running on a node.
+// It is named after the node and written by the pinger itself.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient:nonNamespaced
+// +resourceName=connectivity-reports
+// +kubebuilder:resource:scope="Cluster",path="connectivity-reports",singular="connectivity-report"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Node",type="string",JSONPath=".status.nodeName"
+// +kubebuilder:printcolumn:name="Reachable",type="integer",JSONPath=".status.reachablePeers"
+// +kubebuilder:printcolumn:name="Unreachable",type="integer",JSONPath=".status.unreachablePeers"
+// +kubebuilder:printcolumn:name="APIServer",type="boolean",JSONPath=".status.apiServer.healthy"
+// +kubebuilder:printcolumn:name="DNS",type="boolean",JSONPath=".status.internalDNS.healthy"
+// +kubebuilder:printcolumn:name="LastUpdate",type="date",JSONPath=".status.lastUpdateTime"
+type ConnectivityReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Status ConnectivityReportStatus `json:"status"`
+}
+
+type ConnectivityReportStatus struct {
+	NodeName string `json:"nodeName,omitempty"`
+	PodName  string `json:"podName,omitempty"`
+	PodIP    string `json:"podIP,omitempty"`
+
+	APIServer   ConnectivityCheckResult  `json:"apiServer"`
+	InternalDNS ConnectivityCheckResult  `json:"internalDNS"`
+	ExternalDNS *ConnectivityCheckResult `json:"externalDNS,omitempty"`
+
+	ReachablePeers   int                `json:"reachablePeers"`
+	UnreachablePeers int                `json:"unreachablePeers"`
+	Peers            []ConnectivityPeer `json:"peers,omitempty"`
+
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+type ConnectivityCheckResult struct {
+	Target  string          `json:"target,omitempty"`
+	Healthy bool            `json:"healthy"`
+	Latency metav1.Duration `json:"latency,omitempty"`
+	Error   string          `json:"error,omitempty"`
+}
+
+type ConnectivityPeer struct {
+	// Type is the kind of the checked peer, Pod or Node
+	Type     string `json:"type"`
+	NodeName string `json:"nodeName"`
+	IP       string `json:"ip"`
+	// Vpc and Subnet are set for peers checked over a subnet attached to the pinger pods via Multus
+	Vpc    string `json:"vpc,omitempty"`
+	Subnet string `json:"subnet,omitempty"`
+
+	Reachable   bool            `json:"reachable"`
+	LossPercent int             `json:"lossPercent"`
+	AvgRTT      metav1.Duration `json:"avgRTT,omitempty"`
+	// LastFailureTime is the last time a check of this peer lost packets or failed
+	LastFailureTime metav1.Time `json:"lastFailureTime,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ConnectivityMatrixList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ConnectivityMatrix `json:"items"`
+}
+
+// ConnectivityMatrix aggregates the ConnectivityReports of all nodes.
+// It is maintained by kube-ovn-controller as a single object named cluster.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient:nonNamespaced
+// +resourceName=connectivity-matrices
+// +kubebuilder:resource:scope="Cluster",path="connectivity-matrices",singular="connectivity-matrix"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Connected",type="string",JSONPath=`.status.conditions[?(@.type=="Connected")].status`
+// +kubebuilder:printcolumn:name="Nodes",type="integer",JSONPath=".status.nodes"
+// +kubebuilder:printcolumn:name="Reporting",type="integer",JSONPath=".status.reportingNodes"
+// +kubebuilder:printcolumn:name="Partitioned",type="integer",JSONPath=".status.partitionedPairCount"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type ConnectivityMatrix struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Status ConnectivityMatrixStatus `json:"status"`
+}
+
+type ConnectivityMatrixStatus struct {
+	Nodes          int `json:"nodes"`
+	ReportingNodes int `json:"reportingNodes"`
+	// StaleNodes lists the nodes whose report is missing or has not been updated recently
+	StaleNodes []string `json:"staleNodes,omitempty"`
+
+	PartitionedPairCount int `json:"partitionedPairCount"`
+	// PartitionedPairs lists the node pairs where at least one pod or node IP of the target node is unreachable from the source node
+	PartitionedPairs []ConnectivityNodePair `json:"partitionedPairs,omitempty"`
+
+	Conditions     Conditions  `json:"conditions,omitempty"`
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+type ConnectivityNodePair struct {
+	Source      string `json:"source"`
+	Target      string `json:"target"`
+	LossPercent int    `json:"lossPercent"`
+}
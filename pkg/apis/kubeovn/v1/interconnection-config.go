@@ -0,0 +1,148 @@
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+const (
+	// ICDBConnected tells whether the OVN-IC databases are reachable
+	ICDBConnected ConditionType = "ICDBConnected"
+	// TransitSwitchesReady tells whether this AZ is attached to all transit switches
+	TransitSwitchesReady ConditionType = "TransitSwitchesReady"
+	// GatewaysReady tells whether all interconnection gateway nodes have a chassis
+	GatewaysReady ConditionType = "GatewaysReady"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type InterconnectionConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []InterconnectionConfig `json:"items"`
+}
+
+// InterconnectionConfig configures the OVN interconnection of this cluster.
+// Only the object named ovn-ic-config is handled by ovn-ic-controller,
+// and it takes precedence over the ovn-ic-config ConfigMap.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient:nonNamespaced
+// +resourceName=interconnection-configs
+// +kubebuilder:resource:scope="Cluster",shortName={"ic-config"},path="interconnection-configs",singular="interconnection-config"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Enabled",type="boolean",JSONPath=".spec.enabled"
+// +kubebuilder:printcolumn:name="AZ",type="string",JSONPath=".spec.azName"
+// +kubebuilder:printcolumn:name="AutoRoute",type="boolean",JSONPath=".spec.autoRoute"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type InterconnectionConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec   InterconnectionConfigSpec   `json:"spec"`
+	Status InterconnectionConfigStatus `json:"status"`
+}
+
+type InterconnectionConfigSpec struct {
+	// Enabled turns the interconnection on or off
+	// +kubebuilder:default=true
+	// +optional
+	Enabled bool `json:"enabled"`
+	// AzName is the name of the availability zone of this cluster
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?$`
+	AzName string `json:"azName"`
+	// ICDBHosts are the addresses of the OVN-IC database servers
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:MinLength=1
+	ICDBHosts []string `json:"icDBHosts"`
+	// ICNbPort is the port of the OVN-IC northbound database
+	// +kubebuilder:default=6645
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	ICNbPort int32 `json:"icNbPort,omitempty"`
+	// ICSbPort is the port of the OVN-IC southbound database
+	// +kubebuilder:default=6646
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	ICSbPort int32 `json:"icSbPort,omitempty"`
+	// GatewayNodes are the nodes acting as interconnection gateways
+	// +listType=set
+	// +optional
+	GatewayNodes []string `json:"gatewayNodes,omitempty"`
+	// AutoRoute advertises and learns routes automatically
+	// +optional
+	AutoRoute bool `json:"autoRoute,omitempty"`
+	// RouteFilter filters the routes exchanged with other AZs when AutoRoute is enabled
+	// +optional
+	RouteFilter *InterconnectionRouteFilter `json:"routeFilter,omitempty"`
+}
+
+type InterconnectionRouteFilter struct {
+	// Advertise filters the routes advertised to other AZs
+	// +optional
+	Advertise *InterconnectionRouteFilterRule `json:"advertise,omitempty"`
+	// Learn filters the routes learned from other AZs
+	// +optional
+	Learn []InterconnectionLearnRouteFilter `json:"learn,omitempty"`
+}
+
+// InterconnectionRouteFilterRule filters route prefixes by CIDR.
+// As OVN-IC does not distinguish the direction and the AZ of a filtered prefix,
+// a prefix rejected by any rule is neither advertised to nor learned from any AZ.
+type InterconnectionRouteFilterRule struct {
+	// Allow only the prefixes contained in these CIDRs, all prefixes are allowed if empty
+	// +optional
+	Allow []string `json:"allow,omitempty"`
+	// Deny the prefixes overlapping with these CIDRs, takes precedence over Allow
+	// +optional
+	Deny []string `json:"deny,omitempty"`
+}
+
+type InterconnectionLearnRouteFilter struct {
+	// Az is the name of the remote availability zone, the rule applies to all remote AZs if empty
+	// +optional
+	Az string `json:"az,omitempty"`
+
+	InterconnectionRouteFilterRule `json:",inline"`
+}
+
+type InterconnectionConfigStatus struct {
+	// TransitSwitches are the transit switches this AZ is attached to
+	TransitSwitches []string `json:"transitSwitches,omitempty"`
+	// Gateways are the states of the interconnection gateway nodes
+	Gateways []InterconnectionGateway `json:"gateways,omitempty"`
+	// RemoteAzs are the other availability zones in the OVN-IC databases
+	RemoteAzs []InterconnectionRemoteAz `json:"remoteAzs,omitempty"`
+	// FilteredRoutes are the route prefixes neither advertised nor learned
+	FilteredRoutes []InterconnectionFilteredRoute `json:"filteredRoutes,omitempty"`
+
+	ObservedGeneration int64       `json:"observedGeneration,omitempty"`
+	Conditions         Conditions  `json:"conditions,omitempty"`
+	LastUpdateTime     metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+type InterconnectionGateway struct {
+	Node    string `json:"node"`
+	Chassis string `json:"chassis,omitempty"`
+	Ready   bool   `json:"ready"`
+}
+
+type InterconnectionRemoteAz struct {
+	Name string `json:"name"`
+	// LearnedRoutes is the number of routes learned from the AZ
+	LearnedRoutes int `json:"learnedRoutes"`
+}
+
+type InterconnectionFilteredRoute struct {
+	Prefix string `json:"prefix"`
+	// Direction is Advertise or Learn
+	Direction string `json:"direction"`
+	// Az is the remote availability zone advertising the prefix, empty for local prefixes
+	Az string `json:"az,omitempty"`
+	// Reason is one of Denied, NotAllowed, SubnetPolicy and Conflict
+	Reason string `json:"reason"`
+}
@@ -13,10 +13,10 @@ type IPList struct {
 }
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +genclient:nonNamespaced
 // +kubebuilder:resource:scope="Cluster",shortName="ip",path="ips"
+// +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="V4IP",type="string",JSONPath=".spec.v4IpAddress"
 // +kubebuilder:printcolumn:name="V6IP",type="string",JSONPath=".spec.v6IpAddress"
 // +kubebuilder:printcolumn:name="Mac",type="string",JSONPath=".spec.macAddress"
@@ -26,7 +26,8 @@ type IP struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
 
-	Spec IPSpec `json:"spec"`
+	Spec   IPSpec   `json:"spec"`
+	Status IPStatus `json:"status"`
 }
 
 type IPSpec struct {
@@ -57,3 +58,37 @@ type IPSpec struct {
 	// Pod type (e.g., pod, vm)
 	PodType string `json:"podType"`
 }
+
+type IPStatus struct {
+	// Timestamps of the pod network setup phases, a phase without timestamp has not been reached yet
+	Timeline IPTimeline `json:"timeline"`
+}
+
+type IPTimeline struct {
+	// Time when the address is allocated by kube-ovn-controller
+	// +optional
+	Allocated *metav1.MicroTime `json:"allocated,omitempty"`
+	// Time when the logical switch port is created in OVN northbound database
+	// +optional
+	LSPCreated *metav1.MicroTime `json:"lspCreated,omitempty"`
+	// Time when the ovs port is bound and installed by ovn-controller
+	// +optional
+	PortBound *metav1.MicroTime `json:"portBound,omitempty"`
+	// Time when the routes of the pod are configured by kube-ovn-controller
+	// +optional
+	RouteReady *metav1.MicroTime `json:"routeReady,omitempty"`
+	// Time when the gateway is reachable from the pod
+	// +optional
+	GatewayReachable *metav1.MicroTime `json:"gatewayReachable,omitempty"`
+}
+
+// IPTimelinePhase is the json name of a phase in the IP timeline
+type IPTimelinePhase string
+
+const (
+	IPTimelineAllocated        IPTimelinePhase = "allocated"
+	IPTimelineLSPCreated       IPTimelinePhase = "lspCreated"
+	IPTimelinePortBound        IPTimelinePhase = "portBound"
+	IPTimelineRouteReady       IPTimelinePhase = "routeReady"
+	IPTimelineGatewayReachable IPTimelinePhase = "gatewayReachable"
+)
@@ -90,6 +90,8 @@ type IptablesDnatRuleStatus struct {
 	// +optional
 	// +patchMergeKey=type
 	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
 	Conditions []Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 
 	// Indicates whether the DNAT rule is ready
@@ -110,10 +112,17 @@ type IptablesDnatRuleStatus struct {
 	InternalPort string `json:"internalPort"  patchStrategy:"merge"`
 	// External port configured in the DNAT rule
 	ExternalPort string `json:"externalPort"  patchStrategy:"merge"`
+	// Traffic of the connections translated by the DNAT rule
+	// +optional
+	Traffic *NatTrafficStatus `json:"traffic,omitempty"`
 }
 
+// Bytes returns the merge patch of the status. The conditions are left out as they are applied
+// by the NAT gateway agent with its own field manager.
 func (s *IptablesDnatRuleStatus) Bytes() ([]byte, error) {
-	bytes, err := json.Marshal(s)
+	status := *s
+	status.Conditions = nil
+	bytes, err := json.Marshal(status)
 	if err != nil {
 		return nil, err
 	}
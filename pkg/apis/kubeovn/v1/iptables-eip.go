@@ -60,6 +60,8 @@ type IptablesEIPStatus struct {
 	// +optional
 	// +patchMergeKey=type
 	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
 	Conditions []Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 
 	// Indicates whether the EIP is ready
@@ -72,10 +74,33 @@ type IptablesEIPStatus struct {
 	Redo string `json:"redo" patchStrategy:"merge"`
 	// QoS policy name
 	QoSPolicy string `json:"qosPolicy" patchStrategy:"merge"`
+	// Traffic of the connections translated by the EIP
+	// +optional
+	Traffic *NatTrafficStatus `json:"traffic,omitempty"`
+}
+
+// NatTrafficStatus summarizes the traffic of the connections translated by an EIP or a NAT rule.
+// The counters are collected from the NAT gateway pods, they only cover the traffic since the rule
+// was programmed into the running pods and are reset when a pod is recreated.
+type NatTrafficStatus struct {
+	// Packets received from the external network
+	RxPackets int64 `json:"rxPackets"`
+	// Bytes received from the external network
+	RxBytes int64 `json:"rxBytes"`
+	// Packets sent to the external network
+	TxPackets int64 `json:"txPackets"`
+	// Bytes sent to the external network
+	TxBytes int64 `json:"txBytes"`
+	// Time at which the counters were collected
+	UpdateTime metav1.Time `json:"updateTime,omitempty"`
 }
 
+// Bytes returns the merge patch of the status. The conditions are left out as they are applied
+// by the NAT gateway agent with its own field manager.
 func (s *IptablesEIPStatus) Bytes() ([]byte, error) {
-	bytes, err := json.Marshal(s)
+	status := *s
+	status.Conditions = nil
+	bytes, err := json.Marshal(status)
 	if err != nil {
 		return nil, err
 	}
@@ -57,16 +57,25 @@ type IptablesFIPRuleStatus struct {
 	Redo string `json:"redo" patchStrategy:"merge"`
 	// Internal IP address mapped to the FIP
 	InternalIP string `json:"internalIp"  patchStrategy:"merge"`
+	// Traffic of the connections translated by the FIP
+	// +optional
+	Traffic *NatTrafficStatus `json:"traffic,omitempty"`
 
 	// Conditions represents the latest state of the object
 	// +optional
 	// +patchMergeKey=type
 	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
 	Conditions []Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 }
 
+// Bytes returns the merge patch of the status. The conditions are left out as they are applied
+// by the NAT gateway agent with its own field manager.
 func (s *IptablesFIPRuleStatus) Bytes() ([]byte, error) {
-	bytes, err := json.Marshal(s)
+	status := *s
+	status.Conditions = nil
+	bytes, err := json.Marshal(status)
 	if err != nil {
 		return nil, err
 	}
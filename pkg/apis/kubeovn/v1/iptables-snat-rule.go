@@ -41,6 +41,32 @@ type IptablesSnatRuleSpec struct {
 	EIP string `json:"eip"`
 	// Internal CIDR to be translated via SNAT
 	InternalCIDR string `json:"internalCIDR"`
+	// Deterministic external port block allocation for the internal IPv4 addresses
+	// +optional
+	PortBlock *SnatPortBlock `json:"portBlock,omitempty"`
+}
+
+// SnatPortBlock assigns every address of the internal CIDR of a SNAT rule a fixed block of external ports.
+// The block of an address only depends on its offset in the CIDR, so that the internal address having used
+// an external port at a given time can be found from the rule alone (CGNAT style, see RFC 7422).
+// Address i of the CIDR is translated to the ports [portRangeStart + i*blockSize, portRangeStart + (i+1)*blockSize - 1].
+// Port blocks are IPv4 only, a SNAT rule with a port block and without an IPv4 internal CIDR is rejected.
+type SnatPortBlock struct {
+	// Number of external ports allocated to each internal address
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=16
+	// +kubebuilder:validation:Maximum=64512
+	BlockSize int32 `json:"blockSize"`
+	// First external port of the allocation range
+	// +kubebuilder:default=1024
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	PortRangeStart int32 `json:"portRangeStart,omitempty"`
+	// Last external port of the allocation range
+	// +kubebuilder:default=65535
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	PortRangeEnd int32 `json:"portRangeEnd,omitempty"`
 }
 
 type IptablesSnatRuleStatus struct {
@@ -48,6 +74,8 @@ type IptablesSnatRuleStatus struct {
 	// +optional
 	// +patchMergeKey=type
 	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
 	Conditions []Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 
 	// Indicates whether the SNAT rule is ready
@@ -62,10 +90,19 @@ type IptablesSnatRuleStatus struct {
 	Redo string `json:"redo" patchStrategy:"merge"`
 	// InternalCIDR is the internal CIDR of the SNAT rule
 	InternalCIDR string `json:"internalCIDR" patchStrategy:"merge"`
+	// PortBlock is the port block allocation programmed into the NAT gateway
+	PortBlock *SnatPortBlock `json:"portBlock" patchStrategy:"merge"`
+	// Traffic of the connections translated by the SNAT rule
+	// +optional
+	Traffic *NatTrafficStatus `json:"traffic,omitempty"`
 }
 
+// Bytes returns the merge patch of the status. The conditions are left out as they are applied
+// by the NAT gateway agent with its own field manager.
 func (s *IptablesSnatRuleStatus) Bytes() ([]byte, error) {
-	bytes, err := json.Marshal(s)
+	status := *s
+	status.Conditions = nil
+	bytes, err := json.Marshal(status)
 	if err != nil {
 		return nil, err
 	}
@@ -49,6 +49,9 @@ type OvnSnatRuleSpec struct {
 	V4IpCidr string `json:"v4IpCidr"` // subnet cidr or pod ip address
 	// IPv6 CIDR for SNAT
 	V6IpCidr string `json:"v6IpCidr"` // subnet cidr or pod ip address
+	// Deterministic external port block allocation for the internal IPv4 addresses
+	// +optional
+	PortBlock *SnatPortBlock `json:"portBlock,omitempty"`
 }
 
 type OvnSnatRuleStatus struct {
@@ -70,6 +73,8 @@ type OvnSnatRuleStatus struct {
 	V4IpCidr string `json:"v4IpCidr" patchStrategy:"merge"`
 	// V6IpCidr is the IPv6 CIDR of the SNAT rule
 	V6IpCidr string `json:"v6IpCidr" patchStrategy:"merge"`
+	// PortBlock is the port block allocation programmed into the logical router
+	PortBlock *SnatPortBlock `json:"portBlock" patchStrategy:"merge"`
 }
 
 func (s *OvnSnatRuleStatus) Bytes() ([]byte, error) {
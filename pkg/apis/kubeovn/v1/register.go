@@ -33,10 +33,18 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&BgpConf{},
 		&BgpConfList{},
+		&BgpSpeakerStatus{},
+		&BgpSpeakerStatusList{},
+		&ConnectivityReport{},
+		&ConnectivityReportList{},
+		&ConnectivityMatrix{},
+		&ConnectivityMatrixList{},
 		&DNSNameResolver{},
 		&DNSNameResolverList{},
 		&EvpnConf{},
 		&EvpnConfList{},
+		&InterconnectionConfig{},
+		&InterconnectionConfigList{},
 		&IP{},
 		&IPList{},
 		&IPPool{},
@@ -15,6 +15,8 @@ type SgRemoteType string
 const (
 	SgRemoteTypeAddress SgRemoteType = "address"
 	SgRemoteTypeSg      SgRemoteType = "securityGroup"
+	SgRemoteTypeFQDN    SgRemoteType = "fqdn"
+	SgRemoteTypeIPPool  SgRemoteType = "ipPool"
 )
 
 type SgProtocol string
@@ -49,6 +51,8 @@ type SecurityGroupList struct {
 // +resourceName=security-groups
 // +kubebuilder:resource:scope="Cluster",shortName="sg",path="security-groups",singular="security-group"
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ports",type="integer",JSONPath=".status.ports"
+// +kubebuilder:printcolumn:name="SelectedPorts",type="integer",JSONPath=".status.selectedPorts"
 type SecurityGroup struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -66,6 +70,16 @@ type SecurityGroupSpec struct {
 	AllowSameGroupTraffic bool `json:"allowSameGroupTraffic,omitempty"`
 	// ACL tier to which the rules are added
 	Tier int `json:"tier,omitempty"`
+	// Generate allow-stateless ACLs which bypass connection tracking, the ACLs passing the replies
+	// of the allowed traffic are generated automatically in the reverse direction
+	Stateless bool `json:"stateless,omitempty"`
+	// Select the pods whose logical switch ports join the security group without the security group annotation.
+	// When both podSelector and namespaceSelector are set, a pod must match both of them.
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+	// Select the namespaces whose pods' logical switch ports join the security group without the security group annotation.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
 }
 
 type SecurityGroupRule struct {
@@ -75,12 +89,16 @@ type SecurityGroupRule struct {
 	Protocol SgProtocol `json:"protocol,omitempty"`
 	// Rule priority (1-16384)
 	Priority int `json:"priority,omitempty"`
-	// Type of remote (address, cidr, or securityGroup)
+	// Type of remote (address, cidr, securityGroup, fqdn or ipPool)
 	RemoteType SgRemoteType `json:"remoteType"`
 	// Remote address or CIDR
 	RemoteAddress string `json:"remoteAddress,omitempty"`
 	// Remote security group name
 	RemoteSecurityGroup string `json:"remoteSecurityGroup,omitempty"`
+	// Remote domain name resolved by a DNSNameResolver, requires the DNSNameResolver support to be enabled
+	RemoteFQDN string `json:"remoteFQDN,omitempty"`
+	// Remote IPPool name, the IPPool must have the address set enabled and hold addresses of the IP version of the rule
+	RemoteIPPool string `json:"remoteIPPool,omitempty"`
 	// Start of port range (1-65535)
 	PortRangeMin int `json:"portRangeMin,omitempty"`
 	// End of port range (1-65535)
@@ -100,6 +118,8 @@ type SecurityGroupStatus struct {
 	PortGroup string `json:"portGroup"`
 	// Current allow same group traffic setting
 	AllowSameGroupTraffic bool `json:"allowSameGroupTraffic"`
+	// Current stateless setting
+	Stateless bool `json:"stateless"`
 	// MD5 hash of ingress rules
 	IngressMd5 string `json:"ingressMd5"`
 	// MD5 hash of egress rules
@@ -108,6 +128,10 @@ type SecurityGroupStatus struct {
 	IngressLastSyncSuccess bool `json:"ingressLastSyncSuccess"`
 	// Last egress sync success status
 	EgressLastSyncSuccess bool `json:"egressLastSyncSuccess"`
+	// Number of logical switch ports in the security group
+	Ports int `json:"ports"`
+	// Number of logical switch ports selected by the pod and namespace selectors
+	SelectedPorts int `json:"selectedPorts"`
 }
 
 func (s *SecurityGroupStatus) Bytes() ([]byte, error) {
@@ -115,6 +115,9 @@ type SubnetSpec struct {
 	DisableGatewayCheck bool `json:"disableGatewayCheck,omitempty"`
 	// Disable interconnection for the subnet.
 	DisableInterConnection bool `json:"disableInterConnection,omitempty"`
+	// Interconnection policy of the subnet, takes precedence over DisableInterConnection.
+	// +optional
+	InterConnectionPolicy *SubnetInterConnectionPolicy `json:"interConnectionPolicy,omitempty"`
 
 	// Enable DHCP for the subnet.
 	EnableDHCP bool `json:"enableDHCP,omitempty"`
@@ -159,6 +162,24 @@ type SubnetSpec struct {
 	NodeNetwork string `json:"nodeNetwork,omitempty"`
 }
 
+type SubnetInterConnectionPolicy struct {
+	// Advertise the subnet CIDR to other AZs
+	// +kubebuilder:default=true
+	Advertise bool `json:"advertise"`
+	// IgnoreConflicts stops treating the routes learned from other AZs overlapping with the subnet CIDR as conflicts,
+	// so that the subnet keeps being advertised. The overlapping routes are then only filtered by the route filters.
+	// +optional
+	IgnoreConflicts bool `json:"ignoreConflicts,omitempty"`
+}
+
+// InterConnectionAdvertised returns whether the subnet CIDR is advertised to other AZs via OVN-IC
+func (s *SubnetSpec) InterConnectionAdvertised() bool {
+	if s.InterConnectionPolicy != nil {
+		return s.InterConnectionPolicy.Advertise
+	}
+	return !s.DisableInterConnection
+}
+
 type U2OFeatures struct {
 	// OverlayOnlyRouting controls whether only overlay CIDRs use U2O routing.
 	OverlayOnlyRouting bool `json:"overlayOnlyRouting,omitempty"`
@@ -23,6 +23,9 @@ const (
 	PodAntiAffinityRequired  = "Required"
 	PodAntiAffinityPreferred = "Preferred"
 
+	LoadBalanceModeECMP   = "ECMP"
+	LoadBalanceModePinned = "Pinned"
+
 	ObservabilityConfigured ConditionType = "ObservabilityConfigured"
 	ServiceMonitorReady     ConditionType = "ServiceMonitorReady"
 
@@ -42,6 +45,14 @@ const (
 	ObservabilityNatTypeSNAT     = "snat"
 	ObservabilityNatTypeDNAT     = "dnat"
 	ObservabilityNatTypeSNATDNAT = "snat_dnat"
+
+	ObservabilitySinkIPFIX     = "ipfix"
+	ObservabilitySinkNetFlowV9 = "netflowV9"
+	ObservabilitySinkSyslog    = "syslog"
+	ObservabilitySinkOTLP      = "otlp"
+
+	ObservabilitySinkTransportUDP = "udp"
+	ObservabilitySinkTransportTCP = "tcp"
 )
 
 // Phase represents resource phase
@@ -262,6 +273,7 @@ func (b *BandwidthLimit) Mbps() (int64, int64, error) {
 // +kubebuilder:validation:XValidation:rule="!(has(self.internalIPs) && size(self.internalIPs) != 0 && has(self.internalIPPool) && size(self.internalIPPool) > 0)",message="internalIPs and internalIPPool are mutually exclusive",fieldPath=".internalIPPool"
 // +kubebuilder:validation:XValidation:rule="!(has(self.externalIPs) && size(self.externalIPs) != 0 && has(self.externalIPPool) && size(self.externalIPPool) > 0)",message="externalIPs and externalIPPool are mutually exclusive",fieldPath=".externalIPPool"
 // +kubebuilder:validation:XValidation:rule="(has(self.policies) && size(self.policies) != 0) || (has(self.selectors) && size(self.selectors) != 0)",message="Each VPC Egress Gateway MUST have at least one policy or selector"
+// +kubebuilder:validation:XValidation:rule="!has(self.loadBalanceMode) || self.loadBalanceMode != 'Pinned' || !has(self.trafficPolicy) || self.trafficPolicy != 'Local'",message="loadBalanceMode Pinned cannot be used with trafficPolicy Local",fieldPath=".loadBalanceMode"
 type VpcEgressGatewaySpec struct {
 	// optional VPC name
 	// if not specified, the default VPC will be used
@@ -325,6 +337,20 @@ type VpcEgressGatewaySpec struct {
 	// +kubebuilder:default=Required
 	// +kubebuilder:validation:Enum=Required;Preferred
 	PodAntiAffinity string `json:"podAntiAffinity,omitempty"`
+	// optional load balance mode used to distribute traffic across workload replicas
+	// if not specified, the default mode "ECMP" will be used
+	// if set to "Pinned", each selected pod IP and policy CIDR is pinned to one external IP; new sources are
+	// spread over the replicas using rendezvous hashing, and a source keeps its external IP while the IP is
+	// owned by a ready replica, listed in externalIPs or still allocated to a failed replica.
+	// when the replica owning an external IP fails, a standby replica adds the external IP to its external
+	// interface and forwards its sources. with BFD enabled, the standby replica also has a standby route and
+	// SNATs the sources to the same external IP, so that it takes over as soon as the BFD session is down;
+	// without BFD, it takes over once the failed pod is not ready.
+	// use it with externalIPs so that a recreated replica reclaims the same external IP, the standby replica
+	// releases the IP on the next reconciliation.
+	// +kubebuilder:default=ECMP
+	// +kubebuilder:validation:Enum=ECMP;Pinned
+	LoadBalanceMode string `json:"loadBalanceMode,omitempty"`
 
 	// BFD configuration
 	BFD VpcEgressGatewayBFDConfig `json:"bfd"`
@@ -368,7 +394,7 @@ type VpcEgressGatewayConntrackObservability struct {
 	Log     VpcEgressGatewayConntrackLog         `json:"log,omitempty"`
 }
 
-// VpcEgressGatewayConntrackLog configures JSON Lines flow logging to stdout.
+// VpcEgressGatewayConntrackLog configures JSON Lines flow logging to stdout and external sinks.
 type VpcEgressGatewayConntrackLog struct {
 	Enabled bool `json:"enabled,omitempty"`
 	// flow lifecycle events to log; start and end are used when omitted
@@ -378,6 +404,57 @@ type VpcEgressGatewayConntrackLog struct {
 	Events    []string                              `json:"events,omitempty"`
 	RateLimit VpcEgressGatewayConntrackLogRateLimit `json:"rateLimit,omitempty"`
 	Filters   VpcEgressGatewayConntrackLogFilters   `json:"filters,omitempty"`
+	// stop writing flow log records to the container stdout, e.g. when sinks are configured
+	DisableStdout bool `json:"disableStdout,omitempty"`
+	// external sinks the flow log records are exported to
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	Sinks []VpcEgressGatewayFlowLogSink `json:"sinks,omitempty"`
+}
+
+// VpcEgressGatewayFlowLogSink exports flow log records to an external collector.
+// +kubebuilder:validation:XValidation:rule="self.type != 'netflowV9' || !has(self.transport) || self.transport == 'udp'",message="netflowV9 sinks only support the udp transport"
+// +kubebuilder:validation:XValidation:rule="self.type != 'otlp' || !has(self.transport)",message="otlp sinks do not support the transport field"
+// +kubebuilder:validation:XValidation:rule="self.type != 'otlp' || self.endpoint.startsWith('http://')",message="otlp sinks only support plain http endpoints"
+type VpcEgressGatewayFlowLogSink struct {
+	// sink name, used in metrics
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+	// sink type: IPFIX, NetFlow v9, JSON over syslog, or OTLP logs over HTTP
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=ipfix;netflowV9;syslog;otlp
+	Type string `json:"type"`
+	// collector address in host:port format, or the OTLP/HTTP endpoint URL such as http://collector:4318
+	// otlp sinks have no TLS or authentication options, so https endpoints and credentials in the URL are rejected
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Endpoint string `json:"endpoint"`
+	// transport of ipfix, netflowV9 and syslog sinks
+	// udp is used for ipfix and netflowV9 sinks and tcp is used for syslog sinks when omitted
+	// +kubebuilder:validation:Enum=udp;tcp
+	Transport string `json:"transport,omitempty"`
+	// batching and buffering of the exported records
+	Batch VpcEgressGatewayFlowLogSinkBatch `json:"batch,omitempty"`
+}
+
+// VpcEgressGatewayFlowLogSinkBatch configures batching and backpressure of a flow log sink.
+// Records are dropped and counted when the queue of a slow or unreachable sink is full.
+type VpcEgressGatewayFlowLogSinkBatch struct {
+	// maximum records in one export, 100 when omitted
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	MaxRecords int32 `json:"maxRecords,omitempty"`
+	// maximum time a record waits for its batch to fill, 1000 when omitted
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=60000
+	FlushIntervalMilliseconds int32 `json:"flushIntervalMilliseconds,omitempty"`
+	// maximum records buffered for the sink, 4096 when omitted
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000000
+	QueueSize int32 `json:"queueSize,omitempty"`
 }
 
 // VpcEgressGatewayConntrackLogFilters selects flow records. Exclude rules take precedence.
@@ -485,6 +562,19 @@ type VpcEgressGatewayPolicy struct {
 	IPBlocks []string `json:"ipBlocks,omitempty"`
 	// +listType=set
 	Subnets []string `json:"subnets,omitempty"`
+	// optional destination CIDRs
+	// when destinations or destinationFQDNs are specified, only the traffic to these destinations
+	// is forwarded through the egress gateway, and the rest uses the default path
+	// +listType=set
+	Destinations []string `json:"destinations,omitempty"`
+	// optional destination domain names resolved by DNSNameResolver, requires the DNSNameResolver support to be enabled
+	// +listType=set
+	DestinationFQDNs []string `json:"destinationFQDNs,omitempty"`
+}
+
+// HasDestinations returns true if the policy only applies to specific destinations
+func (p *VpcEgressGatewayPolicy) HasDestinations() bool {
+	return len(p.Destinations) != 0 || len(p.DestinationFQDNs) != 0
 }
 
 type VpcEgressGatewayNodeSelector struct {
@@ -519,6 +609,20 @@ type VpcEgressGatewayStatus struct {
 
 	// workload information
 	Workload VpcEgressWorkload `json:"workload"`
+	// source to external IP assignments, only populated when loadBalanceMode is Pinned
+	Pinning []VpcEgressGatewaySourcePinning `json:"pinning,omitempty"`
+}
+
+// VpcEgressGatewaySourcePinning records the external IP a source is pinned to.
+type VpcEgressGatewaySourcePinning struct {
+	// pod IP or policy CIDR
+	Source string `json:"source"`
+	// external IP(s) the source is translated to
+	ExternalIP string `json:"externalIP,omitempty"`
+	// name of the replica pod currently holding the external IP
+	Replica string `json:"replica,omitempty"`
+	// name of the replica pod taking over the external IP on failure
+	StandbyReplica string `json:"standbyReplica,omitempty"`
 }
 
 type VpcEgressWorkload struct {
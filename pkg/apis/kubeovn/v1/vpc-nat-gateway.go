@@ -9,6 +9,10 @@ import (
 	"k8s.io/klog/v2"
 )
 
+// NatGwRuleApplied is set on iptables EIPs and NAT rules by the NAT gateway agent,
+// its observedGeneration is the rule generation programmed into the gateway.
+const NatGwRuleApplied ConditionType = "Applied"
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 type VpcNatGatewayList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -81,6 +85,8 @@ type VpcNatGatewaySpec struct {
 	BgpSpeaker VpcBgpSpeaker `json:"bgpSpeaker"`
 	// BFD configuration for health monitoring and automatic failover (HA mode only)
 	BFD VpcNatGatewayBFDConfig `json:"bfd,omitempty"`
+	// Conntrack state synchronization between gateway replicas so that established connections survive failover
+	ConntrackSync VpcNatGatewayConntrackSync `json:"conntrackSync,omitempty"`
 	// Internal subnets by name (resolved to CIDRs) for OVN route injection.
 	// Traffic from these subnets destined for 0.0.0.0/0 or ::/0 will be routed to NAT gateway instances.
 	// This field is cumulative with internalCIDRs.
@@ -148,6 +154,45 @@ type VpcNatGatewayBFDConfig struct {
 	Multiplier int32 `json:"multiplier,omitempty"`
 }
 
+// VpcNatGatewayConntrackSync configures conntrackd based connection tracking state synchronization.
+// Every replica multicasts its conntrack updates over a network dedicated to the synchronization and injects
+// the updates of its peers into the kernel table, so that the replica taking over the traffic of a failed one
+// keeps its SNAT/DNAT sessions. The updates are neither authenticated nor encrypted, so the network must only be
+// reachable by the gateway replicas. The synchronization only has peers when the gateway runs more than one replica.
+// +kubebuilder:validation:XValidation:rule="!self.enabled || (has(self.networkAttachment) && self.networkAttachment != '')",message="networkAttachment is required when conntrack synchronization is enabled"
+type VpcNatGatewayConntrackSync struct {
+	// Enable conntrack state synchronization
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled"`
+	// NetworkAttachmentDefinition in the form of namespace/name, attached to the gateway pods as the interface
+	// carrying the conntrack updates. The interface must have an IPv4 address.
+	NetworkAttachment string `json:"networkAttachment,omitempty"`
+	// UDP port of the multicast group used to exchange the conntrack updates
+	// +kubebuilder:default=3780
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port,omitempty"`
+	// IPv4 multicast address used to exchange the conntrack updates. Defaults to an address in 239.192.0.0/14
+	// derived from the name of the gateway, so that the gateways sharing a network do not receive the updates of each other.
+	MulticastAddress string `json:"multicastAddress,omitempty"`
+}
+
+// VpcNatGatewayConntrackSyncStatus reports the conntrack state synchronization of the gateway replicas
+type VpcNatGatewayConntrackSyncStatus struct {
+	// Whether conntrack state synchronization is enabled
+	Enabled bool `json:"enabled"`
+	// Network attachment the synchronization has been configured with
+	NetworkAttachment string `json:"networkAttachment,omitempty"`
+	// Port the synchronization has been configured with
+	Port int32 `json:"port,omitempty"`
+	// Multicast address the synchronization has been configured with
+	MulticastAddress string `json:"multicastAddress,omitempty"`
+	// Number of replicas running a ready conntrack synchronization daemon
+	ReadyReplicas int32 `json:"readyReplicas"`
+	// Names of the pods synchronizing their conntrack state
+	SyncedPods []string `json:"syncedPods"`
+}
+
 // TODO: Consider removing redundant Status fields since statefulset template changes always trigger Pod recreation.
 type VpcNatGatewayStatus struct {
 	// QoS policy applied to the NAT gateway
@@ -172,6 +217,8 @@ type VpcNatGatewayStatus struct {
 	InternalSubnets []string `json:"internalSubnets,omitempty"`
 	// Internal CIDRs configured for OVN route injection
 	InternalCIDRs []string `json:"internalCIDRs,omitempty"`
+	// Conntrack state synchronization between the gateway replicas
+	ConntrackSync VpcNatGatewayConntrackSyncStatus `json:"conntrackSync,omitempty"`
 }
 
 // VpcNatWorkload contains information about the underlying Kubernetes workload (Deployment or StatefulSet)
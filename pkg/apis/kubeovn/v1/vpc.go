@@ -81,6 +81,24 @@ type VpcSpec struct {
 	// optional BFD LRP configuration
 	// currently the LRP is used for vpc external gateway only
 	BFDPort *BFDPort `json:"bfdPort"`
+
+	// Interconnection stretches the VPC across the clusters connected via OVN-IC
+	// +optional
+	Interconnection *VpcInterconnection `json:"interconnection,omitempty"`
+}
+
+type VpcInterconnection struct {
+	// Enable connecting the VPC router to the transit switch ts-<vpc name> dedicated to the VPC.
+	// The VPC must have the same name in all the clusters it spans.
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled"`
+	// CIDR of the transit switch, must be the same in all the clusters and must not overlap with the VPC subnets
+	// +optional
+	Subnet string `json:"subnet,omitempty"`
+}
+
+func (i *VpcInterconnection) IsEnabled() bool {
+	return i != nil && i.Enabled
 }
 
 type BFDPort struct {
@@ -211,6 +211,113 @@ func (in *BgpConfSpec) DeepCopy() *BgpConfSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BgpNeighborStatus) DeepCopyInto(out *BgpNeighborStatus) {
+	*out = *in
+	in.EstablishedTime.DeepCopyInto(&out.EstablishedTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BgpNeighborStatus.
+func (in *BgpNeighborStatus) DeepCopy() *BgpNeighborStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BgpNeighborStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BgpSpeakerStatus) DeepCopyInto(out *BgpSpeakerStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BgpSpeakerStatus.
+func (in *BgpSpeakerStatus) DeepCopy() *BgpSpeakerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BgpSpeakerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BgpSpeakerStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BgpSpeakerStatusInfo) DeepCopyInto(out *BgpSpeakerStatusInfo) {
+	*out = *in
+	if in.Neighbors != nil {
+		in, out := &in.Neighbors, &out.Neighbors
+		*out = make([]BgpNeighborStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AnnouncedPrefixes != nil {
+		in, out := &in.AnnouncedPrefixes, &out.AnnouncedPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.LastErrorTime.DeepCopyInto(&out.LastErrorTime)
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BgpSpeakerStatusInfo.
+func (in *BgpSpeakerStatusInfo) DeepCopy() *BgpSpeakerStatusInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(BgpSpeakerStatusInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BgpSpeakerStatusList) DeepCopyInto(out *BgpSpeakerStatusList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BgpSpeakerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BgpSpeakerStatusList.
+func (in *BgpSpeakerStatusList) DeepCopy() *BgpSpeakerStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(BgpSpeakerStatusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BgpSpeakerStatusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -252,48 +359,43 @@ func (in Conditions) DeepCopy() Conditions {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomInterface) DeepCopyInto(out *CustomInterface) {
+func (in *ConnectivityCheckResult) DeepCopyInto(out *ConnectivityCheckResult) {
 	*out = *in
-	if in.Nodes != nil {
-		in, out := &in.Nodes, &out.Nodes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	out.Latency = in.Latency
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomInterface.
-func (in *CustomInterface) DeepCopy() *CustomInterface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityCheckResult.
+func (in *ConnectivityCheckResult) DeepCopy() *ConnectivityCheckResult {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomInterface)
+	out := new(ConnectivityCheckResult)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSNameResolver) DeepCopyInto(out *DNSNameResolver) {
+func (in *ConnectivityMatrix) DeepCopyInto(out *ConnectivityMatrix) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameResolver.
-func (in *DNSNameResolver) DeepCopy() *DNSNameResolver {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityMatrix.
+func (in *ConnectivityMatrix) DeepCopy() *ConnectivityMatrix {
 	if in == nil {
 		return nil
 	}
-	out := new(DNSNameResolver)
+	out := new(ConnectivityMatrix)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DNSNameResolver) DeepCopyObject() runtime.Object {
+func (in *ConnectivityMatrix) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -301,13 +403,13 @@ func (in *DNSNameResolver) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSNameResolverList) DeepCopyInto(out *DNSNameResolverList) {
+func (in *ConnectivityMatrixList) DeepCopyInto(out *ConnectivityMatrixList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DNSNameResolver, len(*in))
+		*out = make([]ConnectivityMatrix, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -315,18 +417,18 @@ func (in *DNSNameResolverList) DeepCopyInto(out *DNSNameResolverList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameResolverList.
-func (in *DNSNameResolverList) DeepCopy() *DNSNameResolverList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityMatrixList.
+func (in *ConnectivityMatrixList) DeepCopy() *ConnectivityMatrixList {
 	if in == nil {
 		return nil
 	}
-	out := new(DNSNameResolverList)
+	out := new(ConnectivityMatrixList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DNSNameResolverList) DeepCopyObject() runtime.Object {
+func (in *ConnectivityMatrixList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -334,115 +436,94 @@ func (in *DNSNameResolverList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSNameResolverResolvedAddress) DeepCopyInto(out *DNSNameResolverResolvedAddress) {
+func (in *ConnectivityMatrixStatus) DeepCopyInto(out *ConnectivityMatrixStatus) {
 	*out = *in
-	if in.LastLookupTime != nil {
-		in, out := &in.LastLookupTime, &out.LastLookupTime
-		*out = (*in).DeepCopy()
+	if in.StaleNodes != nil {
+		in, out := &in.StaleNodes, &out.StaleNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameResolverResolvedAddress.
-func (in *DNSNameResolverResolvedAddress) DeepCopy() *DNSNameResolverResolvedAddress {
-	if in == nil {
-		return nil
+	if in.PartitionedPairs != nil {
+		in, out := &in.PartitionedPairs, &out.PartitionedPairs
+		*out = make([]ConnectivityNodePair, len(*in))
+		copy(*out, *in)
 	}
-	out := new(DNSNameResolverResolvedAddress)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSNameResolverResolvedName) DeepCopyInto(out *DNSNameResolverResolvedName) {
-	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.ResolvedAddresses != nil {
-		in, out := &in.ResolvedAddresses, &out.ResolvedAddresses
-		*out = make([]DNSNameResolverResolvedAddress, len(*in))
+		*out = make(Conditions, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameResolverResolvedName.
-func (in *DNSNameResolverResolvedName) DeepCopy() *DNSNameResolverResolvedName {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityMatrixStatus.
+func (in *ConnectivityMatrixStatus) DeepCopy() *ConnectivityMatrixStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DNSNameResolverResolvedName)
+	out := new(ConnectivityMatrixStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSNameResolverSpec) DeepCopyInto(out *DNSNameResolverSpec) {
+func (in *ConnectivityNodePair) DeepCopyInto(out *ConnectivityNodePair) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameResolverSpec.
-func (in *DNSNameResolverSpec) DeepCopy() *DNSNameResolverSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityNodePair.
+func (in *ConnectivityNodePair) DeepCopy() *ConnectivityNodePair {
 	if in == nil {
 		return nil
 	}
-	out := new(DNSNameResolverSpec)
+	out := new(ConnectivityNodePair)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSNameResolverStatus) DeepCopyInto(out *DNSNameResolverStatus) {
+func (in *ConnectivityPeer) DeepCopyInto(out *ConnectivityPeer) {
 	*out = *in
-	if in.ResolvedNames != nil {
-		in, out := &in.ResolvedNames, &out.ResolvedNames
-		*out = make([]DNSNameResolverResolvedName, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.AvgRTT = in.AvgRTT
+	in.LastFailureTime.DeepCopyInto(&out.LastFailureTime)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameResolverStatus.
-func (in *DNSNameResolverStatus) DeepCopy() *DNSNameResolverStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityPeer.
+func (in *ConnectivityPeer) DeepCopy() *ConnectivityPeer {
 	if in == nil {
 		return nil
 	}
-	out := new(DNSNameResolverStatus)
+	out := new(ConnectivityPeer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EvpnConf) DeepCopyInto(out *EvpnConf) {
+func (in *ConnectivityReport) DeepCopyInto(out *ConnectivityReport) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvpnConf.
-func (in *EvpnConf) DeepCopy() *EvpnConf {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityReport.
+func (in *ConnectivityReport) DeepCopy() *ConnectivityReport {
 	if in == nil {
 		return nil
 	}
-	out := new(EvpnConf)
+	out := new(ConnectivityReport)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EvpnConf) DeepCopyObject() runtime.Object {
+func (in *ConnectivityReport) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -450,13 +531,13 @@ func (in *EvpnConf) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EvpnConfList) DeepCopyInto(out *EvpnConfList) {
+func (in *ConnectivityReportList) DeepCopyInto(out *ConnectivityReportList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]EvpnConf, len(*in))
+		*out = make([]ConnectivityReport, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -464,18 +545,18 @@ func (in *EvpnConfList) DeepCopyInto(out *EvpnConfList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvpnConfList.
-func (in *EvpnConfList) DeepCopy() *EvpnConfList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityReportList.
+func (in *ConnectivityReportList) DeepCopy() *ConnectivityReportList {
 	if in == nil {
 		return nil
 	}
-	out := new(EvpnConfList)
+	out := new(ConnectivityReportList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EvpnConfList) DeepCopyObject() runtime.Object {
+func (in *ConnectivityReportList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -483,47 +564,79 @@ func (in *EvpnConfList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EvpnConfSpec) DeepCopyInto(out *EvpnConfSpec) {
+func (in *ConnectivityReportStatus) DeepCopyInto(out *ConnectivityReportStatus) {
 	*out = *in
-	if in.RouteTargets != nil {
-		in, out := &in.RouteTargets, &out.RouteTargets
+	out.APIServer = in.APIServer
+	out.InternalDNS = in.InternalDNS
+	if in.ExternalDNS != nil {
+		in, out := &in.ExternalDNS, &out.ExternalDNS
+		*out = new(ConnectivityCheckResult)
+		**out = **in
+	}
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]ConnectivityPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityReportStatus.
+func (in *ConnectivityReportStatus) DeepCopy() *ConnectivityReportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectivityReportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomInterface) DeepCopyInto(out *CustomInterface) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvpnConfSpec.
-func (in *EvpnConfSpec) DeepCopy() *EvpnConfSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomInterface.
+func (in *CustomInterface) DeepCopy() *CustomInterface {
 	if in == nil {
 		return nil
 	}
-	out := new(EvpnConfSpec)
+	out := new(CustomInterface)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IP) DeepCopyInto(out *IP) {
+func (in *DNSNameResolver) DeepCopyInto(out *DNSNameResolver) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IP.
-func (in *IP) DeepCopy() *IP {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameResolver.
+func (in *DNSNameResolver) DeepCopy() *DNSNameResolver {
 	if in == nil {
 		return nil
 	}
-	out := new(IP)
+	out := new(DNSNameResolver)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IP) DeepCopyObject() runtime.Object {
+func (in *DNSNameResolver) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -531,135 +644,679 @@ func (in *IP) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPList) DeepCopyInto(out *IPList) {
+func (in *DNSNameResolverList) DeepCopyInto(out *DNSNameResolverList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]IP, len(*in))
+		*out = make([]DNSNameResolver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameResolverList.
+func (in *DNSNameResolverList) DeepCopy() *DNSNameResolverList {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSNameResolverList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSNameResolverList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSNameResolverResolvedAddress) DeepCopyInto(out *DNSNameResolverResolvedAddress) {
+	*out = *in
+	if in.LastLookupTime != nil {
+		in, out := &in.LastLookupTime, &out.LastLookupTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameResolverResolvedAddress.
+func (in *DNSNameResolverResolvedAddress) DeepCopy() *DNSNameResolverResolvedAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSNameResolverResolvedAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSNameResolverResolvedName) DeepCopyInto(out *DNSNameResolverResolvedName) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResolvedAddresses != nil {
+		in, out := &in.ResolvedAddresses, &out.ResolvedAddresses
+		*out = make([]DNSNameResolverResolvedAddress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameResolverResolvedName.
+func (in *DNSNameResolverResolvedName) DeepCopy() *DNSNameResolverResolvedName {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSNameResolverResolvedName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSNameResolverSpec) DeepCopyInto(out *DNSNameResolverSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameResolverSpec.
+func (in *DNSNameResolverSpec) DeepCopy() *DNSNameResolverSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSNameResolverSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSNameResolverStatus) DeepCopyInto(out *DNSNameResolverStatus) {
+	*out = *in
+	if in.ResolvedNames != nil {
+		in, out := &in.ResolvedNames, &out.ResolvedNames
+		*out = make([]DNSNameResolverResolvedName, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameResolverStatus.
+func (in *DNSNameResolverStatus) DeepCopy() *DNSNameResolverStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSNameResolverStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvpnConf) DeepCopyInto(out *EvpnConf) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvpnConf.
+func (in *EvpnConf) DeepCopy() *EvpnConf {
+	if in == nil {
+		return nil
+	}
+	out := new(EvpnConf)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EvpnConf) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvpnConfList) DeepCopyInto(out *EvpnConfList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EvpnConf, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvpnConfList.
+func (in *EvpnConfList) DeepCopy() *EvpnConfList {
+	if in == nil {
+		return nil
+	}
+	out := new(EvpnConfList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EvpnConfList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvpnConfSpec) DeepCopyInto(out *EvpnConfSpec) {
+	*out = *in
+	if in.RouteTargets != nil {
+		in, out := &in.RouteTargets, &out.RouteTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvpnConfSpec.
+func (in *EvpnConfSpec) DeepCopy() *EvpnConfSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EvpnConfSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IP) DeepCopyInto(out *IP) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IP.
+func (in *IP) DeepCopy() *IP {
+	if in == nil {
+		return nil
+	}
+	out := new(IP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IP) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPList) DeepCopyInto(out *IPList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IP, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPList.
+func (in *IPList) DeepCopy() *IPList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPool) DeepCopyInto(out *IPPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPool.
+func (in *IPPool) DeepCopy() *IPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolList) DeepCopyInto(out *IPPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolList.
+func (in *IPPoolList) DeepCopy() *IPPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolSpec) DeepCopyInto(out *IPPoolSpec) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPs != nil {
+		in, out := &in.IPs, &out.IPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolSpec.
+func (in *IPPoolSpec) DeepCopy() *IPPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolStatus) DeepCopyInto(out *IPPoolStatus) {
+	*out = *in
+	in.V4AvailableIPs.DeepCopyInto(&out.V4AvailableIPs)
+	in.V4UsingIPs.DeepCopyInto(&out.V4UsingIPs)
+	in.V6AvailableIPs.DeepCopyInto(&out.V6AvailableIPs)
+	in.V6UsingIPs.DeepCopyInto(&out.V6UsingIPs)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolStatus.
+func (in *IPPoolStatus) DeepCopy() *IPPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPSpec) DeepCopyInto(out *IPSpec) {
+	*out = *in
+	if in.AttachSubnets != nil {
+		in, out := &in.AttachSubnets, &out.AttachSubnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AttachIPs != nil {
+		in, out := &in.AttachIPs, &out.AttachIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AttachMacs != nil {
+		in, out := &in.AttachMacs, &out.AttachMacs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPSpec.
+func (in *IPSpec) DeepCopy() *IPSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPStatus) DeepCopyInto(out *IPStatus) {
+	*out = *in
+	in.Timeline.DeepCopyInto(&out.Timeline)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPStatus.
+func (in *IPStatus) DeepCopy() *IPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPTimeline) DeepCopyInto(out *IPTimeline) {
+	*out = *in
+	if in.Allocated != nil {
+		in, out := &in.Allocated, &out.Allocated
+		*out = (*in).DeepCopy()
+	}
+	if in.LSPCreated != nil {
+		in, out := &in.LSPCreated, &out.LSPCreated
+		*out = (*in).DeepCopy()
+	}
+	if in.PortBound != nil {
+		in, out := &in.PortBound, &out.PortBound
+		*out = (*in).DeepCopy()
+	}
+	if in.RouteReady != nil {
+		in, out := &in.RouteReady, &out.RouteReady
+		*out = (*in).DeepCopy()
+	}
+	if in.GatewayReachable != nil {
+		in, out := &in.GatewayReachable, &out.GatewayReachable
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPTimeline.
+func (in *IPTimeline) DeepCopy() *IPTimeline {
+	if in == nil {
+		return nil
+	}
+	out := new(IPTimeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterconnectionConfig) DeepCopyInto(out *InterconnectionConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectionConfig.
+func (in *InterconnectionConfig) DeepCopy() *InterconnectionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InterconnectionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InterconnectionConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterconnectionConfigList) DeepCopyInto(out *InterconnectionConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InterconnectionConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectionConfigList.
+func (in *InterconnectionConfigList) DeepCopy() *InterconnectionConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(InterconnectionConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InterconnectionConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterconnectionConfigSpec) DeepCopyInto(out *InterconnectionConfigSpec) {
+	*out = *in
+	if in.ICDBHosts != nil {
+		in, out := &in.ICDBHosts, &out.ICDBHosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GatewayNodes != nil {
+		in, out := &in.GatewayNodes, &out.GatewayNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RouteFilter != nil {
+		in, out := &in.RouteFilter, &out.RouteFilter
+		*out = new(InterconnectionRouteFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectionConfigSpec.
+func (in *InterconnectionConfigSpec) DeepCopy() *InterconnectionConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InterconnectionConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterconnectionConfigStatus) DeepCopyInto(out *InterconnectionConfigStatus) {
+	*out = *in
+	if in.TransitSwitches != nil {
+		in, out := &in.TransitSwitches, &out.TransitSwitches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Gateways != nil {
+		in, out := &in.Gateways, &out.Gateways
+		*out = make([]InterconnectionGateway, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteAzs != nil {
+		in, out := &in.RemoteAzs, &out.RemoteAzs
+		*out = make([]InterconnectionRemoteAz, len(*in))
+		copy(*out, *in)
+	}
+	if in.FilteredRoutes != nil {
+		in, out := &in.FilteredRoutes, &out.FilteredRoutes
+		*out = make([]InterconnectionFilteredRoute, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPList.
-func (in *IPList) DeepCopy() *IPList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectionConfigStatus.
+func (in *InterconnectionConfigStatus) DeepCopy() *InterconnectionConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(IPList)
+	out := new(InterconnectionConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IPList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPPool) DeepCopyInto(out *IPPool) {
+func (in *InterconnectionFilteredRoute) DeepCopyInto(out *InterconnectionFilteredRoute) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPool.
-func (in *IPPool) DeepCopy() *IPPool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectionFilteredRoute.
+func (in *InterconnectionFilteredRoute) DeepCopy() *InterconnectionFilteredRoute {
 	if in == nil {
 		return nil
 	}
-	out := new(IPPool)
+	out := new(InterconnectionFilteredRoute)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IPPool) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPPoolList) DeepCopyInto(out *IPPoolList) {
+func (in *InterconnectionGateway) DeepCopyInto(out *InterconnectionGateway) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]IPPool, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolList.
-func (in *IPPoolList) DeepCopy() *IPPoolList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectionGateway.
+func (in *InterconnectionGateway) DeepCopy() *InterconnectionGateway {
 	if in == nil {
 		return nil
 	}
-	out := new(IPPoolList)
+	out := new(InterconnectionGateway)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IPPoolList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterconnectionLearnRouteFilter) DeepCopyInto(out *InterconnectionLearnRouteFilter) {
+	*out = *in
+	in.InterconnectionRouteFilterRule.DeepCopyInto(&out.InterconnectionRouteFilterRule)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectionLearnRouteFilter.
+func (in *InterconnectionLearnRouteFilter) DeepCopy() *InterconnectionLearnRouteFilter {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(InterconnectionLearnRouteFilter)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPPoolSpec) DeepCopyInto(out *IPPoolSpec) {
+func (in *InterconnectionRemoteAz) DeepCopyInto(out *InterconnectionRemoteAz) {
 	*out = *in
-	if in.Namespaces != nil {
-		in, out := &in.Namespaces, &out.Namespaces
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.IPs != nil {
-		in, out := &in.IPs, &out.IPs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolSpec.
-func (in *IPPoolSpec) DeepCopy() *IPPoolSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectionRemoteAz.
+func (in *InterconnectionRemoteAz) DeepCopy() *InterconnectionRemoteAz {
 	if in == nil {
 		return nil
 	}
-	out := new(IPPoolSpec)
+	out := new(InterconnectionRemoteAz)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPPoolStatus) DeepCopyInto(out *IPPoolStatus) {
+func (in *InterconnectionRouteFilter) DeepCopyInto(out *InterconnectionRouteFilter) {
 	*out = *in
-	in.V4AvailableIPs.DeepCopyInto(&out.V4AvailableIPs)
-	in.V4UsingIPs.DeepCopyInto(&out.V4UsingIPs)
-	in.V6AvailableIPs.DeepCopyInto(&out.V6AvailableIPs)
-	in.V6UsingIPs.DeepCopyInto(&out.V6UsingIPs)
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]Condition, len(*in))
+	if in.Advertise != nil {
+		in, out := &in.Advertise, &out.Advertise
+		*out = new(InterconnectionRouteFilterRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Learn != nil {
+		in, out := &in.Learn, &out.Learn
+		*out = make([]InterconnectionLearnRouteFilter, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -667,43 +1324,38 @@ func (in *IPPoolStatus) DeepCopyInto(out *IPPoolStatus) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolStatus.
-func (in *IPPoolStatus) DeepCopy() *IPPoolStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectionRouteFilter.
+func (in *InterconnectionRouteFilter) DeepCopy() *InterconnectionRouteFilter {
 	if in == nil {
 		return nil
 	}
-	out := new(IPPoolStatus)
+	out := new(InterconnectionRouteFilter)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPSpec) DeepCopyInto(out *IPSpec) {
+func (in *InterconnectionRouteFilterRule) DeepCopyInto(out *InterconnectionRouteFilterRule) {
 	*out = *in
-	if in.AttachSubnets != nil {
-		in, out := &in.AttachSubnets, &out.AttachSubnets
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.AttachIPs != nil {
-		in, out := &in.AttachIPs, &out.AttachIPs
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.AttachMacs != nil {
-		in, out := &in.AttachMacs, &out.AttachMacs
+	if in.Deny != nil {
+		in, out := &in.Deny, &out.Deny
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPSpec.
-func (in *IPSpec) DeepCopy() *IPSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectionRouteFilterRule.
+func (in *InterconnectionRouteFilterRule) DeepCopy() *InterconnectionRouteFilterRule {
 	if in == nil {
 		return nil
 	}
-	out := new(IPSpec)
+	out := new(InterconnectionRouteFilterRule)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -795,6 +1447,11 @@ func (in *IptablesDnatRuleStatus) DeepCopyInto(out *IptablesDnatRuleStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Traffic != nil {
+		in, out := &in.Traffic, &out.Traffic
+		*out = new(NatTrafficStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -895,6 +1552,11 @@ func (in *IptablesEIPStatus) DeepCopyInto(out *IptablesEIPStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Traffic != nil {
+		in, out := &in.Traffic, &out.Traffic
+		*out = new(NatTrafficStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -988,6 +1650,11 @@ func (in *IptablesFIPRuleSpec) DeepCopy() *IptablesFIPRuleSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IptablesFIPRuleStatus) DeepCopyInto(out *IptablesFIPRuleStatus) {
 	*out = *in
+	if in.Traffic != nil {
+		in, out := &in.Traffic, &out.Traffic
+		*out = new(NatTrafficStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]Condition, len(*in))
@@ -1013,7 +1680,7 @@ func (in *IptablesSnatRule) DeepCopyInto(out *IptablesSnatRule) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -1072,6 +1739,11 @@ func (in *IptablesSnatRuleList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IptablesSnatRuleSpec) DeepCopyInto(out *IptablesSnatRuleSpec) {
 	*out = *in
+	if in.PortBlock != nil {
+		in, out := &in.PortBlock, &out.PortBlock
+		*out = new(SnatPortBlock)
+		**out = **in
+	}
 	return
 }
 
@@ -1095,6 +1767,16 @@ func (in *IptablesSnatRuleStatus) DeepCopyInto(out *IptablesSnatRuleStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PortBlock != nil {
+		in, out := &in.PortBlock, &out.PortBlock
+		*out = new(SnatPortBlock)
+		**out = **in
+	}
+	if in.Traffic != nil {
+		in, out := &in.Traffic, &out.Traffic
+		*out = new(NatTrafficStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1158,6 +1840,23 @@ func (in *NatOutgoingPolicyRuleStatus) DeepCopy() *NatOutgoingPolicyRuleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatTrafficStatus) DeepCopyInto(out *NatTrafficStatus) {
+	*out = *in
+	in.UpdateTime.DeepCopyInto(&out.UpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatTrafficStatus.
+func (in *NatTrafficStatus) DeepCopy() *NatTrafficStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NatTrafficStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OvnDnatRule) DeepCopyInto(out *OvnDnatRule) {
 	*out = *in
@@ -1463,7 +2162,7 @@ func (in *OvnSnatRule) DeepCopyInto(out *OvnSnatRule) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -1522,6 +2221,11 @@ func (in *OvnSnatRuleList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OvnSnatRuleSpec) DeepCopyInto(out *OvnSnatRuleSpec) {
 	*out = *in
+	if in.PortBlock != nil {
+		in, out := &in.PortBlock, &out.PortBlock
+		*out = new(SnatPortBlock)
+		**out = **in
+	}
 	return
 }
 
@@ -1545,6 +2249,11 @@ func (in *OvnSnatRuleStatus) DeepCopyInto(out *OvnSnatRuleStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PortBlock != nil {
+		in, out := &in.PortBlock, &out.PortBlock
+		*out = new(SnatPortBlock)
+		**out = **in
+	}
 	return
 }
 
@@ -2111,6 +2820,16 @@ func (in *SecurityGroupSpec) DeepCopyInto(out *SecurityGroupSpec) {
 		*out = make([]SecurityGroupRule, len(*in))
 		copy(*out, *in)
 	}
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2140,6 +2859,22 @@ func (in *SecurityGroupStatus) DeepCopy() *SecurityGroupStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnatPortBlock) DeepCopyInto(out *SnatPortBlock) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnatPortBlock.
+func (in *SnatPortBlock) DeepCopy() *SnatPortBlock {
+	if in == nil {
+		return nil
+	}
+	out := new(SnatPortBlock)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StaticRoute) DeepCopyInto(out *StaticRoute) {
 	*out = *in
@@ -2184,6 +2919,22 @@ func (in *Subnet) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetInterConnectionPolicy) DeepCopyInto(out *SubnetInterConnectionPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetInterConnectionPolicy.
+func (in *SubnetInterConnectionPolicy) DeepCopy() *SubnetInterConnectionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetInterConnectionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetList) DeepCopyInto(out *SubnetList) {
 	*out = *in
@@ -2247,6 +2998,11 @@ func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.InterConnectionPolicy != nil {
+		in, out := &in.InterConnectionPolicy, &out.InterConnectionPolicy
+		*out = new(SubnetInterConnectionPolicy)
+		**out = **in
+	}
 	if in.Acls != nil {
 		in, out := &in.Acls, &out.Acls
 		*out = make([]ACL, len(*in))
@@ -2891,6 +3647,11 @@ func (in *VpcEgressGatewayConntrackLog) DeepCopyInto(out *VpcEgressGatewayConntr
 	}
 	out.RateLimit = in.RateLimit
 	in.Filters.DeepCopyInto(&out.Filters)
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]VpcEgressGatewayFlowLogSink, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3037,6 +3798,39 @@ func (in *VpcEgressGatewayConntrackTupleFilter) DeepCopy() *VpcEgressGatewayConn
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VpcEgressGatewayFlowLogSink) DeepCopyInto(out *VpcEgressGatewayFlowLogSink) {
+	*out = *in
+	out.Batch = in.Batch
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcEgressGatewayFlowLogSink.
+func (in *VpcEgressGatewayFlowLogSink) DeepCopy() *VpcEgressGatewayFlowLogSink {
+	if in == nil {
+		return nil
+	}
+	out := new(VpcEgressGatewayFlowLogSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VpcEgressGatewayFlowLogSinkBatch) DeepCopyInto(out *VpcEgressGatewayFlowLogSinkBatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcEgressGatewayFlowLogSinkBatch.
+func (in *VpcEgressGatewayFlowLogSinkBatch) DeepCopy() *VpcEgressGatewayFlowLogSinkBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(VpcEgressGatewayFlowLogSinkBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VpcEgressGatewayList) DeepCopyInto(out *VpcEgressGatewayList) {
 	*out = *in
@@ -3156,6 +3950,16 @@ func (in *VpcEgressGatewayPolicy) DeepCopyInto(out *VpcEgressGatewayPolicy) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationFQDNs != nil {
+		in, out := &in.DestinationFQDNs, &out.DestinationFQDNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3241,6 +4045,22 @@ func (in *VpcEgressGatewayServiceMonitor) DeepCopy() *VpcEgressGatewayServiceMon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VpcEgressGatewaySourcePinning) DeepCopyInto(out *VpcEgressGatewaySourcePinning) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcEgressGatewaySourcePinning.
+func (in *VpcEgressGatewaySourcePinning) DeepCopy() *VpcEgressGatewaySourcePinning {
+	if in == nil {
+		return nil
+	}
+	out := new(VpcEgressGatewaySourcePinning)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VpcEgressGatewaySpec) DeepCopyInto(out *VpcEgressGatewaySpec) {
 	*out = *in
@@ -3332,6 +4152,11 @@ func (in *VpcEgressGatewayStatus) DeepCopyInto(out *VpcEgressGatewayStatus) {
 		}
 	}
 	in.Workload.DeepCopyInto(&out.Workload)
+	if in.Pinning != nil {
+		in, out := &in.Pinning, &out.Pinning
+		*out = make([]VpcEgressGatewaySourcePinning, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3366,6 +4191,22 @@ func (in *VpcEgressWorkload) DeepCopy() *VpcEgressWorkload {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VpcInterconnection) DeepCopyInto(out *VpcInterconnection) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcInterconnection.
+func (in *VpcInterconnection) DeepCopy() *VpcInterconnection {
+	if in == nil {
+		return nil
+	}
+	out := new(VpcInterconnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VpcList) DeepCopyInto(out *VpcList) {
 	*out = *in
@@ -3443,6 +4284,43 @@ func (in *VpcNatGatewayBFDConfig) DeepCopy() *VpcNatGatewayBFDConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VpcNatGatewayConntrackSync) DeepCopyInto(out *VpcNatGatewayConntrackSync) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcNatGatewayConntrackSync.
+func (in *VpcNatGatewayConntrackSync) DeepCopy() *VpcNatGatewayConntrackSync {
+	if in == nil {
+		return nil
+	}
+	out := new(VpcNatGatewayConntrackSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VpcNatGatewayConntrackSyncStatus) DeepCopyInto(out *VpcNatGatewayConntrackSyncStatus) {
+	*out = *in
+	if in.SyncedPods != nil {
+		in, out := &in.SyncedPods, &out.SyncedPods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcNatGatewayConntrackSyncStatus.
+func (in *VpcNatGatewayConntrackSyncStatus) DeepCopy() *VpcNatGatewayConntrackSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VpcNatGatewayConntrackSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VpcNatGatewayList) DeepCopyInto(out *VpcNatGatewayList) {
 	*out = *in
@@ -3499,6 +4377,7 @@ func (in *VpcNatGatewaySpec) DeepCopyInto(out *VpcNatGatewaySpec) {
 	in.Affinity.DeepCopyInto(&out.Affinity)
 	in.BgpSpeaker.DeepCopyInto(&out.BgpSpeaker)
 	out.BFD = in.BFD
+	out.ConntrackSync = in.ConntrackSync
 	if in.InternalSubnets != nil {
 		in, out := &in.InternalSubnets, &out.InternalSubnets
 		*out = make([]string, len(*in))
@@ -3566,6 +4445,7 @@ func (in *VpcNatGatewayStatus) DeepCopyInto(out *VpcNatGatewayStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	in.ConntrackSync.DeepCopyInto(&out.ConntrackSync)
 	return
 }
 
@@ -3667,6 +4547,11 @@ func (in *VpcSpec) DeepCopyInto(out *VpcSpec) {
 		*out = new(BFDPort)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Interconnection != nil {
+		in, out := &in.Interconnection, &out.Interconnection
+		*out = new(VpcInterconnection)
+		**out = **in
+	}
 	return
 }
 
@@ -0,0 +1,136 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BgpNeighborStatusApplyConfiguration represents a declarative configuration of the BgpNeighborStatus type for use
+// with apply.
+type BgpNeighborStatusApplyConfiguration struct {
+	Address      *string `json:"address,omitempty"`
+	PeerASN      *uint32 `json:"peerASN,omitempty"`
+	LocalAddress *string `json:"localAddress,omitempty"`
+	// State is the BGP FSM state of the session, e.g. Established, Active or Idle
+	State *string `json:"state,omitempty"`
+	// EstablishedTime is the time the session was last established, empty when the session is down
+	EstablishedTime    *metav1.Time `json:"establishedTime,omitempty"`
+	Flaps              *uint32      `json:"flaps,omitempty"`
+	ReceivedPrefixes   *uint64      `json:"receivedPrefixes,omitempty"`
+	AcceptedPrefixes   *uint64      `json:"acceptedPrefixes,omitempty"`
+	AdvertisedPrefixes *uint64      `json:"advertisedPrefixes,omitempty"`
+	// BFDState is the local BFD session state, empty when BFD is disabled
+	BFDState         *string `json:"bfdState,omitempty"`
+	DisconnectReason *string `json:"disconnectReason,omitempty"`
+}
+
+// BgpNeighborStatusApplyConfiguration constructs a declarative configuration of the BgpNeighborStatus type for use with
+// apply.
+func BgpNeighborStatus() *BgpNeighborStatusApplyConfiguration {
+	return &BgpNeighborStatusApplyConfiguration{}
+}
+
+// WithAddress sets the Address field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Address field is set to the value of the last call.
+func (b *BgpNeighborStatusApplyConfiguration) WithAddress(value string) *BgpNeighborStatusApplyConfiguration {
+	b.Address = &value
+	return b
+}
+
+// WithPeerASN sets the PeerASN field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PeerASN field is set to the value of the last call.
+func (b *BgpNeighborStatusApplyConfiguration) WithPeerASN(value uint32) *BgpNeighborStatusApplyConfiguration {
+	b.PeerASN = &value
+	return b
+}
+
+// WithLocalAddress sets the LocalAddress field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LocalAddress field is set to the value of the last call.
+func (b *BgpNeighborStatusApplyConfiguration) WithLocalAddress(value string) *BgpNeighborStatusApplyConfiguration {
+	b.LocalAddress = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *BgpNeighborStatusApplyConfiguration) WithState(value string) *BgpNeighborStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithEstablishedTime sets the EstablishedTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EstablishedTime field is set to the value of the last call.
+func (b *BgpNeighborStatusApplyConfiguration) WithEstablishedTime(value metav1.Time) *BgpNeighborStatusApplyConfiguration {
+	b.EstablishedTime = &value
+	return b
+}
+
+// WithFlaps sets the Flaps field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Flaps field is set to the value of the last call.
+func (b *BgpNeighborStatusApplyConfiguration) WithFlaps(value uint32) *BgpNeighborStatusApplyConfiguration {
+	b.Flaps = &value
+	return b
+}
+
+// WithReceivedPrefixes sets the ReceivedPrefixes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReceivedPrefixes field is set to the value of the last call.
+func (b *BgpNeighborStatusApplyConfiguration) WithReceivedPrefixes(value uint64) *BgpNeighborStatusApplyConfiguration {
+	b.ReceivedPrefixes = &value
+	return b
+}
+
+// WithAcceptedPrefixes sets the AcceptedPrefixes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AcceptedPrefixes field is set to the value of the last call.
+func (b *BgpNeighborStatusApplyConfiguration) WithAcceptedPrefixes(value uint64) *BgpNeighborStatusApplyConfiguration {
+	b.AcceptedPrefixes = &value
+	return b
+}
+
+// WithAdvertisedPrefixes sets the AdvertisedPrefixes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AdvertisedPrefixes field is set to the value of the last call.
+func (b *BgpNeighborStatusApplyConfiguration) WithAdvertisedPrefixes(value uint64) *BgpNeighborStatusApplyConfiguration {
+	b.AdvertisedPrefixes = &value
+	return b
+}
+
+// WithBFDState sets the BFDState field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BFDState field is set to the value of the last call.
+func (b *BgpNeighborStatusApplyConfiguration) WithBFDState(value string) *BgpNeighborStatusApplyConfiguration {
+	b.BFDState = &value
+	return b
+}
+
+// WithDisconnectReason sets the DisconnectReason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisconnectReason field is set to the value of the last call.
+func (b *BgpNeighborStatusApplyConfiguration) WithDisconnectReason(value string) *BgpNeighborStatusApplyConfiguration {
+	b.DisconnectReason = &value
+	return b
+}
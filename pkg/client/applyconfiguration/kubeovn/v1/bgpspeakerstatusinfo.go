@@ -0,0 +1,142 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BgpSpeakerStatusInfoApplyConfiguration represents a declarative configuration of the BgpSpeakerStatusInfo type for use
+// with apply.
+type BgpSpeakerStatusInfoApplyConfiguration struct {
+	NodeName             *string                               `json:"nodeName,omitempty"`
+	RouterID             *string                               `json:"routerID,omitempty"`
+	LocalASN             *uint32                               `json:"localASN,omitempty"`
+	TotalNeighbors       *int                                  `json:"totalNeighbors,omitempty"`
+	EstablishedNeighbors *int                                  `json:"establishedNeighbors,omitempty"`
+	Neighbors            []BgpNeighborStatusApplyConfiguration `json:"neighbors,omitempty"`
+	// AnnouncedPrefixCount is the number of prefixes originated by this speaker
+	AnnouncedPrefixCount *int `json:"announcedPrefixCount,omitempty"`
+	// AnnouncedPrefixes lists the prefixes originated by this speaker, truncated when it grows too large
+	AnnouncedPrefixes []string     `json:"announcedPrefixes,omitempty"`
+	LastError         *string      `json:"lastError,omitempty"`
+	LastErrorTime     *metav1.Time `json:"lastErrorTime,omitempty"`
+	LastUpdateTime    *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// BgpSpeakerStatusInfoApplyConfiguration constructs a declarative configuration of the BgpSpeakerStatusInfo type for use with
+// apply.
+func BgpSpeakerStatusInfo() *BgpSpeakerStatusInfoApplyConfiguration {
+	return &BgpSpeakerStatusInfoApplyConfiguration{}
+}
+
+// WithNodeName sets the NodeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeName field is set to the value of the last call.
+func (b *BgpSpeakerStatusInfoApplyConfiguration) WithNodeName(value string) *BgpSpeakerStatusInfoApplyConfiguration {
+	b.NodeName = &value
+	return b
+}
+
+// WithRouterID sets the RouterID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RouterID field is set to the value of the last call.
+func (b *BgpSpeakerStatusInfoApplyConfiguration) WithRouterID(value string) *BgpSpeakerStatusInfoApplyConfiguration {
+	b.RouterID = &value
+	return b
+}
+
+// WithLocalASN sets the LocalASN field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LocalASN field is set to the value of the last call.
+func (b *BgpSpeakerStatusInfoApplyConfiguration) WithLocalASN(value uint32) *BgpSpeakerStatusInfoApplyConfiguration {
+	b.LocalASN = &value
+	return b
+}
+
+// WithTotalNeighbors sets the TotalNeighbors field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TotalNeighbors field is set to the value of the last call.
+func (b *BgpSpeakerStatusInfoApplyConfiguration) WithTotalNeighbors(value int) *BgpSpeakerStatusInfoApplyConfiguration {
+	b.TotalNeighbors = &value
+	return b
+}
+
+// WithEstablishedNeighbors sets the EstablishedNeighbors field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EstablishedNeighbors field is set to the value of the last call.
+func (b *BgpSpeakerStatusInfoApplyConfiguration) WithEstablishedNeighbors(value int) *BgpSpeakerStatusInfoApplyConfiguration {
+	b.EstablishedNeighbors = &value
+	return b
+}
+
+// WithNeighbors adds the given value to the Neighbors field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Neighbors field.
+func (b *BgpSpeakerStatusInfoApplyConfiguration) WithNeighbors(values ...*BgpNeighborStatusApplyConfiguration) *BgpSpeakerStatusInfoApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNeighbors")
+		}
+		b.Neighbors = append(b.Neighbors, *values[i])
+	}
+	return b
+}
+
+// WithAnnouncedPrefixCount sets the AnnouncedPrefixCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AnnouncedPrefixCount field is set to the value of the last call.
+func (b *BgpSpeakerStatusInfoApplyConfiguration) WithAnnouncedPrefixCount(value int) *BgpSpeakerStatusInfoApplyConfiguration {
+	b.AnnouncedPrefixCount = &value
+	return b
+}
+
+// WithAnnouncedPrefixes adds the given value to the AnnouncedPrefixes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AnnouncedPrefixes field.
+func (b *BgpSpeakerStatusInfoApplyConfiguration) WithAnnouncedPrefixes(values ...string) *BgpSpeakerStatusInfoApplyConfiguration {
+	for i := range values {
+		b.AnnouncedPrefixes = append(b.AnnouncedPrefixes, values[i])
+	}
+	return b
+}
+
+// WithLastError sets the LastError field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastError field is set to the value of the last call.
+func (b *BgpSpeakerStatusInfoApplyConfiguration) WithLastError(value string) *BgpSpeakerStatusInfoApplyConfiguration {
+	b.LastError = &value
+	return b
+}
+
+// WithLastErrorTime sets the LastErrorTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastErrorTime field is set to the value of the last call.
+func (b *BgpSpeakerStatusInfoApplyConfiguration) WithLastErrorTime(value metav1.Time) *BgpSpeakerStatusInfoApplyConfiguration {
+	b.LastErrorTime = &value
+	return b
+}
+
+// WithLastUpdateTime sets the LastUpdateTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastUpdateTime field is set to the value of the last call.
+func (b *BgpSpeakerStatusInfoApplyConfiguration) WithLastUpdateTime(value metav1.Time) *BgpSpeakerStatusInfoApplyConfiguration {
+	b.LastUpdateTime = &value
+	return b
+}
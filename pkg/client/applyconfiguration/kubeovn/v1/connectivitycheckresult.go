@@ -0,0 +1,70 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConnectivityCheckResultApplyConfiguration represents a declarative configuration of the ConnectivityCheckResult type for use
+// with apply.
+type ConnectivityCheckResultApplyConfiguration struct {
+	Target  *string          `json:"target,omitempty"`
+	Healthy *bool            `json:"healthy,omitempty"`
+	Latency *metav1.Duration `json:"latency,omitempty"`
+	Error   *string          `json:"error,omitempty"`
+}
+
+// ConnectivityCheckResultApplyConfiguration constructs a declarative configuration of the ConnectivityCheckResult type for use with
+// apply.
+func ConnectivityCheckResult() *ConnectivityCheckResultApplyConfiguration {
+	return &ConnectivityCheckResultApplyConfiguration{}
+}
+
+// WithTarget sets the Target field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Target field is set to the value of the last call.
+func (b *ConnectivityCheckResultApplyConfiguration) WithTarget(value string) *ConnectivityCheckResultApplyConfiguration {
+	b.Target = &value
+	return b
+}
+
+// WithHealthy sets the Healthy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Healthy field is set to the value of the last call.
+func (b *ConnectivityCheckResultApplyConfiguration) WithHealthy(value bool) *ConnectivityCheckResultApplyConfiguration {
+	b.Healthy = &value
+	return b
+}
+
+// WithLatency sets the Latency field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Latency field is set to the value of the last call.
+func (b *ConnectivityCheckResultApplyConfiguration) WithLatency(value metav1.Duration) *ConnectivityCheckResultApplyConfiguration {
+	b.Latency = &value
+	return b
+}
+
+// WithError sets the Error field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Error field is set to the value of the last call.
+func (b *ConnectivityCheckResultApplyConfiguration) WithError(value string) *ConnectivityCheckResultApplyConfiguration {
+	b.Error = &value
+	return b
+}
@@ -0,0 +1,107 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConnectivityMatrixStatusApplyConfiguration represents a declarative configuration of the ConnectivityMatrixStatus type for use
+// with apply.
+type ConnectivityMatrixStatusApplyConfiguration struct {
+	Nodes          *int `json:"nodes,omitempty"`
+	ReportingNodes *int `json:"reportingNodes,omitempty"`
+	// StaleNodes lists the nodes whose report is missing or has not been updated recently
+	StaleNodes           []string `json:"staleNodes,omitempty"`
+	PartitionedPairCount *int     `json:"partitionedPairCount,omitempty"`
+	// PartitionedPairs lists the node pairs where at least one pod or node IP of the target node is unreachable from the source node
+	PartitionedPairs []ConnectivityNodePairApplyConfiguration `json:"partitionedPairs,omitempty"`
+	Conditions       *kubeovnv1.Conditions                    `json:"conditions,omitempty"`
+	LastUpdateTime   *metav1.Time                             `json:"lastUpdateTime,omitempty"`
+}
+
+// ConnectivityMatrixStatusApplyConfiguration constructs a declarative configuration of the ConnectivityMatrixStatus type for use with
+// apply.
+func ConnectivityMatrixStatus() *ConnectivityMatrixStatusApplyConfiguration {
+	return &ConnectivityMatrixStatusApplyConfiguration{}
+}
+
+// WithNodes sets the Nodes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Nodes field is set to the value of the last call.
+func (b *ConnectivityMatrixStatusApplyConfiguration) WithNodes(value int) *ConnectivityMatrixStatusApplyConfiguration {
+	b.Nodes = &value
+	return b
+}
+
+// WithReportingNodes sets the ReportingNodes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReportingNodes field is set to the value of the last call.
+func (b *ConnectivityMatrixStatusApplyConfiguration) WithReportingNodes(value int) *ConnectivityMatrixStatusApplyConfiguration {
+	b.ReportingNodes = &value
+	return b
+}
+
+// WithStaleNodes adds the given value to the StaleNodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the StaleNodes field.
+func (b *ConnectivityMatrixStatusApplyConfiguration) WithStaleNodes(values ...string) *ConnectivityMatrixStatusApplyConfiguration {
+	for i := range values {
+		b.StaleNodes = append(b.StaleNodes, values[i])
+	}
+	return b
+}
+
+// WithPartitionedPairCount sets the PartitionedPairCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PartitionedPairCount field is set to the value of the last call.
+func (b *ConnectivityMatrixStatusApplyConfiguration) WithPartitionedPairCount(value int) *ConnectivityMatrixStatusApplyConfiguration {
+	b.PartitionedPairCount = &value
+	return b
+}
+
+// WithPartitionedPairs adds the given value to the PartitionedPairs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PartitionedPairs field.
+func (b *ConnectivityMatrixStatusApplyConfiguration) WithPartitionedPairs(values ...*ConnectivityNodePairApplyConfiguration) *ConnectivityMatrixStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPartitionedPairs")
+		}
+		b.PartitionedPairs = append(b.PartitionedPairs, *values[i])
+	}
+	return b
+}
+
+// WithConditions sets the Conditions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Conditions field is set to the value of the last call.
+func (b *ConnectivityMatrixStatusApplyConfiguration) WithConditions(value kubeovnv1.Conditions) *ConnectivityMatrixStatusApplyConfiguration {
+	b.Conditions = &value
+	return b
+}
+
+// WithLastUpdateTime sets the LastUpdateTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastUpdateTime field is set to the value of the last call.
+func (b *ConnectivityMatrixStatusApplyConfiguration) WithLastUpdateTime(value metav1.Time) *ConnectivityMatrixStatusApplyConfiguration {
+	b.LastUpdateTime = &value
+	return b
+}
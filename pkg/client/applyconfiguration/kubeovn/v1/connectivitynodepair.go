@@ -0,0 +1,57 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ConnectivityNodePairApplyConfiguration represents a declarative configuration of the ConnectivityNodePair type for use
+// with apply.
+type ConnectivityNodePairApplyConfiguration struct {
+	Source      *string `json:"source,omitempty"`
+	Target      *string `json:"target,omitempty"`
+	LossPercent *int    `json:"lossPercent,omitempty"`
+}
+
+// ConnectivityNodePairApplyConfiguration constructs a declarative configuration of the ConnectivityNodePair type for use with
+// apply.
+func ConnectivityNodePair() *ConnectivityNodePairApplyConfiguration {
+	return &ConnectivityNodePairApplyConfiguration{}
+}
+
+// WithSource sets the Source field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Source field is set to the value of the last call.
+func (b *ConnectivityNodePairApplyConfiguration) WithSource(value string) *ConnectivityNodePairApplyConfiguration {
+	b.Source = &value
+	return b
+}
+
+// WithTarget sets the Target field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Target field is set to the value of the last call.
+func (b *ConnectivityNodePairApplyConfiguration) WithTarget(value string) *ConnectivityNodePairApplyConfiguration {
+	b.Target = &value
+	return b
+}
+
+// WithLossPercent sets the LossPercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LossPercent field is set to the value of the last call.
+func (b *ConnectivityNodePairApplyConfiguration) WithLossPercent(value int) *ConnectivityNodePairApplyConfiguration {
+	b.LossPercent = &value
+	return b
+}
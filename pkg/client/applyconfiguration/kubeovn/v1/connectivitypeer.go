@@ -0,0 +1,118 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConnectivityPeerApplyConfiguration represents a declarative configuration of the ConnectivityPeer type for use
+// with apply.
+type ConnectivityPeerApplyConfiguration struct {
+	// Type is the kind of the checked peer, Pod or Node
+	Type     *string `json:"type,omitempty"`
+	NodeName *string `json:"nodeName,omitempty"`
+	IP       *string `json:"ip,omitempty"`
+	// Vpc and Subnet are set for peers checked over a subnet attached to the pinger pods via Multus
+	Vpc         *string          `json:"vpc,omitempty"`
+	Subnet      *string          `json:"subnet,omitempty"`
+	Reachable   *bool            `json:"reachable,omitempty"`
+	LossPercent *int             `json:"lossPercent,omitempty"`
+	AvgRTT      *metav1.Duration `json:"avgRTT,omitempty"`
+	// LastFailureTime is the last time a check of this peer lost packets or failed
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+}
+
+// ConnectivityPeerApplyConfiguration constructs a declarative configuration of the ConnectivityPeer type for use with
+// apply.
+func ConnectivityPeer() *ConnectivityPeerApplyConfiguration {
+	return &ConnectivityPeerApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *ConnectivityPeerApplyConfiguration) WithType(value string) *ConnectivityPeerApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithNodeName sets the NodeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeName field is set to the value of the last call.
+func (b *ConnectivityPeerApplyConfiguration) WithNodeName(value string) *ConnectivityPeerApplyConfiguration {
+	b.NodeName = &value
+	return b
+}
+
+// WithIP sets the IP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IP field is set to the value of the last call.
+func (b *ConnectivityPeerApplyConfiguration) WithIP(value string) *ConnectivityPeerApplyConfiguration {
+	b.IP = &value
+	return b
+}
+
+// WithVpc sets the Vpc field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Vpc field is set to the value of the last call.
+func (b *ConnectivityPeerApplyConfiguration) WithVpc(value string) *ConnectivityPeerApplyConfiguration {
+	b.Vpc = &value
+	return b
+}
+
+// WithSubnet sets the Subnet field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Subnet field is set to the value of the last call.
+func (b *ConnectivityPeerApplyConfiguration) WithSubnet(value string) *ConnectivityPeerApplyConfiguration {
+	b.Subnet = &value
+	return b
+}
+
+// WithReachable sets the Reachable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reachable field is set to the value of the last call.
+func (b *ConnectivityPeerApplyConfiguration) WithReachable(value bool) *ConnectivityPeerApplyConfiguration {
+	b.Reachable = &value
+	return b
+}
+
+// WithLossPercent sets the LossPercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LossPercent field is set to the value of the last call.
+func (b *ConnectivityPeerApplyConfiguration) WithLossPercent(value int) *ConnectivityPeerApplyConfiguration {
+	b.LossPercent = &value
+	return b
+}
+
+// WithAvgRTT sets the AvgRTT field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AvgRTT field is set to the value of the last call.
+func (b *ConnectivityPeerApplyConfiguration) WithAvgRTT(value metav1.Duration) *ConnectivityPeerApplyConfiguration {
+	b.AvgRTT = &value
+	return b
+}
+
+// WithLastFailureTime sets the LastFailureTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastFailureTime field is set to the value of the last call.
+func (b *ConnectivityPeerApplyConfiguration) WithLastFailureTime(value metav1.Time) *ConnectivityPeerApplyConfiguration {
+	b.LastFailureTime = &value
+	return b
+}
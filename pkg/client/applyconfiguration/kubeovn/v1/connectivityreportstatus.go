@@ -0,0 +1,129 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConnectivityReportStatusApplyConfiguration represents a declarative configuration of the ConnectivityReportStatus type for use
+// with apply.
+type ConnectivityReportStatusApplyConfiguration struct {
+	NodeName         *string                                    `json:"nodeName,omitempty"`
+	PodName          *string                                    `json:"podName,omitempty"`
+	PodIP            *string                                    `json:"podIP,omitempty"`
+	APIServer        *ConnectivityCheckResultApplyConfiguration `json:"apiServer,omitempty"`
+	InternalDNS      *ConnectivityCheckResultApplyConfiguration `json:"internalDNS,omitempty"`
+	ExternalDNS      *ConnectivityCheckResultApplyConfiguration `json:"externalDNS,omitempty"`
+	ReachablePeers   *int                                       `json:"reachablePeers,omitempty"`
+	UnreachablePeers *int                                       `json:"unreachablePeers,omitempty"`
+	Peers            []ConnectivityPeerApplyConfiguration       `json:"peers,omitempty"`
+	LastUpdateTime   *metav1.Time                               `json:"lastUpdateTime,omitempty"`
+}
+
+// ConnectivityReportStatusApplyConfiguration constructs a declarative configuration of the ConnectivityReportStatus type for use with
+// apply.
+func ConnectivityReportStatus() *ConnectivityReportStatusApplyConfiguration {
+	return &ConnectivityReportStatusApplyConfiguration{}
+}
+
+// WithNodeName sets the NodeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeName field is set to the value of the last call.
+func (b *ConnectivityReportStatusApplyConfiguration) WithNodeName(value string) *ConnectivityReportStatusApplyConfiguration {
+	b.NodeName = &value
+	return b
+}
+
+// WithPodName sets the PodName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodName field is set to the value of the last call.
+func (b *ConnectivityReportStatusApplyConfiguration) WithPodName(value string) *ConnectivityReportStatusApplyConfiguration {
+	b.PodName = &value
+	return b
+}
+
+// WithPodIP sets the PodIP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodIP field is set to the value of the last call.
+func (b *ConnectivityReportStatusApplyConfiguration) WithPodIP(value string) *ConnectivityReportStatusApplyConfiguration {
+	b.PodIP = &value
+	return b
+}
+
+// WithAPIServer sets the APIServer field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIServer field is set to the value of the last call.
+func (b *ConnectivityReportStatusApplyConfiguration) WithAPIServer(value *ConnectivityCheckResultApplyConfiguration) *ConnectivityReportStatusApplyConfiguration {
+	b.APIServer = value
+	return b
+}
+
+// WithInternalDNS sets the InternalDNS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InternalDNS field is set to the value of the last call.
+func (b *ConnectivityReportStatusApplyConfiguration) WithInternalDNS(value *ConnectivityCheckResultApplyConfiguration) *ConnectivityReportStatusApplyConfiguration {
+	b.InternalDNS = value
+	return b
+}
+
+// WithExternalDNS sets the ExternalDNS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExternalDNS field is set to the value of the last call.
+func (b *ConnectivityReportStatusApplyConfiguration) WithExternalDNS(value *ConnectivityCheckResultApplyConfiguration) *ConnectivityReportStatusApplyConfiguration {
+	b.ExternalDNS = value
+	return b
+}
+
+// WithReachablePeers sets the ReachablePeers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReachablePeers field is set to the value of the last call.
+func (b *ConnectivityReportStatusApplyConfiguration) WithReachablePeers(value int) *ConnectivityReportStatusApplyConfiguration {
+	b.ReachablePeers = &value
+	return b
+}
+
+// WithUnreachablePeers sets the UnreachablePeers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UnreachablePeers field is set to the value of the last call.
+func (b *ConnectivityReportStatusApplyConfiguration) WithUnreachablePeers(value int) *ConnectivityReportStatusApplyConfiguration {
+	b.UnreachablePeers = &value
+	return b
+}
+
+// WithPeers adds the given value to the Peers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Peers field.
+func (b *ConnectivityReportStatusApplyConfiguration) WithPeers(values ...*ConnectivityPeerApplyConfiguration) *ConnectivityReportStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPeers")
+		}
+		b.Peers = append(b.Peers, *values[i])
+	}
+	return b
+}
+
+// WithLastUpdateTime sets the LastUpdateTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastUpdateTime field is set to the value of the last call.
+func (b *ConnectivityReportStatusApplyConfiguration) WithLastUpdateTime(value metav1.Time) *ConnectivityReportStatusApplyConfiguration {
+	b.LastUpdateTime = &value
+	return b
+}
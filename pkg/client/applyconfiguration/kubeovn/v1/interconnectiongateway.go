@@ -0,0 +1,57 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// InterconnectionGatewayApplyConfiguration represents a declarative configuration of the InterconnectionGateway type for use
+// with apply.
+type InterconnectionGatewayApplyConfiguration struct {
+	Node    *string `json:"node,omitempty"`
+	Chassis *string `json:"chassis,omitempty"`
+	Ready   *bool   `json:"ready,omitempty"`
+}
+
+// InterconnectionGatewayApplyConfiguration constructs a declarative configuration of the InterconnectionGateway type for use with
+// apply.
+func InterconnectionGateway() *InterconnectionGatewayApplyConfiguration {
+	return &InterconnectionGatewayApplyConfiguration{}
+}
+
+// WithNode sets the Node field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Node field is set to the value of the last call.
+func (b *InterconnectionGatewayApplyConfiguration) WithNode(value string) *InterconnectionGatewayApplyConfiguration {
+	b.Node = &value
+	return b
+}
+
+// WithChassis sets the Chassis field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Chassis field is set to the value of the last call.
+func (b *InterconnectionGatewayApplyConfiguration) WithChassis(value string) *InterconnectionGatewayApplyConfiguration {
+	b.Chassis = &value
+	return b
+}
+
+// WithReady sets the Ready field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ready field is set to the value of the last call.
+func (b *InterconnectionGatewayApplyConfiguration) WithReady(value bool) *InterconnectionGatewayApplyConfiguration {
+	b.Ready = &value
+	return b
+}
@@ -0,0 +1,61 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// InterconnectionLearnRouteFilterApplyConfiguration represents a declarative configuration of the InterconnectionLearnRouteFilter type for use
+// with apply.
+type InterconnectionLearnRouteFilterApplyConfiguration struct {
+	// Az is the name of the remote availability zone, the rule applies to all remote AZs if empty
+	Az                                               *string `json:"az,omitempty"`
+	InterconnectionRouteFilterRuleApplyConfiguration `json:",inline"`
+}
+
+// InterconnectionLearnRouteFilterApplyConfiguration constructs a declarative configuration of the InterconnectionLearnRouteFilter type for use with
+// apply.
+func InterconnectionLearnRouteFilter() *InterconnectionLearnRouteFilterApplyConfiguration {
+	return &InterconnectionLearnRouteFilterApplyConfiguration{}
+}
+
+// WithAz sets the Az field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Az field is set to the value of the last call.
+func (b *InterconnectionLearnRouteFilterApplyConfiguration) WithAz(value string) *InterconnectionLearnRouteFilterApplyConfiguration {
+	b.Az = &value
+	return b
+}
+
+// WithAllow adds the given value to the Allow field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Allow field.
+func (b *InterconnectionLearnRouteFilterApplyConfiguration) WithAllow(values ...string) *InterconnectionLearnRouteFilterApplyConfiguration {
+	for i := range values {
+		b.InterconnectionRouteFilterRuleApplyConfiguration.Allow = append(b.InterconnectionRouteFilterRuleApplyConfiguration.Allow, values[i])
+	}
+	return b
+}
+
+// WithDeny adds the given value to the Deny field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Deny field.
+func (b *InterconnectionLearnRouteFilterApplyConfiguration) WithDeny(values ...string) *InterconnectionLearnRouteFilterApplyConfiguration {
+	for i := range values {
+		b.InterconnectionRouteFilterRuleApplyConfiguration.Deny = append(b.InterconnectionRouteFilterRuleApplyConfiguration.Deny, values[i])
+	}
+	return b
+}
@@ -0,0 +1,55 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// InterconnectionRouteFilterApplyConfiguration represents a declarative configuration of the InterconnectionRouteFilter type for use
+// with apply.
+type InterconnectionRouteFilterApplyConfiguration struct {
+	// Advertise filters the routes advertised to other AZs
+	Advertise *InterconnectionRouteFilterRuleApplyConfiguration `json:"advertise,omitempty"`
+	// Learn filters the routes learned from other AZs
+	Learn []InterconnectionLearnRouteFilterApplyConfiguration `json:"learn,omitempty"`
+}
+
+// InterconnectionRouteFilterApplyConfiguration constructs a declarative configuration of the InterconnectionRouteFilter type for use with
+// apply.
+func InterconnectionRouteFilter() *InterconnectionRouteFilterApplyConfiguration {
+	return &InterconnectionRouteFilterApplyConfiguration{}
+}
+
+// WithAdvertise sets the Advertise field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Advertise field is set to the value of the last call.
+func (b *InterconnectionRouteFilterApplyConfiguration) WithAdvertise(value *InterconnectionRouteFilterRuleApplyConfiguration) *InterconnectionRouteFilterApplyConfiguration {
+	b.Advertise = value
+	return b
+}
+
+// WithLearn adds the given value to the Learn field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Learn field.
+func (b *InterconnectionRouteFilterApplyConfiguration) WithLearn(values ...*InterconnectionLearnRouteFilterApplyConfiguration) *InterconnectionRouteFilterApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithLearn")
+		}
+		b.Learn = append(b.Learn, *values[i])
+	}
+	return b
+}
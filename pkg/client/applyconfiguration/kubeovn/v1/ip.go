@@ -29,7 +29,8 @@ import (
 type IPApplyConfiguration struct {
 	metav1.TypeMetaApplyConfiguration    `json:",inline"`
 	*metav1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                                 *IPSpecApplyConfiguration `json:"spec,omitempty"`
+	Spec                                 *IPSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                               *IPStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // IP constructs a declarative configuration of the IP type for use with
@@ -210,6 +211,14 @@ func (b *IPApplyConfiguration) WithSpec(value *IPSpecApplyConfiguration) *IPAppl
 	return b
 }
 
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *IPApplyConfiguration) WithStatus(value *IPStatusApplyConfiguration) *IPApplyConfiguration {
+	b.Status = value
+	return b
+}
+
 // GetKind retrieves the value of the Kind field in the declarative configuration.
 func (b *IPApplyConfiguration) GetKind() *string {
 	return b.TypeMetaApplyConfiguration.Kind
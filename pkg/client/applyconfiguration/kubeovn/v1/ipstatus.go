@@ -0,0 +1,40 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// IPStatusApplyConfiguration represents a declarative configuration of the IPStatus type for use
+// with apply.
+type IPStatusApplyConfiguration struct {
+	// Timestamps of the pod network setup phases, a phase without timestamp has not been reached yet
+	Timeline *IPTimelineApplyConfiguration `json:"timeline,omitempty"`
+}
+
+// IPStatusApplyConfiguration constructs a declarative configuration of the IPStatus type for use with
+// apply.
+func IPStatus() *IPStatusApplyConfiguration {
+	return &IPStatusApplyConfiguration{}
+}
+
+// WithTimeline sets the Timeline field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeline field is set to the value of the last call.
+func (b *IPStatusApplyConfiguration) WithTimeline(value *IPTimelineApplyConfiguration) *IPStatusApplyConfiguration {
+	b.Timeline = value
+	return b
+}
@@ -41,6 +41,8 @@ type IptablesDnatRuleStatusApplyConfiguration struct {
 	InternalPort *string `json:"internalPort,omitempty"`
 	// External port configured in the DNAT rule
 	ExternalPort *string `json:"externalPort,omitempty"`
+	// Traffic of the connections translated by the DNAT rule
+	Traffic *NatTrafficStatusApplyConfiguration `json:"traffic,omitempty"`
 }
 
 // IptablesDnatRuleStatusApplyConfiguration constructs a declarative configuration of the IptablesDnatRuleStatus type for use with
@@ -133,3 +135,11 @@ func (b *IptablesDnatRuleStatusApplyConfiguration) WithExternalPort(value string
 	b.ExternalPort = &value
 	return b
 }
+
+// WithTraffic sets the Traffic field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Traffic field is set to the value of the last call.
+func (b *IptablesDnatRuleStatusApplyConfiguration) WithTraffic(value *NatTrafficStatusApplyConfiguration) *IptablesDnatRuleStatusApplyConfiguration {
+	b.Traffic = value
+	return b
+}
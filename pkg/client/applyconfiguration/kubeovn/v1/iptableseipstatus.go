@@ -33,6 +33,8 @@ type IptablesEIPStatusApplyConfiguration struct {
 	Redo *string `json:"redo,omitempty"`
 	// QoS policy name
 	QoSPolicy *string `json:"qosPolicy,omitempty"`
+	// Traffic of the connections translated by the EIP
+	Traffic *NatTrafficStatusApplyConfiguration `json:"traffic,omitempty"`
 }
 
 // IptablesEIPStatusApplyConfiguration constructs a declarative configuration of the IptablesEIPStatus type for use with
@@ -93,3 +95,11 @@ func (b *IptablesEIPStatusApplyConfiguration) WithQoSPolicy(value string) *Iptab
 	b.QoSPolicy = &value
 	return b
 }
+
+// WithTraffic sets the Traffic field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Traffic field is set to the value of the last call.
+func (b *IptablesEIPStatusApplyConfiguration) WithTraffic(value *NatTrafficStatusApplyConfiguration) *IptablesEIPStatusApplyConfiguration {
+	b.Traffic = value
+	return b
+}
@@ -33,6 +33,8 @@ type IptablesFIPRuleStatusApplyConfiguration struct {
 	Redo *string `json:"redo,omitempty"`
 	// Internal IP address mapped to the FIP
 	InternalIP *string `json:"internalIp,omitempty"`
+	// Traffic of the connections translated by the FIP
+	Traffic *NatTrafficStatusApplyConfiguration `json:"traffic,omitempty"`
 	// Conditions represents the latest state of the object
 	Conditions []ConditionApplyConfiguration `json:"conditions,omitempty"`
 }
@@ -91,6 +93,14 @@ func (b *IptablesFIPRuleStatusApplyConfiguration) WithInternalIP(value string) *
 	return b
 }
 
+// WithTraffic sets the Traffic field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Traffic field is set to the value of the last call.
+func (b *IptablesFIPRuleStatusApplyConfiguration) WithTraffic(value *NatTrafficStatusApplyConfiguration) *IptablesFIPRuleStatusApplyConfiguration {
+	b.Traffic = value
+	return b
+}
+
 // WithConditions adds the given value to the Conditions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Conditions field.
@@ -25,6 +25,8 @@ type IptablesSnatRuleSpecApplyConfiguration struct {
 	EIP *string `json:"eip,omitempty"`
 	// Internal CIDR to be translated via SNAT
 	InternalCIDR *string `json:"internalCIDR,omitempty"`
+	// Deterministic external port block allocation for the internal IPv4 addresses
+	PortBlock *SnatPortBlockApplyConfiguration `json:"portBlock,omitempty"`
 }
 
 // IptablesSnatRuleSpecApplyConfiguration constructs a declarative configuration of the IptablesSnatRuleSpec type for use with
@@ -48,3 +50,11 @@ func (b *IptablesSnatRuleSpecApplyConfiguration) WithInternalCIDR(value string)
 	b.InternalCIDR = &value
 	return b
 }
+
+// WithPortBlock sets the PortBlock field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PortBlock field is set to the value of the last call.
+func (b *IptablesSnatRuleSpecApplyConfiguration) WithPortBlock(value *SnatPortBlockApplyConfiguration) *IptablesSnatRuleSpecApplyConfiguration {
+	b.PortBlock = value
+	return b
+}
@@ -35,6 +35,10 @@ type IptablesSnatRuleStatusApplyConfiguration struct {
 	Redo *string `json:"redo,omitempty"`
 	// InternalCIDR is the internal CIDR of the SNAT rule
 	InternalCIDR *string `json:"internalCIDR,omitempty"`
+	// PortBlock is the port block allocation programmed into the NAT gateway
+	PortBlock *SnatPortBlockApplyConfiguration `json:"portBlock,omitempty"`
+	// Traffic of the connections translated by the SNAT rule
+	Traffic *NatTrafficStatusApplyConfiguration `json:"traffic,omitempty"`
 }
 
 // IptablesSnatRuleStatusApplyConfiguration constructs a declarative configuration of the IptablesSnatRuleStatus type for use with
@@ -103,3 +107,19 @@ func (b *IptablesSnatRuleStatusApplyConfiguration) WithInternalCIDR(value string
 	b.InternalCIDR = &value
 	return b
 }
+
+// WithPortBlock sets the PortBlock field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PortBlock field is set to the value of the last call.
+func (b *IptablesSnatRuleStatusApplyConfiguration) WithPortBlock(value *SnatPortBlockApplyConfiguration) *IptablesSnatRuleStatusApplyConfiguration {
+	b.PortBlock = value
+	return b
+}
+
+// WithTraffic sets the Traffic field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Traffic field is set to the value of the last call.
+func (b *IptablesSnatRuleStatusApplyConfiguration) WithTraffic(value *NatTrafficStatusApplyConfiguration) *IptablesSnatRuleStatusApplyConfiguration {
+	b.Traffic = value
+	return b
+}
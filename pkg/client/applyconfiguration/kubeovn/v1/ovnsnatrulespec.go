@@ -33,6 +33,8 @@ type OvnSnatRuleSpecApplyConfiguration struct {
 	V4IpCidr *string `json:"v4IpCidr,omitempty"`
 	// IPv6 CIDR for SNAT
 	V6IpCidr *string `json:"v6IpCidr,omitempty"`
+	// Deterministic external port block allocation for the internal IPv4 addresses
+	PortBlock *SnatPortBlockApplyConfiguration `json:"portBlock,omitempty"`
 }
 
 // OvnSnatRuleSpecApplyConfiguration constructs a declarative configuration of the OvnSnatRuleSpec type for use with
@@ -88,3 +90,11 @@ func (b *OvnSnatRuleSpecApplyConfiguration) WithV6IpCidr(value string) *OvnSnatR
 	b.V6IpCidr = &value
 	return b
 }
+
+// WithPortBlock sets the PortBlock field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PortBlock field is set to the value of the last call.
+func (b *OvnSnatRuleSpecApplyConfiguration) WithPortBlock(value *SnatPortBlockApplyConfiguration) *OvnSnatRuleSpecApplyConfiguration {
+	b.PortBlock = value
+	return b
+}
@@ -35,6 +35,8 @@ type OvnSnatRuleStatusApplyConfiguration struct {
 	V4IpCidr *string `json:"v4IpCidr,omitempty"`
 	// V6IpCidr is the IPv6 CIDR of the SNAT rule
 	V6IpCidr *string `json:"v6IpCidr,omitempty"`
+	// PortBlock is the port block allocation programmed into the logical router
+	PortBlock *SnatPortBlockApplyConfiguration `json:"portBlock,omitempty"`
 }
 
 // OvnSnatRuleStatusApplyConfiguration constructs a declarative configuration of the OvnSnatRuleStatus type for use with
@@ -103,3 +105,11 @@ func (b *OvnSnatRuleStatusApplyConfiguration) WithV6IpCidr(value string) *OvnSna
 	b.V6IpCidr = &value
 	return b
 }
+
+// WithPortBlock sets the PortBlock field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PortBlock field is set to the value of the last call.
+func (b *OvnSnatRuleStatusApplyConfiguration) WithPortBlock(value *SnatPortBlockApplyConfiguration) *OvnSnatRuleStatusApplyConfiguration {
+	b.PortBlock = value
+	return b
+}
@@ -31,12 +31,16 @@ type SecurityGroupRuleApplyConfiguration struct {
 	Protocol *kubeovnv1.SgProtocol `json:"protocol,omitempty"`
 	// Rule priority (1-16384)
 	Priority *int `json:"priority,omitempty"`
-	// Type of remote (address, cidr, or securityGroup)
+	// Type of remote (address, cidr, securityGroup, fqdn or ipPool)
 	RemoteType *kubeovnv1.SgRemoteType `json:"remoteType,omitempty"`
 	// Remote address or CIDR
 	RemoteAddress *string `json:"remoteAddress,omitempty"`
 	// Remote security group name
 	RemoteSecurityGroup *string `json:"remoteSecurityGroup,omitempty"`
+	// Remote domain name resolved by a DNSNameResolver, requires the DNSNameResolver support to be enabled
+	RemoteFQDN *string `json:"remoteFQDN,omitempty"`
+	// Remote IPPool name, the IPPool must have the address set enabled and hold addresses of the IP version of the rule
+	RemoteIPPool *string `json:"remoteIPPool,omitempty"`
 	// Start of port range (1-65535)
 	PortRangeMin *int `json:"portRangeMin,omitempty"`
 	// End of port range (1-65535)
@@ -105,6 +109,22 @@ func (b *SecurityGroupRuleApplyConfiguration) WithRemoteSecurityGroup(value stri
 	return b
 }
 
+// WithRemoteFQDN sets the RemoteFQDN field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemoteFQDN field is set to the value of the last call.
+func (b *SecurityGroupRuleApplyConfiguration) WithRemoteFQDN(value string) *SecurityGroupRuleApplyConfiguration {
+	b.RemoteFQDN = &value
+	return b
+}
+
+// WithRemoteIPPool sets the RemoteIPPool field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemoteIPPool field is set to the value of the last call.
+func (b *SecurityGroupRuleApplyConfiguration) WithRemoteIPPool(value string) *SecurityGroupRuleApplyConfiguration {
+	b.RemoteIPPool = &value
+	return b
+}
+
 // WithPortRangeMin sets the PortRangeMin field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the PortRangeMin field is set to the value of the last call.
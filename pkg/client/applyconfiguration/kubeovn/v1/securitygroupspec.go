@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1
 
+import (
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
 // SecurityGroupSpecApplyConfiguration represents a declarative configuration of the SecurityGroupSpec type for use
 // with apply.
 type SecurityGroupSpecApplyConfiguration struct {
@@ -29,6 +33,14 @@ type SecurityGroupSpecApplyConfiguration struct {
 	AllowSameGroupTraffic *bool `json:"allowSameGroupTraffic,omitempty"`
 	// ACL tier to which the rules are added
 	Tier *int `json:"tier,omitempty"`
+	// Generate allow-stateless ACLs which bypass connection tracking, the ACLs passing the replies
+	// of the allowed traffic are generated automatically in the reverse direction
+	Stateless *bool `json:"stateless,omitempty"`
+	// Select the pods whose logical switch ports join the security group without the security group annotation.
+	// When both podSelector and namespaceSelector are set, a pod must match both of them.
+	PodSelector *metav1.LabelSelectorApplyConfiguration `json:"podSelector,omitempty"`
+	// Select the namespaces whose pods' logical switch ports join the security group without the security group annotation.
+	NamespaceSelector *metav1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
 }
 
 // SecurityGroupSpecApplyConfiguration constructs a declarative configuration of the SecurityGroupSpec type for use with
@@ -78,3 +90,27 @@ func (b *SecurityGroupSpecApplyConfiguration) WithTier(value int) *SecurityGroup
 	b.Tier = &value
 	return b
 }
+
+// WithStateless sets the Stateless field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Stateless field is set to the value of the last call.
+func (b *SecurityGroupSpecApplyConfiguration) WithStateless(value bool) *SecurityGroupSpecApplyConfiguration {
+	b.Stateless = &value
+	return b
+}
+
+// WithPodSelector sets the PodSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodSelector field is set to the value of the last call.
+func (b *SecurityGroupSpecApplyConfiguration) WithPodSelector(value *metav1.LabelSelectorApplyConfiguration) *SecurityGroupSpecApplyConfiguration {
+	b.PodSelector = value
+	return b
+}
+
+// WithNamespaceSelector sets the NamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NamespaceSelector field is set to the value of the last call.
+func (b *SecurityGroupSpecApplyConfiguration) WithNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *SecurityGroupSpecApplyConfiguration {
+	b.NamespaceSelector = value
+	return b
+}
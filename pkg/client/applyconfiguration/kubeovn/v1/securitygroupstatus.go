@@ -25,6 +25,8 @@ type SecurityGroupStatusApplyConfiguration struct {
 	PortGroup *string `json:"portGroup,omitempty"`
 	// Current allow same group traffic setting
 	AllowSameGroupTraffic *bool `json:"allowSameGroupTraffic,omitempty"`
+	// Current stateless setting
+	Stateless *bool `json:"stateless,omitempty"`
 	// MD5 hash of ingress rules
 	IngressMd5 *string `json:"ingressMd5,omitempty"`
 	// MD5 hash of egress rules
@@ -33,6 +35,10 @@ type SecurityGroupStatusApplyConfiguration struct {
 	IngressLastSyncSuccess *bool `json:"ingressLastSyncSuccess,omitempty"`
 	// Last egress sync success status
 	EgressLastSyncSuccess *bool `json:"egressLastSyncSuccess,omitempty"`
+	// Number of logical switch ports in the security group
+	Ports *int `json:"ports,omitempty"`
+	// Number of logical switch ports selected by the pod and namespace selectors
+	SelectedPorts *int `json:"selectedPorts,omitempty"`
 }
 
 // SecurityGroupStatusApplyConfiguration constructs a declarative configuration of the SecurityGroupStatus type for use with
@@ -57,6 +63,14 @@ func (b *SecurityGroupStatusApplyConfiguration) WithAllowSameGroupTraffic(value
 	return b
 }
 
+// WithStateless sets the Stateless field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Stateless field is set to the value of the last call.
+func (b *SecurityGroupStatusApplyConfiguration) WithStateless(value bool) *SecurityGroupStatusApplyConfiguration {
+	b.Stateless = &value
+	return b
+}
+
 // WithIngressMd5 sets the IngressMd5 field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the IngressMd5 field is set to the value of the last call.
@@ -88,3 +102,19 @@ func (b *SecurityGroupStatusApplyConfiguration) WithEgressLastSyncSuccess(value
 	b.EgressLastSyncSuccess = &value
 	return b
 }
+
+// WithPorts sets the Ports field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ports field is set to the value of the last call.
+func (b *SecurityGroupStatusApplyConfiguration) WithPorts(value int) *SecurityGroupStatusApplyConfiguration {
+	b.Ports = &value
+	return b
+}
+
+// WithSelectedPorts sets the SelectedPorts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SelectedPorts field is set to the value of the last call.
+func (b *SecurityGroupStatusApplyConfiguration) WithSelectedPorts(value int) *SecurityGroupStatusApplyConfiguration {
+	b.SelectedPorts = &value
+	return b
+}
@@ -71,6 +71,8 @@ type SubnetSpecApplyConfiguration struct {
 	DisableGatewayCheck *bool `json:"disableGatewayCheck,omitempty"`
 	// Disable interconnection for the subnet.
 	DisableInterConnection *bool `json:"disableInterConnection,omitempty"`
+	// Interconnection policy of the subnet, takes precedence over DisableInterConnection.
+	InterConnectionPolicy *SubnetInterConnectionPolicyApplyConfiguration `json:"interConnectionPolicy,omitempty"`
 	// Enable DHCP for the subnet.
 	EnableDHCP *bool `json:"enableDHCP,omitempty"`
 	// DHCPv4 options UUID.
@@ -312,6 +314,14 @@ func (b *SubnetSpecApplyConfiguration) WithDisableInterConnection(value bool) *S
 	return b
 }
 
+// WithInterConnectionPolicy sets the InterConnectionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InterConnectionPolicy field is set to the value of the last call.
+func (b *SubnetSpecApplyConfiguration) WithInterConnectionPolicy(value *SubnetInterConnectionPolicyApplyConfiguration) *SubnetSpecApplyConfiguration {
+	b.InterConnectionPolicy = value
+	return b
+}
+
 // WithEnableDHCP sets the EnableDHCP field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the EnableDHCP field is set to the value of the last call.
@@ -21,13 +21,17 @@ package v1
 // VpcEgressGatewayConntrackLogApplyConfiguration represents a declarative configuration of the VpcEgressGatewayConntrackLog type for use
 // with apply.
 //
-// VpcEgressGatewayConntrackLog configures JSON Lines flow logging to stdout.
+// VpcEgressGatewayConntrackLog configures JSON Lines flow logging to stdout and external sinks.
 type VpcEgressGatewayConntrackLogApplyConfiguration struct {
 	Enabled *bool `json:"enabled,omitempty"`
 	// flow lifecycle events to log; start and end are used when omitted
 	Events    []string                                                 `json:"events,omitempty"`
 	RateLimit *VpcEgressGatewayConntrackLogRateLimitApplyConfiguration `json:"rateLimit,omitempty"`
 	Filters   *VpcEgressGatewayConntrackLogFiltersApplyConfiguration   `json:"filters,omitempty"`
+	// stop writing flow log records to the container stdout, e.g. when sinks are configured
+	DisableStdout *bool `json:"disableStdout,omitempty"`
+	// external sinks the flow log records are exported to
+	Sinks []VpcEgressGatewayFlowLogSinkApplyConfiguration `json:"sinks,omitempty"`
 }
 
 // VpcEgressGatewayConntrackLogApplyConfiguration constructs a declarative configuration of the VpcEgressGatewayConntrackLog type for use with
@@ -69,3 +73,24 @@ func (b *VpcEgressGatewayConntrackLogApplyConfiguration) WithFilters(value *VpcE
 	b.Filters = value
 	return b
 }
+
+// WithDisableStdout sets the DisableStdout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisableStdout field is set to the value of the last call.
+func (b *VpcEgressGatewayConntrackLogApplyConfiguration) WithDisableStdout(value bool) *VpcEgressGatewayConntrackLogApplyConfiguration {
+	b.DisableStdout = &value
+	return b
+}
+
+// WithSinks adds the given value to the Sinks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Sinks field.
+func (b *VpcEgressGatewayConntrackLogApplyConfiguration) WithSinks(values ...*VpcEgressGatewayFlowLogSinkApplyConfiguration) *VpcEgressGatewayConntrackLogApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSinks")
+		}
+		b.Sinks = append(b.Sinks, *values[i])
+	}
+	return b
+}
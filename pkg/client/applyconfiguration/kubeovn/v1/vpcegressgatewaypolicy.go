@@ -26,6 +26,12 @@ type VpcEgressGatewayPolicyApplyConfiguration struct {
 	// CIDRs/subnets targeted by the egress traffic policy
 	IPBlocks []string `json:"ipBlocks,omitempty"`
 	Subnets  []string `json:"subnets,omitempty"`
+	// optional destination CIDRs
+	// when destinations or destinationFQDNs are specified, only the traffic to these destinations
+	// is forwarded through the egress gateway, and the rest uses the default path
+	Destinations []string `json:"destinations,omitempty"`
+	// optional destination domain names resolved by DNSNameResolver, requires the DNSNameResolver support to be enabled
+	DestinationFQDNs []string `json:"destinationFQDNs,omitempty"`
 }
 
 // VpcEgressGatewayPolicyApplyConfiguration constructs a declarative configuration of the VpcEgressGatewayPolicy type for use with
@@ -61,3 +67,23 @@ func (b *VpcEgressGatewayPolicyApplyConfiguration) WithSubnets(values ...string)
 	}
 	return b
 }
+
+// WithDestinations adds the given value to the Destinations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Destinations field.
+func (b *VpcEgressGatewayPolicyApplyConfiguration) WithDestinations(values ...string) *VpcEgressGatewayPolicyApplyConfiguration {
+	for i := range values {
+		b.Destinations = append(b.Destinations, values[i])
+	}
+	return b
+}
+
+// WithDestinationFQDNs adds the given value to the DestinationFQDNs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DestinationFQDNs field.
+func (b *VpcEgressGatewayPolicyApplyConfiguration) WithDestinationFQDNs(values ...string) *VpcEgressGatewayPolicyApplyConfiguration {
+	for i := range values {
+		b.DestinationFQDNs = append(b.DestinationFQDNs, values[i])
+	}
+	return b
+}
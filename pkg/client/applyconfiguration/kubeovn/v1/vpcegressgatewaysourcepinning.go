@@ -0,0 +1,72 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// VpcEgressGatewaySourcePinningApplyConfiguration represents a declarative configuration of the VpcEgressGatewaySourcePinning type for use
+// with apply.
+//
+// VpcEgressGatewaySourcePinning records the external IP a source is pinned to.
+type VpcEgressGatewaySourcePinningApplyConfiguration struct {
+	// pod IP or policy CIDR
+	Source *string `json:"source,omitempty"`
+	// external IP(s) the source is translated to
+	ExternalIP *string `json:"externalIP,omitempty"`
+	// name of the replica pod currently holding the external IP
+	Replica *string `json:"replica,omitempty"`
+	// name of the replica pod taking over the external IP on failure
+	StandbyReplica *string `json:"standbyReplica,omitempty"`
+}
+
+// VpcEgressGatewaySourcePinningApplyConfiguration constructs a declarative configuration of the VpcEgressGatewaySourcePinning type for use with
+// apply.
+func VpcEgressGatewaySourcePinning() *VpcEgressGatewaySourcePinningApplyConfiguration {
+	return &VpcEgressGatewaySourcePinningApplyConfiguration{}
+}
+
+// WithSource sets the Source field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Source field is set to the value of the last call.
+func (b *VpcEgressGatewaySourcePinningApplyConfiguration) WithSource(value string) *VpcEgressGatewaySourcePinningApplyConfiguration {
+	b.Source = &value
+	return b
+}
+
+// WithExternalIP sets the ExternalIP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExternalIP field is set to the value of the last call.
+func (b *VpcEgressGatewaySourcePinningApplyConfiguration) WithExternalIP(value string) *VpcEgressGatewaySourcePinningApplyConfiguration {
+	b.ExternalIP = &value
+	return b
+}
+
+// WithReplica sets the Replica field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replica field is set to the value of the last call.
+func (b *VpcEgressGatewaySourcePinningApplyConfiguration) WithReplica(value string) *VpcEgressGatewaySourcePinningApplyConfiguration {
+	b.Replica = &value
+	return b
+}
+
+// WithStandbyReplica sets the StandbyReplica field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StandbyReplica field is set to the value of the last call.
+func (b *VpcEgressGatewaySourcePinningApplyConfiguration) WithStandbyReplica(value string) *VpcEgressGatewaySourcePinningApplyConfiguration {
+	b.StandbyReplica = &value
+	return b
+}
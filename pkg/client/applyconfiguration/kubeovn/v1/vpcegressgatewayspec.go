@@ -75,6 +75,18 @@ type VpcEgressGatewaySpecApplyConfiguration struct {
 	// co-located replicas but does not provide node-level HA. Changing from
 	// Preferred to Required only takes effect when pods are recreated.
 	PodAntiAffinity *string `json:"podAntiAffinity,omitempty"`
+	// optional load balance mode used to distribute traffic across workload replicas
+	// if not specified, the default mode "ECMP" will be used
+	// if set to "Pinned", each selected pod IP and policy CIDR is pinned to one external IP; new sources are
+	// spread over the replicas using rendezvous hashing, and a source keeps its external IP while the IP is
+	// owned by a ready replica, listed in externalIPs or still allocated to a failed replica.
+	// when the replica owning an external IP fails, a standby replica adds the external IP to its external
+	// interface and forwards its sources. with BFD enabled, the standby replica also has a standby route and
+	// SNATs the sources to the same external IP, so that it takes over as soon as the BFD session is down;
+	// without BFD, it takes over once the failed pod is not ready.
+	// use it with externalIPs so that a recreated replica reclaims the same external IP, the standby replica
+	// releases the IP on the next reconciliation.
+	LoadBalanceMode *string `json:"loadBalanceMode,omitempty"`
 	// BFD configuration
 	BFD *VpcEgressGatewayBFDConfigApplyConfiguration `json:"bfd,omitempty"`
 	// egress policies
@@ -229,6 +241,14 @@ func (b *VpcEgressGatewaySpecApplyConfiguration) WithPodAntiAffinity(value strin
 	return b
 }
 
+// WithLoadBalanceMode sets the LoadBalanceMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LoadBalanceMode field is set to the value of the last call.
+func (b *VpcEgressGatewaySpecApplyConfiguration) WithLoadBalanceMode(value string) *VpcEgressGatewaySpecApplyConfiguration {
+	b.LoadBalanceMode = &value
+	return b
+}
+
 // WithBFD sets the BFD field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the BFD field is set to the value of the last call.
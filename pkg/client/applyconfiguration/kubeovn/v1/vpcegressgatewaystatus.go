@@ -41,6 +41,8 @@ type VpcEgressGatewayStatusApplyConfiguration struct {
 	Conditions *kubeovnv1.Conditions `json:"conditions,omitempty"`
 	// workload information
 	Workload *VpcEgressWorkloadApplyConfiguration `json:"workload,omitempty"`
+	// source to external IP assignments, only populated when loadBalanceMode is Pinned
+	Pinning []VpcEgressGatewaySourcePinningApplyConfiguration `json:"pinning,omitempty"`
 }
 
 // VpcEgressGatewayStatusApplyConfiguration constructs a declarative configuration of the VpcEgressGatewayStatus type for use with
@@ -116,3 +118,16 @@ func (b *VpcEgressGatewayStatusApplyConfiguration) WithWorkload(value *VpcEgress
 	b.Workload = value
 	return b
 }
+
+// WithPinning adds the given value to the Pinning field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Pinning field.
+func (b *VpcEgressGatewayStatusApplyConfiguration) WithPinning(values ...*VpcEgressGatewaySourcePinningApplyConfiguration) *VpcEgressGatewayStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPinning")
+		}
+		b.Pinning = append(b.Pinning, *values[i])
+	}
+	return b
+}
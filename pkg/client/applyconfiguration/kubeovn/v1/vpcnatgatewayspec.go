@@ -51,6 +51,8 @@ type VpcNatGatewaySpecApplyConfiguration struct {
 	BgpSpeaker *VpcBgpSpeakerApplyConfiguration `json:"bgpSpeaker,omitempty"`
 	// BFD configuration for health monitoring and automatic failover (HA mode only)
 	BFD *VpcNatGatewayBFDConfigApplyConfiguration `json:"bfd,omitempty"`
+	// Conntrack state synchronization between gateway replicas so that established connections survive failover
+	ConntrackSync *VpcNatGatewayConntrackSyncApplyConfiguration `json:"conntrackSync,omitempty"`
 	// Internal subnets by name (resolved to CIDRs) for OVN route injection.
 	// Traffic from these subnets destined for 0.0.0.0/0 or ::/0 will be routed to NAT gateway instances.
 	// This field is cumulative with internalCIDRs.
@@ -176,6 +178,14 @@ func (b *VpcNatGatewaySpecApplyConfiguration) WithBFD(value *VpcNatGatewayBFDCon
 	return b
 }
 
+// WithConntrackSync sets the ConntrackSync field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConntrackSync field is set to the value of the last call.
+func (b *VpcNatGatewaySpecApplyConfiguration) WithConntrackSync(value *VpcNatGatewayConntrackSyncApplyConfiguration) *VpcNatGatewaySpecApplyConfiguration {
+	b.ConntrackSync = value
+	return b
+}
+
 // WithInternalSubnets adds the given value to the InternalSubnets field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the InternalSubnets field.
@@ -49,6 +49,8 @@ type VpcNatGatewayStatusApplyConfiguration struct {
 	InternalSubnets []string `json:"internalSubnets,omitempty"`
 	// Internal CIDRs configured for OVN route injection
 	InternalCIDRs []string `json:"internalCIDRs,omitempty"`
+	// Conntrack state synchronization between the gateway replicas
+	ConntrackSync *VpcNatGatewayConntrackSyncStatusApplyConfiguration `json:"conntrackSync,omitempty"`
 }
 
 // VpcNatGatewayStatusApplyConfiguration constructs a declarative configuration of the VpcNatGatewayStatus type for use with
@@ -154,3 +156,11 @@ func (b *VpcNatGatewayStatusApplyConfiguration) WithInternalCIDRs(values ...stri
 	}
 	return b
 }
+
+// WithConntrackSync sets the ConntrackSync field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConntrackSync field is set to the value of the last call.
+func (b *VpcNatGatewayStatusApplyConfiguration) WithConntrackSync(value *VpcNatGatewayConntrackSyncStatusApplyConfiguration) *VpcNatGatewayStatusApplyConfiguration {
+	b.ConntrackSync = value
+	return b
+}
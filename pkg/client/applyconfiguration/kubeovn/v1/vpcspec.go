@@ -44,6 +44,8 @@ type VpcSpecApplyConfiguration struct {
 	// optional BFD LRP configuration
 	// currently the LRP is used for vpc external gateway only
 	BFDPort *BFDPortApplyConfiguration `json:"bfdPort,omitempty"`
+	// Interconnection stretches the VPC across the clusters connected via OVN-IC
+	Interconnection *VpcInterconnectionApplyConfiguration `json:"interconnection,omitempty"`
 }
 
 // VpcSpecApplyConfiguration constructs a declarative configuration of the VpcSpec type for use with
@@ -142,3 +144,11 @@ func (b *VpcSpecApplyConfiguration) WithBFDPort(value *BFDPortApplyConfiguration
 	b.BFDPort = value
 	return b
 }
+
+// WithInterconnection sets the Interconnection field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Interconnection field is set to the value of the last call.
+func (b *VpcSpecApplyConfiguration) WithInterconnection(value *VpcInterconnectionApplyConfiguration) *VpcSpecApplyConfiguration {
+	b.Interconnection = value
+	return b
+}
@@ -44,8 +44,28 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kubeovnv1.BgpConfApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("BgpConfSpec"):
 		return &kubeovnv1.BgpConfSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BgpNeighborStatus"):
+		return &kubeovnv1.BgpNeighborStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BgpSpeakerStatus"):
+		return &kubeovnv1.BgpSpeakerStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BgpSpeakerStatusInfo"):
+		return &kubeovnv1.BgpSpeakerStatusInfoApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Condition"):
 		return &kubeovnv1.ConditionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ConnectivityCheckResult"):
+		return &kubeovnv1.ConnectivityCheckResultApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ConnectivityMatrix"):
+		return &kubeovnv1.ConnectivityMatrixApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ConnectivityMatrixStatus"):
+		return &kubeovnv1.ConnectivityMatrixStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ConnectivityNodePair"):
+		return &kubeovnv1.ConnectivityNodePairApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ConnectivityPeer"):
+		return &kubeovnv1.ConnectivityPeerApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ConnectivityReport"):
+		return &kubeovnv1.ConnectivityReportApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ConnectivityReportStatus"):
+		return &kubeovnv1.ConnectivityReportStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("CustomInterface"):
 		return &kubeovnv1.CustomInterfaceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("DNSNameResolver"):
@@ -62,6 +82,24 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kubeovnv1.EvpnConfApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EvpnConfSpec"):
 		return &kubeovnv1.EvpnConfSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("InterconnectionConfig"):
+		return &kubeovnv1.InterconnectionConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("InterconnectionConfigSpec"):
+		return &kubeovnv1.InterconnectionConfigSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("InterconnectionConfigStatus"):
+		return &kubeovnv1.InterconnectionConfigStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("InterconnectionFilteredRoute"):
+		return &kubeovnv1.InterconnectionFilteredRouteApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("InterconnectionGateway"):
+		return &kubeovnv1.InterconnectionGatewayApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("InterconnectionLearnRouteFilter"):
+		return &kubeovnv1.InterconnectionLearnRouteFilterApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("InterconnectionRemoteAz"):
+		return &kubeovnv1.InterconnectionRemoteAzApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("InterconnectionRouteFilter"):
+		return &kubeovnv1.InterconnectionRouteFilterApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("InterconnectionRouteFilterRule"):
+		return &kubeovnv1.InterconnectionRouteFilterRuleApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IP"):
 		return &kubeovnv1.IPApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IPPool"):
@@ -72,6 +110,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kubeovnv1.IPPoolStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IPSpec"):
 		return &kubeovnv1.IPSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IPStatus"):
+		return &kubeovnv1.IPStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IptablesDnatRule"):
 		return &kubeovnv1.IptablesDnatRuleApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IptablesDnatRuleSpec"):
@@ -96,12 +136,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kubeovnv1.IptablesSnatRuleSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IptablesSnatRuleStatus"):
 		return &kubeovnv1.IptablesSnatRuleStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IPTimeline"):
+		return &kubeovnv1.IPTimelineApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("NatOutGoingPolicyMatch"):
 		return &kubeovnv1.NatOutGoingPolicyMatchApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("NatOutgoingPolicyRule"):
 		return &kubeovnv1.NatOutgoingPolicyRuleApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("NatOutgoingPolicyRuleStatus"):
 		return &kubeovnv1.NatOutgoingPolicyRuleStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NatTrafficStatus"):
+		return &kubeovnv1.NatTrafficStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("OvnDnatRule"):
 		return &kubeovnv1.OvnDnatRuleApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("OvnDnatRuleSpec"):
@@ -162,10 +206,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kubeovnv1.SecurityGroupSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SecurityGroupStatus"):
 		return &kubeovnv1.SecurityGroupStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SnatPortBlock"):
+		return &kubeovnv1.SnatPortBlockApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("StaticRoute"):
 		return &kubeovnv1.StaticRouteApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Subnet"):
 		return &kubeovnv1.SubnetApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SubnetInterConnectionPolicy"):
+		return &kubeovnv1.SubnetInterConnectionPolicyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SubnetSpec"):
 		return &kubeovnv1.SubnetSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SubnetStatus"):
@@ -218,6 +266,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kubeovnv1.VpcEgressGatewayConntrackObservabilityApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("VpcEgressGatewayConntrackTupleFilter"):
 		return &kubeovnv1.VpcEgressGatewayConntrackTupleFilterApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("VpcEgressGatewayFlowLogSink"):
+		return &kubeovnv1.VpcEgressGatewayFlowLogSinkApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("VpcEgressGatewayFlowLogSinkBatch"):
+		return &kubeovnv1.VpcEgressGatewayFlowLogSinkBatchApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("VpcEgressGatewayNodeSelector"):
 		return &kubeovnv1.VpcEgressGatewayNodeSelectorApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("VpcEgressGatewayObservability"):
@@ -232,16 +284,24 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kubeovnv1.VpcEgressGatewaySelectorApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("VpcEgressGatewayServiceMonitor"):
 		return &kubeovnv1.VpcEgressGatewayServiceMonitorApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("VpcEgressGatewaySourcePinning"):
+		return &kubeovnv1.VpcEgressGatewaySourcePinningApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("VpcEgressGatewaySpec"):
 		return &kubeovnv1.VpcEgressGatewaySpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("VpcEgressGatewayStatus"):
 		return &kubeovnv1.VpcEgressGatewayStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("VpcEgressWorkload"):
 		return &kubeovnv1.VpcEgressWorkloadApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("VpcInterconnection"):
+		return &kubeovnv1.VpcInterconnectionApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("VpcNatGateway"):
 		return &kubeovnv1.VpcNatGatewayApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("VpcNatGatewayBFDConfig"):
 		return &kubeovnv1.VpcNatGatewayBFDConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("VpcNatGatewayConntrackSync"):
+		return &kubeovnv1.VpcNatGatewayConntrackSyncApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("VpcNatGatewayConntrackSyncStatus"):
+		return &kubeovnv1.VpcNatGatewayConntrackSyncStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("VpcNatGatewaySpec"):
 		return &kubeovnv1.VpcNatGatewaySpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("VpcNatGatewayStatus"):
@@ -0,0 +1,51 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/client/applyconfiguration/kubeovn/v1"
+	typedkubeovnv1 "github.com/kubeovn/kube-ovn/pkg/client/clientset/versioned/typed/kubeovn/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeBgpSpeakerStatuses implements BgpSpeakerStatusInterface
+type fakeBgpSpeakerStatuses struct {
+	*gentype.FakeClientWithListAndApply[*v1.BgpSpeakerStatus, *v1.BgpSpeakerStatusList, *kubeovnv1.BgpSpeakerStatusApplyConfiguration]
+	Fake *FakeKubeovnV1
+}
+
+func newFakeBgpSpeakerStatuses(fake *FakeKubeovnV1) typedkubeovnv1.BgpSpeakerStatusInterface {
+	return &fakeBgpSpeakerStatuses{
+		gentype.NewFakeClientWithListAndApply[*v1.BgpSpeakerStatus, *v1.BgpSpeakerStatusList, *kubeovnv1.BgpSpeakerStatusApplyConfiguration](
+			fake.Fake,
+			"",
+			v1.SchemeGroupVersion.WithResource("bgp-speaker-statuses"),
+			v1.SchemeGroupVersion.WithKind("BgpSpeakerStatus"),
+			func() *v1.BgpSpeakerStatus { return &v1.BgpSpeakerStatus{} },
+			func() *v1.BgpSpeakerStatusList { return &v1.BgpSpeakerStatusList{} },
+			func(dst, src *v1.BgpSpeakerStatusList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.BgpSpeakerStatusList) []*v1.BgpSpeakerStatus { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1.BgpSpeakerStatusList, items []*v1.BgpSpeakerStatus) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
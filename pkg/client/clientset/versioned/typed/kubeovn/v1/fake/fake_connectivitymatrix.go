@@ -0,0 +1,53 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/client/applyconfiguration/kubeovn/v1"
+	typedkubeovnv1 "github.com/kubeovn/kube-ovn/pkg/client/clientset/versioned/typed/kubeovn/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeConnectivityMatrixes implements ConnectivityMatrixInterface
+type fakeConnectivityMatrixes struct {
+	*gentype.FakeClientWithListAndApply[*v1.ConnectivityMatrix, *v1.ConnectivityMatrixList, *kubeovnv1.ConnectivityMatrixApplyConfiguration]
+	Fake *FakeKubeovnV1
+}
+
+func newFakeConnectivityMatrixes(fake *FakeKubeovnV1) typedkubeovnv1.ConnectivityMatrixInterface {
+	return &fakeConnectivityMatrixes{
+		gentype.NewFakeClientWithListAndApply[*v1.ConnectivityMatrix, *v1.ConnectivityMatrixList, *kubeovnv1.ConnectivityMatrixApplyConfiguration](
+			fake.Fake,
+			"",
+			v1.SchemeGroupVersion.WithResource("connectivity-matrices"),
+			v1.SchemeGroupVersion.WithKind("ConnectivityMatrix"),
+			func() *v1.ConnectivityMatrix { return &v1.ConnectivityMatrix{} },
+			func() *v1.ConnectivityMatrixList { return &v1.ConnectivityMatrixList{} },
+			func(dst, src *v1.ConnectivityMatrixList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.ConnectivityMatrixList) []*v1.ConnectivityMatrix {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1.ConnectivityMatrixList, items []*v1.ConnectivityMatrix) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
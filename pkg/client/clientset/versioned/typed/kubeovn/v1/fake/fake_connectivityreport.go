@@ -0,0 +1,53 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/client/applyconfiguration/kubeovn/v1"
+	typedkubeovnv1 "github.com/kubeovn/kube-ovn/pkg/client/clientset/versioned/typed/kubeovn/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeConnectivityReports implements ConnectivityReportInterface
+type fakeConnectivityReports struct {
+	*gentype.FakeClientWithListAndApply[*v1.ConnectivityReport, *v1.ConnectivityReportList, *kubeovnv1.ConnectivityReportApplyConfiguration]
+	Fake *FakeKubeovnV1
+}
+
+func newFakeConnectivityReports(fake *FakeKubeovnV1) typedkubeovnv1.ConnectivityReportInterface {
+	return &fakeConnectivityReports{
+		gentype.NewFakeClientWithListAndApply[*v1.ConnectivityReport, *v1.ConnectivityReportList, *kubeovnv1.ConnectivityReportApplyConfiguration](
+			fake.Fake,
+			"",
+			v1.SchemeGroupVersion.WithResource("connectivity-reports"),
+			v1.SchemeGroupVersion.WithKind("ConnectivityReport"),
+			func() *v1.ConnectivityReport { return &v1.ConnectivityReport{} },
+			func() *v1.ConnectivityReportList { return &v1.ConnectivityReportList{} },
+			func(dst, src *v1.ConnectivityReportList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.ConnectivityReportList) []*v1.ConnectivityReport {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1.ConnectivityReportList, items []*v1.ConnectivityReport) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
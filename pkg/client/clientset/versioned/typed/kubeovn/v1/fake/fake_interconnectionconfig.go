@@ -0,0 +1,53 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/client/applyconfiguration/kubeovn/v1"
+	typedkubeovnv1 "github.com/kubeovn/kube-ovn/pkg/client/clientset/versioned/typed/kubeovn/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeInterconnectionConfigs implements InterconnectionConfigInterface
+type fakeInterconnectionConfigs struct {
+	*gentype.FakeClientWithListAndApply[*v1.InterconnectionConfig, *v1.InterconnectionConfigList, *kubeovnv1.InterconnectionConfigApplyConfiguration]
+	Fake *FakeKubeovnV1
+}
+
+func newFakeInterconnectionConfigs(fake *FakeKubeovnV1) typedkubeovnv1.InterconnectionConfigInterface {
+	return &fakeInterconnectionConfigs{
+		gentype.NewFakeClientWithListAndApply[*v1.InterconnectionConfig, *v1.InterconnectionConfigList, *kubeovnv1.InterconnectionConfigApplyConfiguration](
+			fake.Fake,
+			"",
+			v1.SchemeGroupVersion.WithResource("interconnection-configs"),
+			v1.SchemeGroupVersion.WithKind("InterconnectionConfig"),
+			func() *v1.InterconnectionConfig { return &v1.InterconnectionConfig{} },
+			func() *v1.InterconnectionConfigList { return &v1.InterconnectionConfigList{} },
+			func(dst, src *v1.InterconnectionConfigList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.InterconnectionConfigList) []*v1.InterconnectionConfig {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1.InterconnectionConfigList, items []*v1.InterconnectionConfig) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -32,6 +32,18 @@ func (c *FakeKubeovnV1) BgpConves() v1.BgpConfInterface {
 	return newFakeBgpConves(c)
 }
 
+func (c *FakeKubeovnV1) BgpSpeakerStatuses() v1.BgpSpeakerStatusInterface {
+	return newFakeBgpSpeakerStatuses(c)
+}
+
+func (c *FakeKubeovnV1) ConnectivityMatrixes() v1.ConnectivityMatrixInterface {
+	return newFakeConnectivityMatrixes(c)
+}
+
+func (c *FakeKubeovnV1) ConnectivityReports() v1.ConnectivityReportInterface {
+	return newFakeConnectivityReports(c)
+}
+
 func (c *FakeKubeovnV1) DNSNameResolvers() v1.DNSNameResolverInterface {
 	return newFakeDNSNameResolvers(c)
 }
@@ -48,6 +60,10 @@ func (c *FakeKubeovnV1) IPPools() v1.IPPoolInterface {
 	return newFakeIPPools(c)
 }
 
+func (c *FakeKubeovnV1) InterconnectionConfigs() v1.InterconnectionConfigInterface {
+	return newFakeInterconnectionConfigs(c)
+}
+
 func (c *FakeKubeovnV1) IptablesDnatRules() v1.IptablesDnatRuleInterface {
 	return newFakeIptablesDnatRules(c)
 }
@@ -20,6 +20,12 @@ package v1
 
 type BgpConfExpansion interface{}
 
+type BgpSpeakerStatusExpansion interface{}
+
+type ConnectivityMatrixExpansion interface{}
+
+type ConnectivityReportExpansion interface{}
+
 type DNSNameResolverExpansion interface{}
 
 type EvpnConfExpansion interface{}
@@ -28,6 +34,8 @@ type IPExpansion interface{}
 
 type IPPoolExpansion interface{}
 
+type InterconnectionConfigExpansion interface{}
+
 type IptablesDnatRuleExpansion interface{}
 
 type IptablesEIPExpansion interface{}
@@ -40,6 +40,8 @@ type IPsGetter interface {
 type IPInterface interface {
 	Create(ctx context.Context, iP *kubeovnv1.IP, opts metav1.CreateOptions) (*kubeovnv1.IP, error)
 	Update(ctx context.Context, iP *kubeovnv1.IP, opts metav1.UpdateOptions) (*kubeovnv1.IP, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, iP *kubeovnv1.IP, opts metav1.UpdateOptions) (*kubeovnv1.IP, error)
 	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
 	Get(ctx context.Context, name string, opts metav1.GetOptions) (*kubeovnv1.IP, error)
@@ -47,6 +49,8 @@ type IPInterface interface {
 	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *kubeovnv1.IP, err error)
 	Apply(ctx context.Context, iP *applyconfigurationkubeovnv1.IPApplyConfiguration, opts metav1.ApplyOptions) (result *kubeovnv1.IP, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, iP *applyconfigurationkubeovnv1.IPApplyConfiguration, opts metav1.ApplyOptions) (result *kubeovnv1.IP, err error)
 	IPExpansion
 }
 
@@ -29,10 +29,14 @@ import (
 type KubeovnV1Interface interface {
 	RESTClient() rest.Interface
 	BgpConvesGetter
+	BgpSpeakerStatusesGetter
+	ConnectivityMatrixesGetter
+	ConnectivityReportsGetter
 	DNSNameResolversGetter
 	EvpnConvesGetter
 	IPsGetter
 	IPPoolsGetter
+	InterconnectionConfigsGetter
 	IptablesDnatRulesGetter
 	IptablesEIPsGetter
 	IptablesFIPRulesGetter
@@ -64,6 +68,18 @@ func (c *KubeovnV1Client) BgpConves() BgpConfInterface {
 	return newBgpConves(c)
 }
 
+func (c *KubeovnV1Client) BgpSpeakerStatuses() BgpSpeakerStatusInterface {
+	return newBgpSpeakerStatuses(c)
+}
+
+func (c *KubeovnV1Client) ConnectivityMatrixes() ConnectivityMatrixInterface {
+	return newConnectivityMatrixes(c)
+}
+
+func (c *KubeovnV1Client) ConnectivityReports() ConnectivityReportInterface {
+	return newConnectivityReports(c)
+}
+
 func (c *KubeovnV1Client) DNSNameResolvers() DNSNameResolverInterface {
 	return newDNSNameResolvers(c)
 }
@@ -80,6 +96,10 @@ func (c *KubeovnV1Client) IPPools() IPPoolInterface {
 	return newIPPools(c)
 }
 
+func (c *KubeovnV1Client) InterconnectionConfigs() InterconnectionConfigInterface {
+	return newInterconnectionConfigs(c)
+}
+
 func (c *KubeovnV1Client) IptablesDnatRules() IptablesDnatRuleInterface {
 	return newIptablesDnatRules(c)
 }
@@ -55,6 +55,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=kubeovn.io, Version=v1
 	case v1.SchemeGroupVersion.WithResource("bgp-confs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeovn().V1().BgpConves().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("bgp-speaker-statuses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeovn().V1().BgpSpeakerStatuses().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("connectivity-matrices"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeovn().V1().ConnectivityMatrixes().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("connectivity-reports"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeovn().V1().ConnectivityReports().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("dnsnameresolvers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeovn().V1().DNSNameResolvers().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("evpn-confs"):
@@ -63,6 +69,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeovn().V1().IPs().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("ippools"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeovn().V1().IPPools().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("interconnection-configs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeovn().V1().InterconnectionConfigs().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("iptables-dnat-rules"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeovn().V1().IptablesDnatRules().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("iptables-eips"):
@@ -26,6 +26,12 @@ import (
 type Interface interface {
 	// BgpConves returns a BgpConfInformer.
 	BgpConves() BgpConfInformer
+	// BgpSpeakerStatuses returns a BgpSpeakerStatusInformer.
+	BgpSpeakerStatuses() BgpSpeakerStatusInformer
+	// ConnectivityMatrixes returns a ConnectivityMatrixInformer.
+	ConnectivityMatrixes() ConnectivityMatrixInformer
+	// ConnectivityReports returns a ConnectivityReportInformer.
+	ConnectivityReports() ConnectivityReportInformer
 	// DNSNameResolvers returns a DNSNameResolverInformer.
 	DNSNameResolvers() DNSNameResolverInformer
 	// EvpnConves returns a EvpnConfInformer.
@@ -34,6 +40,8 @@ type Interface interface {
 	IPs() IPInformer
 	// IPPools returns a IPPoolInformer.
 	IPPools() IPPoolInformer
+	// InterconnectionConfigs returns a InterconnectionConfigInformer.
+	InterconnectionConfigs() InterconnectionConfigInformer
 	// IptablesDnatRules returns a IptablesDnatRuleInformer.
 	IptablesDnatRules() IptablesDnatRuleInformer
 	// IptablesEIPs returns a IptablesEIPInformer.
@@ -92,6 +100,21 @@ func (v *version) BgpConves() BgpConfInformer {
 	return &bgpConfInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// BgpSpeakerStatuses returns a BgpSpeakerStatusInformer.
+func (v *version) BgpSpeakerStatuses() BgpSpeakerStatusInformer {
+	return &bgpSpeakerStatusInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// ConnectivityMatrixes returns a ConnectivityMatrixInformer.
+func (v *version) ConnectivityMatrixes() ConnectivityMatrixInformer {
+	return &connectivityMatrixInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// ConnectivityReports returns a ConnectivityReportInformer.
+func (v *version) ConnectivityReports() ConnectivityReportInformer {
+	return &connectivityReportInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // DNSNameResolvers returns a DNSNameResolverInformer.
 func (v *version) DNSNameResolvers() DNSNameResolverInformer {
 	return &dNSNameResolverInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -112,6 +135,11 @@ func (v *version) IPPools() IPPoolInformer {
 	return &iPPoolInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// InterconnectionConfigs returns a InterconnectionConfigInformer.
+func (v *version) InterconnectionConfigs() InterconnectionConfigInformer {
+	return &interconnectionConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // IptablesDnatRules returns a IptablesDnatRuleInformer.
 func (v *version) IptablesDnatRules() IptablesDnatRuleInformer {
 	return &iptablesDnatRuleInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -0,0 +1,48 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ConnectivityReportLister helps list ConnectivityReports.
+// All objects returned here must be treated as read-only.
+type ConnectivityReportLister interface {
+	// List lists all ConnectivityReports in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*kubeovnv1.ConnectivityReport, err error)
+	// Get retrieves the ConnectivityReport from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*kubeovnv1.ConnectivityReport, error)
+	ConnectivityReportListerExpansion
+}
+
+// connectivityReportLister implements the ConnectivityReportLister interface.
+type connectivityReportLister struct {
+	listers.ResourceIndexer[*kubeovnv1.ConnectivityReport]
+}
+
+// NewConnectivityReportLister returns a new ConnectivityReportLister.
+func NewConnectivityReportLister(indexer cache.Indexer) ConnectivityReportLister {
+	return &connectivityReportLister{listers.New[*kubeovnv1.ConnectivityReport](indexer, kubeovnv1.Resource("connectivityreport"))}
+}
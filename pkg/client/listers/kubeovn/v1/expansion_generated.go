@@ -22,6 +22,18 @@ package v1
 // BgpConfLister.
 type BgpConfListerExpansion interface{}
 
+// BgpSpeakerStatusListerExpansion allows custom methods to be added to
+// BgpSpeakerStatusLister.
+type BgpSpeakerStatusListerExpansion interface{}
+
+// ConnectivityMatrixListerExpansion allows custom methods to be added to
+// ConnectivityMatrixLister.
+type ConnectivityMatrixListerExpansion interface{}
+
+// ConnectivityReportListerExpansion allows custom methods to be added to
+// ConnectivityReportLister.
+type ConnectivityReportListerExpansion interface{}
+
 // DNSNameResolverListerExpansion allows custom methods to be added to
 // DNSNameResolverLister.
 type DNSNameResolverListerExpansion interface{}
@@ -38,6 +50,10 @@ type IPListerExpansion interface{}
 // IPPoolLister.
 type IPPoolListerExpansion interface{}
 
+// InterconnectionConfigListerExpansion allows custom methods to be added to
+// InterconnectionConfigLister.
+type InterconnectionConfigListerExpansion interface{}
+
 // IptablesDnatRuleListerExpansion allows custom methods to be added to
 // IptablesDnatRuleLister.
 type IptablesDnatRuleListerExpansion interface{}
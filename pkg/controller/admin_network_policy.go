@@ -718,28 +718,7 @@ func (c *Controller) resolveDomainNames(domainNames []v1alpha1.DomainName) ([]st
 	var allV4Addresses, allV6Addresses []string
 
 	for _, domainName := range domainNames {
-		// O(1) lookup via the Spec.Name informer index instead of listing all resolvers
-		objs, err := c.dnsNameResolverIndexer.ByIndex(IndexDNSNameResolverByName, string(domainName))
-		if err != nil {
-			klog.Errorf("failed to query DNSNameResolver index for domain %s: %v", domainName, err)
-			continue
-		}
-		if len(objs) == 0 {
-			klog.V(3).Infof("No DNSNameResolver found for domain %s, skipping", domainName)
-			continue
-		}
-		foundResolver, ok := objs[0].(*kubeovnv1.DNSNameResolver)
-		if !ok {
-			continue
-		}
-
-		// Get resolved addresses from DNSNameResolver
-		v4Addresses, v6Addresses, err := getResolvedAddressesFromDNSNameResolver(foundResolver)
-		if err != nil {
-			klog.Errorf("Failed to get resolved addresses from DNSNameResolver %s: %v", foundResolver.Name, err)
-			continue
-		}
-
+		v4Addresses, v6Addresses := c.resolveDomainName(string(domainName))
 		allV4Addresses = append(allV4Addresses, v4Addresses...)
 		allV6Addresses = append(allV6Addresses, v6Addresses...)
 	}
@@ -747,6 +726,32 @@ func (c *Controller) resolveDomainNames(domainNames []v1alpha1.DomainName) ([]st
 	return allV4Addresses, allV6Addresses, nil
 }
 
+// resolveDomainName returns the addresses resolved by the DNSNameResolver of a domain name
+func (c *Controller) resolveDomainName(domainName string) ([]string, []string) {
+	// O(1) lookup via the Spec.Name informer index instead of listing all resolvers
+	objs, err := c.dnsNameResolverIndexer.ByIndex(IndexDNSNameResolverByName, domainName)
+	if err != nil {
+		klog.Errorf("failed to query DNSNameResolver index for domain %s: %v", domainName, err)
+		return nil, nil
+	}
+	if len(objs) == 0 {
+		klog.V(3).Infof("No DNSNameResolver found for domain %s, skipping", domainName)
+		return nil, nil
+	}
+	foundResolver, ok := objs[0].(*kubeovnv1.DNSNameResolver)
+	if !ok {
+		return nil, nil
+	}
+
+	// Get resolved addresses from DNSNameResolver
+	v4Addresses, v6Addresses, err := getResolvedAddressesFromDNSNameResolver(foundResolver)
+	if err != nil {
+		klog.Errorf("Failed to get resolved addresses from DNSNameResolver %s: %v", foundResolver.Name, err)
+		return nil, nil
+	}
+	return v4Addresses, v6Addresses
+}
+
 func (c *Controller) createAsForAnpRule(anpName, ruleName, direction, asName string, addresses []string, isBanp bool) error {
 	var err error
 	if isBanp {
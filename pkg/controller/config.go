@@ -21,6 +21,7 @@ import (
 	anpclientset "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned"
 
 	clientset "github.com/kubeovn/kube-ovn/pkg/client/clientset/versioned"
+	"github.com/kubeovn/kube-ovn/pkg/tracing"
 	"github.com/kubeovn/kube-ovn/pkg/util"
 )
 
@@ -145,6 +146,7 @@ type Configuration struct {
 	EnableLbSvc                 bool
 	EnableOVNLBPreferLocal      bool
 	EnableMetrics               bool
+	EnableTracing               bool
 	EnableANP                   bool
 	EnableDNSNameResolver       bool
 	EnableOVNIPSec              bool
@@ -156,8 +158,9 @@ type Configuration struct {
 	ExternalGatewayNet      string
 	ExternalGatewayVlanID   int
 
-	GCInterval      int
-	InspectInterval int
+	GCInterval         int
+	InspectInterval    int
+	DriftCheckInterval int
 
 	BfdMinTx      int
 	BfdMinRx      int
@@ -187,6 +190,13 @@ type Configuration struct {
 
 	// Skip conntrack for specific destination IP CIDRs
 	SkipConntrackDstCidrs string
+
+	// File receiving the SNAT port block allocation records
+	SnatPortBlockLogFile string
+
+	// Traffic counters of the iptables EIPs and NAT rules
+	NatGwCounterInterval   int
+	EnableNatCounterStatus bool
 }
 
 // ParseFlags parses cmd args then init kubeclient and conf
@@ -254,6 +264,7 @@ func ParseFlags() (*Configuration, error) {
 		argEnableLbSvc                 = pflag.Bool("enable-lb-svc", false, "Whether to support loadbalancer service")
 		argEnableOVNLBPreferLocal      = pflag.Bool("enable-ovn-lb-prefer-local", false, "Whether to support ovn loadbalancer prefer local")
 		argEnableMetrics               = pflag.Bool("enable-metrics", true, "Whether to support metrics query")
+		argEnableTracing               = pflag.Bool("enable-tracing", false, "Whether to export OpenTelemetry traces of reconciliation, kubernetes api requests and ovn nb transactions, the OTLP exporter is configured by the standard OTEL_* environment variables")
 		argEnableANP                   = pflag.Bool("enable-anp", false, "Enable support for admin network policy and baseline admin network policy")
 		argEnableDNSNameResolver       = pflag.Bool("enable-dns-name-resolver", false, "Enable support for DNS name resolver")
 		argEnableOVNIPSec              = pflag.Bool("enable-ovn-ipsec", false, "Whether to enable ovn ipsec")
@@ -266,8 +277,9 @@ func ParseFlags() (*Configuration, error) {
 		argExternalGatewayVlanID   = pflag.Int("external-gateway-vlanid", 0, "The VLAN ID of port ln-ovn-external")
 		argNodeLocalDNSIP          = pflag.String("node-local-dns-ip", "", "Comma-separated string of nodelocal DNS ip addresses")
 
-		argGCInterval      = pflag.Int("gc-interval", 360, "The interval in seconds between GC processes. If set to 0, GC will be disabled")
-		argInspectInterval = pflag.Int("inspect-interval", 20, "The interval in seconds between inspect processes")
+		argGCInterval         = pflag.Int("gc-interval", 360, "The interval in seconds between GC processes. If set to 0, GC will be disabled")
+		argInspectInterval    = pflag.Int("inspect-interval", 20, "The interval in seconds between inspect processes")
+		argDriftCheckInterval = pflag.Int("drift-check-interval", 300, "The interval in seconds between checks of drift between kubernetes objects and ovn nb. If set to 0, periodic drift check will be disabled")
 
 		argBfdMinTx      = pflag.Int("bfd-min-tx", 100, "This is the minimum interval, in milliseconds, ovn would like to use when transmitting BFD Control packets")
 		argBfdMinRx      = pflag.Int("bfd-min-rx", 100, "This is the minimum interval, in milliseconds, between received BFD Control packets")
@@ -286,6 +298,11 @@ func ParseFlags() (*Configuration, error) {
 		argNonPrimaryCNI = pflag.Bool("non-primary-cni-mode", false, "Use Kube-OVN in non primary cni mode. When true, Kube-OVN will only manage the network for network attachment definitions")
 
 		argSkipConntrackDstCidrs = pflag.String("skip-conntrack-dst-cidrs", "", "Comma-separated list of destination IP CIDRs that should skip conntrack processing")
+
+		argSnatPortBlockLogFile = pflag.String("snat-port-block-log-file", "/var/log/kube-ovn/snat-port-block.log", "The file the leader appends the SNAT port block allocation records to, one JSON object per line. The records are written to the controller log when empty")
+
+		argNatGwCounterInterval   = pflag.Int("nat-gw-counter-interval", 0, "The interval in seconds to collect the traffic counters of the iptables EIPs and NAT rules from the VPC NAT gateways. If set to 0, traffic counter collection will be disabled")
+		argEnableNatCounterStatus = pflag.Bool("nat-counter-status", false, "Summarize the collected traffic counters in the status of the iptables EIPs and NAT rules")
 	)
 
 	klogFlags := flag.NewFlagSet("klog", flag.ExitOnError)
@@ -363,9 +380,11 @@ func ParseFlags() (*Configuration, error) {
 		NodePgProbeTime:                *argNodePgProbeTime,
 		GCInterval:                     *argGCInterval,
 		InspectInterval:                *argInspectInterval,
+		DriftCheckInterval:             *argDriftCheckInterval,
 		EnableLbSvc:                    *argEnableLbSvc,
 		EnableOVNLBPreferLocal:         *argEnableOVNLBPreferLocal,
 		EnableMetrics:                  *argEnableMetrics,
+		EnableTracing:                  *argEnableTracing,
 		EnableOVNIPSec:                 *argEnableOVNIPSec,
 		CertManagerIPSecCert:           *argCertManagerIPSecCert,
 		EnableLiveMigrationOptimize:    *argEnableLiveMigrationOptimize,
@@ -383,6 +402,9 @@ func ParseFlags() (*Configuration, error) {
 		EnableNonPrimaryCNI:            *argNonPrimaryCNI,
 		NetworkPolicyEnforcement:       *argNPEnforcement,
 		SkipConntrackDstCidrs:          *argSkipConntrackDstCidrs,
+		SnatPortBlockLogFile:           *argSnatPortBlockLogFile,
+		NatGwCounterInterval:           *argNatGwCounterInterval,
+		EnableNatCounterStatus:         *argEnableNatCounterStatus,
 	}
 	if err := config.LeaderElection.validate(); err != nil {
 		return nil, err
@@ -472,6 +494,9 @@ func (config *Configuration) initKubeClient() error {
 	cfg.Burst = 2000
 	// use cmd arg to modify timeout later
 	cfg.Timeout = 30 * time.Second
+	if config.EnableTracing {
+		cfg.Wrap(tracing.WrapTransport)
+	}
 
 	AttachNetClient, err := attachnetclientset.NewForConfig(cfg)
 	if err != nil {
@@ -0,0 +1,155 @@
+package controller
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+)
+
+const (
+	connectivityMatrixName = "cluster"
+	// connectivityReportStaleTimeout is the age after which a ConnectivityReport is no longer trusted
+	connectivityReportStaleTimeout = 3 * time.Minute
+	// maxPartitionedPairsInMessage bounds the node pairs listed in the Connected condition message
+	maxPartitionedPairsInMessage = 10
+)
+
+// buildConnectivityMatrixStatus aggregates the reports of all nodes into the cluster wide matrix.
+// A node pair is partitioned as soon as the pinger of the source node fails to reach one of the pod or node IPs
+// of the target node, including pod IPs in subnets attached via Multus, so that a single broken path is reported.
+// The loss of the pair is the highest loss of its unreachable IPs.
+func buildConnectivityMatrixStatus(nodes []*corev1.Node, reports []kubeovnv1.ConnectivityReport, now time.Time) kubeovnv1.ConnectivityMatrixStatus {
+	status := kubeovnv1.ConnectivityMatrixStatus{Nodes: len(nodes)}
+
+	nodeNames := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		nodeNames[node.Name] = true
+	}
+	freshReports := make(map[string]*kubeovnv1.ConnectivityReportStatus, len(reports))
+	for i := range reports {
+		report := &reports[i]
+		if nodeNames[report.Name] && now.Sub(report.Status.LastUpdateTime.Time) <= connectivityReportStaleTimeout {
+			freshReports[report.Name] = &report.Status
+		}
+	}
+
+	pairs := make(map[[2]string]int)
+	for _, node := range nodes {
+		report := freshReports[node.Name]
+		if report == nil {
+			status.StaleNodes = append(status.StaleNodes, node.Name)
+			continue
+		}
+		status.ReportingNodes++
+		for _, peer := range report.Peers {
+			if peer.Reachable || peer.NodeName == node.Name || !nodeNames[peer.NodeName] {
+				continue
+			}
+			key := [2]string{node.Name, peer.NodeName}
+			pairs[key] = max(pairs[key], peer.LossPercent)
+		}
+	}
+	slices.Sort(status.StaleNodes)
+
+	for key, loss := range pairs {
+		status.PartitionedPairs = append(status.PartitionedPairs, kubeovnv1.ConnectivityNodePair{
+			Source:      key[0],
+			Target:      key[1],
+			LossPercent: loss,
+		})
+	}
+	slices.SortFunc(status.PartitionedPairs, func(a, b kubeovnv1.ConnectivityNodePair) int {
+		return cmp.Or(cmp.Compare(a.Source, b.Source), cmp.Compare(a.Target, b.Target))
+	})
+	status.PartitionedPairCount = len(status.PartitionedPairs)
+	return status
+}
+
+// setConnectivityCondition sets the Connected condition according to the aggregated status
+func setConnectivityCondition(status *kubeovnv1.ConnectivityMatrixStatus) {
+	switch {
+	case status.ReportingNodes == 0:
+		status.Conditions.SetCondition(kubeovnv1.ConnectivityConnected, corev1.ConditionUnknown, "NoReports", "no node has reported connectivity recently", 0)
+	case status.PartitionedPairCount != 0:
+		pairs := make([]string, 0, maxPartitionedPairsInMessage)
+		for _, pair := range status.PartitionedPairs[:min(status.PartitionedPairCount, maxPartitionedPairsInMessage)] {
+			pairs = append(pairs, pair.Source+" -> "+pair.Target)
+		}
+		message := "unreachable node pairs: " + strings.Join(pairs, ", ")
+		if status.PartitionedPairCount > len(pairs) {
+			message += fmt.Sprintf(" and %d more", status.PartitionedPairCount-len(pairs))
+		}
+		status.Conditions.SetCondition(kubeovnv1.ConnectivityConnected, corev1.ConditionFalse, "Partitioned", message, 0)
+	case len(status.StaleNodes) != 0:
+		message := "nodes without recent report: " + strings.Join(status.StaleNodes, ", ")
+		status.Conditions.SetCondition(kubeovnv1.ConnectivityConnected, corev1.ConditionUnknown, "StaleReports", message, 0)
+	default:
+		status.Conditions.SetCondition(kubeovnv1.ConnectivityConnected, corev1.ConditionTrue, "AllReachable", "", 0)
+	}
+}
+
+// syncConnectivityMatrix aggregates the ConnectivityReports published by kube-ovn-pinger into the ConnectivityMatrix.
+// The matrix is only created once at least one pinger publishes its report.
+func (c *Controller) syncConnectivityMatrix() {
+	reports, err := c.config.KubeOvnClient.KubeovnV1().ConnectivityReports().List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			// the CRD is not installed
+			return
+		}
+		klog.Errorf("failed to list connectivity reports: %v", err)
+		return
+	}
+	nodes, err := c.nodesLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list nodes: %v", err)
+		return
+	}
+
+	client := c.config.KubeOvnClient.KubeovnV1().ConnectivityMatrixes()
+	matrix, err := client.Get(context.Background(), connectivityMatrixName, metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			klog.Errorf("failed to get connectivity matrix %s: %v", connectivityMatrixName, err)
+			return
+		}
+		if len(reports.Items) == 0 {
+			return
+		}
+		matrix = &kubeovnv1.ConnectivityMatrix{ObjectMeta: metav1.ObjectMeta{Name: connectivityMatrixName}}
+		if matrix, err = client.Create(context.Background(), matrix, metav1.CreateOptions{}); err != nil {
+			klog.Errorf("failed to create connectivity matrix %s: %v", connectivityMatrixName, err)
+			return
+		}
+	}
+
+	status := buildConnectivityMatrixStatus(nodes, reports.Items, time.Now())
+	status.Conditions = matrix.Status.Conditions.DeepCopy()
+	setConnectivityCondition(&status)
+	status.LastUpdateTime = matrix.Status.LastUpdateTime
+	if equality.Semantic.DeepEqual(matrix.Status, status) {
+		return
+	}
+
+	if condition := status.Conditions.GetCondition(kubeovnv1.ConnectivityConnected); condition != nil && condition.Status == corev1.ConditionFalse {
+		klog.Warningf("cluster connectivity is partitioned: %s", condition.Message)
+	}
+	matrix = matrix.DeepCopy()
+	matrix.Status = status
+	matrix.Status.LastUpdateTime = metav1.Now()
+	if _, err = client.UpdateStatus(context.Background(), matrix, metav1.UpdateOptions{}); err != nil {
+		klog.Errorf("failed to update status of connectivity matrix %s: %v", connectivityMatrixName, err)
+	}
+}
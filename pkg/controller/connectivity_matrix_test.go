@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+)
+
+func TestBuildConnectivityMatrixStatus(t *testing.T) {
+	now := time.Now()
+	nodes := []*corev1.Node{
+		{ObjectMeta: metav1.ObjectMeta{Name: "node1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "node2"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "node3"}},
+	}
+	newReport := func(name string, updated time.Time, peers ...kubeovnv1.ConnectivityPeer) kubeovnv1.ConnectivityReport {
+		return kubeovnv1.ConnectivityReport{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: kubeovnv1.ConnectivityReportStatus{
+				NodeName:       name,
+				Peers:          peers,
+				LastUpdateTime: metav1.NewTime(updated),
+			},
+		}
+	}
+	peer := func(peerType, nodeName string, reachable bool, loss int) kubeovnv1.ConnectivityPeer {
+		return kubeovnv1.ConnectivityPeer{Type: peerType, NodeName: nodeName, Reachable: reachable, LossPercent: loss}
+	}
+
+	t.Run("all reachable", func(t *testing.T) {
+		reports := []kubeovnv1.ConnectivityReport{
+			newReport("node1", now, peer(kubeovnv1.ConnectivityPeerTypePod, "node2", true, 0)),
+			newReport("node2", now, peer(kubeovnv1.ConnectivityPeerTypePod, "node1", true, 33)),
+			newReport("node3", now),
+		}
+		status := buildConnectivityMatrixStatus(nodes, reports, now)
+		require.Equal(t, 3, status.Nodes)
+		require.Equal(t, 3, status.ReportingNodes)
+		require.Empty(t, status.StaleNodes)
+		require.Zero(t, status.PartitionedPairCount)
+
+		setConnectivityCondition(&status)
+		require.True(t, status.Conditions.IsConditionTrue(kubeovnv1.ConnectivityConnected, 0))
+	})
+
+	t.Run("single unreachable ip partitions the pair", func(t *testing.T) {
+		reports := []kubeovnv1.ConnectivityReport{
+			newReport("node1", now,
+				peer(kubeovnv1.ConnectivityPeerTypePod, "node2", true, 0),
+				peer(kubeovnv1.ConnectivityPeerTypeNode, "node2", false, 100),
+			),
+			newReport("node2", now),
+			newReport("node3", now),
+		}
+		status := buildConnectivityMatrixStatus(nodes, reports, now)
+		require.Equal(t, []kubeovnv1.ConnectivityNodePair{{Source: "node1", Target: "node2", LossPercent: 100}}, status.PartitionedPairs)
+	})
+
+	t.Run("partitioned and stale nodes", func(t *testing.T) {
+		reports := []kubeovnv1.ConnectivityReport{
+			newReport("node1", now,
+				peer(kubeovnv1.ConnectivityPeerTypePod, "node2", false, 100),
+				peer(kubeovnv1.ConnectivityPeerTypeNode, "node2", false, 100),
+				peer(kubeovnv1.ConnectivityPeerTypePod, "node1", false, 100),
+				peer(kubeovnv1.ConnectivityPeerTypePod, "deleted", false, 100),
+			),
+			newReport("node2", now.Add(-time.Hour), peer(kubeovnv1.ConnectivityPeerTypePod, "node1", false, 100)),
+			newReport("unknown", now, peer(kubeovnv1.ConnectivityPeerTypePod, "node1", false, 100)),
+		}
+		status := buildConnectivityMatrixStatus(nodes, reports, now)
+		require.Equal(t, 1, status.ReportingNodes)
+		require.Equal(t, []string{"node2", "node3"}, status.StaleNodes)
+		require.Equal(t, []kubeovnv1.ConnectivityNodePair{{Source: "node1", Target: "node2", LossPercent: 100}}, status.PartitionedPairs)
+		require.Equal(t, 1, status.PartitionedPairCount)
+
+		setConnectivityCondition(&status)
+		condition := status.Conditions.GetCondition(kubeovnv1.ConnectivityConnected)
+		require.NotNil(t, condition)
+		require.Equal(t, corev1.ConditionFalse, condition.Status)
+		require.Equal(t, "Partitioned", condition.Reason)
+		require.Equal(t, "unreachable node pairs: node1 -> node2", condition.Message)
+	})
+
+	t.Run("no reports", func(t *testing.T) {
+		status := buildConnectivityMatrixStatus(nodes, nil, now)
+		require.Zero(t, status.ReportingNodes)
+		require.Len(t, status.StaleNodes, 3)
+
+		setConnectivityCondition(&status)
+		condition := status.Conditions.GetCondition(kubeovnv1.ConnectivityConnected)
+		require.NotNil(t, condition)
+		require.Equal(t, corev1.ConditionUnknown, condition.Status)
+		require.Equal(t, "NoReports", condition.Reason)
+	})
+}
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"runtime"
 	"strings"
@@ -11,10 +12,12 @@ import (
 
 	netAttach "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/client/informers/externalversions"
 	netAttachv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/client/listers/k8s.cni.cncf.io/v1"
+	"github.com/ovn-kubernetes/libovsdb/client"
 	"github.com/puzpuzpuz/xsync/v4"
 	"golang.org/x/time/rate"
 	appsv1api "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -55,12 +58,14 @@ const (
 	portGroupKey                  = "pg"
 	networkPolicyKey              = "np"
 	sgKey                         = "sg"
+	sgFQDNKey                     = "fqdn"
 	sgsKey                        = "security_groups"
 	u2oKey                        = "u2o"
 	adminNetworkPolicyKey         = "anp"
 	baselineAdminNetworkPolicyKey = "banp"
 	ippoolKey                     = "ippool"
 	clusterNetworkPolicyKey       = "cnp"
+	vpcEgressGatewayKey           = "veg"
 )
 
 // Controller is kube-ovn main controller that watch ns/pod/node/svc/ep and operate ovn
@@ -345,6 +350,13 @@ type Controller struct {
 	// Database health check
 	dbFailureCount int
 
+	// Traffic counters collected from the vpc nat gateways
+	natGwCounterCache *natGwCounterCache
+
+	// The latest drift report, shared by the drift metrics and the drift http handler
+	driftReportMutex sync.Mutex
+	driftReport      *DriftReport
+
 	distributedSubnetNeedSync atomic.Bool
 }
 
@@ -1556,6 +1568,14 @@ func (c *Controller) startWorkers(ctx context.Context) {
 		}
 	}, time.Duration(c.config.InspectInterval)*time.Second, ctx.Done())
 
+	activeController.Store(c)
+	if c.config.DriftCheckInterval != 0 {
+		go wait.Until(c.syncDriftMetrics, time.Duration(c.config.DriftCheckInterval)*time.Second, ctx.Done())
+	}
+	if c.config.NatGwCounterInterval != 0 {
+		go wait.Until(c.syncNatGwCounters, time.Duration(c.config.NatGwCounterInterval)*time.Second, ctx.Done())
+	}
+
 	if c.config.EnableExternalVpc {
 		go wait.Until(func() {
 			c.syncExternalVpc()
@@ -1564,6 +1584,7 @@ func (c *Controller) startWorkers(ctx context.Context) {
 
 	go wait.Until(c.resyncProviderNetworkStatus, 30*time.Second, ctx.Done())
 	go wait.Until(c.exportSubnetMetrics, 30*time.Second, ctx.Done())
+	go wait.Until(c.syncConnectivityMatrix, 30*time.Second, ctx.Done())
 	go wait.Until(c.checkSubnetGateway, 5*time.Second, ctx.Done())
 	go wait.Until(c.syncDistributedSubnetRoutes, 5*time.Second, ctx.Done())
 
@@ -1702,10 +1723,14 @@ func processNextWorkItem[T comparable](action string, queue workqueue.TypedRateL
 
 	err := func(item T) error {
 		defer queue.Done(item)
+		start := time.Now()
 		if err := handler(item); err != nil {
+			metricReconcileDuration.WithLabelValues(action, "error").Observe(time.Since(start).Seconds())
+			metricReconcileErrors.WithLabelValues(action, reconcileErrorReason(err)).Inc()
 			queue.AddRateLimited(item)
 			return fmt.Errorf("error syncing %s %q: %w, requeuing", action, getItemKey(item), err)
 		}
+		metricReconcileDuration.WithLabelValues(action, "success").Observe(time.Since(start).Seconds())
 		queue.Forget(item)
 		return nil
 	}(item)
@@ -1716,6 +1741,22 @@ func processNextWorkItem[T comparable](action string, queue workqueue.TypedRateL
 	return true
 }
 
+// reconcileErrorReason classifies the error returned by a workqueue handler for metrics
+func reconcileErrorReason(err error) string {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return "Timeout"
+	case errors.Is(err, client.ErrNotConnected):
+		return "OVNNotConnected"
+	case errors.Is(err, client.ErrNotFound):
+		return "OVNNotFound"
+	}
+	if reason := k8serrors.ReasonForError(err); reason != metav1.StatusReasonUnknown {
+		return string(reason)
+	}
+	return "Unknown"
+}
+
 func getWorkItemKey(obj any) string {
 	switch v := obj.(type) {
 	case string:
@@ -15,6 +15,7 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"testing"
@@ -23,11 +24,14 @@ import (
 	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	nadfake "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/client/clientset/versioned/fake"
 	nadinformers "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/client/informers/externalversions"
+	"github.com/ovn-kubernetes/libovsdb/client"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/informers"
 	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes/fake"
@@ -108,6 +112,7 @@ type FakeControllerOptions struct {
 	OvnSnatRules       []*kubeovnv1.OvnSnatRule
 	QoSPolicies        []*kubeovnv1.QoSPolicy
 	IptablesEips       []*kubeovnv1.IptablesEIP
+	SecurityGroups     []*kubeovnv1.SecurityGroup
 }
 
 // newFakeControllerWithOptions creates a fake controller with optional pre-populated objects
@@ -270,6 +275,14 @@ func newFakeControllerWithOptions(t *testing.T, opts *FakeControllerOptions) (*f
 			return nil, err
 		}
 	}
+	for _, sg := range opts.SecurityGroups {
+		_, err := kubeovnClient.KubeovnV1().SecurityGroups().Create(
+			context.Background(), sg, metav1.CreateOptions{},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Create informer factories
 	kubeInformerFactory := informers.NewSharedInformerFactoryWithOptions(kubeClient, 0,
@@ -314,6 +327,7 @@ func newFakeControllerWithOptions(t *testing.T, opts *FakeControllerOptions) (*f
 	ovnSnatRuleInformer := kubeovnInformerFactory.Kubeovn().V1().OvnSnatRules()
 	qosPolicyInformer := kubeovnInformerFactory.Kubeovn().V1().QoSPolicies()
 	iptablesEipInformer := kubeovnInformerFactory.Kubeovn().V1().IptablesEIPs()
+	sgInformer := kubeovnInformerFactory.Kubeovn().V1().SecurityGroups()
 
 	fakeInformers := &fakeControllerInformers{
 		vpcInformer:       vpcInformer,
@@ -331,6 +345,8 @@ func newFakeControllerWithOptions(t *testing.T, opts *FakeControllerOptions) (*f
 	mockCtrl := gomock.NewController(t)
 	mockOvnClient := mockovs.NewMockNbClient(mockCtrl)
 	mockOvnSbClient := mockovs.NewMockSbClient(mockCtrl)
+	// the handlers trace the nb transactions with a client bound to their context
+	mockOvnClient.EXPECT().WithContext(gomock.Any()).Return(mockOvnClient).AnyTimes()
 
 	// Create controller with all informers
 	ctrl := &Controller{
@@ -365,6 +381,7 @@ func newFakeControllerWithOptions(t *testing.T, opts *FakeControllerOptions) (*f
 		qosPoliciesLister:       qosPolicyInformer.Lister(),
 		qosPolicySynced:         alwaysReady,
 		iptablesEipsLister:      iptablesEipInformer.Lister(),
+		sgsLister:               sgInformer.Lister(),
 		vpcNatGwKeyMutex:        keymutex.NewHashed(0),
 		OVNNbClient:             mockOvnClient,
 		OVNSbClient:             mockOvnSbClient,
@@ -501,3 +518,23 @@ func TestFakeControllerWithOptions(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "net1-subnet", retrievedSubnet.Name)
 }
+
+func TestReconcileErrorReason(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		reason string
+	}{
+		{"timeout", fmt.Errorf("failed to update logical switch: %w", context.DeadlineExceeded), "Timeout"},
+		{"ovn not connected", fmt.Errorf("failed to transact: %w", client.ErrNotConnected), "OVNNotConnected"},
+		{"ovn not found", fmt.Errorf("failed to get logical switch: %w", client.ErrNotFound), "OVNNotFound"},
+		{"kubernetes not found", k8serrors.NewNotFound(schema.GroupResource{Resource: "pods"}, "pod1"), string(metav1.StatusReasonNotFound)},
+		{"kubernetes conflict", fmt.Errorf("failed to update subnet: %w", k8serrors.NewConflict(schema.GroupResource{Resource: "subnets"}, "subnet1", errors.New("conflict"))), string(metav1.StatusReasonConflict)},
+		{"unknown", errors.New("unknown error"), "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.reason, reconcileErrorReason(tt.err))
+		})
+	}
+}
@@ -57,12 +57,6 @@ func (c *Controller) enqueueDeleteDNSNameResolver(obj any) {
 func (c *Controller) handleAddOrUpdateDNSNameResolver(key string) error {
 	klog.Infof("DNSNameResolver add/update handler called for key: %s", key)
 
-	// the ANP/CNP update queues are constructed only when ANP support is enabled
-	if !c.config.EnableANP {
-		klog.Warningf("DNSNameResolver %s is ignored because ANP support is disabled", key)
-		return nil
-	}
-
 	dnsNameResolver, err := c.dnsNameResolversLister.Get(key)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
@@ -72,6 +66,21 @@ func (c *Controller) handleAddOrUpdateDNSNameResolver(key string) error {
 		return fmt.Errorf("failed to get DNSNameResolver %s: %w", key, err)
 	}
 
+	if sgName, exists := dnsNameResolver.Labels[sgKey]; exists {
+		klog.V(3).Infof("Refreshing fqdn address sets of security group %s after DNSNameResolver %s update", sgName, key)
+		return c.refreshSgFQDNAddressSets(sgName)
+	}
+	if owner, exists := dnsNameResolver.Labels[vpcEgressGatewayKey]; exists {
+		klog.V(3).Infof("Triggered vpc-egress-gateway %s re-sync after DNSNameResolver %s update", owner, key)
+		return c.enqueueVpcEgressGatewaysForDNSNameResolver(owner)
+	}
+
+	// the ANP/CNP update queues are constructed only when ANP support is enabled
+	if !c.config.EnableANP {
+		klog.Warningf("DNSNameResolver %s is ignored because ANP support is disabled", key)
+		return nil
+	}
+
 	anpName, exists := dnsNameResolver.Labels[adminNetworkPolicyKey]
 	if !exists {
 		klog.Warningf("DNSNameResolver %s does not have ANP label, skipping", key)
@@ -97,6 +106,18 @@ func (c *Controller) handleAddOrUpdateDNSNameResolver(key string) error {
 func (c *Controller) handleDeleteDNSNameResolver(dnsNameResolver *kubeovnv1.DNSNameResolver) error {
 	klog.Infof("DNSNameResolver delete handler called for: %s", dnsNameResolver.Name)
 
+	if sgName, exists := dnsNameResolver.Labels[sgKey]; exists {
+		// the security group recreates the resolver if the domain is still referenced
+		klog.V(3).Infof("Triggered security group %s re-sync after DNSNameResolver %s deletion", sgName, dnsNameResolver.Name)
+		c.addOrUpdateSgQueue.Add(sgName)
+		return nil
+	}
+	if owner, exists := dnsNameResolver.Labels[vpcEgressGatewayKey]; exists {
+		// the vpc egress gateway recreates the resolver if the domain is still referenced
+		klog.V(3).Infof("Triggered vpc-egress-gateway %s re-sync after DNSNameResolver %s deletion", owner, dnsNameResolver.Name)
+		return c.enqueueVpcEgressGatewaysForDNSNameResolver(owner)
+	}
+
 	// the ANP/CNP update queues are constructed only when ANP support is enabled
 	if !c.config.EnableANP {
 		klog.Warningf("DNSNameResolver %s is ignored because ANP support is disabled", dnsNameResolver.Name)
@@ -185,14 +206,14 @@ func (c *Controller) reconcileDNSNameResolversForNP(npName string, desiredDomain
 
 	// Delete obsolete DNSNameResolvers
 	for _, domainName := range domainsToDelete.List() {
-		if err := c.deleteDNSNameResolver(npName, domainName); err != nil {
+		if err := c.deleteDNSNameResolver(npName, domainName, key); err != nil {
 			return fmt.Errorf("failed to delete DNSNameResolver for domain %s: %w", domainName, err)
 		}
 	}
 
 	// Create new DNSNameResolvers
 	for _, domainName := range domainsToCreate.List() {
-		if err := c.createOrUpdateDNSNameResolver(npName, domainName); err != nil {
+		if err := c.createOrUpdateDNSNameResolver(npName, domainName, key); err != nil {
 			return fmt.Errorf("failed to create DNSNameResolver for domain %s: %w", domainName, err)
 		}
 	}
@@ -200,8 +221,8 @@ func (c *Controller) reconcileDNSNameResolversForNP(npName string, desiredDomain
 	return nil
 }
 
-func (c *Controller) createOrUpdateDNSNameResolver(anpName, domainName string) error {
-	dnsNameResolverName := generateDNSNameResolverName(anpName, domainName)
+func (c *Controller) createOrUpdateDNSNameResolver(npName, domainName, key string) error {
+	dnsNameResolverName := generateDNSNameResolverName(npName, domainName, key)
 
 	// Check if DNSNameResolver already exists
 	existing, err := c.dnsNameResolversLister.Get(dnsNameResolverName)
@@ -209,12 +230,12 @@ func (c *Controller) createOrUpdateDNSNameResolver(anpName, domainName string) e
 		return fmt.Errorf("failed to get DNSNameResolver %s: %w", dnsNameResolverName, err)
 	}
 
-	klog.Infof("Creating or updating DNSNameResolver %s for domain %s in ANP %s", dnsNameResolverName, domainName, anpName)
+	klog.Infof("Creating or updating DNSNameResolver %s for domain %s in %s %s", dnsNameResolverName, domainName, key, npName)
 	dnsNameResolver := &kubeovnv1.DNSNameResolver{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: dnsNameResolverName,
 			Labels: map[string]string{
-				adminNetworkPolicyKey: anpName,
+				key: npName,
 			},
 		},
 		Spec: kubeovnv1.DNSNameResolverSpec{
@@ -228,7 +249,7 @@ func (c *Controller) createOrUpdateDNSNameResolver(anpName, domainName string) e
 		if err != nil {
 			return fmt.Errorf("failed to create DNSNameResolver %s: %w", dnsNameResolverName, err)
 		}
-		klog.Infof("Created DNSNameResolver %s for domain %s in ANP %s", dnsNameResolverName, domainName, anpName)
+		klog.Infof("Created DNSNameResolver %s for domain %s in %s %s", dnsNameResolverName, domainName, key, npName)
 	} else if existing.Spec.Name != kubeovnv1.DNSName(domainName) {
 		// Update existing DNSNameResolver if needed
 		dnsNameResolver.ResourceVersion = existing.ResourceVersion
@@ -236,15 +257,15 @@ func (c *Controller) createOrUpdateDNSNameResolver(anpName, domainName string) e
 		if err != nil {
 			return fmt.Errorf("failed to update DNSNameResolver %s: %w", dnsNameResolverName, err)
 		}
-		klog.Infof("Updated DNSNameResolver %s for domain %s in ANP %s", dnsNameResolverName, domainName, anpName)
+		klog.Infof("Updated DNSNameResolver %s for domain %s in %s %s", dnsNameResolverName, domainName, key, npName)
 	}
 
 	return nil
 }
 
 // deleteDNSNameResolver deletes DNSNameResolver CR
-func (c *Controller) deleteDNSNameResolver(anpName, domainName string) error {
-	dnsNameResolverName := generateDNSNameResolverName(anpName, domainName)
+func (c *Controller) deleteDNSNameResolver(npName, domainName, key string) error {
+	dnsNameResolverName := generateDNSNameResolverName(npName, domainName, key)
 
 	err := c.config.KubeOvnClient.KubeovnV1().DNSNameResolvers().Delete(context.TODO(), dnsNameResolverName, metav1.DeleteOptions{})
 	if err != nil && !k8serrors.IsNotFound(err) {
@@ -252,15 +273,16 @@ func (c *Controller) deleteDNSNameResolver(anpName, domainName string) error {
 	}
 
 	if err == nil {
-		klog.Infof("Deleted DNSNameResolver %s for domain %s in ANP %s", dnsNameResolverName, domainName, anpName)
+		klog.Infof("Deleted DNSNameResolver %s for domain %s in %s %s", dnsNameResolverName, domainName, key, npName)
 	}
 
 	return nil
 }
 
-func generateDNSNameResolverName(anpName, domainName string) string {
+// generateDNSNameResolverName returns the resolver name prefixed with the owner label key, e.g. anp-<name>-<hash>
+func generateDNSNameResolverName(npName, domainName, key string) string {
 	hash := util.Sha256Hash([]byte(domainName))[:8]
-	return fmt.Sprintf("anp-%s-%s", anpName, hash)
+	return fmt.Sprintf("%s-%s-%s", key, npName, hash)
 }
 
 // isDNSNameResolverStatusEqual compares two DNSNameResolverStatus to check if they are equal
@@ -77,4 +77,12 @@ func Test_handleDeleteDNSNameResolver(t *testing.T) {
 		require.Equal(t, 1, ctrl.updateAnpQueue.Len())
 		require.Equal(t, 1, ctrl.updateCnpQueue.Len())
 	})
+	t.Run("security group", func(t *testing.T) {
+		resolver := newTestDNSNameResolver()
+		resolver.Labels = map[string]string{sgKey: "test-sg"}
+		ctrl := newFakeDNSNameResolverController(t, false)
+		ctrl.addOrUpdateSgQueue = newTypedRateLimitingQueue[string]("UpdateSecurityGroup", nil)
+		require.NoError(t, ctrl.handleDeleteDNSNameResolver(resolver))
+		require.Equal(t, 1, ctrl.addOrUpdateSgQueue.Len())
+	})
 }
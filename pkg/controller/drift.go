@@ -0,0 +1,509 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/ovs"
+	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnnb"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+const (
+	driftKindPod           = "Pod"
+	driftKindNetworkPolicy = "NetworkPolicy"
+	driftKindVpc           = "Vpc"
+	driftKindService       = "Service"
+
+	driftReasonMissingLogicalSwitchPort = "MissingLogicalSwitchPort"
+	driftReasonAddressMismatch          = "AddressMismatch"
+	driftReasonMissingPortGroup         = "MissingPortGroup"
+	driftReasonMissingPortGroupMember   = "MissingPortGroupMember"
+	driftReasonStalePortGroupMember     = "StalePortGroupMember"
+	driftReasonMissingACL               = "MissingACL"
+	driftReasonMissingLogicalRouter     = "MissingLogicalRouter"
+	driftReasonMissingStaticRoute       = "MissingStaticRoute"
+	driftReasonStaleStaticRoute         = "StaleStaticRoute"
+	driftReasonMissingVip               = "MissingVip"
+	driftReasonStaleVip                 = "StaleVip"
+	driftReasonBackendMismatch          = "BackendMismatch"
+)
+
+// DriftItem describes a single discrepancy between a kubernetes object and the OVN NB database
+type DriftItem struct {
+	Kind     string `json:"kind"`
+	Object   string `json:"object"`
+	Resource string `json:"resource"`
+	Reason   string `json:"reason"`
+	Detail   string `json:"detail,omitempty"`
+}
+
+// DriftReport is the result of a drift check, nothing in the NB database is changed by the check
+type DriftReport struct {
+	Time  time.Time   `json:"time"`
+	Items []DriftItem `json:"items"`
+}
+
+// driftReportTTL is how long a drift report is reused before another check runs,
+// which prevents the http handler from running full scans of the cluster back to back
+const driftReportTTL = 30 * time.Second
+
+// activeController is the controller running on the leader, which serves the debug http handlers
+var activeController atomic.Pointer[Controller]
+
+// DriftHandler returns the http handler which runs a drift check on demand, a report which is
+// not older than driftReportTTL is returned without running another check. The optional query parameter "kind" limits the report to the given object kinds.
+func DriftHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		c := activeController.Load()
+		if c == nil {
+			http.Error(w, "drift check is only available on the leader", http.StatusServiceUnavailable)
+			return
+		}
+
+		report, err := c.getDriftReport()
+		if err != nil {
+			klog.Errorf("failed to check drift: %v", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if kinds := r.URL.Query()["kind"]; len(kinds) != 0 {
+			items := make([]DriftItem, 0, len(report.Items))
+			for _, item := range report.Items {
+				if slices.Contains(kinds, item.Kind) {
+					items = append(items, item)
+				}
+			}
+			report = &DriftReport{Time: report.Time, Items: items}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err = json.NewEncoder(w).Encode(report); err != nil {
+			klog.Errorf("failed to encode drift report: %v", err)
+		}
+	})
+}
+
+// getDriftReport returns the latest drift report if it is newer than driftReportTTL, or runs a new check otherwise.
+// Only one check runs at a time, concurrent callers wait for it and share its report.
+func (c *Controller) getDriftReport() (*DriftReport, error) {
+	c.driftReportMutex.Lock()
+	defer c.driftReportMutex.Unlock()
+
+	if c.driftReport != nil && time.Since(c.driftReport.Time) < driftReportTTL {
+		return c.driftReport, nil
+	}
+	report, err := c.checkDrift()
+	if err != nil {
+		return nil, err
+	}
+	c.driftReport = report
+	return report, nil
+}
+
+func (c *Controller) syncDriftMetrics() {
+	report, err := c.checkDrift()
+	if err != nil {
+		klog.Errorf("failed to check drift: %v", err)
+		return
+	}
+	c.driftReportMutex.Lock()
+	c.driftReport = report
+	c.driftReportMutex.Unlock()
+
+	metricNbDriftCount.Reset()
+	for _, item := range report.Items {
+		metricNbDriftCount.WithLabelValues(item.Kind, item.Reason).Inc()
+	}
+	if len(report.Items) != 0 {
+		klog.Warningf("found %d discrepancies between kubernetes and ovn nb", len(report.Items))
+	}
+}
+
+func (c *Controller) checkDrift() (*DriftReport, error) {
+	checkers := []struct {
+		kind    string
+		enabled bool
+		check   func() ([]DriftItem, error)
+	}{
+		{driftKindPod, true, c.checkPodDrift},
+		{driftKindNetworkPolicy, c.config.EnableNP, c.checkNetworkPolicyDrift},
+		{driftKindVpc, true, c.checkVpcDrift},
+		{driftKindService, c.config.EnableLb, c.checkServiceDrift},
+	}
+
+	report := &DriftReport{Time: time.Now(), Items: []DriftItem{}}
+	for _, checker := range checkers {
+		if !checker.enabled {
+			continue
+		}
+		items, err := checker.check()
+		if err != nil {
+			return nil, fmt.Errorf("failed to check drift of %s: %w", checker.kind, err)
+		}
+		report.Items = append(report.Items, items...)
+	}
+	return report, nil
+}
+
+func (c *Controller) checkPodDrift() ([]DriftItem, error) {
+	pods, err := c.podsLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list pods: %v", err)
+		return nil, err
+	}
+
+	var items []DriftItem
+	for _, pod := range pods {
+		if pod.Spec.HostNetwork || !isPodAlive(pod) {
+			continue
+		}
+
+		podName := c.getNameByPod(pod)
+		key := cache.MetaObjectToName(pod).String()
+		podNets, err := c.getPodKubeovnNets(pod)
+		if err != nil {
+			klog.Errorf("failed to get networks of pod %s: %v", key, err)
+			continue
+		}
+		for _, podNet := range filterSubnets(pod, podNets) {
+			if podNet.Type == providerTypeIPAM {
+				continue
+			}
+
+			portName := ovs.PodNameToPortName(podName, pod.Namespace, podNet.ProviderName)
+			lsp, err := c.OVNNbClient.GetLogicalSwitchPort(portName, true)
+			if err != nil {
+				klog.Errorf("failed to get logical switch port %s: %v", portName, err)
+				return nil, err
+			}
+			if lsp == nil {
+				items = append(items, DriftItem{Kind: driftKindPod, Object: key, Resource: portName, Reason: driftReasonMissingLogicalSwitchPort})
+				continue
+			}
+
+			mac := pod.Annotations[fmt.Sprintf(util.MacAddressAnnotationTemplate, podNet.ProviderName)]
+			ips := pod.Annotations[fmt.Sprintf(util.IPAddressAnnotationTemplate, podNet.ProviderName)]
+			if detail := diffLspAddresses(lsp.Addresses, mac, ips); detail != "" {
+				items = append(items, DriftItem{Kind: driftKindPod, Object: key, Resource: portName, Reason: driftReasonAddressMismatch, Detail: detail})
+			}
+		}
+	}
+	return items, nil
+}
+
+// diffLspAddresses checks whether the lsp addresses contain the mac and ips allocated to the pod,
+// an empty string is returned if nothing differs
+func diffLspAddresses(addresses []string, mac, ips string) string {
+	expected := strings.TrimSpace(mac + " " + strings.ReplaceAll(ips, ",", " "))
+	for _, address := range addresses {
+		fields := strings.Fields(address)
+		if len(fields) == 0 || fields[0] != mac {
+			continue
+		}
+		if sets.New(fields...).Equal(sets.New(strings.Fields(expected)...)) {
+			return ""
+		}
+		return fmt.Sprintf("expected addresses %q, got %q", expected, address)
+	}
+	return fmt.Sprintf("expected addresses %q, got %q", expected, strings.Join(addresses, ","))
+}
+
+func (c *Controller) checkNetworkPolicyDrift() ([]DriftItem, error) {
+	nps, err := c.npsLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list network policies: %v", err)
+		return nil, err
+	}
+
+	var items []DriftItem
+	for _, np := range nps {
+		key := cache.MetaObjectToName(np).String()
+		npName := np.Name
+		nameArray := []rune(np.Name)
+		if !unicode.IsLetter(nameArray[0]) {
+			npName = "np" + np.Name
+		}
+		pgName := npPortGroupName(np.Namespace, npName)
+
+		pg, err := c.OVNNbClient.GetPortGroup(pgName, true)
+		if err != nil {
+			klog.Errorf("failed to get port group %s: %v", pgName, err)
+			return nil, err
+		}
+		if pg == nil {
+			items = append(items, DriftItem{Kind: driftKindNetworkPolicy, Object: key, Resource: pgName, Reason: driftReasonMissingPortGroup})
+			continue
+		}
+
+		ports, subnetNames, err := c.fetchSelectedPorts(np.Namespace, &np.Spec.PodSelector, parsePolicyFor(np))
+		if err != nil {
+			klog.Errorf("failed to fetch ports selected by network policy %s: %v", key, err)
+			return nil, err
+		}
+		missing, stale, err := c.diffPortGroupMembers(pg, ports)
+		if err != nil {
+			return nil, err
+		}
+		for _, port := range missing {
+			items = append(items, DriftItem{Kind: driftKindNetworkPolicy, Object: key, Resource: pgName, Reason: driftReasonMissingPortGroupMember, Detail: port})
+		}
+		for _, port := range stale {
+			items = append(items, DriftItem{Kind: driftKindNetworkPolicy, Object: key, Resource: pgName, Reason: driftReasonStalePortGroupMember, Detail: port})
+		}
+
+		// acls are only created when the selected pods belong to at least one subnet
+		if len(subnetNames) == 0 {
+			continue
+		}
+		for direction, hasRule := range map[string]bool{
+			ovnnb.ACLDirectionToLport:   hasIngressRule(np),
+			ovnnb.ACLDirectionFromLport: hasEgressRule(np),
+		} {
+			if !hasRule {
+				continue
+			}
+			acls, err := c.OVNNbClient.ListPortGroupAcls(pgName, direction)
+			if err != nil {
+				klog.Errorf("failed to list %s acls of port group %s: %v", direction, pgName, err)
+				return nil, err
+			}
+			if len(acls) == 0 {
+				items = append(items, DriftItem{Kind: driftKindNetworkPolicy, Object: key, Resource: pgName, Reason: driftReasonMissingACL, Detail: direction})
+			}
+		}
+	}
+	return items, nil
+}
+
+// diffPortGroupMembers returns the expected ports which are not members of the port group
+// and the members which are not expected. Expected ports without a logical switch port are
+// ignored since they are reported by the pod check.
+func (c *Controller) diffPortGroupMembers(pg *ovnnb.PortGroup, expected []string) (missing, stale []string, err error) {
+	members := sets.New(pg.Ports...)
+	expectedUUIDs := sets.New[string]()
+	for _, name := range expected {
+		lsp, err := c.OVNNbClient.GetLogicalSwitchPort(name, true)
+		if err != nil {
+			klog.Errorf("failed to get logical switch port %s: %v", name, err)
+			return nil, nil, err
+		}
+		if lsp == nil {
+			continue
+		}
+		expectedUUIDs.Insert(lsp.UUID)
+		if !members.Has(lsp.UUID) {
+			missing = append(missing, name)
+		}
+	}
+
+	staleUUIDs := members.Difference(expectedUUIDs)
+	if staleUUIDs.Len() == 0 {
+		return missing, nil, nil
+	}
+	lsps, err := c.OVNNbClient.ListLogicalSwitchPorts(false, nil, func(lsp *ovnnb.LogicalSwitchPort) bool {
+		return staleUUIDs.Has(lsp.UUID)
+	})
+	if err != nil {
+		klog.Errorf("failed to list logical switch ports: %v", err)
+		return nil, nil, err
+	}
+	for _, lsp := range lsps {
+		stale = append(stale, lsp.Name)
+	}
+	slices.Sort(stale)
+	return missing, stale, nil
+}
+
+func (c *Controller) checkVpcDrift() ([]DriftItem, error) {
+	vpcs, err := c.vpcsLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list vpcs: %v", err)
+		return nil, err
+	}
+
+	var items []DriftItem
+	for _, vpc := range vpcs {
+		// static routes of the default vpc depend on the gateway type of every subnet,
+		// only routes of custom vpcs are checked
+		if vpc.Name == c.config.ClusterRouter || !vpc.Status.Standby || vpc.DeletionTimestamp != nil {
+			continue
+		}
+
+		exist, err := c.OVNNbClient.LogicalRouterExists(vpc.Name)
+		if err != nil {
+			klog.Errorf("failed to check logical router %s exists: %v", vpc.Name, err)
+			return nil, err
+		}
+		if !exist {
+			items = append(items, DriftItem{Kind: driftKindVpc, Object: vpc.Name, Resource: vpc.Name, Reason: driftReasonMissingLogicalRouter})
+			continue
+		}
+
+		existRoutes, err := c.OVNNbClient.ListLogicalRouterStaticRoutes(vpc.Name, nil, nil, "", map[string]string{"vendor": util.CniTypeName})
+		if err != nil {
+			klog.Errorf("failed to list static routes of vpc %s: %v", vpc.Name, err)
+			return nil, err
+		}
+		subnetRoutes, err := c.getCustomVpcSubnetStaticRoutes(vpc.Name)
+		if err != nil {
+			return nil, err
+		}
+		targetRoutes := append(slices.Clone(vpc.Spec.StaticRoutes), subnetRoutes...)
+
+		stale, missing := diffStaticRoute(existRoutes, targetRoutes)
+		for _, route := range missing {
+			items = append(items, DriftItem{Kind: driftKindVpc, Object: vpc.Name, Resource: vpc.Name, Reason: driftReasonMissingStaticRoute, Detail: getStaticRouteItemKey(route)})
+		}
+		for _, route := range stale {
+			items = append(items, DriftItem{Kind: driftKindVpc, Object: vpc.Name, Resource: vpc.Name, Reason: driftReasonStaleStaticRoute, Detail: getStaticRouteItemKey(route)})
+		}
+	}
+	return items, nil
+}
+
+func (c *Controller) checkServiceDrift() ([]DriftItem, error) {
+	// endpoint addresses are rewritten with secondary ips in non-primary mode
+	if c.config.EnableNonPrimaryCNI {
+		return nil, nil
+	}
+
+	svcs, err := c.servicesLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list services: %v", err)
+		return nil, err
+	}
+
+	lbs := make(map[string]*ovnnb.LoadBalancer)
+	getLb := func(name string) (*ovnnb.LoadBalancer, error) {
+		if lb, ok := lbs[name]; ok {
+			return lb, nil
+		}
+		lb, err := c.OVNNbClient.GetLoadBalancer(name, true)
+		if err != nil {
+			klog.Errorf("failed to get load balancer %s: %v", name, err)
+			return nil, err
+		}
+		lbs[name] = lb
+		return lb, nil
+	}
+
+	var items []DriftItem
+	for _, svc := range svcs {
+		// services with lb rule annotations are handled by the switch/router lb rule controllers
+		if svc.Annotations[util.SwitchLBRuleVipsAnnotation] != "" || svc.Annotations[util.RouterLBRuleVipsAnnotation] != "" {
+			continue
+		}
+		vips := util.ServiceClusterIPs(*svc)
+		if len(vips) == 0 {
+			continue
+		}
+
+		key := cache.MetaObjectToName(svc).String()
+		endpointSlices, err := c.endpointSlicesLister.EndpointSlices(svc.Namespace).List(labels.Set{discoveryv1.LabelServiceName: svc.Name}.AsSelector())
+		if err != nil {
+			klog.Errorf("failed to list endpoint slices of service %s: %v", key, err)
+			return nil, err
+		}
+		vpcName, _, err := c.getVpcAndSubnetForEndpoints(endpointSlices, svc)
+		if err != nil {
+			return nil, err
+		}
+		vpc, err := c.vpcsLister.Get(vpcName)
+		if err != nil {
+			klog.Errorf("failed to get vpc %s of service %s: %v", vpcName, key, err)
+			continue
+		}
+
+		for _, port := range svc.Spec.Ports {
+			lbName := getServiceLoadBalancerName(vpc, svc.Spec.SessionAffinity, port.Protocol)
+			if lbName == "" {
+				continue
+			}
+			lb, err := getLb(lbName)
+			if err != nil {
+				return nil, err
+			}
+			var lbVips map[string]string
+			if lb != nil {
+				lbVips = lb.Vips
+			}
+			for _, vip := range vips {
+				endpoint := util.JoinHostPort(vip, port.Port)
+				backends := c.getEndpointBackend(endpointSlices, port, vip)
+				if reason, detail := diffLoadBalancerVip(lbVips, endpoint, backends); reason != "" {
+					items = append(items, DriftItem{Kind: driftKindService, Object: key, Resource: lbName, Reason: reason, Detail: detail})
+				}
+			}
+		}
+	}
+	return items, nil
+}
+
+// getServiceLoadBalancerName returns the name of the vpc load balancer which holds vips of the service port
+func getServiceLoadBalancerName(vpc *kubeovnv1.Vpc, affinity v1.ServiceAffinity, protocol v1.Protocol) string {
+	sessionAffinity := affinity == v1.ServiceAffinityClientIP
+	switch protocol {
+	case v1.ProtocolTCP:
+		if sessionAffinity {
+			return vpc.Status.TCPSessionLoadBalancer
+		}
+		return vpc.Status.TCPLoadBalancer
+	case v1.ProtocolUDP:
+		if sessionAffinity {
+			return vpc.Status.UDPSessionLoadBalancer
+		}
+		return vpc.Status.UDPLoadBalancer
+	case v1.ProtocolSCTP:
+		if sessionAffinity {
+			return vpc.Status.SctpSessionLoadBalancer
+		}
+		return vpc.Status.SctpLoadBalancer
+	}
+	return ""
+}
+
+// diffLoadBalancerVip compares the backends of a load balancer vip with the expected ones,
+// vips without backends are expected to be absent from the load balancer
+func diffLoadBalancerVip(lbVips map[string]string, vip string, backends []string) (reason, detail string) {
+	value, ok := lbVips[vip]
+	if len(backends) == 0 {
+		if ok {
+			return driftReasonStaleVip, fmt.Sprintf("vip %s has backends %q but no ready endpoints", vip, value)
+		}
+		return "", ""
+	}
+	if !ok {
+		return driftReasonMissingVip, fmt.Sprintf("vip %s is missing, expected backends %q", vip, strings.Join(backends, ","))
+	}
+
+	var existing []string
+	if value != "" {
+		existing = strings.Split(value, ",")
+	}
+	if !sets.New(existing...).Equal(sets.New(backends...)) {
+		expected := slices.Clone(backends)
+		slices.Sort(expected)
+		slices.Sort(existing)
+		return driftReasonBackendMismatch, fmt.Sprintf("vip %s expected backends %q, got %q", vip, strings.Join(expected, ","), strings.Join(existing, ","))
+	}
+	return "", ""
+}
@@ -0,0 +1,245 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnnb"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+func TestDiffLspAddresses(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		addresses []string
+		mac       string
+		ips       string
+		drift     bool
+	}{
+		{
+			name:      "same addresses",
+			addresses: []string{"00:00:00:11:22:33 10.16.0.2 fd00::2"},
+			mac:       "00:00:00:11:22:33",
+			ips:       "10.16.0.2,fd00::2",
+		},
+		{
+			name:      "same addresses in different order",
+			addresses: []string{"00:00:00:11:22:33 fd00::2 10.16.0.2"},
+			mac:       "00:00:00:11:22:33",
+			ips:       "10.16.0.2,fd00::2",
+		},
+		{
+			name:      "extra unknown address",
+			addresses: []string{"00:00:00:11:22:33 10.16.0.2", "unknown"},
+			mac:       "00:00:00:11:22:33",
+			ips:       "10.16.0.2",
+		},
+		{
+			name:      "mac only",
+			addresses: []string{"00:00:00:11:22:33"},
+			mac:       "00:00:00:11:22:33",
+		},
+		{
+			name:      "different ip",
+			addresses: []string{"00:00:00:11:22:33 10.16.0.3"},
+			mac:       "00:00:00:11:22:33",
+			ips:       "10.16.0.2",
+			drift:     true,
+		},
+		{
+			name:      "different mac",
+			addresses: []string{"00:00:00:11:22:34 10.16.0.2"},
+			mac:       "00:00:00:11:22:33",
+			ips:       "10.16.0.2",
+			drift:     true,
+		},
+		{
+			name:  "no addresses",
+			mac:   "00:00:00:11:22:33",
+			ips:   "10.16.0.2",
+			drift: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			detail := diffLspAddresses(tt.addresses, tt.mac, tt.ips)
+			if tt.drift {
+				require.NotEmpty(t, detail)
+			} else {
+				require.Empty(t, detail)
+			}
+		})
+	}
+}
+
+func TestDiffLoadBalancerVip(t *testing.T) {
+	t.Parallel()
+
+	lbVips := map[string]string{
+		"10.96.0.10:53": "10.16.0.5:53,10.16.0.6:53",
+		"10.96.0.11:80": "10.16.0.7:8080",
+	}
+	tests := []struct {
+		name     string
+		vip      string
+		backends []string
+		reason   string
+	}{
+		{
+			name:     "same backends in different order",
+			vip:      "10.96.0.10:53",
+			backends: []string{"10.16.0.6:53", "10.16.0.5:53"},
+		},
+		{
+			name:     "missing vip",
+			vip:      "10.96.0.12:443",
+			backends: []string{"10.16.0.8:8443"},
+			reason:   driftReasonMissingVip,
+		},
+		{
+			name:     "backends differ",
+			vip:      "10.96.0.10:53",
+			backends: []string{"10.16.0.5:53"},
+			reason:   driftReasonBackendMismatch,
+		},
+		{
+			name:   "vip without endpoints",
+			vip:    "10.96.0.11:80",
+			reason: driftReasonStaleVip,
+		},
+		{
+			name: "absent vip without endpoints",
+			vip:  "10.96.0.12:443",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			reason, _ := diffLoadBalancerVip(lbVips, tt.vip, tt.backends)
+			require.Equal(t, tt.reason, reason)
+		})
+	}
+}
+
+func TestGetServiceLoadBalancerName(t *testing.T) {
+	t.Parallel()
+
+	vpc := &kubeovnv1.Vpc{
+		Status: kubeovnv1.VpcStatus{
+			TCPLoadBalancer:         "tcp",
+			TCPSessionLoadBalancer:  "tcp-session",
+			UDPLoadBalancer:         "udp",
+			UDPSessionLoadBalancer:  "udp-session",
+			SctpLoadBalancer:        "sctp",
+			SctpSessionLoadBalancer: "sctp-session",
+		},
+	}
+	require.Equal(t, "tcp", getServiceLoadBalancerName(vpc, v1.ServiceAffinityNone, v1.ProtocolTCP))
+	require.Equal(t, "tcp-session", getServiceLoadBalancerName(vpc, v1.ServiceAffinityClientIP, v1.ProtocolTCP))
+	require.Equal(t, "udp", getServiceLoadBalancerName(vpc, v1.ServiceAffinityNone, v1.ProtocolUDP))
+	require.Equal(t, "sctp-session", getServiceLoadBalancerName(vpc, v1.ServiceAffinityClientIP, v1.ProtocolSCTP))
+	require.Empty(t, getServiceLoadBalancerName(vpc, v1.ServiceAffinityNone, v1.Protocol("ICMP")))
+}
+
+func TestCheckVpcDrift(t *testing.T) {
+	fakeController, err := newFakeControllerWithOptions(t, &FakeControllerOptions{
+		Vpcs: []*kubeovnv1.Vpc{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: util.DefaultVpc},
+				Status:     kubeovnv1.VpcStatus{Standby: true, Default: true},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "vpc1"},
+				Spec: kubeovnv1.VpcSpec{
+					StaticRoutes: []*kubeovnv1.StaticRoute{{
+						Policy:    kubeovnv1.PolicyDst,
+						CIDR:      "0.0.0.0/0",
+						NextHopIP: "192.168.0.254",
+					}},
+				},
+				Status: kubeovnv1.VpcStatus{Standby: true},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "vpc2"},
+				Status:     kubeovnv1.VpcStatus{Standby: true},
+			},
+		},
+		Subnets: []*kubeovnv1.Subnet{{
+			ObjectMeta: metav1.ObjectMeta{Name: "vpc1-subnet"},
+			Spec: kubeovnv1.SubnetSpec{
+				Vpc:       "vpc1",
+				CIDRBlock: "192.168.0.0/24",
+				Gateway:   "192.168.0.1",
+			},
+		}},
+	})
+	require.NoError(t, err)
+	ctrl := fakeController.fakeController
+	mockOvnClient := fakeController.mockOvnClient
+
+	mockOvnClient.EXPECT().LogicalRouterExists("vpc1").Return(true, nil)
+	mockOvnClient.EXPECT().LogicalRouterExists("vpc2").Return(false, nil)
+	mockOvnClient.EXPECT().ListLogicalRouterStaticRoutes("vpc1", nil, nil, "", gomock.Any()).Return([]*ovnnb.LogicalRouterStaticRoute{
+		{
+			IPPrefix: "192.168.0.0/24",
+			Nexthop:  "192.168.0.1",
+			Policy:   ptr.To(ovnnb.LogicalRouterStaticRoutePolicySrcIP),
+		},
+		{
+			IPPrefix: "10.0.0.0/8",
+			Nexthop:  "192.168.0.253",
+		},
+	}, nil)
+
+	items, err := ctrl.checkVpcDrift()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []DriftItem{
+		{Kind: driftKindVpc, Object: "vpc1", Resource: "vpc1", Reason: driftReasonMissingStaticRoute, Detail: ":dst:0.0.0.0/0=>192.168.0.254"},
+		{Kind: driftKindVpc, Object: "vpc1", Resource: "vpc1", Reason: driftReasonStaleStaticRoute, Detail: ":dst:10.0.0.0/8=>192.168.0.253"},
+		{Kind: driftKindVpc, Object: "vpc2", Resource: "vpc2", Reason: driftReasonMissingLogicalRouter},
+	}, items)
+}
+
+func TestDriftHandlerWithoutLeader(t *testing.T) {
+	if activeController.Load() != nil {
+		t.Skip("active controller has been set")
+	}
+
+	w := httptest.NewRecorder()
+	DriftHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/drift", nil))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	w = httptest.NewRecorder()
+	DriftHandler().ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/drift", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
+
+func TestGetDriftReport(t *testing.T) {
+	fakeController := newFakeController(t)
+	ctrl := fakeController.fakeController
+
+	cached := &DriftReport{Time: time.Now(), Items: []DriftItem{{Kind: driftKindPod, Object: "default/pod1", Resource: "pod1.default", Reason: driftReasonMissingLogicalSwitchPort}}}
+	ctrl.driftReport = cached
+	report, err := ctrl.getDriftReport()
+	require.NoError(t, err)
+	require.Same(t, cached, report)
+
+	// an expired report is replaced by a new check
+	cached.Time = time.Now().Add(-driftReportTTL)
+	report, err = ctrl.getDriftReport()
+	require.NoError(t, err)
+	require.NotSame(t, cached, report)
+	require.Same(t, ctrl.driftReport, report)
+}
@@ -536,6 +536,14 @@ func (c *Controller) createOrUpdateIPCR(ipCRName, podName, ip, mac, subnetName,
 	return nil
 }
 
+// recordIPTimeline records the pod network setup phases in the status of the ip CR,
+// the timeline is informational so the errors are only logged
+func (c *Controller) recordIPTimeline(ipName string, phases map[kubeovnv1.IPTimelinePhase]*metav1.MicroTime) {
+	if err := util.PatchIPTimeline(c.config.KubeOvnClient.KubeovnV1().IPs(), ipName, phases); err != nil {
+		klog.Errorf("failed to record timeline of ip %s: %v", ipName, err)
+	}
+}
+
 func (c *Controller) ipAcquireAddress(ip *kubeovnv1.IP, subnet *kubeovnv1.Subnet) (string, string, string, error) {
 	key := cache.NewObjectName(ip.Spec.Namespace, ip.Spec.PodName).String()
 	portName := ovs.PodNameToPortName(ip.Spec.PodName, ip.Spec.Namespace, subnet.Spec.Provider)
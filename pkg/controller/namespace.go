@@ -76,6 +76,8 @@ func (c *Controller) enqueueUpdateNamespace(oldObj, newObj any) {
 			c.updateCnpsByLabelsMatch(newObj.(*v1.Namespace).Labels, nil)
 		}
 
+		c.enqueueNamespaceSgPods(newNs.Name)
+
 		expectSubnets, err := c.getNsExpectSubnets(newNs)
 		if err != nil {
 			klog.Errorf("failed to list expected subnets for namespace %s, %v", newNs.Name, err)
@@ -71,12 +71,75 @@ var (
 			"pod_name",
 		},
 	)
+
+	metricNbDriftCount = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "nb_drift_count",
+			Help: "The num of discrepancies between kubernetes objects and ovn nb found by the last drift check.",
+		},
+		[]string{
+			"kind",
+			"reason",
+		},
+	)
+
+	metricReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "reconcile_duration_seconds",
+			Help:    "The time taken by a workqueue handler to reconcile an item. The unit is Seconds.",
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 16),
+		},
+		[]string{
+			"action",
+			"result",
+		},
+	)
+
+	metricReconcileErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "reconcile_errors_total",
+			Help: "The num of errors returned by a workqueue handler, the items are requeued with rate limiting.",
+		},
+		[]string{
+			"action",
+			"reason",
+		},
+	)
+
+	metricNatGwTrafficPackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "vpc_nat_gw_traffic_packets_total",
+			Help: "The num of packets matching the traffic counters of iptables EIPs and NAT rules in the VPC NAT gateways.",
+		},
+		natGwTrafficLabels,
+	)
+
+	metricNatGwTrafficBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "vpc_nat_gw_traffic_bytes_total",
+			Help: "The num of bytes matching the traffic counters of iptables EIPs and NAT rules in the VPC NAT gateways.",
+		},
+		natGwTrafficLabels,
+	)
 )
 
+var natGwTrafficLabels = []string{
+	"vpc",
+	"nat_gw",
+	"kind",
+	"name",
+	"direction",
+}
+
 func registerMetrics() {
 	metrics.Registry.MustRegister(metricSubnetAvailableIPs)
 	metrics.Registry.MustRegister(metricSubnetUsedIPs)
 	metrics.Registry.MustRegister(metricCentralSubnetInfo)
 	metrics.Registry.MustRegister(metricSubnetIPAMInfo)
 	metrics.Registry.MustRegister(metricSubnetIPAssignedInfo)
+	metrics.Registry.MustRegister(metricNbDriftCount)
+	metrics.Registry.MustRegister(metricReconcileDuration)
+	metrics.Registry.MustRegister(metricReconcileErrors)
+	metrics.Registry.MustRegister(metricNatGwTrafficPackets)
+	metrics.Registry.MustRegister(metricNatGwTrafficBytes)
 }
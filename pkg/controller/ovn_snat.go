@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -43,7 +44,8 @@ func (c *Controller) enqueueUpdateOvnSnatRule(oldObj, newObj any) {
 	}
 	if oldSnat.Spec.OvnEip != newSnat.Spec.OvnEip ||
 		oldSnat.Spec.VpcSubnet != newSnat.Spec.VpcSubnet ||
-		oldSnat.Spec.IPName != newSnat.Spec.IPName {
+		oldSnat.Spec.IPName != newSnat.Spec.IPName ||
+		!reflect.DeepEqual(oldSnat.Spec.PortBlock, newSnat.Spec.PortBlock) {
 		klog.Infof("enqueue update snat %s", key)
 		c.updateOvnSnatRuleQueue.Add(key)
 		return
@@ -151,12 +153,24 @@ func (c *Controller) handleAddOvnSnatRule(key string) error {
 		klog.Error(err)
 		return err
 	}
+	if cachedSnat.Spec.PortBlock != nil && (v4IpCidr == "" || v4Eip == "") {
+		err = fmt.Errorf("failed to create snat %s, port block allocation only supports IPv4", key)
+		klog.Error(err)
+		return err
+	}
 	// about conflicts: if multi vpc snat use the same eip, if only one gw node exist, it may should work
 	if v4IpCidr != "" && v4Eip != "" {
-		if err = c.OVNNbClient.AddNat(vpcName, ovnnb.NATTypeSNAT, v4Eip, v4IpCidr, "", "", nil); err != nil {
+		if err = c.syncOvnSnatV4(cachedSnat, vpcName, v4Eip, v4IpCidr); err != nil {
 			klog.Errorf("failed to create v4 snat, %v", err)
 			return err
 		}
+		// the allocation is already recorded when the status has it
+		if cachedSnat.Status.V4Eip != v4Eip || cachedSnat.Status.V4IpCidr != v4IpCidr || !reflect.DeepEqual(cachedSnat.Status.PortBlock, cachedSnat.Spec.PortBlock) {
+			if err = c.recordSnatPortBlocks(cachedSnat, snatPortBlockReasonAllocated, vpcName, v4Eip, v4IpCidr, cachedSnat.Spec.PortBlock); err != nil {
+				klog.Errorf("failed to record port blocks of snat %s, %v", key, err)
+				return err
+			}
+		}
 	}
 	if v6IpCidr != "" && v6Eip != "" {
 		if err = c.OVNNbClient.AddNat(vpcName, ovnnb.NATTypeSNAT, v6Eip, v6IpCidr, "", "", nil); err != nil {
@@ -210,6 +224,10 @@ func (c *Controller) handleUpdateOvnSnatRule(key string) error {
 		}
 
 		// ovn delete snat
+		if err = c.deleteOvnSnatPortBlocks(cachedSnat); err != nil {
+			klog.Errorf("failed to delete port blocks of snat %s, %v", key, err)
+			return err
+		}
 		if cachedSnat.Status.V4Eip != "" && cachedSnat.Status.V4IpCidr != "" {
 			if err = c.OVNNbClient.DeleteNat(cachedSnat.Status.Vpc, ovnnb.NATTypeSNAT, cachedSnat.Status.V4Eip, cachedSnat.Status.V4IpCidr); err != nil {
 				klog.Errorf("failed to delete v4 snat %s, %v", key, err)
@@ -331,6 +349,25 @@ func (c *Controller) handleUpdateOvnSnatRule(key string) error {
 		klog.Error(err)
 		return err
 	}
+	if v4IpCidr != "" && v4Eip != "" && !reflect.DeepEqual(cachedSnat.Status.PortBlock, cachedSnat.Spec.PortBlock) {
+		klog.Infof("update port blocks of ovn snat %s", key)
+		if err = c.syncOvnSnatV4(cachedSnat, vpcName, v4Eip, v4IpCidr); err != nil {
+			klog.Errorf("failed to update v4 snat %s, %v", key, err)
+			return err
+		}
+		if err = c.recordSnatPortBlocks(cachedSnat, snatPortBlockReasonReleased, vpcName, v4Eip, v4IpCidr, cachedSnat.Status.PortBlock); err != nil {
+			klog.Errorf("failed to record port blocks of snat %s, %v", key, err)
+			return err
+		}
+		if err = c.recordSnatPortBlocks(cachedSnat, snatPortBlockReasonAllocated, vpcName, v4Eip, v4IpCidr, cachedSnat.Spec.PortBlock); err != nil {
+			klog.Errorf("failed to record port blocks of snat %s, %v", key, err)
+			return err
+		}
+		if err = c.patchOvnSnatStatus(key, vpcName, v4Eip, v6Eip, v4IpCidr, v6IpCidr, true); err != nil {
+			klog.Errorf("failed to update status for snat %s, %v", key, err)
+			return err
+		}
+	}
 	return nil
 }
 
@@ -345,6 +382,10 @@ func (c *Controller) handleDelOvnSnatRule(key string) error {
 		return err
 	}
 	// ovn delete snat
+	if err = c.deleteOvnSnatPortBlocks(cachedSnat); err != nil {
+		klog.Errorf("failed to delete port blocks of snat %s, %v", key, err)
+		return err
+	}
 	if cachedSnat.Status.Vpc != "" && cachedSnat.Status.V4Eip != "" && cachedSnat.Status.V4IpCidr != "" {
 		if err = c.OVNNbClient.DeleteNat(cachedSnat.Status.Vpc, ovnnb.NATTypeSNAT,
 			cachedSnat.Status.V4Eip, cachedSnat.Status.V4IpCidr); err != nil {
@@ -429,6 +470,10 @@ func (c *Controller) patchOvnSnatStatus(key, vpc, v4Eip, v6Eip, v4IpCidr, v6IpCi
 		snat.Status.V6IpCidr = v6IpCidr
 		changed = true
 	}
+	if ready && !reflect.DeepEqual(snat.Status.PortBlock, snat.Spec.PortBlock) {
+		snat.Status.PortBlock = snat.Spec.PortBlock
+		changed = true
+	}
 	if changed {
 		bytes, err := snat.Status.Bytes()
 		if err != nil {
@@ -16,6 +16,7 @@ import (
 	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	nadutils "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/utils"
 	"github.com/scylladb/go-set/strset"
+	"go.opentelemetry.io/otel/attribute"
 	multustypes "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
@@ -33,6 +34,7 @@ import (
 	"github.com/kubeovn/kube-ovn/pkg/ipam"
 	"github.com/kubeovn/kube-ovn/pkg/ovs"
 	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnnb"
+	"github.com/kubeovn/kube-ovn/pkg/tracing"
 	"github.com/kubeovn/kube-ovn/pkg/util"
 )
 
@@ -451,6 +453,11 @@ func (c *Controller) enqueueUpdatePod(oldObj, newObj any) {
 			break
 		}
 	}
+	// security groups selecting the pod changed
+	if !maps.Equal(oldPod.Labels, newPod.Labels) &&
+		!slices.Equal(c.getPodSelectedSecurityGroups(oldPod), c.getPodSelectedSecurityGroups(newPod)) {
+		c.updatePodSecurityQueue.Add(key)
+	}
 }
 
 func (c *Controller) getPodKubeovnNets(pod *v1.Pod) ([]*kubeovnNet, error) {
@@ -495,13 +502,18 @@ func (c *Controller) handleAddOrUpdatePod(key string) (err error) {
 	now := time.Now()
 	klog.Infof("handle add/update pod %s", key)
 
+	ctx, span := tracing.Start(context.Background(), "handleAddOrUpdatePod", attribute.String("key", key))
+	defer func() { tracing.End(span, err) }()
+
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
 		utilruntime.HandleError(fmt.Errorf("invalid resource key: %s", key))
 		return nil
 	}
 
+	_, lockSpan := tracing.Start(ctx, "lock")
 	c.podKeyMutex.LockKey(key)
+	lockSpan.End()
 	defer func() {
 		_ = c.podKeyMutex.UnlockKey(key)
 		last := time.Since(now)
@@ -522,7 +534,9 @@ func (c *Controller) handleAddOrUpdatePod(key string) (err error) {
 		return err
 	}
 
+	_, stageSpan := tracing.Start(ctx, "getPodKubeovnNets")
 	podNets, err := c.getPodKubeovnNets(pod)
+	tracing.End(stageSpan, err)
 	if err != nil {
 		klog.Errorf("failed to get pod nets %v", err)
 		c.recorder.Eventf(pod, v1.EventTypeWarning, "PodNetworkUpdateFailed", "stage=getPodKubeovnNets error=%v", err)
@@ -530,7 +544,9 @@ func (c *Controller) handleAddOrUpdatePod(key string) (err error) {
 	}
 
 	// check and do hotnoplug nic
-	updatedPod, hotplugDetails, err := c.syncKubeOvnNet(pod, podNets)
+	stageCtx, stageSpan := tracing.Start(ctx, "syncKubeOvnNet")
+	updatedPod, hotplugDetails, err := c.syncKubeOvnNet(stageCtx, pod, podNets)
+	tracing.End(stageSpan, err)
 	if err != nil {
 		klog.Errorf("failed to sync pod nets %v", err)
 		c.recorder.Eventf(pod, v1.EventTypeWarning, "PodNetworkUpdateFailed", "stage=syncKubeOvnNet error=%v", err)
@@ -543,7 +559,10 @@ func (c *Controller) handleAddOrUpdatePod(key string) (err error) {
 	}
 	needAllocatePodNets := needAllocateSubnets(pod, podNets)
 	if len(needAllocatePodNets) != 0 {
-		if pod, err = c.reconcileAllocateSubnets(pod, needAllocatePodNets); err != nil {
+		stageCtx, stageSpan = tracing.Start(ctx, "reconcileAllocateSubnets")
+		pod, err = c.reconcileAllocateSubnets(stageCtx, pod, needAllocatePodNets)
+		tracing.End(stageSpan, err)
+		if err != nil {
 			klog.Error(err)
 			return err
 		}
@@ -564,14 +583,20 @@ func (c *Controller) handleAddOrUpdatePod(key string) (err error) {
 
 	// Reconcile per-port DHCP options for pods that carry DHCP annotations.
 	// This handles annotation add/change on already-running pods without requiring a pod restart.
-	if err = c.reconcilePodDHCPOptions(pod, podNets); err != nil {
+	stageCtx, stageSpan = tracing.Start(ctx, "reconcilePodDHCPOptions")
+	err = c.reconcilePodDHCPOptions(stageCtx, pod, podNets)
+	tracing.End(stageSpan, err)
+	if err != nil {
 		c.recorder.Eventf(pod, v1.EventTypeWarning, "PodNetworkUpdateFailed", "stage=reconcilePodDHCPOptions error=%v", err)
 		return err
 	}
 
 	// check if route subnet is need.
 	needRoutePodNets := needRouteSubnets(pod, podNets)
-	if err = c.reconcileRouteSubnets(pod, needRoutePodNets); err != nil {
+	stageCtx, stageSpan = tracing.Start(ctx, "reconcileRouteSubnets")
+	err = c.reconcileRouteSubnets(stageCtx, pod, needRoutePodNets)
+	tracing.End(stageSpan, err)
+	if err != nil {
 		c.recorder.Eventf(pod, v1.EventTypeWarning, "PodNetworkUpdateFailed", "stage=reconcileRouteSubnets error=%v", err)
 		return err
 	}
@@ -623,7 +648,8 @@ func dhcpOptionsForPodIPFamily(subnetDHCP *ovs.DHCPOptionsUUIDs, podIP, dhcpV4,
 // reconcilePodDHCPOptions reconciles per-port DHCP_Options for already-allocated pods.
 // It delegates all DHCP logic (stale detection, create/update/cleanup, LSP pointer update)
 // to ReconcilePortDHCPOptions in the OVS layer.
-func (c *Controller) reconcilePodDHCPOptions(pod *v1.Pod, podNets []*kubeovnNet) error {
+func (c *Controller) reconcilePodDHCPOptions(ctx context.Context, pod *v1.Pod, podNets []*kubeovnNet) error {
+	nbClient := c.OVNNbClient.WithContext(ctx)
 	podName := c.getNameByPod(pod)
 	for _, podNet := range podNets {
 		if podNet.Type == providerTypeIPAM {
@@ -654,7 +680,7 @@ func (c *Controller) reconcilePodDHCPOptions(pod *v1.Pod, podNets []*kubeovnNet)
 			}
 		}
 
-		if _, _, err := c.OVNNbClient.ReconcilePortDHCPOptions(
+		if _, _, err := nbClient.ReconcilePortDHCPOptions(
 			subnet.Name, portName, dhcpOptions,
 			subnet.Spec.CIDRBlock, gateway, dhcpV4, dhcpV6, mtu,
 		); err != nil {
@@ -666,7 +692,8 @@ func (c *Controller) reconcilePodDHCPOptions(pod *v1.Pod, podNets []*kubeovnNet)
 }
 
 // do the same thing as add pod
-func (c *Controller) reconcileAllocateSubnets(pod *v1.Pod, needAllocatePodNets []*kubeovnNet) (*v1.Pod, error) {
+func (c *Controller) reconcileAllocateSubnets(ctx context.Context, pod *v1.Pod, needAllocatePodNets []*kubeovnNet) (*v1.Pod, error) {
+	nbClient := c.OVNNbClient.WithContext(ctx)
 	namespace := pod.Namespace
 	name := pod.Name
 	klog.Infof("sync pod %s/%s allocated", namespace, name)
@@ -695,6 +722,7 @@ func (c *Controller) reconcileAllocateSubnets(pod *v1.Pod, needAllocatePodNets [
 			klog.Error(err)
 			return nil, err
 		}
+		allocatedTime := metav1.NowMicro()
 		podNet.Subnet = subnet
 		ipStr := util.GetStringIP(v4IP, v6IP)
 		patch[fmt.Sprintf(util.IPAddressAnnotationTemplate, podNet.ProviderName)] = ipStr
@@ -724,6 +752,7 @@ func (c *Controller) reconcileAllocateSubnets(pod *v1.Pod, needAllocatePodNets [
 			return nil, err
 		}
 
+		var lspCreatedTime *metav1.MicroTime
 		if podNet.Type != providerTypeIPAM {
 			if (subnet.Spec.Vlan == "" || subnet.Spec.LogicalGateway || subnet.Spec.U2OInterconnection) && subnet.Spec.Vpc != "" {
 				patch[fmt.Sprintf(util.LogicalRouterAnnotationTemplate, podNet.ProviderName)] = subnet.Spec.Vpc
@@ -773,7 +802,7 @@ func (c *Controller) reconcileAllocateSubnets(pod *v1.Pod, needAllocatePodNets [
 				}
 			}
 
-			dhcpOptions, hasPerPortDHCP, err := c.OVNNbClient.ReconcilePortDHCPOptions(
+			dhcpOptions, hasPerPortDHCP, err := nbClient.ReconcilePortDHCPOptions(
 				subnet.Name, portName, subnetDHCP,
 				subnet.Spec.CIDRBlock, gateway, dhcpV4, dhcpV6, mtu,
 			)
@@ -788,7 +817,7 @@ func (c *Controller) reconcileAllocateSubnets(pod *v1.Pod, needAllocatePodNets [
 
 			var oldSgList []string
 			if vmKey != "" {
-				existingLsp, err := c.OVNNbClient.GetLogicalSwitchPort(portName, true)
+				existingLsp, err := nbClient.GetLogicalSwitchPort(portName, true)
 				if err != nil {
 					klog.Errorf("failed to get logical switch port %s: %v", portName, err)
 					recordFailure("getLogicalSwitchPort", err)
@@ -799,24 +828,25 @@ func (c *Controller) reconcileAllocateSubnets(pod *v1.Pod, needAllocatePodNets [
 				}
 			}
 
-			securityGroupAnnotation := pod.Annotations[fmt.Sprintf(util.SecurityGroupAnnotationTemplate, podNet.ProviderName)]
-			if err := c.OVNNbClient.CreateLogicalSwitchPort(subnet.Name, portName, ipStr, mac, podName, pod.Namespace,
-				portSecurity, securityGroupAnnotation, vips, enableDHCP, dhcpOptions, subnet.Spec.Vpc); err != nil {
+			securityGroups := c.getPodSecurityGroups(pod, podNet.ProviderName)
+			if err := nbClient.CreateLogicalSwitchPort(subnet.Name, portName, ipStr, mac, podName, pod.Namespace,
+				portSecurity, securityGroups, vips, enableDHCP, dhcpOptions, subnet.Spec.Vpc); err != nil {
 				c.recorder.Eventf(pod, v1.EventTypeWarning, "CreateOVNPortFailed", "stage=createLogicalSwitchPort error=%v", err)
 				klog.Errorf("%v", err)
 				return nil, err
 			}
+			now := metav1.NowMicro()
+			lspCreatedTime = &now
 
 			if pod.Annotations[fmt.Sprintf(util.Layer2ForwardAnnotationTemplate, podNet.ProviderName)] == "true" {
-				if err := c.OVNNbClient.EnablePortLayer2forward(portName); err != nil {
+				if err := nbClient.EnablePortLayer2forward(portName); err != nil {
 					c.recorder.Eventf(pod, v1.EventTypeWarning, "SetOVNPortL2ForwardFailed", "stage=setLogicalSwitchPortLayer2Forward error=%v", err)
 					klog.Errorf("%v", err)
 					return nil, err
 				}
 			}
 
-			if securityGroupAnnotation != "" || oldSgList != nil {
-				securityGroups := strings.ReplaceAll(securityGroupAnnotation, " ", "")
+			if securityGroups != "" || oldSgList != nil {
 				newSgList := strings.Split(securityGroups, ",")
 				sgNames := util.UnionStringSlice(oldSgList, newSgList)
 				for _, sgName := range sgNames {
@@ -838,8 +868,16 @@ func (c *Controller) reconcileAllocateSubnets(pod *v1.Pod, needAllocatePodNets [
 			recordFailure("createOrUpdateIPCR", err)
 			return nil, err
 		}
+		// clear the later phases which may be left by a previous pod with the same name
+		c.recordIPTimeline(ipCRName, map[kubeovnv1.IPTimelinePhase]*metav1.MicroTime{
+			kubeovnv1.IPTimelineAllocated:        &allocatedTime,
+			kubeovnv1.IPTimelineLSPCreated:       lspCreatedTime,
+			kubeovnv1.IPTimelinePortBound:        nil,
+			kubeovnv1.IPTimelineRouteReady:       nil,
+			kubeovnv1.IPTimelineGatewayReachable: nil,
+		})
 	}
-	if err = util.PatchAnnotations(c.config.KubeClient.CoreV1().Pods(namespace), name, patch); err != nil {
+	if err = util.PatchAnnotationsWithContext(ctx, c.config.KubeClient.CoreV1().Pods(namespace), name, patch); err != nil {
 		if k8serrors.IsNotFound(err) {
 			// Sometimes pod is deleted between kube-ovn configure ovn-nb and patch pod.
 			// Then we need to recycle the resource again.
@@ -853,7 +891,7 @@ func (c *Controller) reconcileAllocateSubnets(pod *v1.Pod, needAllocatePodNets [
 		return nil, err
 	}
 
-	updatedPod, err := c.config.KubeClient.CoreV1().Pods(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	updatedPod, err := c.config.KubeClient.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			key := strings.Join([]string{namespace, name}, "/")
@@ -887,7 +925,8 @@ func (c *Controller) reconcileAllocateSubnets(pod *v1.Pod, needAllocatePodNets [
 }
 
 // do the same thing as update pod
-func (c *Controller) reconcileRouteSubnets(pod *v1.Pod, needRoutePodNets []*kubeovnNet) error {
+func (c *Controller) reconcileRouteSubnets(ctx context.Context, pod *v1.Pod, needRoutePodNets []*kubeovnNet) error {
+	nbClient := c.OVNNbClient.WithContext(ctx)
 	// the lb-svc pod has dependencies on Running state, check it when pod state get updated
 	if err := c.checkAndReInitLbSvcPod(pod); err != nil {
 		klog.Errorf("failed to init iptable rules for load-balancer pod %s/%s: %v", pod.Namespace, pod.Name, err)
@@ -909,7 +948,7 @@ func (c *Controller) reconcileRouteSubnets(pod *v1.Pod, needRoutePodNets []*kube
 		return err
 	}
 
-	portGroups, err := c.OVNNbClient.ListPortGroups(map[string]string{"node": "", networkPolicyKey: ""})
+	portGroups, err := nbClient.ListPortGroups(map[string]string{"node": "", networkPolicyKey: ""})
 	if err != nil {
 		klog.Errorf("failed to list port groups: %v", err)
 		return err
@@ -940,7 +979,7 @@ func (c *Controller) reconcileRouteSubnets(pod *v1.Pod, needRoutePodNets []*kube
 			return fmt.Errorf("NodeSwitch subnet %s is unavailable for pod", subnet.Name)
 		}
 
-		if portGroups, err = c.OVNNbClient.ListPortGroups(map[string]string{"subnet": subnet.Name, "node": "", networkPolicyKey: ""}); err != nil {
+		if portGroups, err = nbClient.ListPortGroups(map[string]string{"subnet": subnet.Name, "node": "", networkPolicyKey: ""}); err != nil {
 			klog.Errorf("failed to list port groups: %v", err)
 			return err
 		}
@@ -961,12 +1000,12 @@ func (c *Controller) reconcileRouteSubnets(pod *v1.Pod, needRoutePodNets []*kube
 			!subnet.Spec.LogicalGateway {
 			// remove lsp from other port groups
 			// we need to do this because the pod, e.g. a sts/vm, can be rescheduled to another node
-			if err = c.OVNNbClient.RemovePortFromPortGroups(portName, subnetPortGroups...); err != nil {
+			if err = nbClient.RemovePortFromPortGroups(portName, subnetPortGroups...); err != nil {
 				klog.Errorf("failed to remove port %s from port groups %v: %v", portName, subnetPortGroups, err)
 				return err
 			}
 			// add lsp to the port group
-			if err := c.OVNNbClient.PortGroupAddPorts(pgName, portName); err != nil {
+			if err := nbClient.PortGroupAddPorts(pgName, portName); err != nil {
 				klog.Errorf("failed to add port to u2o port group %s: %v", pgName, err)
 				return err
 			}
@@ -975,12 +1014,12 @@ func (c *Controller) reconcileRouteSubnets(pod *v1.Pod, needRoutePodNets []*kube
 		if podIP != "" && (subnet.Spec.Vlan == "" || subnet.Spec.LogicalGateway) && subnet.Spec.Vpc == c.config.ClusterRouter {
 			// remove lsp from other port groups
 			// we need to do this because the pod, e.g. a sts/vm, can be rescheduled to another node
-			if err = c.OVNNbClient.RemovePortFromPortGroups(portName, nodePortGroups...); err != nil {
+			if err = nbClient.RemovePortFromPortGroups(portName, nodePortGroups...); err != nil {
 				klog.Errorf("failed to remove port %s from port groups %v: %v", portName, nodePortGroups, err)
 				return err
 			}
 			// add lsp to the port group
-			if err = c.OVNNbClient.PortGroupAddPorts(nodePortGroup, portName); err != nil {
+			if err = nbClient.PortGroupAddPorts(nodePortGroup, portName); err != nil {
 				klog.Errorf("failed to add port %s to port group %s: %v", portName, nodePortGroup, err)
 				return err
 			}
@@ -1026,7 +1065,7 @@ func (c *Controller) reconcileRouteSubnets(pod *v1.Pod, needRoutePodNets []*kube
 				}
 
 				// remove lsp from port group to make EIP/SNAT work
-				if err = c.OVNNbClient.PortGroupRemovePorts(pgName, portName); err != nil {
+				if err = nbClient.PortGroupRemovePorts(pgName, portName); err != nil {
 					klog.Error(err)
 					return err
 				}
@@ -1047,11 +1086,11 @@ func (c *Controller) reconcileRouteSubnets(pod *v1.Pod, needRoutePodNets []*kube
 
 							// remove lsp from other port groups
 							// we need to do this because the pod, e.g. a sts/vm, can be rescheduled to another node
-							if err = c.OVNNbClient.RemovePortFromPortGroups(portName, subnetPortGroups...); err != nil {
+							if err = nbClient.RemovePortFromPortGroups(portName, subnetPortGroups...); err != nil {
 								klog.Errorf("failed to remove port %s from port groups %v: %v", portName, subnetPortGroups, err)
 								return err
 							}
-							if err := c.OVNNbClient.PortGroupAddPorts(pgName, portName); err != nil {
+							if err := nbClient.PortGroupAddPorts(pgName, portName); err != nil {
 								klog.Errorf("failed to add port %s to port group %s: %v", portName, pgName, err)
 								return err
 							}
@@ -1109,21 +1148,21 @@ func (c *Controller) reconcileRouteSubnets(pod *v1.Pod, needRoutePodNets []*kube
 			if c.config.EnableEipSnat {
 				for ipStr := range strings.SplitSeq(podIP, ",") {
 					if eip := pod.Annotations[util.EipAnnotation]; eip == "" {
-						if err = c.OVNNbClient.DeleteNats(c.config.ClusterRouter, ovnnb.NATTypeDNATAndSNAT, ipStr); err != nil {
+						if err = nbClient.DeleteNats(c.config.ClusterRouter, ovnnb.NATTypeDNATAndSNAT, ipStr); err != nil {
 							klog.Errorf("failed to delete nat rules: %v", err)
 						}
 					} else if util.CheckProtocol(eip) == util.CheckProtocol(ipStr) {
-						if err = c.OVNNbClient.UpdateDnatAndSnat(c.config.ClusterRouter, eip, ipStr, fmt.Sprintf("%s.%s", podName, pod.Namespace), pod.Annotations[util.MacAddressAnnotation], c.ExternalGatewayType); err != nil {
+						if err = nbClient.UpdateDnatAndSnat(c.config.ClusterRouter, eip, ipStr, fmt.Sprintf("%s.%s", podName, pod.Namespace), pod.Annotations[util.MacAddressAnnotation], c.ExternalGatewayType); err != nil {
 							klog.Errorf("failed to add nat rules, %v", err)
 							return err
 						}
 					}
 					if eip := pod.Annotations[util.SnatAnnotation]; eip == "" {
-						if err = c.OVNNbClient.DeleteNats(c.config.ClusterRouter, ovnnb.NATTypeSNAT, ipStr); err != nil {
+						if err = nbClient.DeleteNats(c.config.ClusterRouter, ovnnb.NATTypeSNAT, ipStr); err != nil {
 							klog.Errorf("failed to delete nat rules: %v", err)
 						}
 					} else if util.CheckProtocol(eip) == util.CheckProtocol(ipStr) {
-						if err = c.OVNNbClient.EnsureSnat(c.config.ClusterRouter, eip, ipStr); err != nil {
+						if err = nbClient.EnsureSnat(c.config.ClusterRouter, eip, ipStr); err != nil {
 							klog.Errorf("failed to add nat rules, %v", err)
 							return err
 						}
@@ -1133,7 +1172,7 @@ func (c *Controller) reconcileRouteSubnets(pod *v1.Pod, needRoutePodNets []*kube
 		}
 
 		if pod.Annotations[fmt.Sprintf(util.ActivationStrategyTemplate, podNet.ProviderName)] != "" {
-			if err := c.OVNNbClient.SetLogicalSwitchPortActivationStrategy(portName, pod.Spec.NodeName); err != nil {
+			if err := nbClient.SetLogicalSwitchPortActivationStrategy(portName, pod.Spec.NodeName); err != nil {
 				klog.Errorf("failed to set activation strategy for lsp %s: %v", portName, err)
 				return err
 			}
@@ -1141,7 +1180,7 @@ func (c *Controller) reconcileRouteSubnets(pod *v1.Pod, needRoutePodNets []*kube
 
 		patch[fmt.Sprintf(util.RoutedAnnotationTemplate, podNet.ProviderName)] = "true"
 	}
-	if err := util.PatchAnnotations(c.config.KubeClient.CoreV1().Pods(namespace), name, patch); err != nil {
+	if err := util.PatchAnnotationsWithContext(ctx, c.config.KubeClient.CoreV1().Pods(namespace), name, patch); err != nil {
 		if k8serrors.IsNotFound(err) {
 			// Sometimes pod is deleted between kube-ovn configure ovn-nb and patch pod.
 			// Then we need to recycle the resource again.
@@ -1153,6 +1192,15 @@ func (c *Controller) reconcileRouteSubnets(pod *v1.Pod, needRoutePodNets []*kube
 		klog.Errorf("failed to patch pod %s/%s: %v", namespace, name, err)
 		return err
 	}
+
+	// the route ready phase is recorded asynchronously as it is informational,
+	// the allocated phase is recorded synchronously before the pod annotations are patched
+	// since it clears the later phases which are recorded by kube-ovn-cni
+	routeReadyTime := metav1.NowMicro()
+	for _, podNet := range needRoutePodNets {
+		ipCRName := ovs.PodNameToPortName(podName, namespace, podNet.ProviderName)
+		go c.recordIPTimeline(ipCRName, map[kubeovnv1.IPTimelinePhase]*metav1.MicroTime{kubeovnv1.IPTimelineRouteReady: &routeReadyTime})
+	}
 	return nil
 }
 
@@ -1479,9 +1527,7 @@ func (c *Controller) handleDeletePod(key string) (err error) {
 		}
 
 		c.syncVirtualPortsQueue.Add(podNet.Subnet.Name)
-		securityGroupAnnotation := pod.Annotations[fmt.Sprintf(util.SecurityGroupAnnotationTemplate, podNet.ProviderName)]
-		if securityGroupAnnotation != "" {
-			securityGroups := strings.ReplaceAll(securityGroupAnnotation, " ", "")
+		if securityGroups := c.getPodSecurityGroups(pod, podNet.ProviderName); securityGroups != "" {
 			for sgName := range strings.SplitSeq(securityGroups, ",") {
 				if sgName != "" {
 					c.syncSgPortsQueue.Add(sgName)
@@ -1552,10 +1598,8 @@ func (c *Controller) handleUpdatePodSecurity(key string) error {
 		}
 
 		c.syncVirtualPortsQueue.Add(podNet.Subnet.Name)
-		securityGroupAnnotation := pod.Annotations[fmt.Sprintf(util.SecurityGroupAnnotationTemplate, podNet.ProviderName)]
-		var securityGroups string
-		if securityGroupAnnotation != "" {
-			securityGroups = strings.ReplaceAll(securityGroupAnnotation, " ", "")
+		securityGroups := c.getPodSecurityGroups(pod, podNet.ProviderName)
+		if securityGroups != "" {
 			for sgName := range strings.SplitSeq(securityGroups, ",") {
 				if sgName != "" {
 					c.syncSgPortsQueue.Add(sgName)
@@ -1599,7 +1643,8 @@ func stalePortNetworkDetails(pod *v1.Pod, podName string, port ovnnb.LogicalSwit
 	return providerName, details, nil
 }
 
-func (c *Controller) syncKubeOvnNet(pod *v1.Pod, podNets []*kubeovnNet) (*v1.Pod, string, error) {
+func (c *Controller) syncKubeOvnNet(ctx context.Context, pod *v1.Pod, podNets []*kubeovnNet) (*v1.Pod, string, error) {
+	nbClient := c.OVNNbClient.WithContext(ctx)
 	podName := c.getNameByPod(pod)
 	key := cache.NewObjectName(pod.Namespace, podName).String()
 	targetPortNameList := strset.NewWithSize(len(podNets))
@@ -1630,7 +1675,7 @@ func (c *Controller) syncKubeOvnNet(pod *v1.Pod, podNets []*kubeovnNet) (*v1.Pod
 		}
 	}
 
-	ports, err := c.OVNNbClient.ListNormalLogicalSwitchPorts(true, map[string]string{"pod": key})
+	ports, err := nbClient.ListNormalLogicalSwitchPorts(true, map[string]string{"pod": key})
 	if err != nil {
 		klog.Errorf("failed to list lsps of pod '%s', %v", pod.Name, err)
 		return nil, "", err
@@ -1661,11 +1706,11 @@ func (c *Controller) syncKubeOvnNet(pod *v1.Pod, podNets []*kubeovnNet) (*v1.Pod
 	for _, portNeedDel := range portsNeedToDel {
 		klog.Infof("release port %s for pod %s", portNeedDel, podName)
 		c.ipam.ReleaseAddressByNic(key, portNeedDel, subnetUsedByPort[portNeedDel])
-		if err := c.OVNNbClient.DeleteLogicalSwitchPort(portNeedDel); err != nil {
+		if err := nbClient.DeleteLogicalSwitchPort(portNeedDel); err != nil {
 			klog.Errorf("failed to delete lsp %s, %v", portNeedDel, err)
 			return nil, "", err
 		}
-		if err := c.config.KubeOvnClient.KubeovnV1().IPs().Delete(context.Background(), portNeedDel, metav1.DeleteOptions{}); err != nil {
+		if err := c.config.KubeOvnClient.KubeovnV1().IPs().Delete(ctx, portNeedDel, metav1.DeleteOptions{}); err != nil {
 			if !k8serrors.IsNotFound(err) {
 				klog.Errorf("failed to delete ip %s, %v", portNeedDel, err)
 				return nil, "", err
@@ -1690,7 +1735,7 @@ func (c *Controller) syncKubeOvnNet(pod *v1.Pod, podNets []*kubeovnNet) (*v1.Pod
 		return pod, strings.Join(hotplugDetails, "; "), nil
 	}
 
-	if err = util.PatchAnnotations(c.config.KubeClient.CoreV1().Pods(pod.Namespace), pod.Name, patch); err != nil {
+	if err = util.PatchAnnotationsWithContext(ctx, c.config.KubeClient.CoreV1().Pods(pod.Namespace), pod.Name, patch); err != nil {
 		if k8serrors.IsNotFound(err) {
 			return nil, "", nil
 		}
@@ -1698,7 +1743,7 @@ func (c *Controller) syncKubeOvnNet(pod *v1.Pod, podNets []*kubeovnNet) (*v1.Pod
 		return nil, "", err
 	}
 
-	if pod, err = c.config.KubeClient.CoreV1().Pods(pod.Namespace).Get(context.TODO(), pod.Name, metav1.GetOptions{}); err != nil {
+	if pod, err = c.config.KubeClient.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{}); err != nil {
 		if k8serrors.IsNotFound(err) {
 			return nil, "", nil
 		}
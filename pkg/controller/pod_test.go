@@ -1516,7 +1516,7 @@ func TestSyncKubeOvnNetReportsAnnotationChange(t *testing.T) {
 	require.NoError(t, err)
 	fc.mockOvnClient.EXPECT().ListNormalLogicalSwitchPorts(true, gomock.Any()).Return(nil, nil)
 
-	updatedPod, details, err := fc.fakeController.syncKubeOvnNet(pod, []*kubeovnNet{{
+	updatedPod, details, err := fc.fakeController.syncKubeOvnNet(context.Background(), pod, []*kubeovnNet{{
 		ProviderName: util.OvnProvider,
 		IPRequest:    "10.0.0.2",
 		Subnet:       &kubeovnv1.Subnet{ObjectMeta: metav1.ObjectMeta{Name: "subnet-a"}},
@@ -1537,7 +1537,7 @@ func TestSyncKubeOvnNetReportsNoChange(t *testing.T) {
 	require.NoError(t, err)
 	fc.mockOvnClient.EXPECT().ListNormalLogicalSwitchPorts(true, gomock.Any()).Return(nil, nil)
 
-	_, details, err := fc.fakeController.syncKubeOvnNet(pod, []*kubeovnNet{{
+	_, details, err := fc.fakeController.syncKubeOvnNet(context.Background(), pod, []*kubeovnNet{{
 		ProviderName: util.OvnProvider,
 		IPRequest:    "10.0.0.2",
 	}})
@@ -1564,7 +1564,7 @@ func TestSyncKubeOvnNetParsesStalePortProviders(t *testing.T) {
 		fc.mockOvnClient.EXPECT().ListNormalLogicalSwitchPorts(true, gomock.Any()).Return([]ovnnb.LogicalSwitchPort{{Name: portName}}, nil)
 		fc.mockOvnClient.EXPECT().DeleteLogicalSwitchPort(portName).Return(nil)
 
-		updatedPod, details, err := fc.fakeController.syncKubeOvnNet(pod, nil)
+		updatedPod, details, err := fc.fakeController.syncKubeOvnNet(context.Background(), pod, nil)
 
 		require.NoError(t, err)
 		assert.Equal(t, "true", updatedPod.Annotations[util.AllocatedAnnotation])
@@ -1585,7 +1585,7 @@ func TestSyncKubeOvnNetParsesStalePortProviders(t *testing.T) {
 		fc.mockOvnClient.EXPECT().ListNormalLogicalSwitchPorts(true, gomock.Any()).Return([]ovnnb.LogicalSwitchPort{{Name: portName}}, nil)
 		fc.mockOvnClient.EXPECT().DeleteLogicalSwitchPort(portName).Return(nil)
 
-		updatedPod, details, err := fc.fakeController.syncKubeOvnNet(pod, nil)
+		updatedPod, details, err := fc.fakeController.syncKubeOvnNet(context.Background(), pod, nil)
 
 		require.NoError(t, err)
 		assert.NotContains(t, updatedPod.Annotations, providerKey)
@@ -1610,7 +1610,7 @@ func TestSyncKubeOvnNetParsesStalePortProviders(t *testing.T) {
 		fc.mockOvnClient.EXPECT().ListNormalLogicalSwitchPorts(true, gomock.Any()).Return([]ovnnb.LogicalSwitchPort{{Name: portName}}, nil)
 		fc.mockOvnClient.EXPECT().DeleteLogicalSwitchPort(portName).Return(nil)
 
-		updatedPod, details, err := fc.fakeController.syncKubeOvnNet(pod, nil)
+		updatedPod, details, err := fc.fakeController.syncKubeOvnNet(context.Background(), pod, nil)
 
 		require.NoError(t, err)
 		assert.NotContains(t, updatedPod.Annotations, providerKey)
@@ -2055,7 +2055,7 @@ func TestReconcileAllocateSubnetsSpecificFailureEventsIncludeStage(t *testing.T)
 		fc, err := newFakeControllerWithOptions(t, &FakeControllerOptions{Pods: []*corev1.Pod{pod}, Subnets: []*kubeovnv1.Subnet{subnet}})
 		require.NoError(t, err)
 
-		_, err = fc.fakeController.reconcileAllocateSubnets(pod, []*kubeovnNet{{Type: providerTypeOriginal, ProviderName: util.OvnProvider, Subnet: subnet, IsDefault: true}})
+		_, err = fc.fakeController.reconcileAllocateSubnets(context.Background(), pod, []*kubeovnNet{{Type: providerTypeOriginal, ProviderName: util.OvnProvider, Subnet: subnet, IsDefault: true}})
 
 		require.Error(t, err)
 		assertPodEvent(t, fc.fakeController, "Warning AcquireAddressFailed", "stage=acquireAddress", err.Error())
@@ -2077,7 +2077,7 @@ func TestReconcileAllocateSubnetsSpecificFailureEventsIncludeStage(t *testing.T)
 		require.NoError(t, indexer.Add(vip))
 		fc.fakeController.virtualIpsLister = kubeovnlister.NewVipLister(indexer)
 
-		_, err = fc.fakeController.reconcileAllocateSubnets(pod, []*kubeovnNet{{Type: providerTypeOriginal, ProviderName: util.OvnProvider, Subnet: subnet, IsDefault: true}})
+		_, err = fc.fakeController.reconcileAllocateSubnets(context.Background(), pod, []*kubeovnNet{{Type: providerTypeOriginal, ProviderName: util.OvnProvider, Subnet: subnet, IsDefault: true}})
 
 		require.Error(t, err)
 		assertPodEvent(t, fc.fakeController, "Warning ValidatePodNetworkFailed", "stage=validateNetworkBroadcast", err.Error())
@@ -2090,7 +2090,7 @@ func TestReconcileAllocateSubnetsSpecificFailureEventsIncludeStage(t *testing.T)
 		require.NoError(t, err)
 		require.NoError(t, fc.fakeController.ipam.AddOrUpdateSubnet(subnet.Name, subnet.Spec.CIDRBlock, subnet.Spec.Gateway, nil))
 
-		_, err = fc.fakeController.reconcileAllocateSubnets(pod, []*kubeovnNet{{Type: providerTypeOriginal, ProviderName: util.OvnProvider, Subnet: subnet, IsDefault: true}})
+		_, err = fc.fakeController.reconcileAllocateSubnets(context.Background(), pod, []*kubeovnNet{{Type: providerTypeOriginal, ProviderName: util.OvnProvider, Subnet: subnet, IsDefault: true}})
 
 		require.Error(t, err)
 		assertPodEvent(t, fc.fakeController, "Warning GetVlanInfoFailed", "stage=getVlanInfo", err.Error())
@@ -2104,7 +2104,7 @@ func TestReconcileAllocateSubnetsSpecificFailureEventsIncludeStage(t *testing.T)
 		fc.mockOvnClient.EXPECT().ReconcilePortDHCPOptions(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&ovs.DHCPOptionsUUIDs{}, false, nil)
 		fc.mockOvnClient.EXPECT().CreateLogicalSwitchPort(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("create port failed"))
 
-		_, err = fc.fakeController.reconcileAllocateSubnets(pod, []*kubeovnNet{{Type: providerTypeOriginal, ProviderName: util.OvnProvider, Subnet: subnet, IsDefault: true}})
+		_, err = fc.fakeController.reconcileAllocateSubnets(context.Background(), pod, []*kubeovnNet{{Type: providerTypeOriginal, ProviderName: util.OvnProvider, Subnet: subnet, IsDefault: true}})
 
 		require.EqualError(t, err, "create port failed")
 		assertPodEvent(t, fc.fakeController, "Warning CreateOVNPortFailed", "stage=createLogicalSwitchPort", err.Error())
@@ -2120,7 +2120,7 @@ func TestReconcileAllocateSubnetsSpecificFailureEventsIncludeStage(t *testing.T)
 		fc.mockOvnClient.EXPECT().CreateLogicalSwitchPort(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 		fc.mockOvnClient.EXPECT().EnablePortLayer2forward(gomock.Any()).Return(errors.New("enable layer2 forward failed"))
 
-		_, err = fc.fakeController.reconcileAllocateSubnets(pod, []*kubeovnNet{{Type: providerTypeOriginal, ProviderName: util.OvnProvider, Subnet: subnet, IsDefault: true}})
+		_, err = fc.fakeController.reconcileAllocateSubnets(context.Background(), pod, []*kubeovnNet{{Type: providerTypeOriginal, ProviderName: util.OvnProvider, Subnet: subnet, IsDefault: true}})
 
 		require.EqualError(t, err, "enable layer2 forward failed")
 		assertPodEvent(t, fc.fakeController, "Warning SetOVNPortL2ForwardFailed", "stage=setLogicalSwitchPortLayer2Forward", err.Error())
@@ -2138,7 +2138,7 @@ func TestReconcileAllocateSubnetsRefetchFailureRecordsOriginalPod(t *testing.T)
 	})
 	events := useRealPodEventRecorder(t, fc.fakeController)
 
-	updatedPod, err := fc.fakeController.reconcileAllocateSubnets(pod, []*kubeovnNet{{
+	updatedPod, err := fc.fakeController.reconcileAllocateSubnets(context.Background(), pod, []*kubeovnNet{{
 		Type: providerTypeIPAM, ProviderName: util.OvnProvider, Subnet: subnet, IsDefault: true,
 	}})
 
@@ -2181,6 +2181,13 @@ func TestHandleAddOrUpdatePodReportsActualAllocatedSubnet(t *testing.T) {
 	require.NoError(t, err)
 	event := assertPodEvent(t, fc.fakeController, "Normal PodNetworkAllocated", "provider=ovn", "subnet=subnet-b", "ip=10.1.0.2")
 	assert.NotContains(t, event, "subnet=subnet-a")
+
+	ip, err := fc.fakeController.config.KubeOvnClient.KubeovnV1().IPs().Get(context.Background(), ovs.PodNameToPortName(pod.Name, pod.Namespace, util.OvnProvider), metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, ip.Status.Timeline.Allocated)
+	require.NotNil(t, ip.Status.Timeline.LSPCreated)
+	require.False(t, ip.Status.Timeline.LSPCreated.Before(ip.Status.Timeline.Allocated))
+	require.Nil(t, ip.Status.Timeline.PortBound)
 }
 
 func TestHandleAddOrUpdatePodRecordsHotplugUpdate(t *testing.T) {
@@ -6,16 +6,19 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
 	"slices"
 	"strings"
 
 	"github.com/cnf/structhash"
+	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/set"
 
 	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
 	"github.com/kubeovn/kube-ovn/pkg/ovs"
@@ -23,6 +26,9 @@ import (
 	"github.com/kubeovn/kube-ovn/pkg/util"
 )
 
+// sgRemoteFQDNRegex matches the domain names accepted by DNSNameResolver
+var sgRemoteFQDNRegex = regexp.MustCompile(`^(\*\.)?([a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?\.){2,}$`)
+
 func (c *Controller) enqueueAddSg(obj any) {
 	key := cache.MetaObjectToName(obj.(*kubeovnv1.SecurityGroup)).String()
 	klog.V(3).Infof("enqueue add securityGroup %s", key)
@@ -192,6 +198,18 @@ func (c *Controller) handleAddOrUpdateSg(key string, force bool) error {
 		return err
 	}
 
+	// the fqdn address sets are refreshed on every sync since resolved addresses are not part of the rule md5
+	if c.config.EnableDNSNameResolver {
+		if err = c.reconcileDNSNameResolversForNP(sg.Name, sgRemoteFQDNs(sg), sgKey); err != nil {
+			klog.Errorf("failed to reconcile DNSNameResolvers for sg %s: %v", sg.Name, err)
+			return err
+		}
+	}
+	if err = c.reconcileSgFQDNAddressSets(sg); err != nil {
+		klog.Error(err)
+		return err
+	}
+
 	var ingressNeedUpdate, egressNeedUpdate bool
 	var newIngressMd5, newEgressMd5 string
 	if force {
@@ -217,6 +235,14 @@ func (c *Controller) handleAddOrUpdateSg(key string, force bool) error {
 			ingressNeedUpdate = true
 			egressNeedUpdate = true
 		}
+
+		// the reverse acls of stateless rules are in the other direction, and switching the
+		// stateless mode changes the acls of both directions
+		if (sg.Spec.Stateless && (ingressNeedUpdate || egressNeedUpdate)) || sg.Status.Stateless != sg.Spec.Stateless {
+			klog.Infof("both ingress && egress need update for stateless mode, sg:%s", sg.Name)
+			ingressNeedUpdate = true
+			egressNeedUpdate = true
+		}
 	}
 
 	// update sg rule
@@ -257,12 +283,29 @@ func (c *Controller) handleAddOrUpdateSg(key string, force bool) error {
 	// update status
 	sg.Status.PortGroup = ovs.GetSgPortGroupName(sg.Name)
 	sg.Status.AllowSameGroupTraffic = sg.Spec.AllowSameGroupTraffic
+	sg.Status.Stateless = sg.Spec.Stateless
 	c.patchSgStatus(sg)
 	c.syncSgPortsQueue.Add(key)
+	if err = c.enqueueSgMemberPods(key, sg); err != nil {
+		klog.Error(err)
+		return err
+	}
 	return nil
 }
 
 func (c *Controller) validateSgRule(sg *kubeovnv1.SecurityGroup) error {
+	// check sg selectors
+	if sg.Spec.PodSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(sg.Spec.PodSelector); err != nil {
+			return fmt.Errorf("invalid pod selector: %w", err)
+		}
+	}
+	if sg.Spec.NamespaceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(sg.Spec.NamespaceSelector); err != nil {
+			return fmt.Errorf("invalid namespace selector: %w", err)
+		}
+	}
+
 	// check sg rules
 	allRules := append(sg.Spec.IngressRules, sg.Spec.EgressRules...)
 	if err := util.ValidateSecurityGroupTier(sg.Spec.Tier); err != nil {
@@ -298,6 +341,25 @@ func (c *Controller) validateSgRule(sg *kubeovnv1.SecurityGroup) error {
 			if err != nil {
 				return fmt.Errorf("failed to get remote sg '%s', %w", rule.RemoteSecurityGroup, err)
 			}
+		case kubeovnv1.SgRemoteTypeFQDN:
+			if !c.config.EnableDNSNameResolver {
+				return fmt.Errorf("remote fqdn '%s' requires the DNSNameResolver support to be enabled", rule.RemoteFQDN)
+			}
+			if !sgRemoteFQDNRegex.MatchString(rule.RemoteFQDN) {
+				return fmt.Errorf("invalid remote fqdn '%s'", rule.RemoteFQDN)
+			}
+		case kubeovnv1.SgRemoteTypeIPPool:
+			ippool, err := c.ippoolLister.Get(rule.RemoteIPPool)
+			if err != nil {
+				return fmt.Errorf("failed to get remote ippool '%s', %w", rule.RemoteIPPool, err)
+			}
+			if !ippool.Spec.EnableAddressSet {
+				return fmt.Errorf("address set of remote ippool '%s' is not enabled", rule.RemoteIPPool)
+			}
+			// the address set of an ippool only holds addresses of a single family
+			if len(ippool.Spec.IPs) != 0 && strings.Contains(ippool.Spec.IPs[0], ":") != (rule.IPVersion == "ipv6") {
+				return fmt.Errorf("address set of remote ippool '%s' doesn't hold %s addresses", rule.RemoteIPPool, rule.IPVersion)
+			}
 		default:
 			return fmt.Errorf("not support sgRemoteType '%s'", rule.RemoteType)
 		}
@@ -350,14 +412,95 @@ func (c *Controller) handleDeleteSg(key string) error {
 	defer func() { _ = c.sgKeyMutex.UnlockKey(key) }()
 	klog.Infof("handle delete security group %s", key)
 
+	if err := c.enqueueSgMemberPods(key, nil); err != nil {
+		klog.Error(err)
+		return err
+	}
 	if err := c.OVNNbClient.DeleteSecurityGroup(key); err != nil {
 		klog.Errorf("delete sg %s: %v", key, err)
 		return err
 	}
+	if c.config.EnableDNSNameResolver {
+		if err := c.reconcileDNSNameResolversForNP(key, nil, sgKey); err != nil {
+			klog.Errorf("failed to delete DNSNameResolvers for sg %s: %v", key, err)
+			return err
+		}
+	}
 
 	return nil
 }
 
+// sgRemoteFQDNs returns the sorted domain names referenced by the rules of a security group
+func sgRemoteFQDNs(sg *kubeovnv1.SecurityGroup) []string {
+	domainNames := set.New[string]()
+	for _, rule := range slices.Concat(sg.Spec.IngressRules, sg.Spec.EgressRules) {
+		if rule.RemoteType == kubeovnv1.SgRemoteTypeFQDN {
+			domainNames.Insert(rule.RemoteFQDN)
+		}
+	}
+	return domainNames.SortedList()
+}
+
+// reconcileSgFQDNAddressSets fills the fqdn address sets of a security group with the resolved addresses
+// and removes the address sets of domain names no longer referenced by its rules
+func (c *Controller) reconcileSgFQDNAddressSets(sg *kubeovnv1.SecurityGroup) error {
+	expected := set.New[string]()
+	for _, domainName := range sgRemoteFQDNs(sg) {
+		var v4Addresses, v6Addresses []string
+		if c.config.EnableDNSNameResolver {
+			v4Addresses, v6Addresses = c.resolveDomainName(domainName)
+		}
+		for ipVersion, addresses := range map[string][]string{"ipv4": v4Addresses, "ipv6": v6Addresses} {
+			asName := ovs.GetSgFQDNAddressSetName(sg.Name, domainName, ipVersion)
+			expected.Insert(asName)
+			if err := c.OVNNbClient.CreateAddressSet(asName, map[string]string{sgKey: sg.Name, sgFQDNKey: domainName}); err != nil {
+				return fmt.Errorf("failed to create address set %s for sg %s: %w", asName, sg.Name, err)
+			}
+			if err := c.OVNNbClient.AddressSetUpdateAddress(asName, addresses...); err != nil {
+				return fmt.Errorf("failed to set addresses %q to address set %s: %w", strings.Join(addresses, ","), asName, err)
+			}
+		}
+	}
+
+	ass, err := c.OVNNbClient.ListAddressSets(map[string]string{sgKey: sg.Name})
+	if err != nil {
+		return fmt.Errorf("failed to list address sets of sg %s: %w", sg.Name, err)
+	}
+	var stale []string
+	for _, as := range ass {
+		if as.ExternalIDs[sgFQDNKey] != "" && !expected.Has(as.Name) {
+			stale = append(stale, as.Name)
+		}
+	}
+	if len(stale) != 0 {
+		klog.Infof("delete stale fqdn address sets %v of sg %s", stale, sg.Name)
+		if err = c.OVNNbClient.DeleteAddressSet(stale...); err != nil {
+			return fmt.Errorf("failed to delete address sets %v of sg %s: %w", stale, sg.Name, err)
+		}
+	}
+	return nil
+}
+
+// refreshSgFQDNAddressSets updates the fqdn address sets of a security group after its resolved addresses change
+func (c *Controller) refreshSgFQDNAddressSets(sgName string) error {
+	c.sgKeyMutex.LockKey(sgName)
+	defer func() { _ = c.sgKeyMutex.UnlockKey(sgName) }()
+
+	sg, err := c.sgsLister.Get(sgName)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		klog.Error(err)
+		return err
+	}
+	if err = c.reconcileSgFQDNAddressSets(sg); err != nil {
+		klog.Error(err)
+		return err
+	}
+	return nil
+}
+
 func (c *Controller) syncSgLogicalPort(key string) error {
 	c.sgKeyMutex.LockKey(key)
 	defer func() { _ = c.sgKeyMutex.UnlockKey(key) }()
@@ -410,6 +553,24 @@ func (c *Controller) syncSgLogicalPort(key string) error {
 		return err
 	}
 
+	selectedPods, err := c.getSgSelectedPods(sg)
+	if err != nil {
+		klog.Error(err)
+		return err
+	}
+	var selectedPorts int
+	for _, lsp := range sgPorts {
+		if selectedPods.Has(lsp.ExternalIDs["pod"]) {
+			selectedPorts++
+		}
+	}
+	if sg.Status.Ports != len(ports) || sg.Status.SelectedPorts != selectedPorts {
+		if err = c.patchSgPortsStatus(key, len(ports), selectedPorts); err != nil {
+			klog.Error(err)
+			return err
+		}
+	}
+
 	v4AsName := ovs.GetSgV4AssociatedName(key)
 	if err := c.OVNNbClient.AddressSetUpdateAddress(v4AsName, v4s...); err != nil {
 		klog.Errorf("set ips to address set %s: %v", v4AsName, err)
@@ -498,3 +659,211 @@ func (c *Controller) securityGroupAllNotExist(sgs []string) (bool, error) {
 
 	return notExistsCount == len(sgs), nil
 }
+
+// sgSelectsPod reports whether the pod and namespace selectors of the security group select the pod,
+// a security group without selectors selects no pod
+func sgSelectsPod(sg *kubeovnv1.SecurityGroup, podLabels, nsLabels map[string]string) (bool, error) {
+	if sg.Spec.PodSelector == nil && sg.Spec.NamespaceSelector == nil {
+		return false, nil
+	}
+	selected, err := sgSelectorMatches(sg.Spec.PodSelector, podLabels)
+	if err != nil {
+		return false, fmt.Errorf("invalid pod selector of security group %s: %w", sg.Name, err)
+	}
+	if !selected {
+		return false, nil
+	}
+	if selected, err = sgSelectorMatches(sg.Spec.NamespaceSelector, nsLabels); err != nil {
+		return false, fmt.Errorf("invalid namespace selector of security group %s: %w", sg.Name, err)
+	}
+	return selected, nil
+}
+
+// sgSelectorMatches reports whether the labels match the selector, a nil selector matches everything
+func sgSelectorMatches(labelSelector *metav1.LabelSelector, labelSet map[string]string) (bool, error) {
+	if labelSelector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(labelSet)), nil
+}
+
+// getPodSelectedSecurityGroups returns the sorted names of the security groups selecting the pod
+func (c *Controller) getPodSelectedSecurityGroups(pod *v1.Pod) []string {
+	sgs, err := c.sgsLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list security groups: %v", err)
+		return nil
+	}
+
+	var sgNames []string
+	var nsLabels map[string]string
+	for _, sg := range sgs {
+		if sg.Spec.PodSelector == nil && sg.Spec.NamespaceSelector == nil {
+			continue
+		}
+		if nsLabels == nil {
+			if nsLabels = c.getNsLabels(pod.Namespace, pod.Name); nsLabels == nil {
+				nsLabels = map[string]string{}
+			}
+		}
+		selected, err := sgSelectsPod(sg, pod.Labels, nsLabels)
+		if err != nil {
+			klog.Error(err)
+			continue
+		}
+		if selected {
+			sgNames = append(sgNames, sg.Name)
+		}
+	}
+	slices.Sort(sgNames)
+	return sgNames
+}
+
+// getPodSecurityGroups returns the security groups of the pod network in the format of sg1,sg2,
+// including the ones in the security group annotation and the ones selecting the pod
+func (c *Controller) getPodSecurityGroups(pod *v1.Pod, provider string) string {
+	securityGroups := strings.ReplaceAll(pod.Annotations[fmt.Sprintf(util.SecurityGroupAnnotationTemplate, provider)], " ", "")
+	sgNames := strings.Split(securityGroups, ",")
+	for _, sgName := range c.getPodSelectedSecurityGroups(pod) {
+		if !slices.Contains(sgNames, sgName) {
+			sgNames = append(sgNames, sgName)
+		}
+	}
+	sgNames = slices.DeleteFunc(sgNames, func(sgName string) bool { return sgName == "" })
+	return strings.Join(sgNames, ",")
+}
+
+// getSgSelectedPods returns the pods selected by the security group in the format of the pod
+// external id of logical switch ports
+func (c *Controller) getSgSelectedPods(sg *kubeovnv1.SecurityGroup) (set.Set[string], error) {
+	selectedPods := set.New[string]()
+	if sg.Spec.PodSelector == nil && sg.Spec.NamespaceSelector == nil {
+		return selectedPods, nil
+	}
+
+	namespaces, err := c.namespacesLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list namespaces: %v", err)
+		return nil, err
+	}
+	for _, ns := range namespaces {
+		if selected, err := sgSelectorMatches(sg.Spec.NamespaceSelector, ns.Labels); err != nil || !selected {
+			continue
+		}
+		pods, err := c.podsLister.Pods(ns.Name).List(labels.Everything())
+		if err != nil {
+			klog.Errorf("failed to list pods in namespace %s: %v", ns.Name, err)
+			return nil, err
+		}
+		for _, pod := range pods {
+			if pod.Spec.HostNetwork {
+				continue
+			}
+			if selected, err := sgSelectsPod(sg, pod.Labels, ns.Labels); err == nil && selected {
+				selectedPods.Insert(pod.Namespace + "/" + c.getNameByPod(pod))
+			}
+		}
+	}
+	return selectedPods, nil
+}
+
+// enqueueSgMemberPods enqueues the pods selected by the security group and the pods whose logical
+// switch ports have joined the security group without the security group annotation, so that the
+// port membership follows the changes of the selectors
+func (c *Controller) enqueueSgMemberPods(sgName string, sg *kubeovnv1.SecurityGroup) error {
+	lsps, err := c.OVNNbClient.ListLogicalSwitchPorts(false, map[string]string{"associated_sg_" + sgName: "true"}, nil)
+	if err != nil {
+		klog.Errorf("failed to list logical switch ports of security group %s: %v", sgName, err)
+		return err
+	}
+	members := set.New[string]()
+	for _, lsp := range lsps {
+		if pod := lsp.ExternalIDs["pod"]; pod != "" {
+			members.Insert(pod)
+		}
+	}
+
+	selectedPods := set.New[string]()
+	if sg != nil {
+		if selectedPods, err = c.getSgSelectedPods(sg); err != nil {
+			klog.Error(err)
+			return err
+		}
+	}
+	if members.Len() == 0 && selectedPods.Len() == 0 {
+		return nil
+	}
+
+	pods, err := c.podsLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list pods: %v", err)
+		return err
+	}
+	for _, pod := range pods {
+		if pod.Spec.HostNetwork {
+			continue
+		}
+		podKey := pod.Namespace + "/" + c.getNameByPod(pod)
+		if !selectedPods.Has(podKey) && !members.Has(podKey) {
+			continue
+		}
+		if members.Has(podKey) && !selectedPods.Has(podKey) && podSecurityGroupAnnotated(pod, sgName) {
+			// the membership of the port is kept by the security group annotation
+			continue
+		}
+		klog.V(3).Infof("enqueue update pod security %s for security group %s", podKey, sgName)
+		c.updatePodSecurityQueue.Add(cache.MetaObjectToName(pod).String())
+	}
+	return nil
+}
+
+// enqueueNamespaceSgPods enqueues the pods in the namespace when any security group has a namespace selector
+func (c *Controller) enqueueNamespaceSgPods(namespace string) {
+	sgs, err := c.sgsLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list security groups: %v", err)
+		return
+	}
+	if !slices.ContainsFunc(sgs, func(sg *kubeovnv1.SecurityGroup) bool { return sg.Spec.NamespaceSelector != nil }) {
+		return
+	}
+
+	pods, err := c.podsLister.Pods(namespace).List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list pods in namespace %s: %v", namespace, err)
+		return
+	}
+	for _, pod := range pods {
+		if !pod.Spec.HostNetwork {
+			c.updatePodSecurityQueue.Add(cache.MetaObjectToName(pod).String())
+		}
+	}
+}
+
+// podSecurityGroupAnnotated reports whether any security group annotation of the pod contains the security group
+func podSecurityGroupAnnotated(pod *v1.Pod, sgName string) bool {
+	for key, value := range pod.Annotations {
+		if !strings.HasSuffix(key, strings.TrimPrefix(util.SecurityGroupAnnotationTemplate, "%s")) {
+			continue
+		}
+		if slices.Contains(strings.Split(strings.ReplaceAll(value, " ", ""), ","), sgName) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Controller) patchSgPortsStatus(key string, ports, selectedPorts int) error {
+	patch := fmt.Sprintf(`{"status":{"ports":%d,"selectedPorts":%d}}`, ports, selectedPorts)
+	if _, err := c.config.KubeOvnClient.KubeovnV1().SecurityGroups().Patch(context.Background(), key, types.MergePatchType, []byte(patch), metav1.PatchOptions{}, "status"); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to patch ports status of security group %s: %w", key, err)
+	}
+	return nil
+}
@@ -1,10 +1,12 @@
 package controller
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
@@ -213,4 +215,192 @@ func Test_validateSgRule(t *testing.T) {
 		err := ctrl.validateSgRule(sg)
 		require.ErrorContains(t, err, "range Minimum value greater than maximum value")
 	})
+
+	t.Run("invalid selectors", func(t *testing.T) {
+		t.Parallel()
+
+		invalid := &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Unknown"}}}
+		valid := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+
+		sg := baseSG()
+		sg.Spec.PodSelector = invalid
+		require.ErrorContains(t, ctrl.validateSgRule(sg), "invalid pod selector")
+
+		// the namespace selector is validated even if the pod selector doesn't match empty labels
+		sg = baseSG()
+		sg.Spec.PodSelector = valid
+		sg.Spec.NamespaceSelector = invalid
+		require.ErrorContains(t, ctrl.validateSgRule(sg), "invalid namespace selector")
+
+		sg = baseSG()
+		sg.Spec.PodSelector = valid
+		sg.Spec.NamespaceSelector = valid
+		require.NoError(t, ctrl.validateSgRule(sg))
+	})
+}
+
+func Test_validateSgRuleRemote(t *testing.T) {
+	t.Parallel()
+
+	fakeController, err := newFakeControllerWithOptions(t, &FakeControllerOptions{
+		IPPools: []*kubeovnv1.IPPool{
+			{ObjectMeta: metav1.ObjectMeta{Name: "pool-as"}, Spec: kubeovnv1.IPPoolSpec{EnableAddressSet: true, IPs: []string{"10.0.0.0/24"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "pool-as-v6"}, Spec: kubeovnv1.IPPoolSpec{EnableAddressSet: true, IPs: []string{"fd00::/120"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "pool-no-as"}},
+		},
+	})
+	require.NoError(t, err)
+	ctrl := fakeController.fakeController
+	ctrl.config.EnableDNSNameResolver = true
+
+	sgWithRule := func(rule kubeovnv1.SecurityGroupRule) *kubeovnv1.SecurityGroup {
+		rule.IPVersion = "ipv4"
+		rule.Priority = 1
+		rule.Protocol = "all"
+		rule.Policy = kubeovnv1.SgPolicy(ovnnb.ACLActionAllow)
+		return &kubeovnv1.SecurityGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-sg"},
+			Spec:       kubeovnv1.SecurityGroupSpec{Tier: util.SecurityGroupAPITierMinimum, EgressRules: []kubeovnv1.SecurityGroupRule{rule}},
+		}
+	}
+
+	require.NoError(t, ctrl.validateSgRule(sgWithRule(kubeovnv1.SecurityGroupRule{RemoteType: kubeovnv1.SgRemoteTypeFQDN, RemoteFQDN: "www.example.com."})))
+	require.NoError(t, ctrl.validateSgRule(sgWithRule(kubeovnv1.SecurityGroupRule{RemoteType: kubeovnv1.SgRemoteTypeFQDN, RemoteFQDN: "*.example.com."})))
+	require.ErrorContains(t, ctrl.validateSgRule(sgWithRule(kubeovnv1.SecurityGroupRule{RemoteType: kubeovnv1.SgRemoteTypeFQDN, RemoteFQDN: "www.example.com"})), "invalid remote fqdn")
+	require.NoError(t, ctrl.validateSgRule(sgWithRule(kubeovnv1.SecurityGroupRule{RemoteType: kubeovnv1.SgRemoteTypeIPPool, RemoteIPPool: "pool-as"})))
+	require.ErrorContains(t, ctrl.validateSgRule(sgWithRule(kubeovnv1.SecurityGroupRule{RemoteType: kubeovnv1.SgRemoteTypeIPPool, RemoteIPPool: "pool-as-v6"})), "doesn't hold ipv4 addresses")
+	require.ErrorContains(t, ctrl.validateSgRule(sgWithRule(kubeovnv1.SecurityGroupRule{RemoteType: kubeovnv1.SgRemoteTypeIPPool, RemoteIPPool: "pool-no-as"})), "is not enabled")
+	require.ErrorContains(t, ctrl.validateSgRule(sgWithRule(kubeovnv1.SecurityGroupRule{RemoteType: kubeovnv1.SgRemoteTypeIPPool, RemoteIPPool: "pool-missing"})), "failed to get remote ippool")
+
+	ctrl.config.EnableDNSNameResolver = false
+	require.ErrorContains(t, ctrl.validateSgRule(sgWithRule(kubeovnv1.SecurityGroupRule{RemoteType: kubeovnv1.SgRemoteTypeFQDN, RemoteFQDN: "www.example.com."})), "requires the DNSNameResolver support")
+}
+
+func Test_sgRemoteFQDNs(t *testing.T) {
+	t.Parallel()
+
+	sg := &kubeovnv1.SecurityGroup{
+		Spec: kubeovnv1.SecurityGroupSpec{
+			IngressRules: []kubeovnv1.SecurityGroupRule{
+				{RemoteType: kubeovnv1.SgRemoteTypeFQDN, RemoteFQDN: "b.example.com."},
+				{RemoteType: kubeovnv1.SgRemoteTypeAddress, RemoteAddress: "10.0.0.1"},
+			},
+			EgressRules: []kubeovnv1.SecurityGroupRule{
+				{RemoteType: kubeovnv1.SgRemoteTypeFQDN, RemoteFQDN: "a.example.com."},
+				{RemoteType: kubeovnv1.SgRemoteTypeFQDN, RemoteFQDN: "b.example.com."},
+			},
+		},
+	}
+	require.Equal(t, []string{"a.example.com.", "b.example.com."}, sgRemoteFQDNs(sg))
+	require.Empty(t, sgRemoteFQDNs(&kubeovnv1.SecurityGroup{}))
+}
+
+func Test_sgSelectsPod(t *testing.T) {
+	t.Parallel()
+
+	podLabels := map[string]string{"app": "web"}
+	nsLabels := map[string]string{"tenant": "a"}
+	tests := []struct {
+		name              string
+		podSelector       *metav1.LabelSelector
+		namespaceSelector *metav1.LabelSelector
+		selected          bool
+		hasErr            bool
+	}{
+		{name: "no selectors"},
+		{name: "pod selector matches", podSelector: &metav1.LabelSelector{MatchLabels: podLabels}, selected: true},
+		{name: "pod selector does not match", podSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+		{name: "namespace selector matches", namespaceSelector: &metav1.LabelSelector{MatchLabels: nsLabels}, selected: true},
+		{name: "empty namespace selector", namespaceSelector: &metav1.LabelSelector{}, selected: true},
+		{
+			name:              "both selectors match",
+			podSelector:       &metav1.LabelSelector{MatchLabels: podLabels},
+			namespaceSelector: &metav1.LabelSelector{MatchLabels: nsLabels},
+			selected:          true,
+		},
+		{
+			name:              "namespace selector does not match",
+			podSelector:       &metav1.LabelSelector{MatchLabels: podLabels},
+			namespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "b"}},
+		},
+		{
+			name: "invalid selector",
+			podSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: "Bad"},
+			}},
+			hasErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sg := &kubeovnv1.SecurityGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "sg"},
+				Spec: kubeovnv1.SecurityGroupSpec{
+					PodSelector:       tt.podSelector,
+					NamespaceSelector: tt.namespaceSelector,
+				},
+			}
+			selected, err := sgSelectsPod(sg, podLabels, nsLabels)
+			if tt.hasErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.selected, selected)
+		})
+	}
+}
+
+func Test_getPodSecurityGroups(t *testing.T) {
+	t.Parallel()
+
+	namespaces := []*corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "tenant-a", Labels: map[string]string{"tenant": "a"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "tenant-b", Labels: map[string]string{"tenant": "b"}}},
+	}
+	webPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "web",
+			Namespace:   "tenant-a",
+			Labels:      map[string]string{"app": "web"},
+			Annotations: map[string]string{fmt.Sprintf(util.SecurityGroupAnnotationTemplate, util.OvnProvider): "sg-annotated, sg-web"},
+		},
+	}
+	dbPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "tenant-b", Labels: map[string]string{"app": "db"}},
+	}
+	sgs := []*kubeovnv1.SecurityGroup{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "sg-web"},
+			Spec:       kubeovnv1.SecurityGroupSpec{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "sg-tenant-a"},
+			Spec:       kubeovnv1.SecurityGroupSpec{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}}},
+		},
+		{ObjectMeta: metav1.ObjectMeta{Name: "sg-annotated"}},
+	}
+
+	fakeController, err := newFakeControllerWithOptions(t, &FakeControllerOptions{
+		Namespaces:     namespaces,
+		Pods:           []*corev1.Pod{webPod, dbPod},
+		SecurityGroups: sgs,
+	})
+	require.NoError(t, err)
+	c := fakeController.fakeController
+
+	require.Equal(t, []string{"sg-tenant-a", "sg-web"}, c.getPodSelectedSecurityGroups(webPod))
+	require.Equal(t, "sg-annotated,sg-web,sg-tenant-a", c.getPodSecurityGroups(webPod, util.OvnProvider))
+	require.Empty(t, c.getPodSelectedSecurityGroups(dbPod))
+	require.Empty(t, c.getPodSecurityGroups(dbPod, util.OvnProvider))
+
+	selectedPods, err := c.getSgSelectedPods(sgs[1])
+	require.NoError(t, err)
+	require.True(t, selectedPods.Has("tenant-a/web"))
+	require.Equal(t, 1, selectedPods.Len())
+
+	require.True(t, podSecurityGroupAnnotated(webPod, "sg-annotated"))
+	require.False(t, podSecurityGroupAnnotated(webPod, "sg-tenant-a"))
 }
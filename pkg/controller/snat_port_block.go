@@ -0,0 +1,173 @@
+package controller
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnnb"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+const (
+	snatPortBlockReasonAllocated = "PortBlockAllocated"
+	snatPortBlockReasonReleased  = "PortBlockReleased"
+
+	snatPortBlockEventAllocate = "allocate"
+	snatPortBlockEventRelease  = "release"
+)
+
+// snatPortBlockRecord records which internal address owns an external port block from a point in time,
+// so that the internal address having used an external port can be found afterwards
+type snatPortBlockRecord struct {
+	Timestamp time.Time `json:"timestamp"`
+	Event     string    `json:"event"`
+	Kind      string    `json:"kind"`
+	Name      string    `json:"name"`
+	// Router is the VPC NAT gateway or the VPC logical router translating the traffic
+	Router string `json:"router"`
+	util.SnatPortBlockMapping
+}
+
+// serializes the writers of the port block records file
+var snatPortBlockRecordMutex sync.Mutex
+
+// recordSnatPortBlocks appends a record per port block of the SNAT rule to the port block log file, one JSON object
+// per line, and notifies the allocation or the release with an event of the rule. The file is the durable record:
+// it is written by the leader on its node, so the files of all the controller nodes together hold the full history.
+// Callers only record an allocation when it differs from the one in the status, so retries don't duplicate it.
+func (c *Controller) recordSnatPortBlocks(obj runtime.Object, reason, router, externalIP, internalCIDR string, portBlock *kubeovnv1.SnatPortBlock) error {
+	if portBlock == nil {
+		return nil
+	}
+	mappings, err := util.GenSnatPortBlockMappings(externalIP, internalCIDR, portBlock)
+	if err != nil {
+		klog.Error(err)
+		return err
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		klog.Error(err)
+		return err
+	}
+
+	event := snatPortBlockEventAllocate
+	if reason == snatPortBlockReasonReleased {
+		event = snatPortBlockEventRelease
+	}
+	kind := util.KindOvnSnatRule
+	if _, ok := obj.(*kubeovnv1.IptablesSnatRule); ok {
+		kind = util.KindIptablesSnatRule
+	}
+	if err = c.writeSnatPortBlockRecords(event, kind, accessor.GetName(), router, mappings); err != nil {
+		return err
+	}
+
+	first, last := mappings[0], mappings[len(mappings)-1]
+	c.recorder.Eventf(obj, corev1.EventTypeNormal, reason,
+		"router %s, external ip %s, internal cidr %s, port start %d, block size %d: address i of the cidr owns ports [%d + i*%d, %d + (i+1)*%d - 1], %s owns %s and %s owns %s",
+		router, externalIP, internalCIDR, first.PortStart, portBlock.BlockSize,
+		first.PortStart, portBlock.BlockSize, first.PortStart, portBlock.BlockSize,
+		first.InternalIP, first.PortRange(), last.InternalIP, last.PortRange())
+	return nil
+}
+
+func (c *Controller) writeSnatPortBlockRecords(event, kind, name, router string, mappings []util.SnatPortBlockMapping) error {
+	now := time.Now().UTC()
+	snatPortBlockRecordMutex.Lock()
+	defer snatPortBlockRecordMutex.Unlock()
+
+	if c.config.SnatPortBlockLogFile == "" {
+		for _, mapping := range mappings {
+			buf, err := json.Marshal(snatPortBlockRecord{Timestamp: now, Event: event, Kind: kind, Name: name, Router: router, SnatPortBlockMapping: mapping})
+			if err != nil {
+				klog.Error(err)
+				return err
+			}
+			klog.Infof("snat port block: %s", string(buf))
+		}
+		return nil
+	}
+
+	f, err := os.OpenFile(c.config.SnatPortBlockLogFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		err = fmt.Errorf("failed to open snat port block log file %s: %w", c.config.SnatPortBlockLogFile, err)
+		klog.Error(err)
+		return err
+	}
+	w := bufio.NewWriter(f)
+	encoder := json.NewEncoder(w)
+	for _, mapping := range mappings {
+		if err = encoder.Encode(snatPortBlockRecord{Timestamp: now, Event: event, Kind: kind, Name: name, Router: router, SnatPortBlockMapping: mapping}); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		err = fmt.Errorf("failed to write snat port block records of %s %s: %w", kind, name, err)
+		klog.Error(err)
+		return err
+	}
+	klog.Infof("recorded %d %s port blocks of %s %s", len(mappings), event, kind, name)
+	return nil
+}
+
+// syncOvnSnatV4 translates the IPv4 cidr of the ovn snat rule with a single snat rule,
+// or with a snat rule per address limited to its port block when the port block allocation is enabled
+func (c *Controller) syncOvnSnatV4(snat *kubeovnv1.OvnSnatRule, vpcName, v4Eip, v4IpCidr string) error {
+	if snat.Spec.PortBlock == nil {
+		if snat.Status.PortBlock != nil {
+			if err := c.OVNNbClient.UpdateSnatPortBlocks(vpcName, snat.Name, nil); err != nil {
+				klog.Error(err)
+				return err
+			}
+		}
+		return c.OVNNbClient.AddNat(vpcName, ovnnb.NATTypeSNAT, v4Eip, v4IpCidr, "", "", nil)
+	}
+
+	mappings, err := util.GenSnatPortBlockMappings(v4Eip, v4IpCidr, snat.Spec.PortBlock)
+	if err != nil {
+		err = fmt.Errorf("invalid port block of ovn snat %s: %w", snat.Name, err)
+		klog.Error(err)
+		return err
+	}
+	// the per address rules take precedence, the cidr rule would not limit the ports of the addresses
+	if err = c.OVNNbClient.DeleteNat(vpcName, ovnnb.NATTypeSNAT, v4Eip, v4IpCidr); err != nil {
+		klog.Error(err)
+		return err
+	}
+	if err = c.OVNNbClient.UpdateSnatPortBlocks(vpcName, snat.Name, mappings); err != nil {
+		klog.Error(err)
+		return err
+	}
+	return nil
+}
+
+// deleteOvnSnatPortBlocks deletes the per address snat rules of the ovn snat rule and records the release
+func (c *Controller) deleteOvnSnatPortBlocks(snat *kubeovnv1.OvnSnatRule) error {
+	if snat.Status.PortBlock == nil || snat.Status.Vpc == "" {
+		return nil
+	}
+	if err := c.OVNNbClient.UpdateSnatPortBlocks(snat.Status.Vpc, snat.Name, nil); err != nil {
+		klog.Error(err)
+		return err
+	}
+	return c.recordSnatPortBlocks(snat, snatPortBlockReasonReleased, snat.Status.Vpc, snat.Status.V4Eip, snat.Status.V4IpCidr, snat.Status.PortBlock)
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+func TestRecordSnatPortBlocks(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "snat-port-block.log")
+	recorder := record.NewFakeRecorder(10)
+	c := &Controller{config: &Configuration{SnatPortBlockLogFile: logFile}, recorder: recorder}
+	snat := &kubeovnv1.IptablesSnatRule{ObjectMeta: metav1.ObjectMeta{Name: "snat1"}}
+	portBlock := &kubeovnv1.SnatPortBlock{BlockSize: 32256}
+
+	require.NoError(t, c.recordSnatPortBlocks(snat, snatPortBlockReasonAllocated, "gw1", "172.18.0.10", "10.0.0.0/31", portBlock))
+	require.NoError(t, c.recordSnatPortBlocks(snat, snatPortBlockReasonReleased, "gw1", "172.18.0.10", "10.0.0.0/31", portBlock))
+	// nothing is recorded without port block allocation
+	require.NoError(t, c.recordSnatPortBlocks(snat, snatPortBlockReasonAllocated, "gw1", "172.18.0.10", "10.0.1.0/24", nil))
+
+	f, err := os.Open(logFile)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var records []snatPortBlockRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record snatPortBlockRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, records, 4)
+
+	require.Equal(t, snatPortBlockEventAllocate, records[0].Event)
+	require.Equal(t, util.KindIptablesSnatRule, records[0].Kind)
+	require.Equal(t, "snat1", records[0].Name)
+	require.Equal(t, "gw1", records[0].Router)
+	require.Equal(t, util.SnatPortBlockMapping{InternalIP: "10.0.0.0", ExternalIP: "172.18.0.10", PortStart: 1024, PortEnd: 33279}, records[0].SnatPortBlockMapping)
+	require.Equal(t, util.SnatPortBlockMapping{InternalIP: "10.0.0.1", ExternalIP: "172.18.0.10", PortStart: 33280, PortEnd: 65535}, records[1].SnatPortBlockMapping)
+	require.Equal(t, snatPortBlockEventRelease, records[2].Event)
+	require.Equal(t, records[0].SnatPortBlockMapping, records[2].SnatPortBlockMapping)
+
+	// the events only notify the allocation and the release
+	require.Len(t, recorder.Events, 2)
+	allocated, released := <-recorder.Events, <-recorder.Events
+	require.Equal(t, "Normal PortBlockAllocated router gw1, external ip 172.18.0.10, internal cidr 10.0.0.0/31, port start 1024, block size 32256: "+
+		"address i of the cidr owns ports [1024 + i*32256, 1024 + (i+1)*32256 - 1], 10.0.0.0 owns 1024-33279 and 10.0.0.1 owns 33280-65535", allocated)
+	require.Contains(t, released, "Normal PortBlockReleased router gw1, external ip 172.18.0.10, internal cidr 10.0.0.0/31")
+
+	// the allocation is rejected when the port range is too small for the cidr
+	require.Error(t, c.recordSnatPortBlocks(snat, snatPortBlockReasonAllocated, "vpc1", "172.18.0.10", "10.0.0.0/30", portBlock))
+	// port blocks are IPv4 only
+	require.Error(t, c.recordSnatPortBlocks(snat, snatPortBlockReasonAllocated, "vpc1", "172.18.0.10", "fd00::/127", portBlock))
+	require.Empty(t, recorder.Events)
+
+	// no event is recorded when the record cannot be written
+	c.config.SnatPortBlockLogFile = filepath.Join(t.TempDir(), "missing", "snat-port-block.log")
+	require.Error(t, c.recordSnatPortBlocks(snat, snatPortBlockReasonAllocated, "gw1", "172.18.0.10", "10.0.0.0/31", portBlock))
+	require.Empty(t, recorder.Events)
+}
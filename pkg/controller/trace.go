@@ -0,0 +1,479 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/ovs"
+	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnnb"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+const (
+	traceDecisionACL    = "acl"
+	traceDecisionLB     = "lb"
+	traceDecisionNAT    = "nat"
+	traceDecisionRoute  = "route"
+	traceDecisionPolicy = "policy"
+	traceDecisionPort   = "port"
+
+	traceSourcePort = 30000
+
+	// maxConcurrentTraces is the number of ovn-trace processes which the trace handler runs at the same time
+	maxConcurrentTraces = 4
+)
+
+// traceSlots limits the number of traces running concurrently
+var traceSlots = make(chan struct{}, maxConcurrentTraces)
+
+var (
+	kindNetworkPolicy              = util.ObjectKind[*netv1.NetworkPolicy]()
+	kindAdminNetworkPolicy         = util.ObjectKind[*v1alpha1.AdminNetworkPolicy]()
+	kindBaselineAdminNetworkPolicy = util.ObjectKind[*v1alpha1.BaselineAdminNetworkPolicy]()
+)
+
+// traceRequest describes the packet to trace. The source is a pod or vm,
+// and the destination is one of a pod, a service or an ip address.
+type traceRequest struct {
+	Source      string
+	Destination string
+	Service     string
+	IP          string
+	MAC         string
+	Protocol    string
+	Port        int32
+	Provider    string
+}
+
+// TraceObject is a kubernetes object which an ovn trace step is created by
+type TraceObject struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// TraceStep is a logical flow matched by the traced packet and the objects which the flow is created from
+type TraceStep struct {
+	ovs.OVNTraceStep
+	Decision string        `json:"decision,omitempty"`
+	Entity   *ovs.NbEntity `json:"entity,omitempty"`
+	Object   *TraceObject  `json:"object,omitempty"`
+}
+
+// TraceResult is the result of tracing a packet in ovn
+type TraceResult struct {
+	Datapath  string      `json:"datapath"`
+	Microflow string      `json:"microflow"`
+	Steps     []TraceStep `json:"steps"`
+	Output    string      `json:"output"`
+}
+
+// TraceHandler returns the http handler which traces a packet with ovn-trace.
+// At most maxConcurrentTraces requests are served at the same time, others are rejected with 429.
+// Query parameters:
+//   - src: the source pod or vm in the format of namespace/name, required
+//   - dst, svc or ip: the destination pod (namespace/name), service (namespace/name) or ip address
+//   - protocol: icmp, tcp or udp, defaults to the service port protocol, tcp if port is set, otherwise icmp
+//   - port: the destination port of tcp/udp, defaults to the first service port
+//   - mac: the destination mac address, defaults to the mac of the destination pod or the logical router port
+//   - provider: the provider of the source and destination pods, defaults to ovn
+func TraceHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		query := r.URL.Query()
+		req := &traceRequest{
+			Source:      query.Get("src"),
+			Destination: query.Get("dst"),
+			Service:     query.Get("svc"),
+			IP:          query.Get("ip"),
+			MAC:         query.Get("mac"),
+			Protocol:    strings.ToLower(query.Get("protocol")),
+			Provider:    query.Get("provider"),
+		}
+		if port := query.Get("port"); port != "" {
+			p, err := strconv.ParseInt(port, 10, 32)
+			if err != nil || p <= 0 || p > 65535 {
+				http.Error(w, fmt.Sprintf("invalid port %q", port), http.StatusBadRequest)
+				return
+			}
+			req.Port = int32(p)
+		}
+		// the mac and ip addresses are put into the microflow as they are
+		if req.MAC != "" {
+			mac, err := net.ParseMAC(req.MAC)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid mac %q", req.MAC), http.StatusBadRequest)
+				return
+			}
+			req.MAC = mac.String()
+		}
+		if req.IP != "" {
+			ip := net.ParseIP(req.IP)
+			if ip == nil {
+				http.Error(w, fmt.Sprintf("invalid ip %q", req.IP), http.StatusBadRequest)
+				return
+			}
+			req.IP = ip.String()
+		}
+
+		c := activeController.Load()
+		if c == nil {
+			http.Error(w, "trace is only available on the leader", http.StatusServiceUnavailable)
+			return
+		}
+
+		select {
+		case traceSlots <- struct{}{}:
+			defer func() { <-traceSlots }()
+		default:
+			http.Error(w, "too many traces are running, please retry later", http.StatusTooManyRequests)
+			return
+		}
+
+		result, err := c.trace(req)
+		if err != nil {
+			klog.Errorf("failed to trace %+v: %v", req, err)
+			status := http.StatusInternalServerError
+			var badRequest *traceBadRequestError
+			if errors.As(err, &badRequest) {
+				status = http.StatusBadRequest
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err = json.NewEncoder(w).Encode(result); err != nil {
+			klog.Errorf("failed to encode trace result: %v", err)
+		}
+	})
+}
+
+type traceBadRequestError struct {
+	msg string
+}
+
+func (e *traceBadRequestError) Error() string {
+	return e.msg
+}
+
+func traceBadRequest(format string, args ...any) error {
+	return &traceBadRequestError{msg: fmt.Sprintf(format, args...)}
+}
+
+func (c *Controller) trace(req *traceRequest) (*TraceResult, error) {
+	datapath, microflow, svc, err := c.buildTraceMicroflow(req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := ovs.RunOVNTrace(c.config.OvnSbAddr, c.config.OvnTimeout, datapath, microflow)
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+
+	flowSteps := ovs.ParseOVNTrace(output)
+	uuids := make([]string, 0, len(flowSteps))
+	for _, step := range flowSteps {
+		uuids = append(uuids, step.FlowUUID)
+	}
+	hints, err := ovs.LogicalFlowStageHints(c.config.OvnSbAddr, c.config.OvnTimeout, uuids...)
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+
+	entities := make(map[string]*ovs.NbEntity, len(hints))
+	result := &TraceResult{Datapath: datapath, Microflow: microflow, Output: output, Steps: make([]TraceStep, 0, len(flowSteps))}
+	for _, flowStep := range flowSteps {
+		step := TraceStep{OVNTraceStep: flowStep}
+		if hint := hints[flowStep.FlowUUID]; hint != "" {
+			entity, ok := entities[hint]
+			if !ok {
+				if entity, err = c.OVNNbClient.GetEntityByUUIDPrefix(hint); err != nil {
+					klog.Errorf("failed to get nb entity of stage hint %s: %v", hint, err)
+					return nil, err
+				}
+				entities[hint] = entity
+			}
+			if entity != nil {
+				step.Entity = entity
+				step.Decision = traceEntityDecision(entity)
+				step.Object = c.traceEntityObject(entity, svc)
+			}
+		}
+		result.Steps = append(result.Steps, step)
+	}
+	return result, nil
+}
+
+// buildTraceMicroflow returns the logical switch and the microflow which ovn-trace runs with,
+// and the destination service if any
+func (c *Controller) buildTraceMicroflow(req *traceRequest) (string, string, *v1.Service, error) {
+	if req.Provider == "" {
+		req.Provider = util.OvnProvider
+	}
+
+	srcIP, err := c.getTracePodIP(req.Source, req.Provider)
+	if err != nil {
+		return "", "", nil, err
+	}
+	subnet, err := c.subnetsLister.Get(srcIP.Spec.Subnet)
+	if err != nil {
+		klog.Errorf("failed to get subnet %s: %v", srcIP.Spec.Subnet, err)
+		return "", "", nil, err
+	}
+
+	var (
+		svc    *v1.Service
+		dstIPs []string
+		dstMAC = req.MAC
+	)
+	switch {
+	case req.Destination != "":
+		dstIP, err := c.getTracePodIP(req.Destination, req.Provider)
+		if err != nil {
+			return "", "", nil, err
+		}
+		dstIPs = []string{dstIP.Spec.V4IPAddress, dstIP.Spec.V6IPAddress}
+		if dstMAC == "" && dstIP.Spec.Subnet == srcIP.Spec.Subnet {
+			dstMAC = dstIP.Spec.MacAddress
+		}
+	case req.Service != "":
+		namespace, name, err := cache.SplitMetaNamespaceKey(req.Service)
+		if err != nil {
+			return "", "", nil, traceBadRequest("invalid service %q: %v", req.Service, err)
+		}
+		if svc, err = c.servicesLister.Services(namespace).Get(name); err != nil {
+			if k8serrors.IsNotFound(err) {
+				return "", "", nil, traceBadRequest("service %s not found", req.Service)
+			}
+			klog.Error(err)
+			return "", "", nil, err
+		}
+		dstIPs = util.ServiceClusterIPs(*svc)
+		if len(svc.Spec.Ports) == 0 {
+			return "", "", nil, traceBadRequest("service %s has no ports", req.Service)
+		}
+		port := svc.Spec.Ports[0]
+		for _, p := range svc.Spec.Ports {
+			if req.Port == p.Port {
+				port = p
+				break
+			}
+		}
+		if req.Port == 0 {
+			req.Port = port.Port
+		}
+		if req.Protocol == "" {
+			req.Protocol = strings.ToLower(string(port.Protocol))
+		}
+	case req.IP != "":
+		dstIPs = []string{req.IP}
+	default:
+		return "", "", nil, traceBadRequest("one of the destination pod, service and ip is required")
+	}
+
+	var src, dst string
+	for _, ip := range []string{srcIP.Spec.V4IPAddress, srcIP.Spec.V6IPAddress} {
+		if ip == "" {
+			continue
+		}
+		for _, d := range dstIPs {
+			if d != "" && util.CheckProtocol(d) == util.CheckProtocol(ip) {
+				src, dst = ip, d
+				break
+			}
+		}
+		if dst != "" {
+			break
+		}
+	}
+	if dst == "" {
+		return "", "", nil, traceBadRequest("source %s and destination %v have no address of the same family", req.Source, dstIPs)
+	}
+
+	if dstMAC == "" {
+		lrpName := fmt.Sprintf("%s-%s", subnet.Spec.Vpc, subnet.Name)
+		lrp, err := c.OVNNbClient.GetLogicalRouterPort(lrpName, true)
+		if err != nil {
+			klog.Errorf("failed to get logical router port %s: %v", lrpName, err)
+			return "", "", nil, err
+		}
+		if lrp == nil {
+			return "", "", nil, traceBadRequest("logical router port %s not found, please specify the destination mac address", lrpName)
+		}
+		dstMAC = lrp.MAC
+	}
+
+	if req.Protocol == "" {
+		if req.Port != 0 {
+			req.Protocol = "tcp"
+		} else {
+			req.Protocol = "icmp"
+		}
+	}
+
+	// the name of the ip is the same as the logical switch port
+	portName := srcIP.Name
+	af := "4"
+	if util.CheckProtocol(dst) == kubeovnv1.ProtocolIPv6 {
+		af = "6"
+	}
+	microflow := fmt.Sprintf(`inport == "%s" && ip.ttl == 255 && eth.src == %s && ip%s.src == %s && eth.dst == %s && ip%s.dst == %s`,
+		portName, srcIP.Spec.MacAddress, af, src, dstMAC, af, dst)
+	switch req.Protocol {
+	case "icmp":
+		if af == "6" {
+			microflow += " && icmp6.type == 128"
+		} else {
+			microflow += " && icmp4.type == 8"
+		}
+	case "tcp", "udp":
+		if req.Port == 0 {
+			return "", "", nil, traceBadRequest("port is required for protocol %s", req.Protocol)
+		}
+		microflow += fmt.Sprintf(" && %s.src == %d && %s.dst == %d", req.Protocol, traceSourcePort, req.Protocol, req.Port)
+		if req.Protocol == "tcp" {
+			// TCP SYN
+			microflow += " && tcp.flags == 2"
+		}
+	default:
+		return "", "", nil, traceBadRequest("unsupported protocol %q", req.Protocol)
+	}
+
+	return subnet.Name, microflow, svc, nil
+}
+
+// getTracePodIP returns the IP CR of the pod or vm identified by key
+func (c *Controller) getTracePodIP(key, provider string) (*kubeovnv1.IP, error) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil || namespace == "" || name == "" {
+		return nil, traceBadRequest("invalid pod %q, it should be in the format of namespace/name", key)
+	}
+
+	podName := name
+	if pod, err := c.podsLister.Pods(namespace).Get(name); err == nil {
+		if pod.Spec.HostNetwork {
+			return nil, traceBadRequest("pod %s is in host network", key)
+		}
+		podName = c.getNameByPod(pod)
+	} else if !k8serrors.IsNotFound(err) {
+		klog.Error(err)
+		return nil, err
+	}
+
+	portName := ovs.PodNameToPortName(podName, namespace, provider)
+	ip, err := c.ipsLister.Get(portName)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, traceBadRequest("ip %s of %s not found", portName, key)
+		}
+		klog.Error(err)
+		return nil, err
+	}
+	if ip.Spec.MacAddress == "" || ip.Spec.Subnet == "" {
+		return nil, traceBadRequest("ip %s of %s is not ready", portName, key)
+	}
+	return ip, nil
+}
+
+func traceEntityDecision(entity *ovs.NbEntity) string {
+	switch entity.Table {
+	case ovnnb.ACLTable:
+		return traceDecisionACL
+	case ovnnb.LoadBalancerTable:
+		return traceDecisionLB
+	case ovnnb.NATTable:
+		return traceDecisionNAT
+	case ovnnb.LogicalRouterStaticRouteTable:
+		return traceDecisionRoute
+	case ovnnb.LogicalRouterPolicyTable:
+		return traceDecisionPolicy
+	case ovnnb.LogicalSwitchPortTable, ovnnb.LogicalRouterPortTable:
+		return traceDecisionPort
+	}
+	return ""
+}
+
+// traceEntityObject maps the nb entity to the kubernetes object which creates it by the external ids
+func (c *Controller) traceEntityObject(entity *ovs.NbEntity, svc *v1.Service) *TraceObject {
+	switch entity.Table {
+	case ovnnb.ACLTable:
+		return c.traceACLParentObject(entity.Parent)
+	case ovnnb.LoadBalancerTable:
+		if svc != nil {
+			return &TraceObject{Kind: util.KindService, Namespace: svc.Namespace, Name: svc.Name}
+		}
+	case ovnnb.LogicalSwitchPortTable:
+		if namespace, name, err := cache.SplitMetaNamespaceKey(entity.ExternalIDs["pod"]); err == nil && namespace != "" {
+			return &TraceObject{Kind: util.KindPod, Namespace: namespace, Name: name}
+		}
+	case ovnnb.NATTable, ovnnb.LogicalRouterStaticRouteTable, ovnnb.LogicalRouterPolicyTable:
+		if _, err := c.vpcsLister.Get(entity.Parent); err == nil {
+			return &TraceObject{Kind: util.KindVpc, Name: entity.Parent}
+		}
+	}
+	return nil
+}
+
+// traceACLParentObject maps the port group or logical switch which an acl belongs to the kubernetes object
+func (c *Controller) traceACLParentObject(parent string) *TraceObject {
+	if parent == "" {
+		return nil
+	}
+
+	pg, err := c.OVNNbClient.GetPortGroup(parent, true)
+	if err != nil {
+		klog.Errorf("failed to get port group %s: %v", parent, err)
+		return nil
+	}
+	if pg == nil {
+		if _, err = c.subnetsLister.Get(parent); err == nil {
+			return &TraceObject{Kind: util.KindSubnet, Name: parent}
+		}
+		return nil
+	}
+
+	switch {
+	case pg.ExternalIDs["node"] != "":
+		return &TraceObject{Kind: util.KindNode, Name: pg.ExternalIDs["node"]}
+	case pg.ExternalIDs[networkPolicyKey] != "":
+		namespace, name, err := cache.SplitMetaNamespaceKey(pg.ExternalIDs[networkPolicyKey])
+		if err != nil {
+			return nil
+		}
+		// names of network policies which do not start with a letter are prefixed with "np"
+		if rest, ok := strings.CutPrefix(name, "np"); ok && rest != "" && !unicode.IsLetter([]rune(rest)[0]) {
+			if _, err = c.npsLister.NetworkPolicies(namespace).Get(name); err != nil {
+				name = rest
+			}
+		}
+		return &TraceObject{Kind: kindNetworkPolicy, Namespace: namespace, Name: name}
+	case pg.ExternalIDs[sgKey] != "":
+		return &TraceObject{Kind: util.KindSecurityGroup, Name: pg.ExternalIDs[sgKey]}
+	case pg.ExternalIDs[adminNetworkPolicyKey] != "":
+		return &TraceObject{Kind: kindAdminNetworkPolicy, Name: pg.ExternalIDs[adminNetworkPolicyKey]}
+	case pg.ExternalIDs[baselineAdminNetworkPolicyKey] != "":
+		return &TraceObject{Kind: kindBaselineAdminNetworkPolicy, Name: pg.ExternalIDs[baselineAdminNetworkPolicyKey]}
+	}
+	return nil
+}
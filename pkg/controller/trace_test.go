@@ -0,0 +1,258 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/ovs"
+	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnnb"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+func newTraceTestIP(namespace, pod, subnet, v4, v6, mac string) *kubeovnv1.IP {
+	return &kubeovnv1.IP{
+		ObjectMeta: metav1.ObjectMeta{Name: ovs.PodNameToPortName(pod, namespace, util.OvnProvider)},
+		Spec: kubeovnv1.IPSpec{
+			PodName:     pod,
+			Namespace:   namespace,
+			Subnet:      subnet,
+			V4IPAddress: v4,
+			V6IPAddress: v6,
+			MacAddress:  mac,
+		},
+	}
+}
+
+func TestBuildTraceMicroflow(t *testing.T) {
+	fakeController, err := newFakeControllerWithOptions(t, &FakeControllerOptions{
+		Subnets: []*kubeovnv1.Subnet{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "subnet1"},
+				Spec:       kubeovnv1.SubnetSpec{Vpc: util.DefaultVpc, CIDRBlock: "10.16.0.0/16,fd00::/112"},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "subnet2"},
+				Spec:       kubeovnv1.SubnetSpec{Vpc: util.DefaultVpc, CIDRBlock: "10.17.0.0/16"},
+			},
+		},
+		Pods: []*corev1.Pod{
+			{ObjectMeta: metav1.ObjectMeta{Name: "client", Namespace: "default"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "server", Namespace: "default"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "remote", Namespace: "default"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "host", Namespace: "default"}, Spec: corev1.PodSpec{HostNetwork: true}},
+		},
+		IPs: []*kubeovnv1.IP{
+			newTraceTestIP("default", "client", "subnet1", "10.16.0.2", "fd00::2", "00:00:00:00:00:02"),
+			newTraceTestIP("default", "server", "subnet1", "10.16.0.3", "fd00::3", "00:00:00:00:00:03"),
+			newTraceTestIP("default", "remote", "subnet2", "10.17.0.2", "", "00:00:00:00:01:02"),
+		},
+		Services: []*corev1.Service{{
+			ObjectMeta: metav1.ObjectMeta{Name: "dns", Namespace: "kube-system"},
+			Spec: corev1.ServiceSpec{
+				ClusterIP:  "10.96.0.10",
+				ClusterIPs: []string{"10.96.0.10"},
+				Ports: []corev1.ServicePort{
+					{Name: "dns", Port: 53, Protocol: corev1.ProtocolUDP},
+					{Name: "dns-tcp", Port: 53, Protocol: corev1.ProtocolTCP},
+				},
+			},
+		}},
+	})
+	require.NoError(t, err)
+	ctrl := fakeController.fakeController
+	mockOvnClient := fakeController.mockOvnClient
+	mockOvnClient.EXPECT().GetLogicalRouterPort(util.DefaultVpc+"-subnet1", true).Return(&ovnnb.LogicalRouterPort{MAC: "00:00:00:00:00:01"}, nil).AnyTimes()
+
+	tests := []struct {
+		name       string
+		req        *traceRequest
+		microflow  string
+		svc        string
+		badRequest bool
+	}{
+		{
+			name:      "pod in the same subnet",
+			req:       &traceRequest{Source: "default/client", Destination: "default/server"},
+			microflow: `inport == "client.default" && ip.ttl == 255 && eth.src == 00:00:00:00:00:02 && ip4.src == 10.16.0.2 && eth.dst == 00:00:00:00:00:03 && ip4.dst == 10.16.0.3 && icmp4.type == 8`,
+		},
+		{
+			name:      "pod in another subnet",
+			req:       &traceRequest{Source: "default/client", Destination: "default/remote", Port: 80},
+			microflow: `inport == "client.default" && ip.ttl == 255 && eth.src == 00:00:00:00:00:02 && ip4.src == 10.16.0.2 && eth.dst == 00:00:00:00:00:01 && ip4.dst == 10.17.0.2 && tcp.src == 30000 && tcp.dst == 80 && tcp.flags == 2`,
+		},
+		{
+			name:      "ipv6 address",
+			req:       &traceRequest{Source: "default/client", IP: "fd00::3", Protocol: "icmp"},
+			microflow: `inport == "client.default" && ip.ttl == 255 && eth.src == 00:00:00:00:00:02 && ip6.src == fd00::2 && eth.dst == 00:00:00:00:00:01 && ip6.dst == fd00::3 && icmp6.type == 128`,
+		},
+		{
+			name:      "service",
+			req:       &traceRequest{Source: "default/client", Service: "kube-system/dns"},
+			microflow: `inport == "client.default" && ip.ttl == 255 && eth.src == 00:00:00:00:00:02 && ip4.src == 10.16.0.2 && eth.dst == 00:00:00:00:00:01 && ip4.dst == 10.96.0.10 && udp.src == 30000 && udp.dst == 53`,
+			svc:       "dns",
+		},
+		{
+			name:      "service with destination mac",
+			req:       &traceRequest{Source: "default/client", Service: "kube-system/dns", Protocol: "tcp", MAC: "00:00:00:00:00:aa"},
+			microflow: `inport == "client.default" && ip.ttl == 255 && eth.src == 00:00:00:00:00:02 && ip4.src == 10.16.0.2 && eth.dst == 00:00:00:00:00:aa && ip4.dst == 10.96.0.10 && tcp.src == 30000 && tcp.dst == 53 && tcp.flags == 2`,
+			svc:       "dns",
+		},
+		{
+			name:       "missing destination",
+			req:        &traceRequest{Source: "default/client"},
+			badRequest: true,
+		},
+		{
+			name:       "host network pod",
+			req:        &traceRequest{Source: "default/host", Destination: "default/server"},
+			badRequest: true,
+		},
+		{
+			name:       "different ip families",
+			req:        &traceRequest{Source: "default/remote", IP: "fd00::3"},
+			badRequest: true,
+		},
+		{
+			name:       "tcp without port",
+			req:        &traceRequest{Source: "default/client", Destination: "default/server", Protocol: "tcp"},
+			badRequest: true,
+		},
+		{
+			name:       "unknown service",
+			req:        &traceRequest{Source: "default/client", Service: "default/unknown"},
+			badRequest: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			datapath, microflow, svc, err := ctrl.buildTraceMicroflow(tt.req)
+			if tt.badRequest {
+				var badRequest *traceBadRequestError
+				require.True(t, errors.As(err, &badRequest), "unexpected error %v", err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "subnet1", datapath)
+			require.Equal(t, tt.microflow, microflow)
+			if tt.svc == "" {
+				require.Nil(t, svc)
+			} else {
+				require.Equal(t, tt.svc, svc.Name)
+			}
+		})
+	}
+}
+
+func TestTraceEntityObject(t *testing.T) {
+	fakeController, err := newFakeControllerWithOptions(t, &FakeControllerOptions{
+		Subnets: []*kubeovnv1.Subnet{{ObjectMeta: metav1.ObjectMeta{Name: "subnet1"}}},
+		Vpcs:    []*kubeovnv1.Vpc{{ObjectMeta: metav1.ObjectMeta{Name: "vpc1"}}},
+	})
+	require.NoError(t, err)
+	ctrl := fakeController.fakeController
+	mockOvnClient := fakeController.mockOvnClient
+
+	mockOvnClient.EXPECT().GetPortGroup("np.pg", true).Return(&ovnnb.PortGroup{ExternalIDs: map[string]string{networkPolicyKey: "default/deny-all"}}, nil)
+	mockOvnClient.EXPECT().GetPortGroup("sg.pg", true).Return(&ovnnb.PortGroup{ExternalIDs: map[string]string{sgKey: "sg1"}}, nil)
+	mockOvnClient.EXPECT().GetPortGroup("node.pg", true).Return(&ovnnb.PortGroup{ExternalIDs: map[string]string{"node": "node1"}}, nil)
+	mockOvnClient.EXPECT().GetPortGroup("anp.pg", true).Return(&ovnnb.PortGroup{ExternalIDs: map[string]string{adminNetworkPolicyKey: "anp1"}}, nil)
+	mockOvnClient.EXPECT().GetPortGroup("subnet1", true).Return(nil, nil)
+	mockOvnClient.EXPECT().GetPortGroup("unknown", true).Return(nil, nil)
+
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "dns", Namespace: "kube-system"}}
+	tests := []struct {
+		name   string
+		entity *ovs.NbEntity
+		svc    *corev1.Service
+		object *TraceObject
+	}{
+		{
+			name:   "network policy acl",
+			entity: &ovs.NbEntity{Table: ovnnb.ACLTable, Parent: "np.pg"},
+			object: &TraceObject{Kind: kindNetworkPolicy, Namespace: "default", Name: "deny-all"},
+		},
+		{
+			name:   "security group acl",
+			entity: &ovs.NbEntity{Table: ovnnb.ACLTable, Parent: "sg.pg"},
+			object: &TraceObject{Kind: util.KindSecurityGroup, Name: "sg1"},
+		},
+		{
+			name:   "node acl",
+			entity: &ovs.NbEntity{Table: ovnnb.ACLTable, Parent: "node.pg"},
+			object: &TraceObject{Kind: util.KindNode, Name: "node1"},
+		},
+		{
+			name:   "admin network policy acl",
+			entity: &ovs.NbEntity{Table: ovnnb.ACLTable, Parent: "anp.pg"},
+			object: &TraceObject{Kind: kindAdminNetworkPolicy, Name: "anp1"},
+		},
+		{
+			name:   "subnet acl",
+			entity: &ovs.NbEntity{Table: ovnnb.ACLTable, Parent: "subnet1"},
+			object: &TraceObject{Kind: util.KindSubnet, Name: "subnet1"},
+		},
+		{
+			name:   "unknown acl",
+			entity: &ovs.NbEntity{Table: ovnnb.ACLTable, Parent: "unknown"},
+		},
+		{
+			name:   "logical switch port",
+			entity: &ovs.NbEntity{Table: ovnnb.LogicalSwitchPortTable, ExternalIDs: map[string]string{"pod": "default/client"}},
+			object: &TraceObject{Kind: util.KindPod, Namespace: "default", Name: "client"},
+		},
+		{
+			name:   "load balancer of service",
+			entity: &ovs.NbEntity{Table: ovnnb.LoadBalancerTable, Name: "cluster-udp-loadbalancer"},
+			svc:    svc,
+			object: &TraceObject{Kind: util.KindService, Namespace: "kube-system", Name: "dns"},
+		},
+		{
+			name:   "static route",
+			entity: &ovs.NbEntity{Table: ovnnb.LogicalRouterStaticRouteTable, Parent: "vpc1"},
+			object: &TraceObject{Kind: util.KindVpc, Name: "vpc1"},
+		},
+		{
+			name:   "nat of unknown router",
+			entity: &ovs.NbEntity{Table: ovnnb.NATTable, Parent: "lr1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.object, ctrl.traceEntityObject(tt.entity, tt.svc))
+		})
+	}
+}
+
+func TestTraceHandlerWithoutLeader(t *testing.T) {
+	if activeController.Load() != nil {
+		t.Skip("active controller has been set")
+	}
+
+	w := httptest.NewRecorder()
+	TraceHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/trace?src=default/client&dst=default/server", nil))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	w = httptest.NewRecorder()
+	TraceHandler().ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/trace", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
+
+func TestTraceHandlerInvalidRequest(t *testing.T) {
+	for _, query := range []url.Values{
+		{"src": {"default/client"}, "ip": {"10.0.0.1"}, "port": {"70000"}},
+		{"src": {"default/client"}, "ip": {"10.0.0.1"}, "mac": {"00:00:00:00:00:01 || 1"}},
+		{"src": {"default/client"}, "ip": {"10.0.0.1 || 1"}},
+	} {
+		w := httptest.NewRecorder()
+		TraceHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/trace?"+query.Encode(), nil))
+		require.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+}
@@ -471,34 +471,12 @@ func (c *Controller) handleAddOrUpdateVpc(key string) error {
 			}
 		}
 	} else {
-		subnets, err := c.subnetsLister.List(labels.Everything())
+		subnetRoutes, err := c.getCustomVpcSubnetStaticRoutes(key)
 		if err != nil {
 			klog.Error(err)
 			return err
 		}
-		// Add static routes created by addCustomVPCStaticRouteForSubnet
-		for _, subnet := range subnets {
-			if subnet.Spec.Vpc == key {
-				v4Gw, v6Gw := util.SplitStringIP(subnet.Spec.Gateway)
-				v4Cidr, v6Cidr := util.SplitStringIP(subnet.Spec.CIDRBlock)
-				if v4Gw != "" && v4Cidr != "" {
-					staticTargetRoutes = append(staticTargetRoutes, &kubeovnv1.StaticRoute{
-						Policy:     kubeovnv1.PolicySrc,
-						CIDR:       v4Cidr,
-						NextHopIP:  v4Gw,
-						RouteTable: subnet.Spec.RouteTable,
-					})
-				}
-				if v6Gw != "" && v6Cidr != "" {
-					staticTargetRoutes = append(staticTargetRoutes, &kubeovnv1.StaticRoute{
-						Policy:     kubeovnv1.PolicySrc,
-						CIDR:       v6Cidr,
-						NextHopIP:  v6Gw,
-						RouteTable: subnet.Spec.RouteTable,
-					})
-				}
-			}
-		}
+		staticTargetRoutes = append(staticTargetRoutes, subnetRoutes...)
 	}
 
 	routeNeedDel, routeNeedAdd := diffStaticRoute(staticExistedRoutes, staticTargetRoutes)
@@ -1130,6 +1108,41 @@ func diffStaticRoute(exist []*ovnnb.LogicalRouterStaticRoute, target []*kubeovnv
 	return routeNeedDel, routeNeedAdd
 }
 
+// getCustomVpcSubnetStaticRoutes returns the static routes created by addCustomVPCStaticRouteForSubnet
+func (c *Controller) getCustomVpcSubnetStaticRoutes(vpcName string) ([]*kubeovnv1.StaticRoute, error) {
+	subnets, err := c.subnetsLister.List(labels.Everything())
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+
+	var routes []*kubeovnv1.StaticRoute
+	for _, subnet := range subnets {
+		if subnet.Spec.Vpc != vpcName {
+			continue
+		}
+		v4Gw, v6Gw := util.SplitStringIP(subnet.Spec.Gateway)
+		v4Cidr, v6Cidr := util.SplitStringIP(subnet.Spec.CIDRBlock)
+		if v4Gw != "" && v4Cidr != "" {
+			routes = append(routes, &kubeovnv1.StaticRoute{
+				Policy:     kubeovnv1.PolicySrc,
+				CIDR:       v4Cidr,
+				NextHopIP:  v4Gw,
+				RouteTable: subnet.Spec.RouteTable,
+			})
+		}
+		if v6Gw != "" && v6Cidr != "" {
+			routes = append(routes, &kubeovnv1.StaticRoute{
+				Policy:     kubeovnv1.PolicySrc,
+				CIDR:       v6Cidr,
+				NextHopIP:  v6Gw,
+				RouteTable: subnet.Spec.RouteTable,
+			})
+		}
+	}
+	return routes, nil
+}
+
 func getStaticRouteItemKey(item *kubeovnv1.StaticRoute) string {
 	var key string
 	if item.Policy == kubeovnv1.PolicyDst {
@@ -233,11 +233,13 @@ type vpcEgressGatewayReconcileContext struct {
 }
 
 type vpcEgressGatewayWorkloadState struct {
-	ready           bool
-	ipv4Src         set.Set[string]
-	ipv6Src         set.Set[string]
-	nodeNexthopIPv4 map[string]set.Set[string]
-	nodeNexthopIPv6 map[string]set.Set[string]
+	ready                 bool
+	ipv4Src               set.Set[string]
+	ipv6Src               set.Set[string]
+	nodeNexthopIPv4       map[string]set.Set[string]
+	nodeNexthopIPv6       map[string]set.Set[string]
+	pods                  []*corev1.Pod
+	attachmentNetworkName string
 }
 
 func (c *Controller) prepareVpcEgressGateway(gw *kubeovnv1.VpcEgressGateway) (*vpcEgressGatewayReconcileContext, error) {
@@ -345,11 +347,13 @@ func (c *Controller) reconcileVpcEgressGatewayWorkloadState(ctx *vpcEgressGatewa
 	ctx.gateway = updatedGateway
 
 	return &vpcEgressGatewayWorkloadState{
-		ready:           deploymentReady && workloadReady,
-		ipv4Src:         ipv4Src,
-		ipv6Src:         ipv6Src,
-		nodeNexthopIPv4: nodeNexthopIPv4,
-		nodeNexthopIPv6: nodeNexthopIPv6,
+		ready:                 deploymentReady && workloadReady,
+		ipv4Src:               ipv4Src,
+		ipv6Src:               ipv6Src,
+		nodeNexthopIPv4:       nodeNexthopIPv4,
+		nodeNexthopIPv6:       nodeNexthopIPv6,
+		pods:                  pods,
+		attachmentNetworkName: attachmentNetworkName,
 	}, nil
 }
 
@@ -372,6 +376,14 @@ func (c *Controller) reconcileVpcEgressGatewayRoutes(ctx *vpcEgressGatewayReconc
 			return c.failVpcEgressGatewayReconcile(ctx.gateway, "ReconcileOVNRoutesFailed", err)
 		}
 	}
+	if err := c.reconcileVpcEgressGatewayPinnedRoutes(ctx, state); err != nil {
+		klog.Error(err)
+		err = fmt.Errorf("failed to reconcile pinned OVN routes: %w", err)
+		return c.failVpcEgressGatewayReconcile(ctx.gateway, "ReconcileOVNRoutesFailed", err)
+	}
+	if err := c.cleanVpcEgressGatewayDestinationAddressSets(cache.MetaObjectToName(ctx.gateway).String(), ctx.gateway.Spec.Policies); err != nil {
+		return c.failVpcEgressGatewayReconcile(ctx.gateway, "ReconcileOVNRoutesFailed", err)
+	}
 	return nil
 }
 
@@ -420,6 +432,10 @@ func (c *Controller) handleAddOrUpdateVpcEgressGateway(key string) error {
 		return err
 	}
 
+	if err = c.reconcileVpcEgressGatewayDNSNameResolvers(ns, name, vpcEgressGatewayDestinationFQDNs(ctx.gateway)); err != nil {
+		return c.failVpcEgressGatewayReconcile(ctx.gateway, "ReconcileDNSNameResolversFailed", err)
+	}
+
 	state, err := c.reconcileVpcEgressGatewayWorkloadState(ctx)
 	if err != nil {
 		return err
@@ -539,45 +555,29 @@ func (c *Controller) reconcileVpcEgressGatewayWorkload(gw *kubeovnv1.VpcEgressGa
 	// collect egress policies
 	ipv4ForwardSrc, ipv6ForwardSrc := set.New[string](), set.New[string]()
 	ipv4SNATSrc, ipv6SNATSrc := set.New[string](), set.New[string]()
+	// sources of policies without destinations are matched by the gateway address sets
+	ipv4Src, ipv6Src := set.New[string](), set.New[string]()
 	for _, policy := range gw.Spec.Policies {
-		ipv4, ipv6 := util.SplitIpsByProtocol(policy.IPBlocks)
+		ipv4, ipv6, err := c.vpcEgressGatewayPolicySources(&policy)
+		if err != nil {
+			return attachmentNetworkName, nil, nil, nil, err
+		}
 		if policy.SNAT {
-			ipv4SNATSrc = ipv4SNATSrc.Insert(ipv4...)
-			ipv6SNATSrc = ipv6SNATSrc.Insert(ipv6...)
+			ipv4SNATSrc.Insert(ipv4.UnsortedList()...)
+			ipv6SNATSrc.Insert(ipv6.UnsortedList()...)
 		} else {
-			ipv4ForwardSrc = ipv4ForwardSrc.Insert(ipv4...)
-			ipv6ForwardSrc = ipv6ForwardSrc.Insert(ipv6...)
+			ipv4ForwardSrc.Insert(ipv4.UnsortedList()...)
+			ipv6ForwardSrc.Insert(ipv6.UnsortedList()...)
 		}
-		for _, subnetName := range policy.Subnets {
-			subnet, err := c.subnetsLister.Get(subnetName)
-			if err != nil {
-				klog.Error(err)
-				return attachmentNetworkName, nil, nil, nil, err
-			}
-			if subnet.Status.IsNotValidated() {
-				err = fmt.Errorf("subnet %s is not validated", subnet.Name)
-				klog.Error(err)
-				return attachmentNetworkName, nil, nil, nil, err
-			}
-			// TODO: check subnet's vpc and vlan
-			ipv4, ipv6 := util.SplitStringIP(subnet.Spec.CIDRBlock)
-			if policy.SNAT {
-				ipv4SNATSrc.Insert(ipv4)
-				ipv6SNATSrc.Insert(ipv6)
-			} else {
-				ipv4ForwardSrc.Insert(ipv4)
-				ipv6ForwardSrc.Insert(ipv6)
-			}
+		if !policy.HasDestinations() {
+			ipv4Src.Insert(ipv4.UnsortedList()...)
+			ipv6Src.Insert(ipv6.UnsortedList()...)
 		}
 	}
 
 	// calculate internal route destinations and forward source CIDR blocks
-	ipv4ForwardSrc.Delete("")
-	ipv6ForwardSrc.Delete("")
-	ipv4SNATSrc.Delete("")
-	ipv6SNATSrc.Delete("")
-	ipv4Src := ipv4ForwardSrc.Union(ipv4SNATSrc)
-	ipv6Src := ipv6ForwardSrc.Union(ipv6SNATSrc)
+	ipv4AllSrc := ipv4ForwardSrc.Union(ipv4SNATSrc)
+	ipv6AllSrc := ipv6ForwardSrc.Union(ipv6SNATSrc)
 
 	// filter out ip blocks within the internal subnet CIDR(s) to avoid route(s) configuration failure
 	fnFilter := func(internalCIDR string, ipBlocks set.Set[string]) set.Set[string] {
@@ -593,8 +593,8 @@ func (c *Controller) reconcileVpcEgressGatewayWorkload(gw *kubeovnv1.VpcEgressGa
 		}
 		return ret
 	}
-	intRouteDstIPv4 := fnFilter(internalCIDRv4, ipv4Src)
-	intRouteDstIPv6 := fnFilter(internalCIDRv6, ipv6Src)
+	intRouteDstIPv4 := fnFilter(internalCIDRv4, ipv4AllSrc)
+	intRouteDstIPv6 := fnFilter(internalCIDRv6, ipv6AllSrc)
 
 	// generate route annotations used to configure routes in the pod
 	routes := util.NewPodRoutes()
@@ -889,15 +889,43 @@ func setVpcEgressGatewayBandwidthAnnotations(annotations map[string]string, band
 	return nil
 }
 
-func vpcEgressGatewayPolicyMatches(af int, pgName, asName string, includePortGroup bool) set.Set[string] {
+// vpcEgressGatewayPolicySources returns the IPv4/IPv6 source CIDRs of the egress policy
+func (c *Controller) vpcEgressGatewayPolicySources(policy *kubeovnv1.VpcEgressGatewayPolicy) (set.Set[string], set.Set[string], error) {
+	ipv4, ipv6 := util.SplitIpsByProtocol(policy.IPBlocks)
+	ipv4Src, ipv6Src := set.New(ipv4...), set.New(ipv6...)
+	for _, subnetName := range policy.Subnets {
+		subnet, err := c.subnetsLister.Get(subnetName)
+		if err != nil {
+			klog.Error(err)
+			return nil, nil, err
+		}
+		if subnet.Status.IsNotValidated() {
+			err = fmt.Errorf("subnet %s is not validated", subnet.Name)
+			klog.Error(err)
+			return nil, nil, err
+		}
+		// TODO: check subnet's vpc and vlan
+		ipv4, ipv6 := util.SplitStringIP(subnet.Spec.CIDRBlock)
+		ipv4Src.Insert(ipv4)
+		ipv6Src.Insert(ipv6)
+	}
+	ipv4Src.Delete("")
+	ipv6Src.Delete("")
+	return ipv4Src, ipv6Src, nil
+}
+
+// vpcEgressGatewayPolicyMatches returns the LR policy matches of the gateway,
+// destinationMatches are the matches of the policies with destinations.
+func vpcEgressGatewayPolicyMatches(af int, pgName, asName string, includePortGroup bool, destinationMatches ...string) set.Set[string] {
 	matches := set.New[string](fmt.Sprintf("ip%d.src == $%s", af, asName))
 	if includePortGroup {
 		matches.Insert(fmt.Sprintf("ip%d.src == $%s_ip%d", af, pgName, af))
 	}
+	matches.Insert(destinationMatches...)
 	return matches
 }
 
-func vpcEgressGatewayLocalPolicyMatches(af int, localPgName, pgName, asName string, includePortGroup bool) set.Set[string] {
+func vpcEgressGatewayLocalPolicyMatches(af int, localPgName, pgName, asName string, includePortGroup bool, destinationMatches ...string) set.Set[string] {
 	matches := set.New[string](fmt.Sprintf(
 		"ip%d.src == $%s_ip%d && ip%d.src == $%s", af, localPgName, af, af, asName,
 	))
@@ -906,42 +934,37 @@ func vpcEgressGatewayLocalPolicyMatches(af int, localPgName, pgName, asName stri
 			"ip%d.src == $%s_ip%d && ip%d.src == $%s_ip%d", af, localPgName, af, af, pgName, af,
 		))
 	}
+	for _, match := range destinationMatches {
+		matches.Insert(fmt.Sprintf("ip%d.src == $%s_ip%d && %s", af, localPgName, af, match))
+	}
 	return matches
 }
 
-func (c *Controller) reconcileVpcEgressGatewayOVNRoutes(gw *kubeovnv1.VpcEgressGateway, af int, lrName, lrpName, bfdIP string, nodeNexthops map[string]set.Set[string], sources set.Set[string]) error {
-	nextHops := flattenVpcEgressGatewayNexthops(nodeNexthops)
-
-	externalIDs := map[string]string{
-		ovs.ExternalIDVendor:           util.CniTypeName,
-		ovs.ExternalIDVpcEgressGateway: fmt.Sprintf("%s/%s", gw.Namespace, gw.Name),
-		"af":                           strconv.Itoa(af),
-	}
-
-	// reconcile OVN port group
+// listVpcEgressGatewaySelectedPods returns the alive pods in the gateway VPC matched by the gateway selectors.
+func (c *Controller) listVpcEgressGatewaySelectedPods(gw *kubeovnv1.VpcEgressGateway) ([]*corev1.Pod, error) {
 	var err error
-	ports := set.New[string]()
+	var result []*corev1.Pod
 	for _, selector := range gw.Spec.Selectors {
 		sel := labels.Everything()
 		if selector.NamespaceSelector != nil {
 			if sel, err = metav1.LabelSelectorAsSelector(selector.NamespaceSelector); err != nil {
 				err = fmt.Errorf("failed to create label selector for namespace selector %#v: %w", selector.NamespaceSelector, err)
 				klog.Error(err)
-				return err
+				return nil, err
 			}
 		}
 		namespaces, err := c.namespacesLister.List(sel)
 		if err != nil {
 			err = fmt.Errorf("failed to list namespaces with selector %s: %w", sel, err)
 			klog.Error(err)
-			return err
+			return nil, err
 		}
 		sel = labels.Everything()
 		if selector.PodSelector != nil {
 			if sel, err = metav1.LabelSelectorAsSelector(selector.PodSelector); err != nil {
 				err = fmt.Errorf("failed to create label selector for pod selector %#v: %w", selector.PodSelector, err)
 				klog.Error(err)
-				return err
+				return nil, err
 			}
 		}
 		for _, ns := range namespaces {
@@ -949,7 +972,7 @@ func (c *Controller) reconcileVpcEgressGatewayOVNRoutes(gw *kubeovnv1.VpcEgressG
 			if err != nil {
 				err = fmt.Errorf("failed to list pods with selector %s in namespace %s: %w", sel, ns.Name, err)
 				klog.Error(err)
-				return err
+				return nil, err
 			}
 			for _, pod := range pods {
 				if pod.Spec.HostNetwork ||
@@ -958,11 +981,32 @@ func (c *Controller) reconcileVpcEgressGatewayOVNRoutes(gw *kubeovnv1.VpcEgressG
 					!isPodAlive(pod) {
 					continue
 				}
-				podName := c.getNameByPod(pod)
-				ports.Insert(ovs.PodNameToPortName(podName, pod.Namespace, util.OvnProvider))
+				result = append(result, pod)
 			}
 		}
 	}
+	return result, nil
+}
+
+func (c *Controller) reconcileVpcEgressGatewayOVNRoutes(gw *kubeovnv1.VpcEgressGateway, af int, lrName, lrpName, bfdIP string, nodeNexthops map[string]set.Set[string], sources set.Set[string]) error {
+	nextHops := flattenVpcEgressGatewayNexthops(nodeNexthops)
+
+	externalIDs := map[string]string{
+		ovs.ExternalIDVendor:           util.CniTypeName,
+		ovs.ExternalIDVpcEgressGateway: fmt.Sprintf("%s/%s", gw.Namespace, gw.Name),
+		"af":                           strconv.Itoa(af),
+	}
+
+	// reconcile OVN port group
+	pods, err := c.listVpcEgressGatewaySelectedPods(gw)
+	if err != nil {
+		return err
+	}
+	ports := set.New[string]()
+	for _, pod := range pods {
+		podName := c.getNameByPod(pod)
+		ports.Insert(ovs.PodNameToPortName(podName, pod.Namespace, util.OvnProvider))
+	}
 	key := cache.MetaObjectToName(gw).String()
 	pgName := vegPortGroupName(key)
 	// Keep both policy forms for compatibility with existing policy observers. For
@@ -1017,6 +1061,16 @@ func (c *Controller) reconcileVpcEgressGatewayOVNRoutes(gw *kubeovnv1.VpcEgressG
 		return err
 	}
 
+	// policies with destinations are matched by their own sources and destinations
+	destinationPolicies, err := c.vpcEgressGatewayDestinationPolicies(gw, af)
+	if err != nil {
+		return err
+	}
+	if err = c.reconcileVpcEgressGatewayDestinationAddressSets(destinationPolicies, externalIDs); err != nil {
+		return err
+	}
+	destinationMatches := vegDestinationPolicyMatches(af, destinationPolicies)
+
 	// reconcile OVN BFD entries
 	bfdIDs, bfdMap, staleBFDIDs, err := reconcileGatewayBFD(
 		c.OVNNbClient,
@@ -1051,7 +1105,7 @@ func (c *Controller) reconcileVpcEgressGatewayOVNRoutes(gw *kubeovnv1.VpcEgressG
 				return err
 			}
 			localPgName := strings.ReplaceAll(portName, "-", ".")
-			for _, match := range vpcEgressGatewayLocalPolicyMatches(af, localPgName, pgName, asName, includePortGroup).UnsortedList() {
+			for _, match := range vpcEgressGatewayLocalPolicyMatches(af, localPgName, pgName, asName, includePortGroup, destinationMatches...).UnsortedList() {
 				rules[match] = nodeNextHops
 			}
 		}
@@ -1102,7 +1156,7 @@ func (c *Controller) reconcileVpcEgressGatewayOVNRoutes(gw *kubeovnv1.VpcEgressG
 	}
 	matches := set.New[string]()
 	if nextHops.Len() != 0 {
-		matches = vpcEgressGatewayPolicyMatches(af, pgName, asName, includePortGroup)
+		matches = vpcEgressGatewayPolicyMatches(af, pgName, asName, includePortGroup, destinationMatches...)
 	}
 	for _, policy := range policies {
 		if matches.Has(policy.Match) {
@@ -1136,7 +1190,7 @@ func (c *Controller) reconcileVpcEgressGatewayOVNRoutes(gw *kubeovnv1.VpcEgressG
 			klog.Error(err)
 			return err
 		}
-		matches = vpcEgressGatewayPolicyMatches(af, pgName, asName, includePortGroup)
+		matches = vpcEgressGatewayPolicyMatches(af, pgName, asName, includePortGroup, destinationMatches...)
 		for _, policy := range policies {
 			if matches.Has(policy.Match) {
 				matches.Delete(policy.Match)
@@ -1461,6 +1515,9 @@ func (c *Controller) handleDelVpcEgressGateway(key string) error {
 		klog.Error(err)
 		return c.recordVpcEgressGatewayError(cachedGateway, "DeleteFailed", err)
 	}
+	if err = c.reconcileVpcEgressGatewayDNSNameResolvers(ns, name, nil); err != nil {
+		return c.recordVpcEgressGatewayError(cachedGateway, "DeleteFailed", err)
+	}
 
 	gw := cachedGateway.DeepCopy()
 	if controllerutil.RemoveFinalizer(gw, util.KubeOVNControllerFinalizer) {
@@ -1515,6 +1572,9 @@ func (c *Controller) cleanOVNForVpcEgressGateway(key, lrName string) error {
 			return err
 		}
 	}
+	if err = c.cleanVpcEgressGatewayDestinationAddressSets(key, nil); err != nil {
+		return err
+	}
 
 	return nil
 }
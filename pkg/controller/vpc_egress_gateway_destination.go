@@ -0,0 +1,197 @@
+package controller
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/set"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/ovs"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+// vegDestinationExternalID marks the address sets holding the destinations of the gateway policies
+const vegDestinationExternalID = "destination"
+
+// vegDestinationPolicy is an egress policy restricted to destination CIDRs/FQDNs.
+// The destinations are matched by an address set, so that the LR policies are kept when the FQDNs are resolved again.
+type vegDestinationPolicy struct {
+	sources      set.Set[string]
+	addressSet   string
+	destinations set.Set[string]
+}
+
+func vegMatchSet(values set.Set[string]) string {
+	if values.Len() == 1 {
+		return values.UnsortedList()[0]
+	}
+	return "{" + strings.Join(values.SortedList(), ", ") + "}"
+}
+
+func (p vegDestinationPolicy) match(af int, source string) string {
+	if source == "" {
+		source = vegMatchSet(p.sources)
+	}
+	return fmt.Sprintf("ip%d.src == %s && ip%d.dst == $%s", af, source, af, p.addressSet)
+}
+
+func vegDestinationPolicyMatches(af int, policies []vegDestinationPolicy) []string {
+	matches := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		matches = append(matches, policy.match(af, ""))
+	}
+	return matches
+}
+
+// vegDestinationAddressSetName returns the name of the address set holding the destinations of the policy.
+// The name only depends on the destination CIDRs/FQDNs, not on the addresses the FQDNs are resolved to.
+func vegDestinationAddressSetName(key string, policy *kubeovnv1.VpcEgressGatewayPolicy, af int) string {
+	destinations := slices.Concat(policy.Destinations, policy.DestinationFQDNs)
+	slices.Sort(destinations)
+	hash := util.Sha256Hash([]byte(key))
+	return fmt.Sprintf("VEG.%s.dst.%s.ipv%d", hash[:12], util.Sha256Hash([]byte(strings.Join(destinations, ",")))[:8], af)
+}
+
+// vpcEgressGatewayDestinationPolicies returns the policies with destinations of the address family.
+// Policies with FQDNs are returned before the FQDNs are resolved, their address sets are filled once the names resolve.
+func (c *Controller) vpcEgressGatewayDestinationPolicies(gw *kubeovnv1.VpcEgressGateway, af int) ([]vegDestinationPolicy, error) {
+	key := cache.MetaObjectToName(gw).String()
+	var policies []vegDestinationPolicy
+	for _, policy := range gw.Spec.Policies {
+		if !policy.HasDestinations() {
+			continue
+		}
+		if len(policy.DestinationFQDNs) != 0 && !c.config.EnableDNSNameResolver {
+			err := fmt.Errorf("DNSNameResolver is disabled but destination FQDNs are specified: %v", policy.DestinationFQDNs)
+			klog.Error(err)
+			return nil, err
+		}
+
+		ipv4Src, ipv6Src, err := c.vpcEgressGatewayPolicySources(&policy)
+		if err != nil {
+			return nil, err
+		}
+		ipv4Dst, ipv6Dst := util.SplitIpsByProtocol(policy.Destinations)
+		for _, domainName := range policy.DestinationFQDNs {
+			v4Addresses, v6Addresses := c.resolveDomainName(domainName)
+			ipv4Dst = append(ipv4Dst, v4Addresses...)
+			ipv6Dst = append(ipv6Dst, v6Addresses...)
+		}
+
+		p := vegDestinationPolicy{sources: ipv4Src, destinations: set.New(ipv4Dst...)}
+		if af == 6 {
+			p = vegDestinationPolicy{sources: ipv6Src, destinations: set.New(ipv6Dst...)}
+		}
+		if p.sources.Len() == 0 || (p.destinations.Len() == 0 && len(policy.DestinationFQDNs) == 0) {
+			continue
+		}
+		p.addressSet = vegDestinationAddressSetName(key, &policy, af)
+		policies = append(policies, p)
+	}
+	return policies, nil
+}
+
+// reconcileVpcEgressGatewayDestinationAddressSets fills the address sets of the policies with their destinations.
+// DNS answer changes only update the address sets, the LR policies matching them are not replaced.
+func (c *Controller) reconcileVpcEgressGatewayDestinationAddressSets(policies []vegDestinationPolicy, externalIDs map[string]string) error {
+	asExternalIDs := maps.Clone(externalIDs)
+	asExternalIDs[vegDestinationExternalID] = "true"
+	for _, policy := range policies {
+		if err := c.OVNNbClient.CreateAddressSet(policy.addressSet, asExternalIDs); err != nil {
+			err = fmt.Errorf("failed to create address set %s: %w", policy.addressSet, err)
+			klog.Error(err)
+			return err
+		}
+		if err := c.OVNNbClient.AddressSetUpdateAddress(policy.addressSet, policy.destinations.SortedList()...); err != nil {
+			err = fmt.Errorf("failed to update address set %s: %w", policy.addressSet, err)
+			klog.Error(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// cleanVpcEgressGatewayDestinationAddressSets deletes the destination address sets of the gateway not used by the policies.
+// It runs after the LR policies are reconciled, so that no policy references a deleted address set.
+func (c *Controller) cleanVpcEgressGatewayDestinationAddressSets(key string, policies []kubeovnv1.VpcEgressGatewayPolicy) error {
+	expected := set.New[string]()
+	for _, policy := range policies {
+		if policy.HasDestinations() {
+			expected.Insert(vegDestinationAddressSetName(key, &policy, 4), vegDestinationAddressSetName(key, &policy, 6))
+		}
+	}
+	ass, err := c.OVNNbClient.ListAddressSets(map[string]string{
+		ovs.ExternalIDVendor:           util.CniTypeName,
+		ovs.ExternalIDVpcEgressGateway: key,
+		vegDestinationExternalID:       "",
+	})
+	if err != nil {
+		err = fmt.Errorf("failed to list destination address sets of vpc-egress-gateway %s: %w", key, err)
+		klog.Error(err)
+		return err
+	}
+	var stale []string
+	for _, as := range ass {
+		if !expected.Has(as.Name) {
+			stale = append(stale, as.Name)
+		}
+	}
+	if len(stale) != 0 {
+		klog.Infof("delete stale destination address sets %v of vpc-egress-gateway %s", stale, key)
+		if err = c.OVNNbClient.DeleteAddressSet(stale...); err != nil {
+			err = fmt.Errorf("failed to delete address sets %v of vpc-egress-gateway %s: %w", stale, key, err)
+			klog.Error(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func vpcEgressGatewayDestinationFQDNs(gw *kubeovnv1.VpcEgressGateway) []string {
+	fqdns := set.New[string]()
+	for _, policy := range gw.Spec.Policies {
+		fqdns.Insert(policy.DestinationFQDNs...)
+	}
+	return fqdns.SortedList()
+}
+
+// vegDNSNameResolverOwner returns the label value of the DNSNameResolvers created for the gateway
+func vegDNSNameResolverOwner(namespace, name string) string {
+	return util.NormalizeLabelValue(fmt.Sprintf("%s.%s", namespace, name))
+}
+
+// reconcileVpcEgressGatewayDNSNameResolvers ensures DNSNameResolvers exist only for the destination FQDNs of the gateway
+func (c *Controller) reconcileVpcEgressGatewayDNSNameResolvers(namespace, name string, fqdns []string) error {
+	if !c.config.EnableDNSNameResolver {
+		return nil
+	}
+	if err := c.reconcileDNSNameResolversForNP(vegDNSNameResolverOwner(namespace, name), fqdns, vpcEgressGatewayKey); err != nil {
+		err = fmt.Errorf("failed to reconcile DNSNameResolvers for vpc-egress-gateway %s/%s: %w", namespace, name, err)
+		klog.Error(err)
+		return err
+	}
+	return nil
+}
+
+// enqueueVpcEgressGatewaysForDNSNameResolver enqueues the gateways owning DNSNameResolvers with the label value
+func (c *Controller) enqueueVpcEgressGatewaysForDNSNameResolver(owner string) error {
+	gateways, err := c.vpcEgressGatewayLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list vpc egress gateways: %v", err)
+		return err
+	}
+	for _, gw := range gateways {
+		if vegDNSNameResolverOwner(gw.Namespace, gw.Name) == owner {
+			key := cache.MetaObjectToName(gw).String()
+			klog.V(3).Infof("enqueue update vpc-egress-gateway %s for DNSNameResolver change", key)
+			c.addOrUpdateVpcEgressGatewayQueue.Add(key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/set"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/ovs"
+	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnnb"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+func TestVpcEgressGatewayPolicyMatchesWithDestinations(t *testing.T) {
+	destinationMatches := vegDestinationPolicyMatches(4, []vegDestinationPolicy{{
+		sources:      set.New("10.0.1.0/24", "10.0.2.0/24"),
+		addressSet:   "VEG.example.dst.0123abcd.ipv4",
+		destinations: set.New("203.0.113.10", "198.51.100.0/24"),
+	}})
+	require.Equal(t, []string{"ip4.src == {10.0.1.0/24, 10.0.2.0/24} && ip4.dst == $VEG.example.dst.0123abcd.ipv4"}, destinationMatches)
+
+	got := vpcEgressGatewayPolicyMatches(4, "VEG.example", "VEG.example.ipv4", false, destinationMatches...)
+	require.Equal(t, []string{
+		"ip4.src == $VEG.example.ipv4",
+		"ip4.src == {10.0.1.0/24, 10.0.2.0/24} && ip4.dst == $VEG.example.dst.0123abcd.ipv4",
+	}, got.SortedList())
+
+	got = vpcEgressGatewayLocalPolicyMatches(4, "node.example", "VEG.example", "VEG.example.ipv4", false, destinationMatches...)
+	require.Equal(t, []string{
+		"ip4.src == $node.example_ip4 && ip4.src == $VEG.example.ipv4",
+		"ip4.src == $node.example_ip4 && ip4.src == {10.0.1.0/24, 10.0.2.0/24} && ip4.dst == $VEG.example.dst.0123abcd.ipv4",
+	}, got.SortedList())
+}
+
+func TestVpcEgressGatewayDestinationPolicies(t *testing.T) {
+	fakeController := newFakeController(t)
+	controller := fakeController.fakeController
+	gw := &kubeovnv1.VpcEgressGateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "veg", Namespace: "default"},
+		Spec: kubeovnv1.VpcEgressGatewaySpec{
+			Policies: []kubeovnv1.VpcEgressGatewayPolicy{
+				{IPBlocks: []string{"10.0.0.0/24"}},
+				{IPBlocks: []string{"10.0.1.0/24", "fd00:1::/64"}, Destinations: []string{"198.51.100.0/24"}},
+				{IPBlocks: []string{"10.0.2.0/24"}, DestinationFQDNs: []string{"api.example.com"}},
+			},
+		},
+	}
+
+	_, err := controller.vpcEgressGatewayDestinationPolicies(gw, 4)
+	require.ErrorContains(t, err, "DNSNameResolver is disabled")
+
+	controller.config.EnableDNSNameResolver = true
+	controller.dnsNameResolverIndexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{
+		IndexDNSNameResolverByName: indexDNSNameResolverByName,
+	})
+	cidrAsName := vegDestinationAddressSetName("default/veg", &gw.Spec.Policies[1], 4)
+	fqdnAsName := vegDestinationAddressSetName("default/veg", &gw.Spec.Policies[2], 4)
+	matches := []string{
+		"ip4.src == 10.0.1.0/24 && ip4.dst == $" + cidrAsName,
+		"ip4.src == 10.0.2.0/24 && ip4.dst == $" + fqdnAsName,
+	}
+	// the policy of an unresolved FQDN is kept with an empty address set
+	policies, err := controller.vpcEgressGatewayDestinationPolicies(gw, 4)
+	require.NoError(t, err)
+	require.Equal(t, matches, vegDestinationPolicyMatches(4, policies))
+	require.Equal(t, []string{"198.51.100.0/24"}, policies[0].destinations.SortedList())
+	require.Empty(t, policies[1].destinations)
+
+	require.NoError(t, controller.dnsNameResolverIndexer.Add(&kubeovnv1.DNSNameResolver{
+		ObjectMeta: metav1.ObjectMeta{Name: "veg-default.veg-0123abcd"},
+		Spec:       kubeovnv1.DNSNameResolverSpec{Name: "api.example.com"},
+		Status: kubeovnv1.DNSNameResolverStatus{ResolvedNames: []kubeovnv1.DNSNameResolverResolvedName{{
+			DNSName:           "api.example.com",
+			ResolvedAddresses: []kubeovnv1.DNSNameResolverResolvedAddress{{IP: "203.0.113.10"}, {IP: "2001:db8::10"}},
+		}}},
+	}))
+	// resolving the FQDN only changes the address set contents
+	policies, err = controller.vpcEgressGatewayDestinationPolicies(gw, 4)
+	require.NoError(t, err)
+	require.Equal(t, matches, vegDestinationPolicyMatches(4, policies))
+	require.Equal(t, []string{"203.0.113.10"}, policies[1].destinations.SortedList())
+
+	// the IPv6 destination of the FQDN has no IPv6 source in the same policy
+	policies, err = controller.vpcEgressGatewayDestinationPolicies(gw, 6)
+	require.NoError(t, err)
+	require.Empty(t, policies)
+
+	require.Equal(t, []string{"api.example.com"}, vpcEgressGatewayDestinationFQDNs(gw))
+}
+
+func TestReconcileVpcEgressGatewayDestinationAddressSets(t *testing.T) {
+	fakeController := newFakeController(t)
+	controller := fakeController.fakeController
+	mockOvnClient := fakeController.mockOvnClient
+	externalIDs := map[string]string{
+		ovs.ExternalIDVendor:           util.CniTypeName,
+		ovs.ExternalIDVpcEgressGateway: "default/veg",
+		"af":                           "4",
+	}
+	asExternalIDs := maps.Clone(externalIDs)
+	asExternalIDs[vegDestinationExternalID] = "true"
+	policy := kubeovnv1.VpcEgressGatewayPolicy{IPBlocks: []string{"10.0.2.0/24"}, DestinationFQDNs: []string{"api.example.com"}}
+	asName := vegDestinationAddressSetName("default/veg", &policy, 4)
+
+	mockOvnClient.EXPECT().CreateAddressSet(asName, asExternalIDs).Return(nil)
+	mockOvnClient.EXPECT().AddressSetUpdateAddress(asName, "198.51.100.10", "203.0.113.10").Return(nil)
+	require.NoError(t, controller.reconcileVpcEgressGatewayDestinationAddressSets([]vegDestinationPolicy{{
+		sources:      set.New("10.0.2.0/24"),
+		addressSet:   asName,
+		destinations: set.New("203.0.113.10", "198.51.100.10"),
+	}}, externalIDs))
+
+	// the address sets of removed destinations are deleted
+	mockOvnClient.EXPECT().ListAddressSets(map[string]string{
+		ovs.ExternalIDVendor:           util.CniTypeName,
+		ovs.ExternalIDVpcEgressGateway: "default/veg",
+		vegDestinationExternalID:       "",
+	}).Return([]ovnnb.AddressSet{{Name: asName}, {Name: "VEG.stale.dst.0123abcd.ipv4"}}, nil)
+	mockOvnClient.EXPECT().DeleteAddressSet("VEG.stale.dst.0123abcd.ipv4").Return(nil)
+	require.NoError(t, controller.cleanVpcEgressGatewayDestinationAddressSets("default/veg", []kubeovnv1.VpcEgressGatewayPolicy{policy}))
+}
@@ -0,0 +1,389 @@
+package controller
+
+import (
+	"cmp"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/set"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/ovs"
+	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnnb"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+// vegPinnedReplica is a ready gateway replica.
+type vegPinnedReplica struct {
+	pod string
+	// address family -> internal IP
+	nexthops map[int]string
+	// comma separated external IP(s)
+	externalIP string
+}
+
+// vpcEgressGatewayPinnedReplicas returns the ready replicas, and the external IPs of the other
+// gateway pods, which are still allocated to the gateway and can be taken over by a ready replica.
+func vpcEgressGatewayPinnedReplicas(pods []*corev1.Pod, attachmentNetworkName string) ([]vegPinnedReplica, set.Set[string]) {
+	replicas := make([]vegPinnedReplica, 0, len(pods))
+	allocated := set.New[string]()
+	for _, pod := range pods {
+		if !pod.DeletionTimestamp.IsZero() {
+			continue
+		}
+		extIPs, err := util.PodAttachmentIPs(pod, attachmentNetworkName)
+		if err != nil || len(extIPs) == 0 {
+			continue
+		}
+		ips := util.PodIPs(*pod)
+		if !podReady(pod) || len(ips) == 0 {
+			allocated.Insert(extIPs...)
+			continue
+		}
+		replica := vegPinnedReplica{pod: pod.Name, nexthops: make(map[int]string, 2), externalIP: strings.Join(extIPs, ",")}
+		ipv4, ipv6 := util.SplitIpsByProtocol(ips)
+		if len(ipv4) != 0 {
+			replica.nexthops[4] = ipv4[0]
+		}
+		if len(ipv6) != 0 {
+			replica.nexthops[6] = ipv6[0]
+		}
+		replicas = append(replicas, replica)
+	}
+	slices.SortFunc(replicas, func(a, b vegPinnedReplica) int {
+		return cmp.Compare(a.pod, b.pod)
+	})
+	return replicas, allocated
+}
+
+// rankVpcEgressGatewayReplicas orders the replicas for the key by rendezvous hashing.
+// The ranking only depends on the key and the external IPs, so removing a replica
+// does not change the order of the others.
+func rankVpcEgressGatewayReplicas(key string, replicas []vegPinnedReplica) []vegPinnedReplica {
+	score := func(replica vegPinnedReplica) uint64 {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(key))
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write([]byte(replica.externalIP))
+		return h.Sum64()
+	}
+	ranked := slices.Clone(replicas)
+	slices.SortStableFunc(ranked, func(a, b vegPinnedReplica) int {
+		if c := cmp.Compare(score(b), score(a)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.externalIP, b.externalIP)
+	})
+	return ranked
+}
+
+// vegPinningPlan is the assignment of the sources to the external IPs and of the external IPs to the replicas.
+type vegPinningPlan struct {
+	// source -> external IP
+	externalIPs map[string]string
+	// external IP -> replica holding it
+	holders map[string]vegPinnedReplica
+	// external IP -> replica taking it over on failure
+	standbys map[string]vegPinnedReplica
+	// external IPs which are not owned by their holders
+	takenOver set.Set[string]
+}
+
+// planVpcEgressGatewayPinning assigns the sources to external IPs. A source keeps the external IP recorded
+// in the previous status while the IP is owned by a ready replica or reserved for the gateway, so that scaling
+// and failures do not move it; other sources are assigned to a ready replica of their address family by
+// rendezvous hashing. An external IP without a ready owner is held by a ready replica, which takes it over.
+func planVpcEgressGatewayPinning(previous []kubeovnv1.VpcEgressGatewaySourcePinning, sources map[string]int, replicas []vegPinnedReplica, reserved set.Set[string]) *vegPinningPlan {
+	plan := &vegPinningPlan{
+		externalIPs: make(map[string]string, len(sources)),
+		holders:     make(map[string]vegPinnedReplica),
+		standbys:    make(map[string]vegPinnedReplica),
+		takenOver:   set.New[string](),
+	}
+	if len(replicas) == 0 {
+		return plan
+	}
+
+	owners := make(map[string]vegPinnedReplica, len(replicas))
+	for _, replica := range replicas {
+		owners[replica.externalIP] = replica
+	}
+	previousIPs := make(map[string]string, len(previous))
+	for _, pinning := range previous {
+		previousIPs[pinning.Source] = pinning.ExternalIP
+	}
+	for source, af := range sources {
+		externalIP := previousIPs[source]
+		if _, ok := owners[externalIP]; ok || (externalIP != "" && reserved.HasAll(strings.Split(externalIP, ",")...)) {
+			plan.externalIPs[source] = externalIP
+			continue
+		}
+		candidates := slices.DeleteFunc(slices.Clone(replicas), func(replica vegPinnedReplica) bool {
+			return replica.nexthops[af] == ""
+		})
+		if len(candidates) != 0 {
+			plan.externalIPs[source] = rankVpcEgressGatewayReplicas(source, candidates)[0].externalIP
+		}
+	}
+
+	for _, externalIP := range plan.externalIPs {
+		if _, ok := plan.holders[externalIP]; ok {
+			continue
+		}
+		ranked := rankVpcEgressGatewayReplicas(externalIP, replicas)
+		if owner, ok := owners[externalIP]; ok {
+			ranked = slices.DeleteFunc(ranked, func(replica vegPinnedReplica) bool { return replica.pod == owner.pod })
+			ranked = slices.Insert(ranked, 0, owner)
+		} else {
+			plan.takenOver.Insert(externalIP)
+		}
+		plan.holders[externalIP] = ranked[0]
+		if len(ranked) > 1 {
+			plan.standbys[externalIP] = ranked[1]
+		}
+	}
+	return plan
+}
+
+// pinningArgs returns the arguments of vpc-egress-gateway-pinning.sh for the replica: the external IPs
+// it takes over, and the SNAT rules translating their sources. With BFD, the standby replica translates
+// the sources of an external IP as well, since they are rerouted to it before the IP is moved.
+func (p *vegPinningPlan) pinningArgs(replica vegPinnedReplica, standby bool) []string {
+	args := []string{replica.externalIP}
+	for _, externalIP := range p.takenOver.SortedList() {
+		if p.holders[externalIP].pod == replica.pod {
+			for ip := range strings.SplitSeq(externalIP, ",") {
+				args = append(args, "hold,"+ip)
+			}
+		}
+	}
+	for _, source := range slices.Sorted(maps.Keys(p.externalIPs)) {
+		externalIP := p.externalIPs[source]
+		if (!p.takenOver.Has(externalIP) || p.holders[externalIP].pod != replica.pod) &&
+			(!standby || p.standbys[externalIP].pod != replica.pod) {
+			continue
+		}
+		for ip := range strings.SplitSeq(externalIP, ",") {
+			if util.CheckProtocol(ip) == util.CheckProtocol(source) {
+				args = append(args, fmt.Sprintf("snat,%s,%s", source, ip))
+			}
+		}
+	}
+	return args
+}
+
+// vpcEgressGatewayPinnedSources returns the pod IPs of the selected pods and the policy CIDRs of the address family.
+func (c *Controller) vpcEgressGatewayPinnedSources(gw *kubeovnv1.VpcEgressGateway, af int, policySources set.Set[string]) (set.Set[string], error) {
+	sources := policySources.Clone()
+	pods, err := c.listVpcEgressGatewaySelectedPods(gw)
+	if err != nil {
+		return nil, err
+	}
+	for _, pod := range pods {
+		ipv4, ipv6 := util.SplitStringIP(pod.Annotations[util.IPAddressAnnotation])
+		if af == 4 && ipv4 != "" {
+			sources.Insert(ipv4)
+		} else if af == 6 && ipv6 != "" {
+			sources.Insert(ipv6)
+		}
+	}
+	return sources, nil
+}
+
+func (c *Controller) reconcileVpcEgressGatewayPinnedRoutes(ctx *vpcEgressGatewayReconcileContext, state *vpcEgressGatewayWorkloadState) error {
+	gw := ctx.gateway
+	lrName := ctx.vpc.Status.Router
+	pinned := gw.Spec.LoadBalanceMode == kubeovnv1.LoadBalanceModePinned
+	bfdEnabled := gw.Spec.BFD.Enabled && ctx.bfdIP != ""
+
+	// address family -> source -> matches, policies with destinations are pinned per source and keep their destinations
+	sourceMatches := make(map[int]map[string][]string, 2)
+	sources := make(map[string]int)
+	if pinned {
+		for af, policySources := range map[int]set.Set[string]{4: state.ipv4Src, 6: state.ipv6Src} {
+			afSources, err := c.vpcEgressGatewayPinnedSources(gw, af, policySources)
+			if err != nil {
+				return err
+			}
+			sourceMatches[af] = make(map[string][]string, afSources.Len())
+			for source := range afSources {
+				sources[source] = af
+				sourceMatches[af][source] = append(sourceMatches[af][source], fmt.Sprintf("ip%d.src == %s", af, source))
+			}
+			destinationPolicies, err := c.vpcEgressGatewayDestinationPolicies(gw, af)
+			if err != nil {
+				return err
+			}
+			for _, policy := range destinationPolicies {
+				for source := range policy.sources {
+					sources[source] = af
+					sourceMatches[af][source] = append(sourceMatches[af][source], policy.match(af, source))
+				}
+			}
+		}
+	}
+
+	replicas, reserved := vpcEgressGatewayPinnedReplicas(state.pods, state.attachmentNetworkName)
+	for _, externalIPs := range gw.Spec.ExternalIPs {
+		reserved.Insert(strings.Split(externalIPs, ",")...)
+	}
+	plan := planVpcEgressGatewayPinning(gw.Status.Pinning, sources, replicas, reserved)
+
+	for _, af := range [...]int{4, 6} {
+		externalIDs := map[string]string{
+			ovs.ExternalIDVendor:           util.CniTypeName,
+			ovs.ExternalIDVpcEgressGateway: fmt.Sprintf("%s/%s", gw.Namespace, gw.Name),
+			"af":                           strconv.Itoa(af),
+		}
+		if !pinned {
+			for _, priority := range [...]int{util.EgressGatewayPinnedPolicyPriority, util.EgressGatewayPinnedStandbyPolicyPriority} {
+				if err := c.OVNNbClient.DeleteLogicalRouterPolicies(lrName, priority, externalIDs); err != nil {
+					klog.Error(err)
+					return err
+				}
+			}
+			continue
+		}
+
+		primaryRules := make(map[string]string, len(sourceMatches[af]))
+		standbyRules := make(map[string]string, len(sourceMatches[af]))
+		for source, matches := range sourceMatches[af] {
+			externalIP, ok := plan.externalIPs[source]
+			if !ok {
+				continue
+			}
+			holder, standby := plan.holders[externalIP], plan.standbys[externalIP]
+			for _, match := range matches {
+				if nexthop := holder.nexthops[af]; nexthop != "" {
+					primaryRules[match] = nexthop
+				}
+				// without BFD the standby route is never used, the external IP is taken over instead
+				if nexthop := standby.nexthops[af]; bfdEnabled && nexthop != "" {
+					standbyRules[match] = nexthop
+				}
+			}
+		}
+
+		bfdMap := make(map[string]string)
+		if bfdEnabled {
+			bfdList, err := c.OVNNbClient.FindBFD(externalIDs)
+			if err != nil {
+				klog.Error(err)
+				return err
+			}
+			for _, bfd := range bfdList {
+				if bfd.LogicalPort == ctx.vpc.Status.BFDPort.Name {
+					bfdMap[bfd.DstIP] = bfd.UUID
+				}
+			}
+		}
+		if err := c.reconcileVpcEgressGatewayPinnedPolicies(lrName, util.EgressGatewayPinnedPolicyPriority, primaryRules, bfdMap, externalIDs); err != nil {
+			return err
+		}
+		if err := c.reconcileVpcEgressGatewayPinnedPolicies(lrName, util.EgressGatewayPinnedStandbyPolicyPriority, standbyRules, bfdMap, externalIDs); err != nil {
+			return err
+		}
+	}
+
+	container := "gateway"
+	if ctx.bfdIP != "" {
+		container = "bfdd"
+	}
+	podsByName := make(map[string]*corev1.Pod, len(replicas))
+	for _, pod := range state.pods {
+		podsByName[pod.Name] = pod
+	}
+	for _, replica := range replicas {
+		if err := c.configureVpcEgressGatewayPinning(podsByName[replica.pod], container, plan.pinningArgs(replica, bfdEnabled)); err != nil {
+			return err
+		}
+	}
+
+	pinning := make([]kubeovnv1.VpcEgressGatewaySourcePinning, 0, len(plan.externalIPs))
+	for source, externalIP := range plan.externalIPs {
+		pinning = append(pinning, kubeovnv1.VpcEgressGatewaySourcePinning{
+			Source:         source,
+			ExternalIP:     externalIP,
+			Replica:        plan.holders[externalIP].pod,
+			StandbyReplica: plan.standbys[externalIP].pod,
+		})
+	}
+	slices.SortFunc(pinning, func(a, b kubeovnv1.VpcEgressGatewaySourcePinning) int {
+		return cmp.Compare(a.Source, b.Source)
+	})
+	gw.Status.Pinning = pinning
+	return nil
+}
+
+// configureVpcEgressGatewayPinning runs vpc-egress-gateway-pinning.sh in the replica pod unless the arguments
+// are recorded in the pod annotation. A replica without any taken over external IP or SNAT rule is left alone
+// unless it was configured before.
+func (c *Controller) configureVpcEgressGatewayPinning(pod *corev1.Pod, container string, args []string) error {
+	applied := pod.Annotations[util.VpcEgressGatewayPinningAnnotation]
+	desired := strings.Join(args, " ")
+	if applied == desired || (applied == "" && len(args) == 1) {
+		return nil
+	}
+
+	cmd := append([]string{"bash", "/kube-ovn/vpc-egress-gateway-pinning.sh"}, args...)
+	klog.Infof("configuring pinned external IPs of vpc-egress-gateway pod %s/%s: %s", pod.Namespace, pod.Name, desired)
+	stdOutput, errOutput, err := util.ExecuteCommandInContainer(c.config.KubeClient, c.config.KubeRestConfig, pod.Namespace, pod.Name, container, cmd...)
+	if err != nil {
+		err = fmt.Errorf("failed to configure pinned external IPs of pod %s/%s: %w, stdout: %q, stderr: %q", pod.Namespace, pod.Name, err, stdOutput, errOutput)
+		klog.Error(err)
+		return err
+	}
+
+	var value any = desired
+	if len(args) == 1 {
+		value = nil
+	}
+	patch := util.KVPatch{util.VpcEgressGatewayPinningAnnotation: value}
+	if err = util.PatchAnnotations(c.config.KubeClient.CoreV1().Pods(pod.Namespace), pod.Name, patch); err != nil {
+		err = fmt.Errorf("failed to patch pod %s/%s: %w", pod.Namespace, pod.Name, err)
+		klog.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (c *Controller) reconcileVpcEgressGatewayPinnedPolicies(lrName string, priority int, rules, bfdMap, externalIDs map[string]string) error {
+	policies, err := c.OVNNbClient.ListLogicalRouterPolicies(lrName, priority, externalIDs, false)
+	if err != nil {
+		klog.Error(err)
+		return err
+	}
+	for _, policy := range policies {
+		nextHop, ok := rules[policy.Match]
+		if !ok {
+			if err = c.OVNNbClient.DeleteLogicalRouterPolicyByUUID(lrName, policy.UUID); err != nil {
+				err = fmt.Errorf("failed to delete ovn lr policy %q: %w", policy.Match, err)
+				klog.Error(err)
+				return err
+			}
+			continue
+		}
+		if updateVpcEgressGatewayPolicyNexthops(policy, set.New(nextHop), localGatewayPolicyBFDSessions(bfdMap, set.New(nextHop))) {
+			if err = c.OVNNbClient.UpdateLogicalRouterPolicy(policy, &policy.Nexthops, &policy.BFDSessions); err != nil {
+				err = fmt.Errorf("failed to update logical router policy %s: %w", policy.UUID, err)
+				klog.Error(err)
+				return err
+			}
+		}
+		delete(rules, policy.Match)
+	}
+	for match, nextHop := range rules {
+		if err = c.OVNNbClient.AddLogicalRouterPolicy(lrName, priority, match, ovnnb.LogicalRouterPolicyActionReroute,
+			[]string{nextHop}, localGatewayPolicyBFDSessions(bfdMap, set.New(nextHop)).UnsortedList(), externalIDs); err != nil {
+			klog.Error(err)
+			return err
+		}
+	}
+	return nil
+}
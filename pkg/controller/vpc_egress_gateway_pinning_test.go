@@ -0,0 +1,199 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/set"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/ovs"
+	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnnb"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+func newVegPinnedReplica(index int) vegPinnedReplica {
+	return vegPinnedReplica{
+		pod:        fmt.Sprintf("veg-%d", index),
+		nexthops:   map[int]string{4: fmt.Sprintf("10.16.1.%d", index)},
+		externalIP: fmt.Sprintf("172.17.1.%d", index),
+	}
+}
+
+func TestVpcEgressGatewayPinnedReplicas(t *testing.T) {
+	dualStack := newVegWorkloadPod("veg-2", "node-2", "10.16.1.12", `[{"name":"default/eth1","ips":["172.17.1.12"]}]`)
+	dualStack.Status.PodIPs = append(dualStack.Status.PodIPs, corev1.PodIP{IP: "fd00:10::12"})
+	notReady := newVegWorkloadPod("veg-3", "node-3", "10.16.1.13", `[{"name":"default/eth1","ips":["172.17.1.13"]}]`)
+	notReady.Status.Conditions[0].Status = corev1.ConditionFalse
+	deleting := newVegWorkloadPod("veg-4", "node-4", "10.16.1.14", `[{"name":"default/eth1","ips":["172.17.1.14"]}]`)
+	deleting.DeletionTimestamp = new(metav1.Now())
+	pods := []*corev1.Pod{
+		dualStack,
+		newVegWorkloadPod("veg-1", "node-1", "10.16.1.11", `[{"name":"default/eth1","ips":["172.17.1.11"]}]`),
+		notReady,
+		deleting,
+		newVegWorkloadPod("veg-5", "node-5", "10.16.1.15", `[{"name":"kube-ovn","ips":["10.16.1.15"]}]`),
+	}
+
+	replicas, allocated := vpcEgressGatewayPinnedReplicas(pods, "default/eth1")
+	require.Equal(t, []vegPinnedReplica{
+		{pod: "veg-1", nexthops: map[int]string{4: "10.16.1.11"}, externalIP: "172.17.1.11"},
+		{pod: "veg-2", nexthops: map[int]string{4: "10.16.1.12", 6: "fd00:10::12"}, externalIP: "172.17.1.12"},
+	}, replicas)
+	require.Equal(t, set.New("172.17.1.13"), allocated)
+}
+
+func TestPlanVpcEgressGatewayPinningKeepsSourcesSticky(t *testing.T) {
+	replicas := []vegPinnedReplica{newVegPinnedReplica(1), newVegPinnedReplica(2)}
+	sources := make(map[string]int, 64)
+	for i := range 64 {
+		sources[fmt.Sprintf("10.0.0.%d", i)] = 4
+	}
+	plan := planVpcEgressGatewayPinning(nil, sources, replicas, set.New[string]())
+	require.Len(t, plan.externalIPs, len(sources))
+	require.Empty(t, plan.takenOver)
+
+	previous := make([]kubeovnv1.VpcEgressGatewaySourcePinning, 0, len(plan.externalIPs))
+	for source, externalIP := range plan.externalIPs {
+		previous = append(previous, kubeovnv1.VpcEgressGatewaySourcePinning{Source: source, ExternalIP: externalIP})
+	}
+	scaled := planVpcEgressGatewayPinning(previous, sources, append(replicas, newVegPinnedReplica(3)), set.New[string]())
+	require.Equal(t, plan.externalIPs, scaled.externalIPs, "scaling out moved pinned sources")
+
+	sources["10.0.1.0/24"] = 4
+	scaled = planVpcEgressGatewayPinning(previous, sources, append(replicas, newVegPinnedReplica(3)), set.New[string]())
+	require.Contains(t, scaled.externalIPs, "10.0.1.0/24")
+	delete(scaled.externalIPs, "10.0.1.0/24")
+	require.Equal(t, plan.externalIPs, scaled.externalIPs)
+}
+
+func TestPlanVpcEgressGatewayPinningTakesOverExternalIP(t *testing.T) {
+	replicas := []vegPinnedReplica{newVegPinnedReplica(1), newVegPinnedReplica(2), newVegPinnedReplica(3)}
+	previous := []kubeovnv1.VpcEgressGatewaySourcePinning{
+		{Source: "10.0.0.1", ExternalIP: "172.17.1.1"},
+		{Source: "10.0.0.2", ExternalIP: "172.17.1.2"},
+		{Source: "10.0.0.3", ExternalIP: "172.17.1.9"},
+	}
+	sources := map[string]int{"10.0.0.1": 4, "10.0.0.2": 4, "10.0.0.3": 4}
+
+	// replica 2 failed and its external IP is still allocated, replica 9 is gone and its IP released
+	plan := planVpcEgressGatewayPinning(previous, sources, []vegPinnedReplica{replicas[0], replicas[2]}, set.New("172.17.1.2"))
+	require.Equal(t, "172.17.1.1", plan.externalIPs["10.0.0.1"])
+	require.Equal(t, "172.17.1.2", plan.externalIPs["10.0.0.2"])
+	require.NotEqual(t, "172.17.1.9", plan.externalIPs["10.0.0.3"])
+	require.Equal(t, set.New("172.17.1.2"), plan.takenOver)
+	holder := plan.holders["172.17.1.2"]
+	require.NotEqual(t, "veg-2", holder.pod)
+	require.Equal(t, "veg-1", plan.holders["172.17.1.1"].pod)
+
+	for _, replica := range []vegPinnedReplica{replicas[0], replicas[2]} {
+		args := plan.pinningArgs(replica, false)
+		if replica.pod == holder.pod {
+			require.Equal(t, []string{replica.externalIP, "hold,172.17.1.2", "snat,10.0.0.2,172.17.1.2"}, args)
+		} else {
+			require.Equal(t, []string{replica.externalIP}, args)
+		}
+	}
+
+	// the recreated replica owns its external IP again and the holder releases it
+	plan = planVpcEgressGatewayPinning(previous, sources, replicas, set.New[string]())
+	require.Equal(t, "172.17.1.2", plan.externalIPs["10.0.0.2"])
+	require.Equal(t, "veg-2", plan.holders["172.17.1.2"].pod)
+	require.Empty(t, plan.takenOver)
+	require.Equal(t, []string{holder.externalIP}, plan.pinningArgs(holder, false))
+}
+
+func TestVpcEgressGatewayPinningArgsOfStandbyReplica(t *testing.T) {
+	replicas := []vegPinnedReplica{newVegPinnedReplica(1), newVegPinnedReplica(2)}
+	previous := []kubeovnv1.VpcEgressGatewaySourcePinning{{Source: "10.0.0.0/24", ExternalIP: "172.17.1.1"}}
+	plan := planVpcEgressGatewayPinning(previous, map[string]int{"10.0.0.0/24": 4}, replicas, set.New[string]())
+	require.Equal(t, "veg-2", plan.standbys["172.17.1.1"].pod)
+
+	require.Equal(t, []string{"172.17.1.1"}, plan.pinningArgs(replicas[0], true))
+	require.Equal(t, []string{"172.17.1.2"}, plan.pinningArgs(replicas[1], false))
+	require.Equal(t, []string{"172.17.1.2", "snat,10.0.0.0/24,172.17.1.1"}, plan.pinningArgs(replicas[1], true))
+}
+
+func TestRankVpcEgressGatewayReplicasMovesOnlyFailedReplicaSources(t *testing.T) {
+	replicas := []vegPinnedReplica{newVegPinnedReplica(10), newVegPinnedReplica(11), newVegPinnedReplica(12)}
+	failed := replicas[1]
+	survivors := []vegPinnedReplica{replicas[0], replicas[2]}
+
+	for i := range 64 {
+		source := fmt.Sprintf("10.0.0.%d", i)
+		ranked := rankVpcEgressGatewayReplicas(source, replicas)
+		require.Len(t, ranked, len(replicas))
+		require.Equal(t, ranked, rankVpcEgressGatewayReplicas(source, []vegPinnedReplica{replicas[2], replicas[0], replicas[1]}))
+
+		afterFailure := rankVpcEgressGatewayReplicas(source, survivors)
+		if ranked[0].pod != failed.pod {
+			require.Equal(t, ranked[0], afterFailure[0], "source %s moved away from a healthy replica", source)
+		} else {
+			require.Equal(t, ranked[1], afterFailure[0], "source %s did not move to its standby replica", source)
+		}
+	}
+}
+
+func TestReconcileVpcEgressGatewayPinnedPolicies(t *testing.T) {
+	fakeController := newFakeController(t)
+	controller := fakeController.fakeController
+	mockOvnClient := fakeController.mockOvnClient
+	externalIDs := map[string]string{
+		ovs.ExternalIDVendor:           util.CniTypeName,
+		ovs.ExternalIDVpcEgressGateway: "default/veg",
+		"af":                           "4",
+	}
+	kept := &ovnnb.LogicalRouterPolicy{UUID: "kept", Match: "ip4.src == 10.0.0.2", Nexthops: []string{"10.16.1.10"}, BFDSessions: []string{"bfd-1"}}
+	moved := &ovnnb.LogicalRouterPolicy{UUID: "moved", Match: "ip4.src == 10.0.0.3", Nexthops: []string{"10.16.1.11"}}
+	stale := &ovnnb.LogicalRouterPolicy{UUID: "stale", Match: "ip4.src == 10.0.0.4", Nexthops: []string{"10.16.1.11"}}
+	rules := map[string]string{
+		kept.Match:               "10.16.1.10",
+		moved.Match:              "10.16.1.10",
+		"ip4.src == 10.1.0.0/24": "10.16.1.12",
+	}
+	bfdMap := map[string]string{"10.16.1.10": "bfd-1"}
+
+	mockOvnClient.EXPECT().ListLogicalRouterPolicies("tenant", util.EgressGatewayPinnedPolicyPriority, externalIDs, false).
+		Return([]*ovnnb.LogicalRouterPolicy{kept, moved, stale}, nil)
+	mockOvnClient.EXPECT().UpdateLogicalRouterPolicy(moved, &moved.Nexthops, &moved.BFDSessions).Return(nil)
+	mockOvnClient.EXPECT().DeleteLogicalRouterPolicyByUUID("tenant", stale.UUID).Return(nil)
+	mockOvnClient.EXPECT().AddLogicalRouterPolicy("tenant", util.EgressGatewayPinnedPolicyPriority, "ip4.src == 10.1.0.0/24",
+		ovnnb.LogicalRouterPolicyActionReroute, []string{"10.16.1.12"}, []string{}, externalIDs).Return(nil)
+
+	err := controller.reconcileVpcEgressGatewayPinnedPolicies("tenant", util.EgressGatewayPinnedPolicyPriority, rules, bfdMap, externalIDs)
+	require.NoError(t, err)
+	require.Equal(t, []string{"10.16.1.10"}, moved.Nexthops)
+	require.Equal(t, []string{"bfd-1"}, moved.BFDSessions)
+}
+
+func TestReconcileVpcEgressGatewayPinnedRoutesCleansUpInECMPMode(t *testing.T) {
+	fakeController := newFakeController(t)
+	controller := fakeController.fakeController
+	mockOvnClient := fakeController.mockOvnClient
+	gw := &kubeovnv1.VpcEgressGateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "veg", Namespace: "default"},
+		Status: kubeovnv1.VpcEgressGatewayStatus{
+			Pinning: []kubeovnv1.VpcEgressGatewaySourcePinning{{Source: "10.0.0.2", ExternalIP: "172.17.1.10"}},
+		},
+	}
+	vpc := &kubeovnv1.Vpc{Status: kubeovnv1.VpcStatus{Router: "tenant"}}
+	for _, af := range []string{"4", "6"} {
+		externalIDs := map[string]string{
+			ovs.ExternalIDVendor:           util.CniTypeName,
+			ovs.ExternalIDVpcEgressGateway: "default/veg",
+			"af":                           af,
+		}
+		mockOvnClient.EXPECT().DeleteLogicalRouterPolicies("tenant", util.EgressGatewayPinnedPolicyPriority, externalIDs).Return(nil)
+		mockOvnClient.EXPECT().DeleteLogicalRouterPolicies("tenant", util.EgressGatewayPinnedStandbyPolicyPriority, externalIDs).Return(nil)
+	}
+
+	err := controller.reconcileVpcEgressGatewayPinnedRoutes(
+		&vpcEgressGatewayReconcileContext{gateway: gw, vpc: vpc},
+		&vpcEgressGatewayWorkloadState{},
+	)
+	require.NoError(t, err)
+	require.Empty(t, gw.Status.Pinning)
+}
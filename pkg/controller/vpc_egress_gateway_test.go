@@ -191,6 +191,7 @@ func newVpcEgressGatewayDeleteController(t *testing.T, gw *kubeovnv1.VpcEgressGa
 	mockOvnClient.EXPECT().DeleteLogicalRouterPolicies(util.DefaultVpc, -1, gomock.Any()).Return(nil)
 	mockOvnClient.EXPECT().DeletePortGroup(gomock.Any()).Return(nil)
 	mockOvnClient.EXPECT().DeleteAddressSet(gomock.Any()).Return(nil).Times(4)
+	mockOvnClient.EXPECT().ListAddressSets(gomock.Any()).Return(nil, nil)
 	recorder := record.NewFakeRecorder(2)
 
 	c := &Controller{
@@ -13,6 +13,7 @@ var (
 	vpcNatImage             = ""
 	vpcNatGwBgpSpeakerImage = ""
 	vpcNatAPINadProvider    = ""
+	vpcNatAgentEnabled      = false
 )
 
 func (c *Controller) resyncVpcNatConfig() {
@@ -48,4 +49,7 @@ func (c *Controller) resyncVpcNatConfig() {
 
 	// NetworkAttachmentDefinition provider for the BGP speaker to call the API server
 	vpcNatAPINadProvider = cm.Data["apiNadProvider"]
+
+	// Program the rules with the in-pod agent instead of executing the gateway script in the pod
+	vpcNatAgentEnabled = cm.Data["enableAgent"] == "true"
 }
@@ -28,6 +28,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/natgwagent"
 	"github.com/kubeovn/kube-ovn/pkg/ovs"
 	"github.com/kubeovn/kube-ovn/pkg/request"
 	"github.com/kubeovn/kube-ovn/pkg/util"
@@ -47,6 +48,8 @@ const (
 	natGwDnatDel          = "dnat-del"
 	natGwSnatAdd          = "snat-add"
 	natGwSnatDel          = "snat-del"
+	natGwSnatPortBlockAdd = "snat-port-block-add"
+	natGwSnatPortBlockDel = "snat-port-block-del"
 	natGwEipIngressQoSAdd = "eip-ingress-qos-add"
 	natGwEipIngressQoSDel = "eip-ingress-qos-del"
 	QoSAdd                = "qos-add"
@@ -59,6 +62,7 @@ const (
 	natGwSubnetRouteDel   = "subnet-route-del"
 
 	getIptablesVersion = "get-iptables-version"
+	natGwGetCounters   = "get-counters"
 )
 
 // natGwNamespace returns the namespace where the NAT gateway StatefulSet/Pod should be created.
@@ -268,7 +272,7 @@ func isVpcNatGwChanged(gw *kubeovnv1.VpcNatGateway) bool {
 	if gw.Spec.Replicas != gw.Status.Replicas {
 		return true
 	}
-	return false
+	return isNatGwConntrackSyncChanged(gw)
 }
 
 // handleAddOrUpdateVpcNatGw is called when a VPC NAT gateway is added or updated.
@@ -945,6 +949,20 @@ func (c *Controller) handleUpdateNatGwSubnetRoute(natGwKey string) error {
 }
 
 func (c *Controller) execNatGwRules(pod *corev1.Pod, operation string, rules []string) error {
+	return c.execNatGwRulesFor(pod, operation, rules, nil)
+}
+
+// execNatGwRulesFor programs the rules into the NAT gateway pod. For pods running the NAT gateway agent
+// the rules are recorded in the desired rules ConfigMap along with their owner, otherwise they are
+// executed in the pod.
+func (c *Controller) execNatGwRulesFor(pod *corev1.Pod, operation string, rules []string, owner *natgwagent.Owner) error {
+	if natGwAgentEnabled(pod) {
+		handled, err := c.updateNatGwDesiredRules(pod, operation, rules, owner)
+		if handled || err != nil {
+			return err
+		}
+	}
+
 	lockKey := fmt.Sprintf("nat-gw-exec:%s/%s", pod.Namespace, pod.Name)
 
 	c.vpcNatGwExecKeyMutex.LockKey(lockKey)
@@ -1197,12 +1215,15 @@ func (c *Controller) genNatGwStatefulSet(gw *kubeovnv1.VpcNatGateway, oldSts *v1
 	klog.V(3).Infof("%s templateAnnotations:%v", gw.Name, templateAnnotations)
 
 	// Add an interface that can reach the API server, we need access to it to probe Kube-OVN resources
-	if gw.Spec.BgpSpeaker.Enabled {
+	if gw.Spec.BgpSpeaker.Enabled || vpcNatAgentEnabled {
 		if err := c.setNatGwAPIAccess(templateAnnotations); err != nil {
 			klog.Errorf("couldn't add an API interface to the NAT gateway: %v", err)
 			return nil, err
 		}
 	}
+	if gw.Spec.ConntrackSync.Enabled {
+		setNatGwConntrackSyncNetwork(templateAnnotations, gw.Spec.ConntrackSync)
+	}
 
 	// Retrieve the gateways of the subnet sitting behind the NAT gateway
 	eth0V4Gateway, eth0V6Gateway, err := c.GetGwBySubnet(gw.Spec.Subnet)
@@ -1308,6 +1329,16 @@ func (c *Controller) genNatGwStatefulSet(gw *kubeovnv1.VpcNatGateway, oldSts *v1
 		},
 	}
 
+	// The rules are programmed by the agent watching the desired rules ConfigMap
+	if vpcNatAgentEnabled {
+		setNatGwAgent(gw, &sts.Spec.Template)
+	}
+
+	// Synchronize the conntrack state with the other replicas of the gateway
+	if gw.Spec.ConntrackSync.Enabled {
+		sts.Spec.Template.Spec.Containers = append(sts.Spec.Template.Spec.Containers, genNatGwConntrackdContainer(vpcNatImage, gw.Name, gw.Spec.ConntrackSync))
+	}
+
 	// BGP speaker is enabled on this instance, add a BGP speaker to the statefulset
 	if gw.Spec.BgpSpeaker.Enabled {
 		// We need to connect to the K8S API to make the BGP speaker work, this implies a ServiceAccount
@@ -1372,12 +1403,15 @@ func (c *Controller) genNatGwDeployment(gw *kubeovnv1.VpcNatGateway) (*v1.Deploy
 		bfdIP = vpc.Status.BFDPort.IP
 	}
 
-	if gw.Spec.BgpSpeaker.Enabled {
+	if gw.Spec.BgpSpeaker.Enabled || vpcNatAgentEnabled {
 		if err := c.setNatGwAPIAccess(templateAnnotations); err != nil {
 			klog.Errorf("couldn't add an API interface to the NAT gateway: %v", err)
 			return nil, err
 		}
 	}
+	if gw.Spec.ConntrackSync.Enabled {
+		setNatGwConntrackSyncNetwork(templateAnnotations, gw.Spec.ConntrackSync)
+	}
 
 	eth0V4Gateway, eth0V6Gateway, err := c.GetGwBySubnet(gw.Spec.Subnet)
 	if err != nil {
@@ -1503,6 +1537,16 @@ func (c *Controller) genNatGwDeployment(gw *kubeovnv1.VpcNatGateway) (*v1.Deploy
 		deploy.Spec.Template.Spec.Containers = append(deploy.Spec.Template.Spec.Containers, bfdContainer)
 	}
 
+	// The rules are programmed by the agent watching the desired rules ConfigMap
+	if vpcNatAgentEnabled {
+		setNatGwAgent(gw, &deploy.Spec.Template)
+	}
+
+	// Synchronize the conntrack state between the replicas so that failover keeps the established sessions
+	if gw.Spec.ConntrackSync.Enabled {
+		deploy.Spec.Template.Spec.Containers = append(deploy.Spec.Template.Spec.Containers, genNatGwConntrackdContainer(vpcNatImage, gw.Name, gw.Spec.ConntrackSync))
+	}
+
 	// BGP speaker is enabled on this instance
 	if gw.Spec.BgpSpeaker.Enabled {
 		deploy.Spec.Template.Spec.ServiceAccountName = "vpc-nat-gw"
@@ -1795,6 +1839,14 @@ func (c *Controller) patchNatGwStatus(key string) error {
 	if updateNatGwWorkloadStatus(gw, c.podsLister, c.deploymentsLister, c.config.KubeClient, c.natGwNamespace(gw)) {
 		changed = true
 	}
+	conntrackSyncChanged, err := c.updateNatGwConntrackSyncStatus(gw)
+	if err != nil {
+		klog.Errorf("failed to get conntrack sync status of nat gw %s, %v", gw.Name, err)
+		return err
+	}
+	if conntrackSyncChanged {
+		changed = true
+	}
 
 	if changed {
 		bytes, err := gw.Status.Bytes()
@@ -128,6 +128,39 @@ func TestIsVpcNatGwChanged(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name: "ConntrackSync enabled returns true",
+			gw: &kubeovnv1.VpcNatGateway{
+				Spec: kubeovnv1.VpcNatGatewaySpec{
+					ConntrackSync: kubeovnv1.VpcNatGatewayConntrackSync{Enabled: true},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "ConntrackSync port changed returns true",
+			gw: &kubeovnv1.VpcNatGateway{
+				Spec: kubeovnv1.VpcNatGatewaySpec{
+					ConntrackSync: kubeovnv1.VpcNatGatewayConntrackSync{Enabled: true, NetworkAttachment: "kube-system/ctsync", Port: 3800},
+				},
+				Status: kubeovnv1.VpcNatGatewayStatus{
+					ConntrackSync: kubeovnv1.VpcNatGatewayConntrackSyncStatus{Enabled: true, NetworkAttachment: "kube-system/ctsync", Port: 3780},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "ConntrackSync unchanged returns false",
+			gw: &kubeovnv1.VpcNatGateway{
+				Spec: kubeovnv1.VpcNatGatewaySpec{
+					ConntrackSync: kubeovnv1.VpcNatGatewayConntrackSync{Enabled: true, NetworkAttachment: "kube-system/ctsync", Port: 3780},
+				},
+				Status: kubeovnv1.VpcNatGatewayStatus{
+					ConntrackSync: kubeovnv1.VpcNatGatewayConntrackSyncStatus{Enabled: true, NetworkAttachment: "kube-system/ctsync", Port: 3780, ReadyReplicas: 2},
+				},
+			},
+			expected: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/natgwagent"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+const natGwAgentStateVolume = "nat-gw-agent-state"
+
+// natGwAgentEnabled returns whether the rules of the NAT gateway pod are programmed by the in-pod agent
+func natGwAgentEnabled(pod *corev1.Pod) bool {
+	return pod.Annotations[util.VpcNatGatewayAgentAnnotation] == "true"
+}
+
+// natGwRuleOwner returns the owner recorded along with the desired rules rendered from obj
+func natGwRuleOwner(kind string, obj metav1.Object) *natgwagent.Owner {
+	return &natgwagent.Owner{Kind: kind, Name: obj.GetName(), Generation: obj.GetGeneration()}
+}
+
+// setNatGwAgent runs the NAT gateway agent as the main container of the gateway pod,
+// the API access of the pod must be set up by the caller
+func setNatGwAgent(gw *kubeovnv1.VpcNatGateway, template *corev1.PodTemplateSpec) {
+	template.Annotations[util.VpcNatGatewayAgentAnnotation] = "true"
+	template.Spec.ServiceAccountName = "vpc-nat-gw"
+	template.Spec.AutomountServiceAccountToken = new(true)
+	template.Spec.Volumes = append(template.Spec.Volumes, corev1.Volume{
+		Name: natGwAgentStateVolume,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	})
+
+	container := &template.Spec.Containers[0]
+	container.Command = []string{natgwagent.BinaryPath}
+	container.Env = append(container.Env,
+		corev1.EnvVar{
+			Name: natgwagent.NamespaceEnv,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"},
+			},
+		},
+		corev1.EnvVar{
+			Name: natgwagent.PodNameEnv,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"},
+			},
+		},
+		corev1.EnvVar{
+			Name:  natgwagent.ConfigMapEnv,
+			Value: util.GenNatGwRulesConfigMapName(gw.Name),
+		},
+	)
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      natGwAgentStateVolume,
+		MountPath: natgwagent.StateDir,
+	})
+}
+
+// updateNatGwDesiredRules records the rules in the desired rules ConfigMap of the gateway running the pod.
+// It returns false if the operation cannot be programmed by the agent.
+// Like any ConfigMap, the desired rules are limited to 1MiB in total. Each rule takes less than 256 bytes,
+// so a gateway holds a few thousand EIPs and NAT rules, the rules exceeding the limit are rejected.
+func (c *Controller) updateNatGwDesiredRules(pod *corev1.Pod, operation string, rules []string, owner *natgwagent.Owner) (bool, error) {
+	if _, _, ok := natgwagent.Key(operation, ""); !ok {
+		return false, nil
+	}
+	if len(rules) == 0 {
+		return true, nil
+	}
+
+	data := make(map[string]*string, len(rules))
+	for _, rule := range rules {
+		key, add, _ := natgwagent.Key(operation, rule)
+		if !add {
+			data[key] = nil
+			continue
+		}
+		value, err := natgwagent.Rule{Operation: operation, Args: rule, Owner: owner}.Encode()
+		if err != nil {
+			klog.Error(err)
+			return true, err
+		}
+		data[key] = &value
+	}
+
+	gwName := pod.Annotations[util.VpcNatGatewayAnnotation]
+	name := util.GenNatGwRulesConfigMapName(gwName)
+	patch, err := json.Marshal(map[string]any{"data": data})
+	if err != nil {
+		klog.Error(err)
+		return true, err
+	}
+	klog.V(3).Infof("updating desired rules of nat gateway %s: %s", gwName, string(patch))
+	client := c.config.KubeClient.CoreV1().ConfigMaps(pod.Namespace)
+	if _, err = client.Patch(context.Background(), name, types.MergePatchType, patch, metav1.PatchOptions{}); err == nil || !k8serrors.IsNotFound(err) {
+		if err != nil {
+			if k8serrors.IsInvalid(err) || k8serrors.IsRequestEntityTooLargeError(err) {
+				err = fmt.Errorf("desired rules of nat gateway %s exceed the size limit of configmap %s/%s: %w", gwName, pod.Namespace, name, err)
+			} else {
+				err = fmt.Errorf("failed to patch configmap %s/%s: %w", pod.Namespace, name, err)
+			}
+			klog.Error(err)
+		}
+		return true, err
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: pod.Namespace,
+			Labels:    util.GenNatGwLabels(gwName),
+		},
+		Data: make(map[string]string, len(data)),
+	}
+	for key, value := range data {
+		if value != nil {
+			cm.Data[key] = *value
+		}
+	}
+	// Set owner reference so that the desired rules will be deleted automatically when the VPC NAT gateway is deleted
+	if gw, err := c.vpcNatGatewayLister.Get(gwName); err == nil {
+		if err = util.SetOwnerReference(gw, cm); err != nil {
+			klog.Error(err)
+			return true, err
+		}
+	}
+	if _, err = client.Create(context.Background(), cm, metav1.CreateOptions{}); err != nil {
+		if k8serrors.IsAlreadyExists(err) {
+			// created concurrently, apply the patch again
+			return c.updateNatGwDesiredRules(pod, operation, rules, owner)
+		}
+		err = fmt.Errorf("failed to create configmap %s/%s: %w", pod.Namespace, name, err)
+		klog.Error(err)
+		return true, err
+	}
+	return true, nil
+}
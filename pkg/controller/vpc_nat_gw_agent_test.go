@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/natgwagent"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+func TestUpdateNatGwDesiredRules(t *testing.T) {
+	gw := &kubeovnv1.VpcNatGateway{ObjectMeta: metav1.ObjectMeta{Name: "gw1", UID: "gw1-uid"}}
+	fakeController, err := newFakeControllerWithOptions(t, &FakeControllerOptions{
+		VpcNatGateways: []*kubeovnv1.VpcNatGateway{gw},
+	})
+	require.NoError(t, err)
+	c := fakeController.fakeController
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      util.GenNatGwPodName(gw.Name),
+			Namespace: "kube-system",
+			Annotations: map[string]string{
+				util.VpcNatGatewayAnnotation:      gw.Name,
+				util.VpcNatGatewayAgentAnnotation: "true",
+			},
+		},
+	}
+	require.True(t, natGwAgentEnabled(pod))
+	owner := &natgwagent.Owner{Kind: util.KindIptablesSnatRule, Name: "snat1", Generation: 2}
+	getRules := func() map[string]natgwagent.Rule {
+		cm, err := c.config.KubeClient.CoreV1().ConfigMaps(pod.Namespace).Get(context.Background(), util.GenNatGwRulesConfigMapName(gw.Name), metav1.GetOptions{})
+		require.NoError(t, err)
+		rules, err := natgwagent.DecodeRules(cm.Data)
+		require.NoError(t, err)
+		return rules
+	}
+
+	// the configmap is created on the first update and owned by the gateway
+	handled, err := c.updateNatGwDesiredRules(pod, natGwSnatAdd, []string{"172.18.0.10,10.0.0.0/24"}, owner)
+	require.NoError(t, err)
+	require.True(t, handled)
+	cm, err := c.config.KubeClient.CoreV1().ConfigMaps(pod.Namespace).Get(context.Background(), util.GenNatGwRulesConfigMapName(gw.Name), metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, cm.OwnerReferences, 1)
+	require.Equal(t, gw.Name, cm.OwnerReferences[0].Name)
+	require.Equal(t, map[string]natgwagent.Rule{
+		"snat-add.172.18.0.10_10.0.0.0_24": {Operation: natGwSnatAdd, Args: "172.18.0.10,10.0.0.0/24", Owner: owner},
+	}, getRules())
+
+	// later updates are merged into the configmap
+	handled, err = c.updateNatGwDesiredRules(pod, natGwDnatAdd, []string{"172.18.0.10,8080,tcp,10.0.0.5,80"}, nil)
+	require.NoError(t, err)
+	require.True(t, handled)
+	require.Len(t, getRules(), 2)
+
+	// deletions only need the rule identity
+	handled, err = c.updateNatGwDesiredRules(pod, natGwSnatDel, []string{"172.18.0.10,10.0.0.0/24"}, nil)
+	require.NoError(t, err)
+	require.True(t, handled)
+	require.Equal(t, map[string]natgwagent.Rule{
+		"dnat-add.172.18.0.10_8080_tcp": {Operation: natGwDnatAdd, Args: "172.18.0.10,8080,tcp,10.0.0.5,80"},
+	}, getRules())
+
+	// queries are still executed in the pod
+	handled, err = c.updateNatGwDesiredRules(pod, getIptablesVersion, nil, nil)
+	require.NoError(t, err)
+	require.False(t, handled)
+}
+
+func TestSetNatGwAgent(t *testing.T) {
+	gw := &kubeovnv1.VpcNatGateway{ObjectMeta: metav1.ObjectMeta{Name: "gw1"}}
+	template := &corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{}},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "vpc-nat-gw", Command: []string{"sleep", "infinity"}}},
+		},
+	}
+	setNatGwAgent(gw, template)
+
+	require.Equal(t, "true", template.Annotations[util.VpcNatGatewayAgentAnnotation])
+	require.Equal(t, "vpc-nat-gw", template.Spec.ServiceAccountName)
+	require.True(t, *template.Spec.AutomountServiceAccountToken)
+	container := template.Spec.Containers[0]
+	require.Equal(t, []string{natgwagent.BinaryPath}, container.Command)
+	require.Contains(t, container.Env, corev1.EnvVar{Name: natgwagent.ConfigMapEnv, Value: util.GenNatGwRulesConfigMapName(gw.Name)})
+	require.Equal(t, []corev1.VolumeMount{{Name: natGwAgentStateVolume, MountPath: natgwagent.StateDir}}, container.VolumeMounts)
+	require.Len(t, template.Spec.Volumes, 1)
+	require.NotNil(t, template.Spec.Volumes[0].EmptyDir)
+}
@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net"
+	"slices"
+	"strconv"
+
+	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+const (
+	natGwConntrackdContainer = "conntrackd"
+	natGwConntrackdConf      = "/etc/conntrackd/conntrackd.conf"
+	// natGwConntrackSyncInterface is the interface of the network attachment dedicated to the synchronization
+	natGwConntrackSyncInterface = "ctsync0"
+
+	defaultNatGwConntrackSyncPort = 3780
+)
+
+// natGwConntrackSyncMulticastAddress returns the multicast address of the gateway, an address in the
+// organization local scope 239.192.0.0/14 is derived from the gateway name if none is configured
+func natGwConntrackSyncMulticastAddress(gwName string, sync kubeovnv1.VpcNatGatewayConntrackSync) string {
+	if sync.MulticastAddress != "" {
+		return sync.MulticastAddress
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(gwName))
+	sum := h.Sum32()
+	return net.IPv4(239, byte(192|(sum>>16)&0x3), byte(sum>>8), byte(sum)).String()
+}
+
+// setNatGwConntrackSyncNetwork attaches the network dedicated to the conntrack synchronization to the gateway pods
+func setNatGwConntrackSyncNetwork(annotations map[string]string, sync kubeovnv1.VpcNatGatewayConntrackSync) {
+	network := fmt.Sprintf("%s@%s", sync.NetworkAttachment, natGwConntrackSyncInterface)
+	if networks := annotations[nadv1.NetworkAttachmentAnnot]; networks != "" {
+		network = networks + "," + network
+	}
+	annotations[nadv1.NetworkAttachmentAnnot] = network
+}
+
+// genNatGwConntrackdContainer creates a conntrackd container synchronizing the conntrack state of the
+// NAT gateway replicas over the multicast group of the gateway on the dedicated network attachment
+func genNatGwConntrackdContainer(image, gwName string, sync kubeovnv1.VpcNatGatewayConntrackSync) corev1.Container {
+	port := sync.Port
+	if port == 0 {
+		port = defaultNatGwConntrackSyncPort
+	}
+
+	return corev1.Container{
+		Name:            natGwConntrackdContainer,
+		Image:           image,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command:         []string{"bash", "/kube-ovn/start-conntrackd.sh"},
+		Env: []corev1.EnvVar{
+			{
+				Name:  "CONNTRACKD_CONF",
+				Value: natGwConntrackdConf,
+			},
+			{
+				Name:  "CONNTRACKD_INTERFACE",
+				Value: natGwConntrackSyncInterface,
+			},
+			{
+				Name:  "CONNTRACKD_PORT",
+				Value: strconv.Itoa(int(port)),
+			},
+			{
+				Name:  "CONNTRACKD_MCAST_ADDRESS",
+				Value: natGwConntrackSyncMulticastAddress(gwName, sync),
+			},
+		},
+		// The daemon answers on its control socket once it is synchronizing
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				Exec: &corev1.ExecAction{
+					Command: []string{"conntrackd", "-C", natGwConntrackdConf, "-s"},
+				},
+			},
+			InitialDelaySeconds: 3,
+			PeriodSeconds:       5,
+			TimeoutSeconds:      5,
+		},
+		SecurityContext: &corev1.SecurityContext{
+			Privileged:               new(true),
+			AllowPrivilegeEscalation: new(true),
+		},
+	}
+}
+
+// isNatGwConntrackSyncChanged checks if the conntrack synchronization spec differs from the one
+// the gateway has been configured with
+func isNatGwConntrackSyncChanged(gw *kubeovnv1.VpcNatGateway) bool {
+	status := gw.Status.ConntrackSync
+	return gw.Spec.ConntrackSync != kubeovnv1.VpcNatGatewayConntrackSync{
+		Enabled:           status.Enabled,
+		NetworkAttachment: status.NetworkAttachment,
+		Port:              status.Port,
+		MulticastAddress:  status.MulticastAddress,
+	}
+}
+
+// natGwConntrackSyncReadyPods returns the sorted names of the running gateway pods with a ready conntrackd container
+func (c *Controller) natGwConntrackSyncReadyPods(gw *kubeovnv1.VpcNatGateway) ([]string, error) {
+	selector := labels.Set(util.GenNatGwLabels(gw.Name)).AsSelector()
+	pods, err := c.podsLister.Pods(c.natGwNamespace(gw)).List(selector)
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+
+	var names []string
+	for _, pod := range pods {
+		if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name == natGwConntrackdContainer && status.Ready {
+				names = append(names, pod.Name)
+				break
+			}
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+// updateNatGwConntrackSyncStatus updates the conntrack synchronization status of the gateway
+// and returns whether it has been changed
+func (c *Controller) updateNatGwConntrackSyncStatus(gw *kubeovnv1.VpcNatGateway) (bool, error) {
+	var pods []string
+	if gw.Spec.ConntrackSync.Enabled {
+		var err error
+		if pods, err = c.natGwConntrackSyncReadyPods(gw); err != nil {
+			return false, err
+		}
+	}
+
+	status := kubeovnv1.VpcNatGatewayConntrackSyncStatus{
+		Enabled:           gw.Spec.ConntrackSync.Enabled,
+		NetworkAttachment: gw.Spec.ConntrackSync.NetworkAttachment,
+		Port:              gw.Spec.ConntrackSync.Port,
+		MulticastAddress:  gw.Spec.ConntrackSync.MulticastAddress,
+		ReadyReplicas:     int32(len(pods)), // #nosec G115
+		SyncedPods:        pods,
+	}
+	if !isNatGwConntrackSyncChanged(gw) &&
+		gw.Status.ConntrackSync.ReadyReplicas == status.ReadyReplicas &&
+		slices.Equal(gw.Status.ConntrackSync.SyncedPods, status.SyncedPods) {
+		return false, nil
+	}
+	gw.Status.ConntrackSync = status
+	return true, nil
+}
@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"net"
+	"testing"
+
+	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+func TestGenNatGwConntrackdContainer(t *testing.T) {
+	container := genNatGwConntrackdContainer("vpc-nat-gw:test", "gw1", kubeovnv1.VpcNatGatewayConntrackSync{Enabled: true})
+	require.Equal(t, natGwConntrackdContainer, container.Name)
+	require.Equal(t, "vpc-nat-gw:test", container.Image)
+	require.Contains(t, container.Env, corev1.EnvVar{Name: "CONNTRACKD_INTERFACE", Value: natGwConntrackSyncInterface})
+	require.Contains(t, container.Env, corev1.EnvVar{Name: "CONNTRACKD_PORT", Value: "3780"})
+	require.Contains(t, container.Env, corev1.EnvVar{Name: "CONNTRACKD_MCAST_ADDRESS", Value: natGwConntrackSyncMulticastAddress("gw1", kubeovnv1.VpcNatGatewayConntrackSync{})})
+	require.NotNil(t, container.ReadinessProbe)
+
+	container = genNatGwConntrackdContainer("vpc-nat-gw:test", "gw1", kubeovnv1.VpcNatGatewayConntrackSync{
+		Enabled:          true,
+		Port:             3800,
+		MulticastAddress: "225.0.0.60",
+	})
+	require.Contains(t, container.Env, corev1.EnvVar{Name: "CONNTRACKD_PORT", Value: "3800"})
+	require.Contains(t, container.Env, corev1.EnvVar{Name: "CONNTRACKD_MCAST_ADDRESS", Value: "225.0.0.60"})
+}
+
+func TestNatGwConntrackSyncMulticastAddress(t *testing.T) {
+	sync := kubeovnv1.VpcNatGatewayConntrackSync{Enabled: true}
+	address := natGwConntrackSyncMulticastAddress("gw1", sync)
+	_, scope, err := net.ParseCIDR("239.192.0.0/14")
+	require.NoError(t, err)
+	require.True(t, scope.Contains(net.ParseIP(address)), address)
+	// the address is stable and differs between gateways
+	require.Equal(t, address, natGwConntrackSyncMulticastAddress("gw1", sync))
+	require.NotEqual(t, address, natGwConntrackSyncMulticastAddress("gw2", sync))
+
+	sync.MulticastAddress = "239.1.1.1"
+	require.Equal(t, "239.1.1.1", natGwConntrackSyncMulticastAddress("gw1", sync))
+}
+
+func TestSetNatGwConntrackSyncNetwork(t *testing.T) {
+	sync := kubeovnv1.VpcNatGatewayConntrackSync{Enabled: true, NetworkAttachment: "kube-system/ctsync"}
+	annotations := map[string]string{}
+	setNatGwConntrackSyncNetwork(annotations, sync)
+	require.Equal(t, "kube-system/ctsync@"+natGwConntrackSyncInterface, annotations[nadv1.NetworkAttachmentAnnot])
+
+	annotations = map[string]string{nadv1.NetworkAttachmentAnnot: "kube-system/external"}
+	setNatGwConntrackSyncNetwork(annotations, sync)
+	require.Equal(t, "kube-system/external,kube-system/ctsync@"+natGwConntrackSyncInterface, annotations[nadv1.NetworkAttachmentAnnot])
+}
+
+func TestUpdateNatGwConntrackSyncStatus(t *testing.T) {
+	gw := &kubeovnv1.VpcNatGateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw1"},
+		Spec: kubeovnv1.VpcNatGatewaySpec{
+			Namespace:     "kube-system",
+			Replicas:      3,
+			ConntrackSync: kubeovnv1.VpcNatGatewayConntrackSync{Enabled: true, NetworkAttachment: "kube-system/ctsync", Port: 3780},
+		},
+	}
+	newPod := func(name string, phase corev1.PodPhase, ready bool) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "kube-system",
+				Labels:    util.GenNatGwLabels(gw.Name),
+			},
+			Status: corev1.PodStatus{
+				Phase: phase,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "vpc-nat-gw", Ready: true},
+					{Name: natGwConntrackdContainer, Ready: ready},
+				},
+			},
+		}
+	}
+	fakeController, err := newFakeControllerWithOptions(t, &FakeControllerOptions{
+		Pods: []*corev1.Pod{
+			newPod("vpc-nat-gw-gw1-b", corev1.PodRunning, true),
+			newPod("vpc-nat-gw-gw1-a", corev1.PodRunning, true),
+			newPod("vpc-nat-gw-gw1-c", corev1.PodRunning, false),
+			newPod("vpc-nat-gw-gw1-d", corev1.PodPending, true),
+		},
+	})
+	require.NoError(t, err)
+	c := fakeController.fakeController
+
+	// only the running pods with a ready conntrackd are reported
+	changed, err := c.updateNatGwConntrackSyncStatus(gw)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, kubeovnv1.VpcNatGatewayConntrackSyncStatus{
+		Enabled:           true,
+		NetworkAttachment: "kube-system/ctsync",
+		Port:              3780,
+		ReadyReplicas:     2,
+		SyncedPods:        []string{"vpc-nat-gw-gw1-a", "vpc-nat-gw-gw1-b"},
+	}, gw.Status.ConntrackSync)
+
+	changed, err = c.updateNatGwConntrackSyncStatus(gw)
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	// a changed spec is recorded
+	gw.Spec.ConntrackSync.Port = 3800
+	changed, err = c.updateNatGwConntrackSyncStatus(gw)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.EqualValues(t, 3800, gw.Status.ConntrackSync.Port)
+
+	// the status is reset when the synchronization is disabled
+	gw.Spec.ConntrackSync = kubeovnv1.VpcNatGatewayConntrackSync{}
+	changed, err = c.updateNatGwConntrackSyncStatus(gw)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, kubeovnv1.VpcNatGatewayConntrackSyncStatus{}, gw.Status.ConntrackSync)
+}
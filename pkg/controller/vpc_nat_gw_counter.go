@@ -0,0 +1,346 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+const (
+	natGwCounterChainIn  = "NAT_COUNTER_IN"
+	natGwCounterChainOut = "NAT_COUNTER_OUT"
+
+	natGwTrafficIngress = "ingress"
+	natGwTrafficEgress  = "egress"
+)
+
+// natGwCounters holds the traffic counters of a counter id in the nat gateway pod
+type natGwCounters struct {
+	In  util.GwIPTablesCounters
+	Out util.GwIPTablesCounters
+}
+
+func (c natGwCounters) add(o natGwCounters) natGwCounters {
+	c.In.Packets += o.In.Packets
+	c.In.PacketBytes += o.In.PacketBytes
+	c.Out.Packets += o.Out.Packets
+	c.Out.PacketBytes += o.Out.PacketBytes
+	return c
+}
+
+// sub returns the traffic since the last observation, a counter less than the last
+// observation has been reset by recreating the rule and is counted from zero
+func (c natGwCounters) sub(last natGwCounters) natGwCounters {
+	diff := func(current, last uint64) uint64 {
+		if current < last {
+			return current
+		}
+		return current - last
+	}
+	return natGwCounters{
+		In:  util.GwIPTablesCounters{Packets: diff(c.In.Packets, last.In.Packets), PacketBytes: diff(c.In.PacketBytes, last.In.PacketBytes)},
+		Out: util.GwIPTablesCounters{Packets: diff(c.Out.Packets, last.Out.Packets), PacketBytes: diff(c.Out.PacketBytes, last.Out.PacketBytes)},
+	}
+}
+
+// natGwCounterTarget is the custom resource a traffic counter is reported for
+type natGwCounterTarget struct {
+	Vpc   string
+	NatGw string
+	Kind  string
+	Name  string
+}
+
+type natGwCounterCache struct {
+	// counters of the last collection per nat gateway pod
+	counters map[types.UID]map[string]natGwCounters
+	// targets with metrics reported by the last collection
+	targets map[natGwCounterTarget]struct{}
+}
+
+// the counter ids must be kept in sync with nat-gateway.sh
+func natGwEipCounterID(v4ip string) string {
+	return "eip:" + v4ip
+}
+
+func natGwFipCounterID(v4ip string) string {
+	return "fip:" + v4ip
+}
+
+func natGwDnatCounterID(v4ip, protocol, externalPort string) string {
+	return fmt.Sprintf("dnat:%s:%s:%s", v4ip, strings.ToLower(protocol), externalPort)
+}
+
+func natGwSnatCounterID(v4ip, internalCIDR string) string {
+	return fmt.Sprintf("snat:%s:%s", v4ip, normalizeSnatInternalCIDR(internalCIDR))
+}
+
+// parseNatGwCounters parses the output of the get-counters operation of nat-gateway.sh,
+// which prints a "<chain> <packets> <bytes> <id>" line per counter rule
+func parseNatGwCounters(output string) map[string]natGwCounters {
+	result := make(map[string]natGwCounters)
+	for line := range strings.Lines(output) {
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			continue
+		}
+		packets, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			klog.Warningf("failed to parse packets of nat gw counter %q: %v", line, err)
+			continue
+		}
+		packetBytes, err := strconv.ParseUint(fields[2], 10, 64)
+		if err != nil {
+			klog.Warningf("failed to parse bytes of nat gw counter %q: %v", line, err)
+			continue
+		}
+
+		counters := result[fields[3]]
+		switch fields[0] {
+		case natGwCounterChainIn:
+			counters.In.Packets += packets
+			counters.In.PacketBytes += packetBytes
+		case natGwCounterChainOut:
+			counters.Out.Packets += packets
+			counters.Out.PacketBytes += packetBytes
+		default:
+			continue
+		}
+		result[fields[3]] = counters
+	}
+	return result
+}
+
+// natGwCounterTargets maps the counter ids of a nat gateway to the custom resources
+func natGwCounterTargets(gw *kubeovnv1.VpcNatGateway, eips []*kubeovnv1.IptablesEIP, fips []*kubeovnv1.IptablesFIPRule,
+	dnats []*kubeovnv1.IptablesDnatRule, snats []*kubeovnv1.IptablesSnatRule,
+) map[string]natGwCounterTarget {
+	targets := make(map[string]natGwCounterTarget)
+	target := func(kind, name string) natGwCounterTarget {
+		return natGwCounterTarget{Vpc: gw.Spec.Vpc, NatGw: gw.Name, Kind: kind, Name: name}
+	}
+	for _, eip := range eips {
+		if eip.Spec.NatGwDp == gw.Name && eip.Status.IP != "" {
+			targets[natGwEipCounterID(eip.Status.IP)] = target(util.KindIptablesEIP, eip.Name)
+		}
+	}
+	for _, fip := range fips {
+		if fip.Status.NatGwDp == gw.Name && fip.Status.V4ip != "" {
+			targets[natGwFipCounterID(fip.Status.V4ip)] = target(util.KindIptablesFIPRule, fip.Name)
+		}
+	}
+	for _, dnat := range dnats {
+		if dnat.Status.NatGwDp == gw.Name && dnat.Status.V4ip != "" {
+			targets[natGwDnatCounterID(dnat.Status.V4ip, dnat.Status.Protocol, dnat.Status.ExternalPort)] = target(util.KindIptablesDnatRule, dnat.Name)
+		}
+	}
+	for _, snat := range snats {
+		if snat.Status.NatGwDp == gw.Name && snat.Status.V4ip != "" {
+			targets[natGwSnatCounterID(snat.Status.V4ip, snat.Status.InternalCIDR)] = target(util.KindIptablesSnatRule, snat.Name)
+		}
+	}
+	return targets
+}
+
+func (c *Controller) getNatGwCounters(pod *corev1.Pod) (map[string]natGwCounters, error) {
+	cmd := "bash /kube-ovn/nat-gateway.sh " + natGwGetCounters
+	klog.V(5).Info(cmd)
+	stdOutput, errOutput, err := util.ExecuteCommandInContainer(c.config.KubeClient, c.config.KubeRestConfig, pod.Namespace, pod.Name, "vpc-nat-gw", []string{"/bin/bash", "-c", cmd}...)
+	if err != nil {
+		if len(errOutput) > 0 {
+			klog.Errorf("failed to ExecuteCommandInContainer, errOutput: %v", errOutput)
+		}
+		return nil, fmt.Errorf("failed to get traffic counters of pod %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+	return parseNatGwCounters(stdOutput), nil
+}
+
+// syncNatGwCounters collects the traffic counters from the running vpc nat gateway pods,
+// reports the traffic since the last collection as metrics and optionally summarizes
+// the counters in the status of the iptables eips and nat rules
+func (c *Controller) syncNatGwCounters() {
+	gws, err := c.vpcNatGatewayLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list vpc nat gateways: %v", err)
+		return
+	}
+	eips, err := c.iptablesEipsLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list iptables eips: %v", err)
+		return
+	}
+	fips, err := c.iptablesFipsLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list iptables fips: %v", err)
+		return
+	}
+	dnats, err := c.iptablesDnatRulesLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list iptables dnat rules: %v", err)
+		return
+	}
+	snats, err := c.iptablesSnatRulesLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list iptables snat rules: %v", err)
+		return
+	}
+
+	if c.natGwCounterCache == nil {
+		c.natGwCounterCache = &natGwCounterCache{
+			counters: make(map[types.UID]map[string]natGwCounters),
+			targets:  make(map[natGwCounterTarget]struct{}),
+		}
+	}
+	cache := c.natGwCounterCache
+	counters := make(map[types.UID]map[string]natGwCounters)
+	targets := make(map[natGwCounterTarget]struct{})
+	totals := make(map[natGwCounterTarget]natGwCounters)
+	for _, gw := range gws {
+		idTargets := natGwCounterTargets(gw, eips, fips, dnats, snats)
+		for _, target := range idTargets {
+			targets[target] = struct{}{}
+		}
+
+		pods, err := c.podsLister.Pods(c.natGwNamespace(gw)).List(labels.Set(util.GenNatGwLabels(gw.Name)).AsSelector())
+		if err != nil {
+			klog.Errorf("failed to list pods of vpc nat gateway %s: %v", gw.Name, err)
+			continue
+		}
+		for _, pod := range pods {
+			if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+				continue
+			}
+			current, err := c.getNatGwCounters(pod)
+			if err != nil {
+				klog.Error(err)
+				// keep the last observation to count the traffic at the next collection
+				if last, ok := cache.counters[pod.UID]; ok {
+					counters[pod.UID] = last
+				}
+				continue
+			}
+			counters[pod.UID] = current
+
+			// the first observation of a pod only initializes the counters
+			last, known := cache.counters[pod.UID]
+			for id, value := range current {
+				target, ok := idTargets[id]
+				if !ok {
+					continue
+				}
+				totals[target] = totals[target].add(value)
+				if known {
+					addNatGwTrafficMetrics(target, value.sub(last[id]))
+				}
+			}
+		}
+	}
+
+	for target := range cache.targets {
+		if _, ok := targets[target]; !ok {
+			matchLabels := prometheus.Labels{"kind": target.Kind, "name": target.Name}
+			metricNatGwTrafficPackets.DeletePartialMatch(matchLabels)
+			metricNatGwTrafficBytes.DeletePartialMatch(matchLabels)
+		}
+	}
+	cache.counters, cache.targets = counters, targets
+
+	if !c.config.EnableNatCounterStatus {
+		return
+	}
+	for target, total := range totals {
+		if err = c.patchNatTrafficStatus(target, total); err != nil {
+			klog.Error(err)
+		}
+	}
+}
+
+func addNatGwTrafficMetrics(target natGwCounterTarget, delta natGwCounters) {
+	metricNatGwTrafficPackets.WithLabelValues(target.Vpc, target.NatGw, target.Kind, target.Name, natGwTrafficIngress).Add(float64(delta.In.Packets))
+	metricNatGwTrafficBytes.WithLabelValues(target.Vpc, target.NatGw, target.Kind, target.Name, natGwTrafficIngress).Add(float64(delta.In.PacketBytes))
+	metricNatGwTrafficPackets.WithLabelValues(target.Vpc, target.NatGw, target.Kind, target.Name, natGwTrafficEgress).Add(float64(delta.Out.Packets))
+	metricNatGwTrafficBytes.WithLabelValues(target.Vpc, target.NatGw, target.Kind, target.Name, natGwTrafficEgress).Add(float64(delta.Out.PacketBytes))
+}
+
+// natTrafficStatusChanged reports whether the traffic summary differs from the counters
+func natTrafficStatusChanged(status *kubeovnv1.NatTrafficStatus, total natGwCounters) bool {
+	if status == nil {
+		return true
+	}
+	return status.RxPackets != int64(total.In.Packets) || status.RxBytes != int64(total.In.PacketBytes) ||
+		status.TxPackets != int64(total.Out.Packets) || status.TxBytes != int64(total.Out.PacketBytes)
+}
+
+func (c *Controller) patchNatTrafficStatus(target natGwCounterTarget, total natGwCounters) error {
+	var status *kubeovnv1.NatTrafficStatus
+	switch target.Kind {
+	case util.KindIptablesEIP:
+		eip, err := c.iptablesEipsLister.Get(target.Name)
+		if err != nil {
+			return nil
+		}
+		status = eip.Status.Traffic
+	case util.KindIptablesFIPRule:
+		fip, err := c.iptablesFipsLister.Get(target.Name)
+		if err != nil {
+			return nil
+		}
+		status = fip.Status.Traffic
+	case util.KindIptablesDnatRule:
+		dnat, err := c.iptablesDnatRulesLister.Get(target.Name)
+		if err != nil {
+			return nil
+		}
+		status = dnat.Status.Traffic
+	case util.KindIptablesSnatRule:
+		snat, err := c.iptablesSnatRulesLister.Get(target.Name)
+		if err != nil {
+			return nil
+		}
+		status = snat.Status.Traffic
+	}
+	if !natTrafficStatusChanged(status, total) {
+		return nil
+	}
+
+	traffic := &kubeovnv1.NatTrafficStatus{
+		RxPackets:  int64(total.In.Packets),
+		RxBytes:    int64(total.In.PacketBytes),
+		TxPackets:  int64(total.Out.Packets),
+		TxBytes:    int64(total.Out.PacketBytes),
+		UpdateTime: metav1.Now(),
+	}
+	patch, err := json.Marshal(map[string]any{"status": map[string]any{"traffic": traffic}})
+	if err != nil {
+		return fmt.Errorf("failed to generate traffic status patch for %s %s: %w", target.Kind, target.Name, err)
+	}
+
+	client := c.config.KubeOvnClient.KubeovnV1()
+	switch target.Kind {
+	case util.KindIptablesEIP:
+		_, err = client.IptablesEIPs().Patch(context.Background(), target.Name, types.MergePatchType, patch, metav1.PatchOptions{}, "status")
+	case util.KindIptablesFIPRule:
+		_, err = client.IptablesFIPRules().Patch(context.Background(), target.Name, types.MergePatchType, patch, metav1.PatchOptions{}, "status")
+	case util.KindIptablesDnatRule:
+		_, err = client.IptablesDnatRules().Patch(context.Background(), target.Name, types.MergePatchType, patch, metav1.PatchOptions{}, "status")
+	case util.KindIptablesSnatRule:
+		_, err = client.IptablesSnatRules().Patch(context.Background(), target.Name, types.MergePatchType, patch, metav1.PatchOptions{}, "status")
+	}
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to patch traffic status of %s %s: %w", target.Kind, target.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+func TestParseNatGwCounters(t *testing.T) {
+	output := `NAT_COUNTER_IN 10 1000 eip:172.18.0.10
+NAT_COUNTER_IN 5 500 eip:172.18.0.10
+NAT_COUNTER_OUT 20 2000 eip:172.18.0.10
+NAT_COUNTER_OUT 3 300 snat:172.18.0.11:10.0.0.0/24
+NAT_COUNTER_FOO 1 1 eip:172.18.0.10
+NAT_COUNTER_IN x 1 eip:172.18.0.12
+iptables: No chain/target/match by that name.
+`
+	counters := parseNatGwCounters(output)
+	require.Len(t, counters, 2)
+	require.Equal(t, natGwCounters{
+		In:  util.GwIPTablesCounters{Packets: 15, PacketBytes: 1500},
+		Out: util.GwIPTablesCounters{Packets: 20, PacketBytes: 2000},
+	}, counters["eip:172.18.0.10"])
+	require.Equal(t, natGwCounters{
+		Out: util.GwIPTablesCounters{Packets: 3, PacketBytes: 300},
+	}, counters["snat:172.18.0.11:10.0.0.0/24"])
+	require.Empty(t, parseNatGwCounters(""))
+}
+
+func TestNatGwCountersSub(t *testing.T) {
+	last := natGwCounters{
+		In:  util.GwIPTablesCounters{Packets: 10, PacketBytes: 1000},
+		Out: util.GwIPTablesCounters{Packets: 20, PacketBytes: 2000},
+	}
+	current := natGwCounters{
+		In:  util.GwIPTablesCounters{Packets: 15, PacketBytes: 1500},
+		Out: util.GwIPTablesCounters{Packets: 2, PacketBytes: 200},
+	}
+	// the egress counter has been reset and is counted from zero
+	require.Equal(t, natGwCounters{
+		In:  util.GwIPTablesCounters{Packets: 5, PacketBytes: 500},
+		Out: util.GwIPTablesCounters{Packets: 2, PacketBytes: 200},
+	}, current.sub(last))
+	require.Equal(t, current, current.sub(natGwCounters{}))
+}
+
+func TestNatGwCounterTargets(t *testing.T) {
+	gw := &kubeovnv1.VpcNatGateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw1"},
+		Spec:       kubeovnv1.VpcNatGatewaySpec{Vpc: "vpc1"},
+	}
+	eips := []*kubeovnv1.IptablesEIP{
+		{ObjectMeta: metav1.ObjectMeta{Name: "eip1"}, Spec: kubeovnv1.IptablesEIPSpec{NatGwDp: "gw1"}, Status: kubeovnv1.IptablesEIPStatus{IP: "172.18.0.10"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "eip2"}, Spec: kubeovnv1.IptablesEIPSpec{NatGwDp: "gw2"}, Status: kubeovnv1.IptablesEIPStatus{IP: "172.18.0.20"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "eip3"}, Spec: kubeovnv1.IptablesEIPSpec{NatGwDp: "gw1"}},
+	}
+	fips := []*kubeovnv1.IptablesFIPRule{
+		{ObjectMeta: metav1.ObjectMeta{Name: "fip1"}, Status: kubeovnv1.IptablesFIPRuleStatus{NatGwDp: "gw1", V4ip: "172.18.0.11"}},
+	}
+	dnats := []*kubeovnv1.IptablesDnatRule{
+		{ObjectMeta: metav1.ObjectMeta{Name: "dnat1"}, Status: kubeovnv1.IptablesDnatRuleStatus{NatGwDp: "gw1", V4ip: "172.18.0.10", Protocol: "TCP", ExternalPort: "8080"}},
+	}
+	snats := []*kubeovnv1.IptablesSnatRule{
+		{ObjectMeta: metav1.ObjectMeta{Name: "snat1"}, Status: kubeovnv1.IptablesSnatRuleStatus{NatGwDp: "gw1", V4ip: "172.18.0.10", InternalCIDR: "10.0.0.5"}},
+	}
+
+	target := func(kind, name string) natGwCounterTarget {
+		return natGwCounterTarget{Vpc: "vpc1", NatGw: "gw1", Kind: kind, Name: name}
+	}
+	require.Equal(t, map[string]natGwCounterTarget{
+		"eip:172.18.0.10":              target(util.KindIptablesEIP, "eip1"),
+		"fip:172.18.0.11":              target(util.KindIptablesFIPRule, "fip1"),
+		"dnat:172.18.0.10:tcp:8080":    target(util.KindIptablesDnatRule, "dnat1"),
+		"snat:172.18.0.10:10.0.0.5/32": target(util.KindIptablesSnatRule, "snat1"),
+	}, natGwCounterTargets(gw, eips, fips, dnats, snats))
+}
+
+func TestNatTrafficStatusChanged(t *testing.T) {
+	total := natGwCounters{
+		In:  util.GwIPTablesCounters{Packets: 15, PacketBytes: 1500},
+		Out: util.GwIPTablesCounters{Packets: 20, PacketBytes: 2000},
+	}
+	require.True(t, natTrafficStatusChanged(nil, total))
+	status := &kubeovnv1.NatTrafficStatus{RxPackets: 15, RxBytes: 1500, TxPackets: 20, TxBytes: 2000}
+	require.False(t, natTrafficStatusChanged(status, total))
+	status.TxBytes = 1000
+	require.True(t, natTrafficStatusChanged(status, total))
+}
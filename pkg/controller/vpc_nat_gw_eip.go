@@ -18,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/natgwagent"
 	"github.com/kubeovn/kube-ovn/pkg/ovs"
 	"github.com/kubeovn/kube-ovn/pkg/util"
 )
@@ -151,7 +152,7 @@ func (c *Controller) handleAddIptablesEip(key string) error {
 		return err
 	}
 
-	if err = c.createEipInPod(cachedEip.Spec.NatGwDp, addrV4, c.natEipNamespace(cachedEip)); err != nil {
+	if err = c.createEipInPod(cachedEip.Spec.NatGwDp, addrV4, c.natEipNamespace(cachedEip), natGwRuleOwner(util.KindIptablesEIP, cachedEip)); err != nil {
 		klog.Errorf("failed to create eip '%s' in pod, %v", key, err)
 		return err
 	}
@@ -352,7 +353,7 @@ func (c *Controller) handleUpdateIptablesEip(key string) error {
 			klog.Error(err)
 			return err
 		}
-		if err = c.createEipInPod(cachedEip.Spec.NatGwDp, addrV4, c.natEipNamespace(cachedEip)); err != nil {
+		if err = c.createEipInPod(cachedEip.Spec.NatGwDp, addrV4, c.natEipNamespace(cachedEip), natGwRuleOwner(util.KindIptablesEIP, cachedEip)); err != nil {
 			klog.Errorf("failed to create eip, %v", err)
 			return err
 		}
@@ -402,7 +403,7 @@ func (c *Controller) GetEip(eipName string) (*kubeovnv1.IptablesEIP, error) {
 	return eip, nil
 }
 
-func (c *Controller) createEipInPod(dp, addrV4, ns string) error {
+func (c *Controller) createEipInPod(dp, addrV4, ns string, owner *natgwagent.Owner) error {
 	gwPods, err := c.getNatGwPods(dp, ns, false)
 	if err != nil {
 		klog.Error(err)
@@ -410,7 +411,7 @@ func (c *Controller) createEipInPod(dp, addrV4, ns string) error {
 	}
 	var errs []error
 	for _, gwPod := range gwPods {
-		if err = c.execNatGwRules(gwPod, natGwEipAdd, []string{addrV4}); err != nil {
+		if err = c.execNatGwRulesFor(gwPod, natGwEipAdd, []string{addrV4}, owner); err != nil {
 			klog.Errorf("failed to create eip in pod %s/%s, err: %v", gwPod.Namespace, gwPod.Name, err)
 			errs = append(errs, err)
 		}
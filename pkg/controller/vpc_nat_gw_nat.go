@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/natgwagent"
 	"github.com/kubeovn/kube-ovn/pkg/util"
 )
 
@@ -165,7 +167,8 @@ func (c *Controller) enqueueUpdateIptablesSnatRule(oldObj, newObj any) {
 	if oldSnat.Status.V4ip != newSnat.Status.V4ip ||
 		oldSnat.Spec.EIP != newSnat.Spec.EIP ||
 		oldSnat.Status.Redo != newSnat.Status.Redo ||
-		oldSnat.Spec.InternalCIDR != newSnat.Spec.InternalCIDR {
+		oldSnat.Spec.InternalCIDR != newSnat.Spec.InternalCIDR ||
+		!reflect.DeepEqual(oldSnat.Spec.PortBlock, newSnat.Spec.PortBlock) {
 		klog.V(3).Infof("enqueue update snat %s", key)
 		c.updateIptablesSnatRuleQueue.Add(key)
 		return
@@ -259,7 +262,7 @@ func (c *Controller) handleAddIptablesFip(key string) error {
 		return err
 	}
 
-	if err = c.createFipInPod(eip.Spec.NatGwDp, eip.Status.IP, fip.Spec.InternalIP); err != nil {
+	if err = c.createFipInPod(eip.Spec.NatGwDp, eip.Status.IP, fip.Spec.InternalIP, natGwRuleOwner(util.KindIptablesFIPRule, fip)); err != nil {
 		klog.Errorf("failed to create fip, %v", err)
 		return err
 	}
@@ -443,7 +446,7 @@ func (c *Controller) handleUpdateIptablesFip(key string) error {
 		if err = c.finalDeleteFipInPod(key, cachedFip); err != nil {
 			return err
 		}
-		if err = c.createFipInPod(eip.Spec.NatGwDp, newV4ip, newInternalIP); err != nil {
+		if err = c.createFipInPod(eip.Spec.NatGwDp, newV4ip, newInternalIP, natGwRuleOwner(util.KindIptablesFIPRule, cachedFip)); err != nil {
 			klog.Errorf("failed to create fip %s, %v", key, err)
 			return err
 		}
@@ -500,7 +503,7 @@ func (c *Controller) handleUpdateIptablesFip(key string) error {
 			klog.V(3).Infof("fip %s: all pods started before redo mark, rules intact, skip", key)
 			return nil
 		}
-		if err = c.createFipInPod(cachedFip.Status.NatGwDp, cachedFip.Status.V4ip, cachedFip.Status.InternalIP); err != nil {
+		if err = c.createFipInPod(cachedFip.Status.NatGwDp, cachedFip.Status.V4ip, cachedFip.Status.InternalIP, natGwRuleOwner(util.KindIptablesFIPRule, cachedFip)); err != nil {
 			klog.Errorf("failed to create fip, %v", err)
 			return err
 		}
@@ -598,7 +601,7 @@ func (c *Controller) handleAddIptablesDnatRule(key string) error {
 		// Exclusive type (default): use iptables DNAT
 		if err = c.createDnatInPod(eip.Spec.NatGwDp, dnat.Spec.Protocol,
 			eip.Status.IP, dnat.Spec.InternalIP,
-			dnat.Spec.ExternalPort, dnat.Spec.InternalPort); err != nil {
+			dnat.Spec.ExternalPort, dnat.Spec.InternalPort, natGwRuleOwner(util.KindIptablesDnatRule, dnat)); err != nil {
 			klog.Errorf("failed to create dnat, %v", err)
 			return err
 		}
@@ -771,7 +774,7 @@ func (c *Controller) handleUpdateIptablesDnatRule(key string) error {
 			// Exclusive type: use iptables DNAT
 			if err = c.createDnatInPod(eip.Spec.NatGwDp, newProtocol,
 				newV4ip, newInternalIP,
-				newExternalPort, newInternalPort); err != nil {
+				newExternalPort, newInternalPort, natGwRuleOwner(util.KindIptablesDnatRule, cachedDnat)); err != nil {
 				klog.Errorf("failed to create dnat %s, %v", key, err)
 				return err
 			}
@@ -852,7 +855,7 @@ func (c *Controller) handleUpdateIptablesDnatRule(key string) error {
 			// Exclusive type: use iptables DNAT
 			if err = c.createDnatInPod(cachedDnat.Status.NatGwDp, cachedDnat.Status.Protocol,
 				cachedDnat.Status.V4ip, cachedDnat.Status.InternalIP,
-				cachedDnat.Status.ExternalPort, cachedDnat.Status.InternalPort); err != nil {
+				cachedDnat.Status.ExternalPort, cachedDnat.Status.InternalPort, natGwRuleOwner(util.KindIptablesDnatRule, cachedDnat)); err != nil {
 				klog.Errorf("failed to create dnat %s, %v", key, err)
 				return err
 			}
@@ -934,10 +937,18 @@ func (c *Controller) handleAddIptablesSnatRule(key string) error {
 		klog.Errorf("failed to handle add finalizer for snat, %v", err)
 		return err
 	}
-	if err = c.createSnatInPod(eip.Spec.NatGwDp, eip.Status.IP, v4Cidr); err != nil {
+	if err = c.createSnatInPod(eip.Spec.NatGwDp, eip.Status.IP, v4Cidr, snat.Spec.PortBlock, natGwRuleOwner(util.KindIptablesSnatRule, snat)); err != nil {
 		klog.Errorf("failed to create snat, %v", err)
 		return err
 	}
+	// the allocation is already recorded when the status has it
+	v4Cidr = normalizeSnatInternalCIDR(v4Cidr)
+	if snat.Status.V4ip != eip.Status.IP || snat.Status.InternalCIDR != v4Cidr || !reflect.DeepEqual(snat.Status.PortBlock, snat.Spec.PortBlock) {
+		if err = c.recordSnatPortBlocks(snat, snatPortBlockReasonAllocated, eip.Spec.NatGwDp, eip.Status.IP, v4Cidr, snat.Spec.PortBlock); err != nil {
+			klog.Errorf("failed to record port blocks of snat %s, %v", key, err)
+			return err
+		}
+	}
 	if err = c.patchSnatStatus(key, eip.Status.IP, eip.Spec.V6ip, eip.Spec.NatGwDp, "", true); err != nil {
 		klog.Errorf("failed to update status for snat %s, %v", key, err)
 		return err
@@ -1065,7 +1076,7 @@ func (c *Controller) handleUpdateIptablesSnatRule(key string) error {
 		return nil
 	}
 
-	if oldV4ip != newV4ip || oldV4Cidr != newV4Cidr {
+	if oldV4ip != newV4ip || oldV4Cidr != newV4Cidr || !reflect.DeepEqual(cachedSnat.Status.PortBlock, cachedSnat.Spec.PortBlock) {
 		// Mark SNAT as not ready before starting the update.
 		// This ensures that if the controller crashes or the update fails midway,
 		// the resource will be left in a non-ready state, indicating a potential inconsistency.
@@ -1080,10 +1091,14 @@ func (c *Controller) handleUpdateIptablesSnatRule(key string) error {
 		if err = c.finalDeleteSnatInPod(key, cachedSnat); err != nil {
 			return err
 		}
-		if err = c.createSnatInPod(eip.Spec.NatGwDp, newV4ip, newV4Cidr); err != nil {
+		if err = c.createSnatInPod(eip.Spec.NatGwDp, newV4ip, newV4Cidr, cachedSnat.Spec.PortBlock, natGwRuleOwner(util.KindIptablesSnatRule, cachedSnat)); err != nil {
 			klog.Errorf("failed to create snat %s, %v", key, err)
 			return err
 		}
+		if err = c.recordSnatPortBlocks(cachedSnat, snatPortBlockReasonAllocated, eip.Spec.NatGwDp, newV4ip, newV4Cidr, cachedSnat.Spec.PortBlock); err != nil {
+			klog.Errorf("failed to record port blocks of snat %s, %v", key, err)
+			return err
+		}
 		if err = c.patchSnatStatus(key, newV4ip, eip.Spec.V6ip, eip.Spec.NatGwDp, "", true); err != nil {
 			klog.Errorf("failed to patch status for snat %s, %v", key, err)
 			return err
@@ -1136,7 +1151,7 @@ func (c *Controller) handleUpdateIptablesSnatRule(key string) error {
 			klog.V(3).Infof("snat %s: all pods started before redo mark, rules intact, skip", key)
 			return nil
 		}
-		if err = c.createSnatInPod(cachedSnat.Status.NatGwDp, cachedSnat.Status.V4ip, cachedSnat.Status.InternalCIDR); err != nil {
+		if err = c.createSnatInPod(cachedSnat.Status.NatGwDp, cachedSnat.Status.V4ip, cachedSnat.Status.InternalCIDR, cachedSnat.Status.PortBlock, natGwRuleOwner(util.KindIptablesSnatRule, cachedSnat)); err != nil {
 			klog.Errorf("failed to create new snat, %v", err)
 			return err
 		}
@@ -1744,6 +1759,10 @@ func (c *Controller) patchSnatStatus(key, v4ip, v6ip, natGwDp, redo string, read
 			}
 		}
 	}
+	if ready && !reflect.DeepEqual(snat.Status.PortBlock, snat.Spec.PortBlock) {
+		snat.Status.PortBlock = snat.Spec.PortBlock
+		changed = true
+	}
 
 	if changed {
 		bytes, err := snat.Status.Bytes()
@@ -1789,7 +1808,7 @@ func (c *Controller) redoSnat(key, redo string, eipReady bool) error {
 	return nil
 }
 
-func (c *Controller) createFipInPod(dp, v4ip, internalIP string) error {
+func (c *Controller) createFipInPod(dp, v4ip, internalIP string, owner *natgwagent.Owner) error {
 	gwPods, err := c.getNatGwPods(dp, c.natGwNamespaceByName(dp), false)
 	if err != nil {
 		klog.Error(err)
@@ -1800,7 +1819,7 @@ func (c *Controller) createFipInPod(dp, v4ip, internalIP string) error {
 	addRules = append(addRules, rule)
 	var firstErr error
 	for _, gwPod := range gwPods {
-		if err = c.execNatGwRules(gwPod, natGwSubnetFipAdd, addRules); err != nil {
+		if err = c.execNatGwRulesFor(gwPod, natGwSubnetFipAdd, addRules, owner); err != nil {
 			klog.Errorf("failed to create fip in pod %s/%s, err: %v", gwPod.Namespace, gwPod.Name, err)
 			if firstErr == nil {
 				firstErr = err
@@ -2004,9 +2023,12 @@ func (c *Controller) finalDeleteSnatInPod(key string, cachedSnat *kubeovnv1.Ipta
 	}
 	if statusV4ip == "" || statusNatGwDp == "" {
 		klog.Warningf("snat %s: skip status-based cleanup due to incomplete identity (v4ip=%q, natGwDp=%q)", key, statusV4ip, statusNatGwDp)
-	} else if err := c.deleteSnatInPod(statusNatGwDp, statusV4ip, statusV4Cidr); err != nil {
+	} else if err := c.deleteSnatInPod(statusNatGwDp, statusV4ip, statusV4Cidr, cachedSnat.Status.PortBlock); err != nil {
 		klog.Errorf("failed to delete snat %s, %v", key, err)
 		firstErr = err
+	} else if err := c.recordSnatPortBlocks(cachedSnat, snatPortBlockReasonReleased, statusNatGwDp, statusV4ip, normalizeSnatInternalCIDR(statusV4Cidr), cachedSnat.Status.PortBlock); err != nil {
+		klog.Errorf("failed to record port blocks of snat %s, %v", key, err)
+		firstErr = err
 	}
 
 	// Spec-change crash: Status has old IP (V4ip != "") but Ready=false means a spec
@@ -2029,7 +2051,7 @@ func (c *Controller) finalDeleteSnatInPod(key string, cachedSnat *kubeovnv1.Ipta
 			return firstErr
 		}
 		if specV4ip != statusV4ip || specNatGwDp != statusNatGwDp || specV4Cidr != statusV4Cidr {
-			if err = c.deleteSnatInPod(specNatGwDp, specV4ip, specV4Cidr); err != nil {
+			if err = c.deleteSnatInPod(specNatGwDp, specV4ip, specV4Cidr, cachedSnat.Spec.PortBlock); err != nil {
 				klog.Errorf("failed spec-based cleanup for snat %s, %v", key, err)
 				if firstErr == nil {
 					firstErr = err
@@ -2077,7 +2099,7 @@ func (c *Controller) deleteFipInPod(dp, v4ip string) error {
 	return firstErr
 }
 
-func (c *Controller) createDnatInPod(dp, protocol, v4ip, internalIP, externalPort, internalPort string) error {
+func (c *Controller) createDnatInPod(dp, protocol, v4ip, internalIP, externalPort, internalPort string, owner *natgwagent.Owner) error {
 	gwPods, err := c.getNatGwPods(dp, c.natGwNamespaceByName(dp), false)
 	if err != nil {
 		klog.Errorf("failed to get nat gw pods, %v", err)
@@ -2089,7 +2111,7 @@ func (c *Controller) createDnatInPod(dp, protocol, v4ip, internalIP, externalPor
 
 	var firstErr error
 	for _, gwPod := range gwPods {
-		if err = c.execNatGwRules(gwPod, natGwDnatAdd, addRules); err != nil {
+		if err = c.execNatGwRulesFor(gwPod, natGwDnatAdd, addRules, owner); err != nil {
 			klog.Errorf("failed to create dnat in pod %s/%s, err: %v", gwPod.Namespace, gwPod.Name, err)
 			if firstErr == nil {
 				firstErr = err
@@ -2139,7 +2161,7 @@ func (c *Controller) deleteDnatInPod(dp, protocol, v4ip, externalPort string) er
 	return firstErr
 }
 
-func (c *Controller) createSnatInPod(dp, v4ip, internalCIDR string) error {
+func (c *Controller) createSnatInPod(dp, v4ip, internalCIDR string, portBlock *kubeovnv1.SnatPortBlock, owner *natgwagent.Owner) error {
 	internalCIDR = normalizeSnatInternalCIDR(internalCIDR)
 	gwPods, err := c.getNatGwPods(dp, c.natGwNamespaceByName(dp), false)
 	if err != nil {
@@ -2152,28 +2174,42 @@ func (c *Controller) createSnatInPod(dp, v4ip, internalCIDR string) error {
 		var rules []string
 		rule := fmt.Sprintf("%s,%s", v4ip, internalCIDR)
 
-		version, err := c.getIptablesVersion(gwPod)
-		if err != nil {
-			version = "1.0.0"
-			klog.Warningf("failed to checking iptables version, assuming version at least %s: %v", version, err)
-		}
-		if util.CompareVersion(version, "1.6.2") >= 1 {
-			rule = fmt.Sprintf("%s,%s", rule, "--random-fully")
+		// the agent checks the iptables version by itself
+		if !natGwAgentEnabled(gwPod) {
+			version, err := c.getIptablesVersion(gwPod)
+			if err != nil {
+				version = "1.0.0"
+				klog.Warningf("failed to checking iptables version, assuming version at least %s: %v", version, err)
+			}
+			if util.CompareVersion(version, "1.6.2") >= 1 {
+				rule = fmt.Sprintf("%s,%s", rule, "--random-fully")
+			}
 		}
 
 		rules = append(rules, rule)
-		if err = c.execNatGwRules(gwPod, natGwSnatAdd, rules); err != nil {
+		if err = c.execNatGwRulesFor(gwPod, natGwSnatAdd, rules, owner); err != nil {
 			klog.Errorf("failed to exec nat gateway rule in pod %s/%s, err: %v", gwPod.Namespace, gwPod.Name, err)
 			if firstErr == nil {
 				firstErr = err
 			}
 			// Continue to sync remaining pods even if one fails
+			continue
+		}
+		if portBlock != nil {
+			start, _ := util.SnatPortBlockRange(portBlock)
+			rules = []string{fmt.Sprintf("%s,%s,%d,%d", v4ip, internalCIDR, start, portBlock.BlockSize)}
+			if err = c.execNatGwRulesFor(gwPod, natGwSnatPortBlockAdd, rules, owner); err != nil {
+				klog.Errorf("failed to add snat port blocks in pod %s/%s, err: %v", gwPod.Namespace, gwPod.Name, err)
+				if firstErr == nil {
+					firstErr = err
+				}
+			}
 		}
 	}
 	return firstErr
 }
 
-func (c *Controller) deleteSnatInPod(dp, v4ip, internalCIDR string) error {
+func (c *Controller) deleteSnatInPod(dp, v4ip, internalCIDR string, portBlock *kubeovnv1.SnatPortBlock) error {
 	internalCIDR = normalizeSnatInternalCIDR(internalCIDR)
 
 	// If the NAT gateway CRD is gone the gateway (and its pod) have been deleted;
@@ -2204,6 +2240,15 @@ func (c *Controller) deleteSnatInPod(dp, v4ip, internalCIDR string) error {
 	delRules = append(delRules, rule)
 	var firstErr error
 	for _, gwPod := range gwPods {
+		if portBlock != nil {
+			if err = c.execNatGwRules(gwPod, natGwSnatPortBlockDel, delRules); err != nil {
+				klog.Errorf("failed to delete snat port blocks in pod %s/%s, err: %v", gwPod.Namespace, gwPod.Name, err)
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+		}
 		if err = c.execNatGwRules(gwPod, natGwSnatDel, delRules); err != nil {
 			klog.Errorf("failed to delete snat in pod %s/%s, err: %v", gwPod.Namespace, gwPod.Name, err)
 			if firstErr == nil {
@@ -2378,6 +2423,11 @@ func (c *Controller) validateSnatRule(snat *kubeovnv1.IptablesSnatRule) error {
 		klog.Error(err)
 		return err
 	}
+	if _, err = util.GenSnatPortBlockMappings("", normalizeSnatInternalCIDR(internalCIDR), snat.Spec.PortBlock); err != nil {
+		err = fmt.Errorf("%s: invalid port block: %w", snat.Name, err)
+		klog.Error(err)
+		return err
+	}
 	return nil
 }
 
@@ -538,7 +538,7 @@ func TestDeleteSnatInPod_NatGwGone(t *testing.T) {
 	t.Parallel()
 	fc, err := newFakeControllerWithOptions(t, nil)
 	require.NoError(t, err)
-	err = fc.fakeController.deleteSnatInPod("missing-gw", "10.0.0.1", "192.168.1.0/24")
+	err = fc.fakeController.deleteSnatInPod("missing-gw", "10.0.0.1", "192.168.1.0/24", nil)
 	require.NoError(t, err, "should skip cleanup when gateway CRD is gone")
 }
 
@@ -550,7 +550,7 @@ func TestDeleteSnatInPod_NatGwExistsPodMissing(t *testing.T) {
 		VpcNatGateways: []*kubeovnv1.VpcNatGateway{fakeGw("test-gw")},
 	})
 	require.NoError(t, err)
-	err = fc.fakeController.deleteSnatInPod("test-gw", "10.0.0.1", "192.168.1.0/24")
+	err = fc.fakeController.deleteSnatInPod("test-gw", "10.0.0.1", "192.168.1.0/24", nil)
 	require.Error(t, err, "should return error to retry when pod is temporarily absent")
 }
 
@@ -39,6 +39,9 @@ import (
 	"github.com/kubeovn/kube-ovn/pkg/util"
 )
 
+// vswitchDBAddress is the address of the local ovsdb-server of ovs-vswitchd
+const vswitchDBAddress = "unix:/var/run/openvswitch/db.sock"
+
 // Controller watch pod and namespace changes to update iptables, ipset and ovs qos
 type Controller struct {
 	config *Configuration
@@ -237,7 +240,7 @@ func NewController(config *Configuration,
 		return nil, err
 	}
 
-	if controller.vswitchClient, err = ovs.NewVswitchClient("unix:/var/run/openvswitch/db.sock", 1, 3); err != nil {
+	if controller.vswitchClient, err = ovs.NewVswitchClient(vswitchDBAddress, 1, 3); err != nil {
 		return nil, fmt.Errorf("failed to create vswitch client: %w", err)
 	}
 
@@ -1044,6 +1047,9 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 	}
 	go wait.Until(c.loopEncapIPCheck, 3*time.Second, stopCh)
 	go wait.Until(c.ovnMetricsUpdate, 3*time.Second, stopCh)
+	if c.config.OvnEipCounterInterval != 0 {
+		go wait.Until(c.setOvnEipMetric, time.Duration(c.config.OvnEipCounterInterval)*time.Second, stopCh)
+	}
 	go wait.Until(func() {
 		if err := c.reconcileRouters(nil); err != nil {
 			klog.Errorf("failed to reconcile %s routes: %v", util.NodeNic, err)
@@ -39,12 +39,6 @@ const (
 	kernelModuleIP6Tables = "ip6_tables"
 )
 
-var (
-	setInterfaceBandwidth = ovs.SetInterfaceBandwidth
-	configInterfaceMirror = ovs.ConfigInterfaceMirror
-	setNetemQos           = ovs.SetNetemQos
-)
-
 // ControllerRuntime represents runtime specific controller members
 type ControllerRuntime struct {
 	iptables         map[string]*iptables.IPTables
@@ -53,6 +47,7 @@ type ControllerRuntime struct {
 	k8sipsets        k8sipset.Interface
 	ipsets           map[string]*ipsets.IPSets
 	gwCounters       map[string]*util.GwIPTablesCounters
+	eipCounters      map[ovnEipCounterKey]*ovnEipCounter
 
 	nmSyncer  *networkManagerSyncer
 	ovsClient *ovsutil.Client
@@ -111,6 +106,7 @@ func (c *Controller) initRuntime() error {
 	c.iptables = make(map[string]*iptables.IPTables)
 	c.ipsets = make(map[string]*ipsets.IPSets)
 	c.gwCounters = make(map[string]*util.GwIPTablesCounters)
+	c.eipCounters = make(map[ovnEipCounterKey]*ovnEipCounter)
 	c.k8siptables = make(map[string]k8siptables.Interface)
 	c.k8sipsets = k8sipset.New()
 	c.ovsClient = ovsutil.New()
@@ -893,20 +889,20 @@ func (c *Controller) handleUpdatePod(key string) error {
 	ovsEgress := pod.Annotations[util.IngressRateAnnotation]
 	ovsIngressBurst := pod.Annotations[util.EgressBurstAnnotation]
 	ovsEgressBurst := pod.Annotations[util.IngressBurstAnnotation]
-	err = setInterfaceBandwidth(podName, pod.Namespace, ifaceID, ovsIngress, ovsEgress, ovsIngressBurst, ovsEgressBurst)
+	err = c.vswitchClient.SetInterfaceBandwidth(podName, pod.Namespace, ifaceID, ovsIngress, ovsEgress, ovsIngressBurst, ovsEgressBurst)
 	if err != nil {
 		klog.Error(err)
 		c.recorder.Eventf(pod, v1.EventTypeWarning, "PodQoSUpdateFailed", "Failed to update pod QoS: stage=bandwidth provider=%s interface=%s node=%s: %v", util.OvnProvider, ifaceID, c.config.NodeName, err)
 		return err
 	}
-	err = configInterfaceMirror(c.config.EnableMirror, pod.Annotations[util.MirrorControlAnnotation], ifaceID)
+	err = c.vswitchClient.ConfigInterfaceMirror(c.config.EnableMirror, pod.Annotations[util.MirrorControlAnnotation], ifaceID)
 	if err != nil {
 		klog.Error(err)
 		c.recorder.Eventf(pod, v1.EventTypeWarning, "PodQoSUpdateFailed", "Failed to update pod QoS: stage=mirror provider=%s interface=%s node=%s: %v", util.OvnProvider, ifaceID, c.config.NodeName, err)
 		return err
 	}
 	// set linux-netem qos
-	err = setNetemQos(podName, pod.Namespace, ifaceID, pod.Annotations[util.NetemQosLatencyAnnotation], pod.Annotations[util.NetemQosLimitAnnotation], pod.Annotations[util.NetemQosLossAnnotation], pod.Annotations[util.NetemQosJitterAnnotation])
+	err = c.vswitchClient.SetNetemQos(podName, pod.Namespace, ifaceID, pod.Annotations[util.NetemQosLatencyAnnotation], pod.Annotations[util.NetemQosLimitAnnotation], pod.Annotations[util.NetemQosLossAnnotation], pod.Annotations[util.NetemQosJitterAnnotation])
 	if err != nil {
 		klog.Error(err)
 		c.recorder.Eventf(pod, v1.EventTypeWarning, "PodQoSUpdateFailed", "Failed to update pod QoS: stage=netem provider=%s interface=%s node=%s: %v", util.OvnProvider, ifaceID, c.config.NodeName, err)
@@ -931,7 +927,7 @@ func (c *Controller) handleUpdatePod(key string) error {
 		}
 		if pod.Annotations[fmt.Sprintf(util.AllocatedAnnotationTemplate, provider)] == "true" {
 			ifaceID = ovs.PodNameToPortName(multiNetPodName, pod.Namespace, provider)
-			err = setInterfaceBandwidth(multiNetPodName, pod.Namespace, ifaceID,
+			err = c.vswitchClient.SetInterfaceBandwidth(multiNetPodName, pod.Namespace, ifaceID,
 				pod.Annotations[fmt.Sprintf(util.EgressRateAnnotationTemplate, provider)],
 				pod.Annotations[fmt.Sprintf(util.IngressRateAnnotationTemplate, provider)],
 				pod.Annotations[fmt.Sprintf(util.EgressBurstAnnotationTemplate, provider)],
@@ -941,13 +937,13 @@ func (c *Controller) handleUpdatePod(key string) error {
 				c.recorder.Eventf(pod, v1.EventTypeWarning, "PodQoSUpdateFailed", "Failed to update pod QoS: stage=bandwidth provider=%s interface=%s node=%s: %v", provider, ifaceID, c.config.NodeName, err)
 				return err
 			}
-			err = configInterfaceMirror(c.config.EnableMirror, pod.Annotations[fmt.Sprintf(util.MirrorControlAnnotationTemplate, provider)], ifaceID)
+			err = c.vswitchClient.ConfigInterfaceMirror(c.config.EnableMirror, pod.Annotations[fmt.Sprintf(util.MirrorControlAnnotationTemplate, provider)], ifaceID)
 			if err != nil {
 				klog.Error(err)
 				c.recorder.Eventf(pod, v1.EventTypeWarning, "PodQoSUpdateFailed", "Failed to update pod QoS: stage=mirror provider=%s interface=%s node=%s: %v", provider, ifaceID, c.config.NodeName, err)
 				return err
 			}
-			err = setNetemQos(multiNetPodName, pod.Namespace, ifaceID, pod.Annotations[fmt.Sprintf(util.NetemQosLatencyAnnotationTemplate, provider)], pod.Annotations[fmt.Sprintf(util.NetemQosLimitAnnotationTemplate, provider)], pod.Annotations[fmt.Sprintf(util.NetemQosLossAnnotationTemplate, provider)], pod.Annotations[fmt.Sprintf(util.NetemQosJitterAnnotationTemplate, provider)])
+			err = c.vswitchClient.SetNetemQos(multiNetPodName, pod.Namespace, ifaceID, pod.Annotations[fmt.Sprintf(util.NetemQosLatencyAnnotationTemplate, provider)], pod.Annotations[fmt.Sprintf(util.NetemQosLimitAnnotationTemplate, provider)], pod.Annotations[fmt.Sprintf(util.NetemQosLossAnnotationTemplate, provider)], pod.Annotations[fmt.Sprintf(util.NetemQosJitterAnnotationTemplate, provider)])
 			if err != nil {
 				klog.Error(err)
 				c.recorder.Eventf(pod, v1.EventTypeWarning, "PodQoSUpdateFailed", "Failed to update pod QoS: stage=netem provider=%s interface=%s node=%s: %v", provider, ifaceID, c.config.NodeName, err)
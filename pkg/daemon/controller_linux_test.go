@@ -9,6 +9,7 @@ import (
 	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	"github.com/stretchr/testify/require"
 	"github.com/vishvananda/netlink"
+	"go.uber.org/mock/gomock"
 	"golang.org/x/sys/unix"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -16,6 +17,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 
+	mockovs "github.com/kubeovn/kube-ovn/mocks/pkg/ovs"
 	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
 	"github.com/kubeovn/kube-ovn/pkg/util"
 )
@@ -73,13 +75,13 @@ func TestHandleUpdatePodValidationFailureEmitsOnlyValidationEvent(t *testing.T)
 
 func TestHandleUpdatePodBandwidthFailureEmitsQoSFailureEvent(t *testing.T) {
 	failErr := errors.New("default bandwidth failure")
-	stubPodQoSFunctions(t, "bandwidth", "pod.default", failErr)
 	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
 		Name:        "pod",
 		Namespace:   metav1.NamespaceDefault,
 		Annotations: map[string]string{},
 	}}
 	controller, recorder := newPodQoSTestController(t, pod)
+	stubPodQoSFunctions(t, controller, "bandwidth", "pod.default", failErr)
 
 	require.ErrorIs(t, controller.handleUpdatePod("default/pod"), failErr)
 	requirePodEvent(t, recorder,
@@ -88,40 +90,25 @@ func TestHandleUpdatePodBandwidthFailureEmitsQoSFailureEvent(t *testing.T) {
 	requireNoPodEvent(t, recorder)
 }
 
-func stubPodQoSFunctions(t *testing.T, failStage, failInterface string, failErr error) map[string][]string {
+func stubPodQoSFunctions(t *testing.T, controller *Controller, failStage, failInterface string, failErr error) map[string][]string {
 	t.Helper()
 
-	originalBandwidth := setInterfaceBandwidth
-	originalMirror := configInterfaceMirror
-	originalNetem := setNetemQos
-	t.Cleanup(func() {
-		setInterfaceBandwidth = originalBandwidth
-		configInterfaceMirror = originalMirror
-		setNetemQos = originalNetem
-	})
-
 	calls := map[string][]string{}
-	setInterfaceBandwidth = func(_, _, iface, _, _, _, _ string) error {
-		calls["bandwidth"] = append(calls["bandwidth"], iface)
-		if failStage == "bandwidth" && iface == failInterface {
-			return failErr
-		}
-		return nil
-	}
-	configInterfaceMirror = func(_ bool, _, iface string) error {
-		calls["mirror"] = append(calls["mirror"], iface)
-		if failStage == "mirror" && iface == failInterface {
-			return failErr
-		}
-		return nil
-	}
-	setNetemQos = func(_, _, iface, _, _, _, _ string) error {
-		calls["netem"] = append(calls["netem"], iface)
-		if failStage == "netem" && iface == failInterface {
+	call := func(stage, iface string) error {
+		calls[stage] = append(calls[stage], iface)
+		if failStage == stage && iface == failInterface {
 			return failErr
 		}
 		return nil
 	}
+	vswitchClient := mockovs.NewMockVswitch(gomock.NewController(t))
+	vswitchClient.EXPECT().SetInterfaceBandwidth(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_, _, iface, _, _, _, _ string) error { return call("bandwidth", iface) }).AnyTimes()
+	vswitchClient.EXPECT().ConfigInterfaceMirror(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ bool, _, iface string) error { return call("mirror", iface) }).AnyTimes()
+	vswitchClient.EXPECT().SetNetemQos(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_, _, iface, _, _, _, _ string) error { return call("netem", iface) }).AnyTimes()
+	controller.vswitchClient = vswitchClient
 	return calls
 }
 
@@ -144,8 +131,8 @@ func TestHandleUpdatePodQoSCallFailuresEmitOneEvent(t *testing.T) {
 
 	for _, stage := range []string{"bandwidth", "mirror", "netem"} {
 		t.Run(stage, func(t *testing.T) {
-			calls := stubPodQoSFunctions(t, stage, multusInterface, failErr)
 			controller, recorder := newPodQoSTestController(t, newPodQoSTestPod("default/net1"))
+			calls := stubPodQoSFunctions(t, controller, stage, multusInterface, failErr)
 
 			require.ErrorIs(t, controller.handleUpdatePod("default/pod"), failErr)
 			require.Contains(t, calls[stage], multusInterface)
@@ -159,8 +146,8 @@ func TestHandleUpdatePodQoSCallFailuresEmitOneEvent(t *testing.T) {
 }
 
 func TestHandleUpdatePodSuccessEmitsOneEventWithProcessedInterfaces(t *testing.T) {
-	calls := stubPodQoSFunctions(t, "", "", nil)
 	controller, recorder := newPodQoSTestController(t, newPodQoSTestPod("default/net1"))
+	calls := stubPodQoSFunctions(t, controller, "", "", nil)
 
 	require.NoError(t, controller.handleUpdatePod("default/pod"))
 	require.Equal(t, []string{"pod.default", "pod.default.net1.default.ovn"}, calls["netem"])
@@ -175,8 +162,8 @@ func TestHandleUpdatePodKeepsMultusPodNamesIndependent(t *testing.T) {
 	pod := newPodQoSTestPod("default/net1,default/net2")
 	pod.Annotations["net1.default.ovn.kubernetes.io/virtualmachine"] = "vm-one"
 	pod.Annotations["net2.default.ovn.kubernetes.io/allocated"] = "true"
-	calls := stubPodQoSFunctions(t, "", "", nil)
 	controller, recorder := newPodQoSTestController(t, pod)
+	calls := stubPodQoSFunctions(t, controller, "", "", nil)
 
 	require.NoError(t, controller.handleUpdatePod("default/pod"))
 	expectedInterfaces := []string{
@@ -195,9 +182,9 @@ func TestHandleUpdatePodKeepsMultusPodNamesIndependent(t *testing.T) {
 }
 
 func TestHandleUpdatePodNetworkAttachmentParseFailureEmitsOneEvent(t *testing.T) {
-	stubPodQoSFunctions(t, "", "", nil)
 	pod := newPodQoSTestPod("[")
 	controller, recorder := newPodQoSTestController(t, pod)
+	stubPodQoSFunctions(t, controller, "", "", nil)
 
 	err := controller.handleUpdatePod("default/pod")
 	require.Error(t, err)
@@ -208,8 +195,8 @@ func TestHandleUpdatePodNetworkAttachmentParseFailureEmitsOneEvent(t *testing.T)
 }
 
 func TestHandleUpdatePodWithoutMultusEmitsDefaultInterfaceSuccess(t *testing.T) {
-	stubPodQoSFunctions(t, "", "", nil)
 	controller, recorder := newPodQoSTestController(t, newPodQoSTestPod(""))
+	stubPodQoSFunctions(t, controller, "", "", nil)
 
 	require.NoError(t, controller.handleUpdatePod("default/pod"))
 	requirePodEvent(t, recorder,
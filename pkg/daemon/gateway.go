@@ -5,6 +5,7 @@ import (
 	"net"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
 	v1 "k8s.io/api/core/v1"
@@ -50,11 +51,11 @@ func (c *Controller) setGatewayBandwidth() error {
 	ingressBurst, egressBurst := node.Annotations[util.IngressBurstAnnotation], node.Annotations[util.EgressBurstAnnotation]
 	ifaceID := util.NodeLspName(c.config.NodeName)
 	if ingress == "" && egress == "" {
-		if htbQos, _ := ovs.IsHtbQos(ifaceID); !htbQos {
+		if htbQos, _ := c.vswitchClient.IsHtbQos(ifaceID); !htbQos {
 			return nil
 		}
 	}
-	return ovs.SetInterfaceBandwidth("", "", ifaceID, egress, ingress, egressBurst, ingressBurst)
+	return c.vswitchClient.SetInterfaceBandwidth("", "", ifaceID, egress, ingress, egressBurst, ingressBurst)
 }
 
 func (c *Controller) setICGateway() error {
@@ -329,3 +330,95 @@ func (c *Controller) getTProxyConditionPod(pods []*v1.Pod, needSort bool) ([]*v1
 
 	return filteredPods, nil
 }
+
+// ovnEipCounterKey identifies the traffic translated by an ovn eip in a direction
+type ovnEipCounterKey struct {
+	IP        string
+	Direction string
+}
+
+// ovnEipCounter is the last observation of the traffic translated by an ovn eip
+type ovnEipCounter struct {
+	name     string
+	counters util.GwIPTablesCounters
+}
+
+// parseOvnEipFlowCounters sums the statistics of the logical router nat flows dumped from br-int
+// by the eip. The ingress traffic is counted by the unsnat flows matching the eip as the destination,
+// and the egress traffic is counted by the snat flows translating the source to the eip.
+func parseOvnEipFlowCounters(flows []string) map[ovnEipCounterKey]util.GwIPTablesCounters {
+	counters := make(map[ovnEipCounterKey]util.GwIPTablesCounters)
+	for _, flow := range flows {
+		match, actions, ok := strings.Cut(flow, " actions=")
+		if !ok {
+			continue
+		}
+
+		var key ovnEipCounterKey
+		if ip := ovnNatFlowSourceIP(actions); ip != "" {
+			key = ovnEipCounterKey{IP: ip, Direction: "egress"}
+		} else if strings.Contains(actions, ",nat)") {
+			key = ovnEipCounterKey{IP: ovnNatFlowDestinationIP(match), Direction: "ingress"}
+		}
+		if key.IP == "" {
+			continue
+		}
+
+		var packets, packetBytes uint64
+		var err error
+		for field := range strings.FieldsFuncSeq(match, func(r rune) bool { return r == ',' || r == ' ' }) {
+			if value, ok := strings.CutPrefix(field, "n_packets="); ok {
+				packets, err = strconv.ParseUint(value, 10, 64)
+			} else if value, ok := strings.CutPrefix(field, "n_bytes="); ok {
+				packetBytes, err = strconv.ParseUint(value, 10, 64)
+			}
+			if err != nil {
+				break
+			}
+		}
+		if err != nil {
+			klog.Warningf("failed to parse statistics of flow %q: %v", flow, err)
+			continue
+		}
+
+		counter := counters[key]
+		counter.Packets += packets
+		counter.PacketBytes += packetBytes
+		counters[key] = counter
+	}
+	return counters
+}
+
+// ovnNatFlowSourceIP returns the address of a nat(src=...) action, e.g. nat(src=172.18.0.10:1024-33279)
+func ovnNatFlowSourceIP(actions string) string {
+	_, addr, ok := strings.Cut(actions, "nat(src=")
+	if !ok {
+		return ""
+	}
+	if end := strings.IndexAny(addr, "),"); end != -1 {
+		addr = addr[:end]
+	}
+	if strings.HasPrefix(addr, "[") {
+		// ipv6 address with port range, e.g. [fd00::10]:1024-33279
+		addr, _, _ = strings.Cut(addr[1:], "]")
+	} else if strings.Count(addr, ":") == 1 {
+		addr, _, _ = strings.Cut(addr, ":")
+	}
+	addr, _, _ = strings.Cut(addr, "-")
+	if net.ParseIP(addr) == nil {
+		return ""
+	}
+	return addr
+}
+
+// ovnNatFlowDestinationIP returns the exact destination address matched by a flow
+func ovnNatFlowDestinationIP(match string) string {
+	for field := range strings.FieldsFuncSeq(match, func(r rune) bool { return r == ',' || r == ' ' }) {
+		for _, prefix := range []string{"nw_dst=", "ipv6_dst="} {
+			if addr, ok := strings.CutPrefix(field, prefix); ok && net.ParseIP(addr) != nil {
+				return addr
+			}
+		}
+	}
+	return ""
+}
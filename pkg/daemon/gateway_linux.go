@@ -15,6 +15,7 @@ import (
 
 	"github.com/kubeovn/felix/ipsets"
 	"github.com/kubeovn/go-iptables/iptables"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/scylladb/go-set/strset"
 	"github.com/vishvananda/netlink"
 	v1 "k8s.io/api/core/v1"
@@ -1620,6 +1621,65 @@ func (c *Controller) setOvnSubnetGatewayMetric() {
 	}
 }
 
+// setOvnEipMetric exports the traffic translated by the ovn eips on the gateway chassis, labeled by the nat types
+// using the eip. OVN doesn't count the traffic of its nat rows, the counters are summed from the logical router
+// flows of the eip, so there are no separate counters of the OvnFip, OvnDnatRule and OvnSnatRule sharing an eip
+// and the counters are not summarized in their status.
+func (c *Controller) setOvnEipMetric() {
+	nodeName := os.Getenv(util.EnvNodeName)
+	flows, err := ovs.DumpFlowsWithStats("br-int")
+	if err != nil {
+		klog.Errorf("failed to dump flows of br-int: %v", err)
+		return
+	}
+	eips, err := c.ovnEipsLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list ovn eips: %v", err)
+		return
+	}
+	ipEips := make(map[string]*kubeovnv1.OvnEip, len(eips))
+	for _, eip := range eips {
+		for _, ip := range []string{eip.Status.V4Ip, eip.Status.V6Ip} {
+			if ip != "" {
+				ipEips[ip] = eip
+			}
+		}
+	}
+
+	counters := parseOvnEipFlowCounters(flows)
+	for key, counter := range counters {
+		eip := ipEips[key.IP]
+		if eip == nil {
+			continue
+		}
+
+		last := c.eipCounters[key]
+		c.eipCounters[key] = &ovnEipCounter{name: eip.Name, counters: counter}
+		if last == nil || last.name != eip.Name || counter.Packets < last.counters.Packets || counter.PacketBytes < last.counters.PacketBytes {
+			// the counters may just initialize or the flows have been reinstalled,
+			// it may loss packets to calculate during a metric period
+			continue
+		}
+
+		diffPackets := counter.Packets - last.counters.Packets
+		diffPacketBytes := counter.PacketBytes - last.counters.PacketBytes
+		vpc := eip.Labels[util.VpcNameLabel]
+		metricOvnEipPackets.WithLabelValues(nodeName, eip.Name, vpc, eip.Status.Nat, key.Direction).Add(float64(diffPackets))
+		metricOvnEipPacketBytes.WithLabelValues(nodeName, eip.Name, vpc, eip.Status.Nat, key.Direction).Add(float64(diffPacketBytes))
+	}
+
+	for key, last := range c.eipCounters {
+		if eip := ipEips[key.IP]; eip == nil || eip.Name != last.name {
+			// the eip has been deleted
+			metricOvnEipPackets.DeletePartialMatch(prometheus.Labels{"ovn_eip": last.name})
+			metricOvnEipPacketBytes.DeletePartialMatch(prometheus.Labels{"ovn_eip": last.name})
+			delete(c.eipCounters, key)
+		} else if _, ok := counters[key]; !ok {
+			delete(c.eipCounters, key)
+		}
+	}
+}
+
 func (c *Controller) addEgressConfig(subnet *kubeovnv1.Subnet, ip string) error {
 	if (subnet.Spec.Vlan != "" && !subnet.Spec.LogicalGateway) ||
 		subnet.Spec.GatewayType != kubeovnv1.GWDistributedType ||
@@ -1671,7 +1731,7 @@ func (c *Controller) setExGateway() error {
 		return err
 	}
 	var isUserspaceDP bool
-	isUserspaceDP, err = ovs.IsUserspaceDataPath()
+	isUserspaceDP, err = c.vswitchClient.IsUserspaceDataPath()
 	if err != nil {
 		klog.Error(err)
 		return err
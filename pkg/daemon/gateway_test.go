@@ -255,3 +255,34 @@ func TestProviderExistsAllowsOnSubnetListError(t *testing.T) {
 	require.True(t, ok)
 	require.Nil(t, subnet)
 }
+
+func TestParseOvnEipFlowCounters(t *testing.T) {
+	flows := []string{
+		"cookie=0x1, duration=10.1s, table=13, n_packets=10, n_bytes=1000, idle_age=1, priority=90,ip,reg14=0x2,metadata=0x3,nw_dst=172.18.0.10 actions=ct(table=14,zone=NXM_NX_REG11[0..15],nat)",
+		"cookie=0x2, duration=10.1s, table=13, n_packets=5, n_bytes=500, idle_age=1, priority=90,ip,reg14=0x4,metadata=0x3,nw_dst=172.18.0.10 actions=ct(table=14,zone=NXM_NX_REG11[0..15],nat)",
+		"cookie=0x3, duration=10.1s, table=43, n_packets=20, n_bytes=2000, idle_age=1, priority=153,ip,metadata=0x3,nw_src=10.0.0.0/24 actions=ct(commit,table=44,zone=NXM_NX_REG12[0..15],nat(src=172.18.0.10))",
+		"cookie=0x4, duration=10.1s, table=43, n_packets=3, n_bytes=300, idle_age=1, priority=161,ip,metadata=0x3,nw_src=10.0.0.5 actions=ct(commit,table=44,zone=NXM_NX_REG12[0..15],nat(src=172.18.0.11:1024-33279))",
+		"cookie=0x5, duration=10.1s, table=43, n_packets=7, n_bytes=700, idle_age=1, priority=153,ipv6,metadata=0x3,ipv6_src=fd00::/64 actions=ct(commit,table=44,zone=NXM_NX_REG12[0..15],nat(src=[fd00:10::10]:1024-65535))",
+		"cookie=0x6, duration=10.1s, table=14, n_packets=8, n_bytes=800, idle_age=1, priority=100,ip,metadata=0x3,nw_dst=172.18.0.12 actions=ct(commit,table=15,zone=NXM_NX_REG11[0..15],nat(dst=10.0.0.6))",
+		"cookie=0x7, duration=10.1s, table=0, n_packets=1, n_bytes=100, idle_age=1, priority=100,in_port=1 actions=load:0x1->NXM_NX_REG13[0..15],resubmit(,8)",
+		"NXST_FLOW reply (xid=0x4):",
+	}
+
+	counters := parseOvnEipFlowCounters(flows)
+	require.Equal(t, map[ovnEipCounterKey]util.GwIPTablesCounters{
+		{IP: "172.18.0.10", Direction: "ingress"}: {Packets: 15, PacketBytes: 1500},
+		{IP: "172.18.0.10", Direction: "egress"}:  {Packets: 20, PacketBytes: 2000},
+		{IP: "172.18.0.11", Direction: "egress"}:  {Packets: 3, PacketBytes: 300},
+		{IP: "fd00:10::10", Direction: "egress"}:  {Packets: 7, PacketBytes: 700},
+	}, counters)
+}
+
+func TestOvnNatFlowSourceIP(t *testing.T) {
+	require.Equal(t, "172.18.0.10", ovnNatFlowSourceIP("ct(commit,zone=1,nat(src=172.18.0.10))"))
+	require.Equal(t, "172.18.0.10", ovnNatFlowSourceIP("ct(commit,zone=1,nat(src=172.18.0.10:1024-2047))"))
+	require.Equal(t, "fd00::10", ovnNatFlowSourceIP("ct(commit,zone=1,nat(src=fd00::10))"))
+	require.Equal(t, "fd00::10", ovnNatFlowSourceIP("ct(commit,zone=1,nat(src=[fd00::10]:1024-2047))"))
+	require.Equal(t, "172.18.0.10", ovnNatFlowSourceIP("ct(commit,zone=1,nat(src=172.18.0.10-172.18.0.20))"))
+	require.Empty(t, ovnNatFlowSourceIP("ct(commit,zone=1,nat(dst=10.0.0.6))"))
+	require.Empty(t, ovnNatFlowSourceIP("ct(commit,zone=1,nat)"))
+}
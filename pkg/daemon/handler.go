@@ -3,13 +3,16 @@ package daemon
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	cnitypes "github.com/containernetworking/cni/pkg/types"
 	"github.com/emicklei/go-restful/v3"
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -501,7 +504,7 @@ func (csh cniServerHandler) handleAdd(req *restful.Request, resp *restful.Respon
 		}
 
 		ifaceID := ovs.PodNameToPortName(podRequest.PodName, podRequest.PodNamespace, podRequest.Provider)
-		if err = ovs.ConfigInterfaceMirror(csh.Config.EnableMirror, pod.Annotations[fmt.Sprintf(util.MirrorControlAnnotationTemplate, podRequest.Provider)], ifaceID); err != nil {
+		if err = csh.Controller.vswitchClient.ConfigInterfaceMirror(csh.Config.EnableMirror, pod.Annotations[fmt.Sprintf(util.MirrorControlAnnotationTemplate, podRequest.Provider)], ifaceID); err != nil {
 			klog.Errorf("failed mirror to mirror0, %v", err)
 			recordFailure("mirror", err)
 			return
@@ -623,6 +626,14 @@ func (csh cniServerHandler) UpdateIPCR(podRequest request.CniRequest, subnet, ip
 	return nil
 }
 
+// recordIPTimeline records the pod network setup phases in the status of the ip CR,
+// the timeline is informational so the errors are only logged
+func (csh cniServerHandler) recordIPTimeline(ipName string, phases map[kubeovnv1.IPTimelinePhase]*metav1.MicroTime) {
+	if err := util.PatchIPTimeline(csh.KubeOvnClient.KubeovnV1().IPs(), ipName, phases); err != nil {
+		klog.Errorf("failed to record timeline of ip %s: %v", ipName, err)
+	}
+}
+
 func (csh cniServerHandler) handleDel(req *restful.Request, resp *restful.Response) {
 	var podRequest request.CniRequest
 	var appendIfName bool
@@ -750,3 +761,209 @@ func (csh cniServerHandler) handleDel(req *restful.Request, resp *restful.Respon
 	csh.recordCNIPodEvent(eventPod, &podRequest, v1.EventTypeNormal, "PodNetworkRemoved", "removed pod network")
 	resp.WriteHeader(http.StatusNoContent)
 }
+
+func (csh cniServerHandler) handleCheck(req *restful.Request, resp *restful.Response) {
+	var podRequest request.CniRequest
+	if err := req.ReadEntity(&podRequest); err != nil {
+		errMsg := fmt.Errorf("parse check request failed %w", err)
+		klog.Error(errMsg)
+		if err := resp.WriteHeaderAndEntity(http.StatusBadRequest, request.CniResponse{Err: errMsg.Error()}); err != nil {
+			klog.Errorf("failed to write response, %v", err)
+		}
+		return
+	}
+
+	klog.Infof("check port request: %v", podRequest)
+	if err := csh.checkPodNetwork(&podRequest); err != nil {
+		klog.Error(err)
+		if err := resp.WriteHeaderAndEntity(http.StatusInternalServerError, request.CniResponse{Err: err.Error()}); err != nil {
+			klog.Errorf("failed to write response, %v", err)
+		}
+		return
+	}
+	resp.WriteHeader(http.StatusNoContent)
+}
+
+// checkPodNetwork verifies that the network of the pod interface still matches the IP CR and the pod annotations
+func (csh cniServerHandler) checkPodNetwork(podRequest *request.CniRequest) error {
+	pod, err := csh.Controller.podsLister.Pods(podRequest.PodNamespace).Get(podRequest.PodName)
+	if err != nil {
+		return fmt.Errorf("failed to get pod %s/%s: %w", podRequest.PodNamespace, podRequest.PodName, err)
+	}
+
+	ifName := podRequest.IfName
+	if ifName == "" {
+		ifName = "eth0"
+	}
+	providerWithIfName := fmt.Sprintf("%s.%s", podRequest.Provider, podRequest.IfName)
+	_, appendIfName := pod.Annotations[fmt.Sprintf(util.IPAddressAnnotationTemplate, providerWithIfName)]
+	getAnnotation := func(template string) string {
+		return util.GetAnnotationWithIfNameOverride(pod.Annotations, podRequest.Provider, podRequest.IfName, template, appendIfName)
+	}
+
+	ip := getAnnotation(util.IPAddressAnnotationTemplate)
+	if ip == "" {
+		if isMacOnlyAllocation(pod.Annotations, podRequest.Provider, podRequest.IfName, appendIfName) {
+			return nil
+		}
+		return fmt.Errorf("no address allocated to pod %s/%s provider %s", pod.Namespace, pod.Name, podRequest.Provider)
+	}
+
+	podName := pod.Name
+	if vmName := getAnnotation(util.VMAnnotationTemplate); vmName != "" {
+		podName = vmName
+	}
+	ipCRName := ovs.PodNameToPortName(podName, pod.Namespace, podRequest.Provider)
+	if appendIfName {
+		ipCRName = fmt.Sprintf("%s.%s", ipCRName, podRequest.IfName)
+	}
+	ipCR, err := csh.KubeOvnClient.KubeovnV1().IPs().Get(context.Background(), ipCRName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get ip %s: %w", ipCRName, err)
+	}
+	if ipCR.Spec.IPAddress != ip {
+		return fmt.Errorf("address %s of pod %s/%s does not match address %s of ip %s", ip, pod.Namespace, pod.Name, ipCR.Spec.IPAddress, ipCRName)
+	}
+	if podRequest.CniType != util.CniTypeName || !strings.HasSuffix(podRequest.Provider, util.OvnProvider) {
+		// the interface is not created by kube-ovn
+		return nil
+	}
+
+	ipAddr, _, err := util.GetIPAddrWithMaskForCNI(ipCR.Spec.IPAddress, getAnnotation(util.CidrAnnotationTemplate))
+	if err != nil {
+		return fmt.Errorf("failed to get ip address with mask: %w", err)
+	}
+	var routes []request.Route
+	if s := pod.Annotations[fmt.Sprintf(util.RoutesAnnotationTemplate, podRequest.Provider)]; s != "" {
+		if err = json.Unmarshal([]byte(s), &routes); err != nil {
+			return fmt.Errorf("invalid routes for pod %s/%s: %w", pod.Namespace, pod.Name, err)
+		}
+	}
+	routes = append(podRequest.Routes, routes...)
+
+	var isDefaultRoute bool
+	switch pod.Annotations[fmt.Sprintf(util.DefaultRouteAnnotationTemplate, podRequest.Provider)] {
+	case "true":
+		isDefaultRoute = true
+	case "false":
+		isDefaultRoute = false
+	default:
+		isDefaultRoute = ifName == "eth0"
+	}
+	gateway := gatewayForCNIIPFamily(ipAddr, getAnnotation(util.GatewayAnnotationTemplate))
+
+	if len(podRequest.ContainerID) < 12 {
+		return fmt.Errorf("invalid container id %q", podRequest.ContainerID)
+	}
+	ifaceID := ipCRName
+	switch {
+	case podRequest.DeviceID != "":
+		// the representor of the VF is named by the device
+		return nil
+	case podRequest.VhostUserSocketVolumeName != "" || podRequest.VhostUserSocketConsumption == util.ConsumptionKubevirt:
+		// there is no kernel interface in the pod for vhost-user ports
+		hostNicName, _ := generateNicName(podRequest.ContainerID, ifName)
+		return csh.checkNic(hostNicName, ifaceID, "", "", "", "", "", false, nil)
+	default:
+		hostNicName, _ := generateNicName(podRequest.ContainerID, ifName)
+		return csh.checkNic(hostNicName, ifaceID, podRequest.NetNs, ifName, ipCR.Spec.MacAddress, ipAddr, gateway, isDefaultRoute, routes)
+	}
+}
+
+// handleGC removes the OVS ports of the attachments which are no longer known to the container runtime,
+// along with the IPs of the pods which have been deleted
+func (csh cniServerHandler) handleGC(req *restful.Request, resp *restful.Response) {
+	var gcRequest request.CniGCRequest
+	if err := req.ReadEntity(&gcRequest); err != nil {
+		errMsg := fmt.Errorf("parse gc request failed %w", err)
+		klog.Error(errMsg)
+		if err := resp.WriteHeaderAndEntity(http.StatusBadRequest, request.CniResponse{Err: errMsg.Error()}); err != nil {
+			klog.Errorf("failed to write response, %v", err)
+		}
+		return
+	}
+
+	// attachment networks list only the containers attached to them,
+	// so only the default network tells which ports are no longer used
+	if gcRequest.CniType != util.CniTypeName || gcRequest.Provider != util.OvnProvider {
+		klog.Infof("skip gc request of provider %s", gcRequest.Provider)
+		resp.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	klog.Infof("gc request with %d valid attachments", len(gcRequest.ValidAttachments))
+	ifaces, err := ovs.ListPodInterfaces()
+	if err != nil {
+		errMsg := fmt.Errorf("failed to list pod interfaces: %w", err)
+		klog.Error(errMsg)
+		if err := resp.WriteHeaderAndEntity(http.StatusInternalServerError, request.CniResponse{Err: errMsg.Error()}); err != nil {
+			klog.Errorf("failed to write response, %v", err)
+		}
+		return
+	}
+
+	var errs []error
+	for _, iface := range staleCNIInterfaces(ifaces, gcRequest.ValidAttachments) {
+		if err = gcInterface(iface); err != nil {
+			klog.Error(err)
+			errs = append(errs, err)
+		}
+	}
+	if err = errors.Join(errs...); err != nil {
+		if err := resp.WriteHeaderAndEntity(http.StatusInternalServerError, request.CniResponse{Err: err.Error()}); err != nil {
+			klog.Errorf("failed to write response, %v", err)
+		}
+		return
+	}
+	resp.WriteHeader(http.StatusNoContent)
+}
+
+// staleCNIInterfaces returns the pod interfaces whose host nic name does not belong to any valid attachment.
+// Host nic names only keep a prefix of the container id, so an interface is kept as long as any valid
+// container id starts with the prefix, which also keeps the interfaces of attachment networks of running containers.
+func staleCNIInterfaces(ifaces []ovs.PodInterface, validAttachments []cnitypes.GCAttachment) []ovs.PodInterface {
+	var stale []ovs.PodInterface
+	for _, iface := range ifaces {
+		if !strings.HasSuffix(iface.Name, "_h") {
+			// not a veth or vhost-user port named by generateNicName
+			continue
+		}
+		prefix, _, _ := strings.Cut(iface.Name, "_")
+		if prefix == "" {
+			continue
+		}
+		if !slices.ContainsFunc(validAttachments, func(attachment cnitypes.GCAttachment) bool {
+			return strings.HasPrefix(attachment.ContainerID, prefix)
+		}) {
+			stale = append(stale, iface)
+		}
+	}
+	return stale
+}
+
+// gcInterface removes the OVS port and the host nic of a stale interface. Only node local state is cleaned up,
+// the IPs of deleted pods are released by kube-ovn-controller.
+func gcInterface(iface ovs.PodInterface) error {
+	klog.Infof("remove stale port %s of pod %s/%s", iface.Name, iface.PodNamespace, iface.PodName)
+	if err := ovs.CleanInterface(iface.Name); err != nil {
+		return fmt.Errorf("failed to remove stale port %s: %w", iface.Name, err)
+	}
+	return rollBackVethPair(iface.Name)
+}
+
+// cniStatusComponents are the daemons that must be running to set up pod networks
+var cniStatusComponents = [...]string{ovs.OvsdbServer, ovs.OvsVswitchd, ovs.OvnController}
+
+func (csh cniServerHandler) handleStatus(_ *restful.Request, resp *restful.Response) {
+	for _, component := range cniStatusComponents {
+		if _, err := ovs.Appctl(component, "-T", "1", "version"); err != nil {
+			errMsg := fmt.Errorf("%s is not running: %w", component, err)
+			klog.Error(errMsg)
+			if err := resp.WriteHeaderAndEntity(http.StatusServiceUnavailable, request.CniResponse{Err: errMsg.Error()}); err != nil {
+				klog.Errorf("failed to write response, %v", err)
+			}
+			return
+		}
+	}
+	resp.WriteHeader(http.StatusNoContent)
+}
@@ -3,14 +3,15 @@ package daemon
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
-	"os"
-	"path/filepath"
 	"testing"
 
+	cnitypes "github.com/containernetworking/cni/pkg/types"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -18,6 +19,7 @@ import (
 	listerv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 
+	mockovs "github.com/kubeovn/kube-ovn/mocks/pkg/ovs"
 	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
 	kubeovnfake "github.com/kubeovn/kube-ovn/pkg/client/clientset/versioned/fake"
 	kubeovnlister "github.com/kubeovn/kube-ovn/pkg/client/listers/kubeovn/v1"
@@ -177,7 +179,6 @@ func TestHandleAddFailureEvent(t *testing.T) {
 }
 
 func TestHandleDelSuccessEventPreservesPodReference(t *testing.T) {
-	useFakeOVSVsctl(t, true)
 	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
 		Name: "virt-launcher", Namespace: "ns", UID: types.UID("real-uid"),
 		Annotations: map[string]string{
@@ -186,6 +187,10 @@ func TestHandleDelSuccessEventPreservesPodReference(t *testing.T) {
 	}}
 	recorder := &cniEventRecorder{}
 	handler := cniEventTestHandler(t, pod, nil, recorder)
+	vswitchClient := useMockVswitch(t, handler)
+	vswitchClient.EXPECT().DeletePort("br-int", gomock.Any()).Return(nil)
+	// the qos of vm pods is named after the vm
+	vswitchClient.EXPECT().ClearPodBandwidth("vm-name", pod.Namespace).Return(nil)
 
 	response := serveCNIRequest(t, handler, "/api/v1/del", request.CniRequest{
 		CniType: util.CniTypeName, PodName: pod.Name, PodNamespace: pod.Namespace,
@@ -204,9 +209,9 @@ func TestHandleDelSuccessEventPreservesPodReference(t *testing.T) {
 }
 
 func TestHandleDelFailureEvent(t *testing.T) {
-	useFakeOVSVsctl(t, false)
 	recorder := &cniEventRecorder{}
 	handler := cniEventTestHandler(t, nil, nil, recorder)
+	useMockVswitch(t, handler).EXPECT().DeletePort("br-int", "12345678_net1_h").Return(errors.New("connection refused"))
 
 	response := serveCNIRequest(t, handler, "/api/v1/del", request.CniRequest{
 		PodName: "deleted", PodNamespace: "ns", ContainerID: "1234567890abcdef",
@@ -217,7 +222,7 @@ func TestHandleDelFailureEvent(t *testing.T) {
 	require.Equal(t, v1.EventTypeWarning, event.eventType)
 	require.Equal(t, "PodNetworkRemoveFailed", event.reason)
 	require.Contains(t, event.message, "stage=delete-nic")
-	require.Contains(t, event.message, "exit status 1")
+	require.Contains(t, event.message, "connection refused")
 	require.NotContains(t, event.message, "12345678_net1_h")
 	require.NotContains(t, event.message, "12345678")
 	pod := event.object.(*v1.Pod)
@@ -253,6 +258,43 @@ func TestHandleAddAndDelInvalidRequestHaveNoEvent(t *testing.T) {
 	}
 }
 
+func TestStaleCNIInterfaces(t *testing.T) {
+	ifaces := []ovs.PodInterface{
+		{Name: "0123456789ab_h", IfaceID: "running.ns"},
+		{Name: "0123456_net1_h", IfaceID: "running.ns.attach.ns.ovn.net1"},
+		{Name: "fedcba987654_h", IfaceID: "deleted.ns"},
+		{Name: "fedcba9_net1_h", IfaceID: "deleted.ns.attach.ns.ovn.net1"},
+		{Name: "_eth0123456789_h"},
+		{Name: "mirror0"},
+	}
+	validAttachments := []cnitypes.GCAttachment{{ContainerID: "0123456789abcdef", IfName: "eth0"}}
+
+	stale := staleCNIInterfaces(ifaces, validAttachments)
+	require.Equal(t, []ovs.PodInterface{ifaces[2], ifaces[3]}, stale)
+	require.Len(t, staleCNIInterfaces(ifaces, nil), 4)
+}
+
+func TestHandleGCSkipsAttachmentNetworks(t *testing.T) {
+	handler := cniEventTestHandler(t, nil, nil, &cniEventRecorder{})
+	body, err := json.Marshal(request.CniGCRequest{CniType: util.CniTypeName, Provider: "attach.ns.ovn"})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/gc", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	response := httptest.NewRecorder()
+
+	createHandler(handler).ServeHTTP(response, req)
+	require.Equal(t, http.StatusNoContent, response.Code)
+}
+
+func TestHandleCheckPodNotFound(t *testing.T) {
+	handler := cniEventTestHandler(t, nil, nil, &cniEventRecorder{})
+	response := serveCNIRequest(t, handler, "/api/v1/check", request.CniRequest{
+		CniType: util.CniTypeName, PodName: "deleted", PodNamespace: "ns", Provider: util.OvnProvider, IfName: "eth0",
+	})
+	require.Equal(t, http.StatusInternalServerError, response.Code)
+	require.Contains(t, response.Body.String(), "failed to get pod ns/deleted")
+}
+
 func cniEventTestHandler(t *testing.T, pod *v1.Pod, subnet *kubeovnv1.Subnet, recorder *cniEventRecorder) *cniServerHandler {
 	t.Helper()
 	podIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
@@ -290,13 +332,9 @@ func requireSingleCNIEvent(t *testing.T, recorder *cniEventRecorder) recordedCNI
 	return recorder.events[0]
 }
 
-func useFakeOVSVsctl(t *testing.T, succeed bool) {
+func useMockVswitch(t *testing.T, handler *cniServerHandler) *mockovs.MockVswitch {
 	t.Helper()
-	target := "/bin/false"
-	if succeed {
-		target = "/bin/true"
-	}
-	dir := t.TempDir()
-	require.NoError(t, os.Symlink(target, filepath.Join(dir, "ovs-vsctl")))
-	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	vswitchClient := mockovs.NewMockVswitch(gomock.NewController(t))
+	handler.Controller.vswitchClient = vswitchClient
+	return vswitchClient
 }
@@ -91,10 +91,13 @@ func InitNodeGateway(config *Configuration) error {
 }
 
 func InitMirror(config *Configuration) error {
-	if config.EnableMirror {
-		return configureGlobalMirror(config.MirrorNic, config.MTU)
+	vswitchClient, err := ovs.NewVswitchClient(vswitchDBAddress, 1, 3)
+	if err != nil {
+		klog.Error(err)
+		return fmt.Errorf("failed to create vswitch client: %w", err)
 	}
-	return configureEmptyMirror(config.MirrorNic, config.MTU)
+	defer vswitchClient.Close()
+	return configureMirror(vswitchClient, config.MirrorNic, config.MTU, config.EnableMirror)
 }
 
 func (c *Controller) ovsInitProviderNetwork(provider, nic string, trunks []string, exchangeLinkName, macLearningFallback bool, vlanInterfaceMap map[string]int) (int, error) { // create and configure external bridge
@@ -192,7 +195,7 @@ func (c *Controller) ovsCleanProviderNetwork(provider, nic string, vlanInterface
 		return nil
 	}
 
-	isUserspaceDP, err := ovs.IsUserspaceDataPath()
+	isUserspaceDP, err := c.vswitchClient.IsUserspaceDataPath()
 	if err != nil {
 		klog.Error(err)
 		return err
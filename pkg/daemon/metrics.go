@@ -69,6 +69,32 @@ var (
 		},
 	)
 
+	metricOvnEipPacketBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ovn_eip_packet_bytes",
+			Help: "the packet bytes translated by the ovn eip on the node.",
+		}, []string{
+			"hostname",
+			"ovn_eip",
+			"vpc",
+			"nat",
+			"direction",
+		},
+	)
+
+	metricOvnEipPackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ovn_eip_packets",
+			Help: "the packet num translated by the ovn eip on the node.",
+		}, []string{
+			"hostname",
+			"ovn_eip",
+			"vpc",
+			"nat",
+			"direction",
+		},
+	)
+
 	metricIPLocalPortRange = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "ip_local_port_range",
 		Help: "value of system parameter /proc/sys/net/ipv4/ip_local_port_range, which should not conflict with the nodeport range",
@@ -142,6 +168,7 @@ var (
 
 func InitMetrics() {
 	registerOvnSubnetGatewayMetrics()
+	registerOvnEipMetrics()
 	registerSystemParameterMetrics()
 	metrics.Registry.MustRegister(cniOperationHistogram)
 	metrics.Registry.MustRegister(cniWaitAddressResult)
@@ -154,6 +181,11 @@ func registerOvnSubnetGatewayMetrics() {
 	metrics.Registry.MustRegister(metricOvnSubnetGatewayPackets)
 }
 
+func registerOvnEipMetrics() {
+	metrics.Registry.MustRegister(metricOvnEipPacketBytes)
+	metrics.Registry.MustRegister(metricOvnEipPackets)
+}
+
 func registerSystemParameterMetrics() {
 	metrics.Registry.MustRegister(metricIPLocalPortRange)
 	metrics.Registry.MustRegister(metricCheckSumErr)
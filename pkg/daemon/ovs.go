@@ -1,7 +1,6 @@
 package daemon
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -134,7 +133,9 @@ func pingGateway(gw, src string, verbose bool, maxRetry int, done chan struct{})
 	return pinger.PacketsSent, nil
 }
 
-func configureGlobalMirror(portName string, mtu int) error {
+// configureMirror creates the mirror of br-int which outputs to portName. The global mirror selects all packets
+// of br-int, while the ports of the empty mirror are selected by the mirror annotation of the pods.
+func configureMirror(vswitchClient ovs.Vswitch, portName string, mtu int, global bool) error {
 	nicExist, err := linkExists(portName)
 	if err != nil {
 		klog.Error(err)
@@ -143,63 +144,13 @@ func configureGlobalMirror(portName string, mtu int) error {
 
 	if !nicExist {
 		klog.Infof("nic %s not exist, create it", portName)
-		raw, err := ovs.Exec(ovs.MayExist, "add-port", "br-int", portName, "--",
-			"set", "interface", portName, "type=internal", "--",
-			"clear", "bridge", "br-int", "mirrors", "--",
-			"--id=@mirror0", "get", "port", portName, "--",
-			"--id=@m", "create", "mirror", "name="+util.MirrorDefaultName, "select_all=true", "output_port=@mirror0", "--",
-			"add", "bridge", "br-int", "mirrors", "@m")
-		if err != nil {
-			klog.Errorf("failed to configure mirror nic %s, %q, %v", portName, raw, err)
-			return errors.New(raw)
-		}
 	} else {
 		klog.Infof("nic %s exist, configure it", portName)
-		raw, err := ovs.Exec(ovs.MayExist, "add-port", "br-int", portName, "--",
-			"clear", "bridge", "br-int", "mirrors", "--",
-			"--id=@mirror0", "get", "port", portName, "--",
-			"--id=@m", "create", "mirror", "name="+util.MirrorDefaultName, "select_all=true", "output_port=@mirror0", "--",
-			"add", "bridge", "br-int", "mirrors", "@m")
-		if err != nil {
-			klog.Errorf("failed to configure mirror nic %s, %q, %v", portName, raw, err)
-			return errors.New(raw)
-		}
 	}
-
-	return configureMirrorLink(portName, mtu)
-}
-
-func configureEmptyMirror(portName string, mtu int) error {
-	nicExist, err := linkExists(portName)
-	if err != nil {
-		klog.Error(err)
+	if err = vswitchClient.CreateMirror("br-int", portName, !nicExist, global); err != nil {
+		klog.Errorf("failed to configure mirror nic %s: %v", portName, err)
 		return err
 	}
-
-	if !nicExist {
-		klog.Infof("nic %s not exist, create it", portName)
-		raw, err := ovs.Exec(ovs.MayExist, "add-port", "br-int", portName, "--",
-			"set", "interface", portName, "type=internal", "--",
-			"clear", "bridge", "br-int", "mirrors", "--",
-			"--id=@mirror0", "get", "port", portName, "--",
-			"--id=@m", "create", "mirror", "name="+util.MirrorDefaultName, "output_port=@mirror0", "--",
-			"add", "bridge", "br-int", "mirrors", "@m")
-		if err != nil {
-			klog.Errorf("failed to configure mirror nic %s %q, %v", portName, raw, err)
-			return errors.New(raw)
-		}
-	} else {
-		klog.Infof("nic %s exist, configure it", portName)
-		raw, err := ovs.Exec(ovs.MayExist, "add-port", "br-int", portName, "--",
-			"clear", "bridge", "br-int", "mirrors", "--",
-			"--id=@mirror0", "get", "port", portName, "--",
-			"--id=@m", "create", "mirror", "name="+util.MirrorDefaultName, "output_port=@mirror0", "--",
-			"add", "bridge", "br-int", "mirrors", "@m")
-		if err != nil {
-			klog.Errorf("failed to configure mirror nic %s %q", portName, raw)
-			return errors.New(raw)
-		}
-	}
 	return configureMirrorLink(portName, mtu)
 }
 
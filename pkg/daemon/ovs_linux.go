@@ -36,6 +36,7 @@ import (
 	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
 	"github.com/kubeovn/kube-ovn/pkg/net/yusur"
 	"github.com/kubeovn/kube-ovn/pkg/ovs"
+	"github.com/kubeovn/kube-ovn/pkg/ovsdb/vswitch"
 	"github.com/kubeovn/kube-ovn/pkg/request"
 	"github.com/kubeovn/kube-ovn/pkg/util"
 )
@@ -48,7 +49,9 @@ func (csh cniServerHandler) configureDpdkNic(podName, podNamespace, provider, ne
 
 	ipStr := util.GetIPWithoutMask(ip)
 	ifaceID := ovs.PodNameToPortName(podName, podNamespace, provider)
-	ovs.CleanDuplicatePort(ifaceID, hostNicName)
+	if err := csh.Controller.vswitchClient.CleanDuplicatePort(ifaceID, hostNicName); err != nil {
+		klog.Error(err)
+	}
 
 	vhostServerPath := path.Join(sharedDir, socketName)
 	if socketConsumption == util.ConsumptionKubevirt {
@@ -56,19 +59,21 @@ func (csh cniServerHandler) configureDpdkNic(podName, podNamespace, provider, ne
 	}
 
 	// Add vhostuser host end to ovs port
-	output, err := ovs.Exec(ovs.MayExist, "add-port", "br-int", hostNicName, "--",
-		"set", "interface", hostNicName,
-		"type=dpdkvhostuserclient",
-		"options:vhost-server-path="+vhostServerPath,
-		"external_ids:iface-id="+ifaceID,
-		"external_ids:pod_name="+podName,
-		"external_ids:pod_namespace="+podNamespace,
-		"external_ids:ip="+ipStr,
-		"external_ids:pod_netns="+netns)
+	err := csh.Controller.vswitchClient.CreatePort("br-int", hostNicName, &vswitch.Interface{
+		Type:    "dpdkvhostuserclient",
+		Options: map[string]string{"vhost-server-path": vhostServerPath},
+		ExternalIDs: map[string]string{
+			ovs.ExternalIDIfaceID: ifaceID,
+			"pod_name":            podName,
+			"pod_namespace":       podNamespace,
+			"ip":                  ipStr,
+			"pod_netns":           netns,
+		},
+	})
 	if err != nil {
-		return fmt.Errorf("add nic to ovs failed %w: %q", err, output)
+		return fmt.Errorf("add nic to ovs failed: %w", err)
 	}
-	return ovs.SetInterfaceBandwidth(podName, podNamespace, ifaceID, egress, ingress, egressBurst, ingressBurst)
+	return csh.Controller.vswitchClient.SetInterfaceBandwidth(podName, podNamespace, ifaceID, egress, ingress, egressBurst, ingressBurst)
 }
 
 func (csh cniServerHandler) configureNic(podName, podNamespace, provider, netns, containerID, vfDriver, ifName, mac string, mtu int, ip, gateway string, isDefaultRoute, vmMigration bool, routes []request.Route, _, _ []string, ingress, egress, ingressBurst, egressBurst, deviceID, latency, limit, loss, jitter string, gwCheckMode int, u2oInterconnectionIP, oldPodName, encapIP, localnetSubnet string, appendIfName bool) ([]request.Route, error) {
@@ -109,58 +114,43 @@ func (csh cniServerHandler) configureNic(podName, podNamespace, provider, netns,
 	}
 
 	ipStr := util.GetIPWithoutMask(ip)
-	ifaceID := ovs.PodNameToPortName(podName, podNamespace, provider)
+	// the ip CR is named after the port name without the interface name
+	ipName := ovs.PodNameToPortName(podName, podNamespace, provider)
+	ifaceID := ipName
 	// in case of multiple interfaces the interface name is used to distinguish different interfaces
 	// currently ovs.PodNameToPortName ignores the ifname which results in same port being returned
 	// for default nics the ifname is set to eth0 by the handler, so we can use that to distinguish default nics and non default nics, for non default nics we can append the ifname to the ifaceID to make it unique for ovs port creation and later retrieval, this is required to avoid the issue of same port being returned for multiple interfaces which results in wrong port being configured and attached to the pod, and also results in wrong port being deleted during pod deletion which affects other interfaces attached to the same pod.
 	if appendIfName {
 		ifaceID = fmt.Sprintf("%s.%s", ifaceID, ifName)
 	}
-	ovs.CleanDuplicatePort(ifaceID, hostNicName)
+	if err := csh.Controller.vswitchClient.CleanDuplicatePort(ifaceID, hostNicName); err != nil {
+		klog.Error(err)
+	}
+	iface := &vswitch.Interface{
+		ExternalIDs: map[string]string{
+			ovs.ExternalIDIfaceID: ifaceID,
+			ovs.ExternalIDVendor:  util.CniTypeName,
+			"pod_name":            podName,
+			"pod_namespace":       podNamespace,
+			"pod_netns":           netns,
+		},
+	}
+	if ip != "" {
+		iface.ExternalIDs["ip"] = ipStr
+	}
+	if encapIP != "" {
+		iface.ExternalIDs["encap-ip"] = encapIP
+	}
 	if yusur.IsYusurSmartNic(deviceID) {
 		klog.Infof("add Yusur smartnic vfr %s to ovs", hostNicName)
 		// Add yusur ovs port
-		args := []string{
-			ovs.MayExist, "add-port", "br-int", hostNicName, "--",
-			"set", "interface", hostNicName, "type=dpdk",
-			fmt.Sprintf("options:dpdk-devargs=%s,representor=[%d]", pfPci, vfID),
-			fmt.Sprintf("mtu_request=%d", mtu),
-			"external_ids:iface-id=" + ifaceID,
-			"external_ids:vendor=" + util.CniTypeName,
-			"external_ids:pod_name=" + podName,
-			"external_ids:pod_namespace=" + podNamespace,
-			"external_ids:pod_netns=" + netns,
-		}
-		if ip != "" {
-			args = append(args, "external_ids:ip="+ipStr)
-		}
-		if encapIP != "" {
-			args = append(args, "external_ids:encap-ip="+encapIP)
-		}
-		output, err := ovs.Exec(args...)
-		if err != nil {
-			return nil, fmt.Errorf("add nic to ovs failed %w: %q", err, output)
-		}
-	} else {
-		// Add veth pair host end to ovs port
-		args := []string{
-			ovs.MayExist, "add-port", "br-int", hostNicName, "--",
-			"set", "interface", hostNicName, "external_ids:iface-id=" + ifaceID,
-			"external_ids:vendor=" + util.CniTypeName,
-			"external_ids:pod_name=" + podName,
-			"external_ids:pod_namespace=" + podNamespace,
-			"external_ids:pod_netns=" + netns,
-		}
-		if ip != "" {
-			args = append(args, "external_ids:ip="+ipStr)
-		}
-		if encapIP != "" {
-			args = append(args, "external_ids:encap-ip="+encapIP)
-		}
-		output, err := ovs.Exec(args...)
-		if err != nil {
-			return nil, fmt.Errorf("add nic to ovs failed %w: %q", err, output)
-		}
+		iface.Type = "dpdk"
+		iface.Options = map[string]string{"dpdk-devargs": fmt.Sprintf("%s,representor=[%d]", pfPci, vfID)}
+		iface.MTURequest = &mtu
+	}
+	// Add veth pair host end or yusur vf representor to ovs port
+	if err = csh.Controller.vswitchClient.CreatePort("br-int", hostNicName, iface); err != nil {
+		return nil, fmt.Errorf("add nic to ovs failed: %w", err)
 	}
 	defer func() {
 		if err != nil {
@@ -213,12 +203,12 @@ func (csh cniServerHandler) configureNic(podName, podNamespace, provider, netns,
 			return nil, err
 		}
 	}
-	if err = ovs.SetInterfaceBandwidth(podName, podNamespace, ifaceID, egress, ingress, egressBurst, ingressBurst); err != nil {
+	if err = csh.Controller.vswitchClient.SetInterfaceBandwidth(podName, podNamespace, ifaceID, egress, ingress, egressBurst, ingressBurst); err != nil {
 		klog.Error(err)
 		return nil, err
 	}
 
-	if err = ovs.SetNetemQos(podName, podNamespace, ifaceID, latency, limit, loss, jitter); err != nil {
+	if err = csh.Controller.vswitchClient.SetNetemQos(podName, podNamespace, ifaceID, latency, limit, loss, jitter); err != nil {
 		klog.Error(err)
 		return nil, err
 	}
@@ -226,7 +216,7 @@ func (csh cniServerHandler) configureNic(podName, podNamespace, provider, netns,
 	if containerNicName == "" {
 		return nil, nil
 	}
-	isUserspaceDP, err := ovs.IsUserspaceDataPath()
+	isUserspaceDP, err := csh.Controller.vswitchClient.IsUserspaceDataPath()
 	if err != nil {
 		klog.Error(err)
 		return nil, err
@@ -249,12 +239,12 @@ func (csh cniServerHandler) configureNic(podName, podNamespace, provider, netns,
 			ch <- struct{}{}
 			return
 		}
-		output, err := ovs.Exec(ovs.IfExists, "get", "interface", hostNicName, "external-ids:ovn-installed")
+		iface, err := csh.Controller.vswitchClient.GetInterface(hostNicName, true)
 		if err != nil {
-			klog.Errorf("failed to get ovn-installed for ovs port %s: %v, %q", hostNicName, err, output)
+			klog.Errorf("failed to get ovn-installed for ovs port %s: %v", hostNicName, err)
 			return
 		}
-		if strings.Trim(strings.TrimSpace(output), `"`) == "true" {
+		if iface != nil && iface.ExternalIDs["ovn-installed"] == "true" {
 			klog.Infof("ovs interface %s is ready", hostNicName)
 			ch <- struct{}{}
 			ready = true
@@ -265,6 +255,17 @@ func (csh cniServerHandler) configureNic(podName, podNamespace, provider, netns,
 		klog.Error(err)
 		return nil, err
 	}
+	// the timeline is recorded as soon as each phase is reached, so that a pod stuck in a later phase
+	// shows the phases it passed, and asynchronously as it is informational and should not delay the pod
+	portBoundTime := metav1.NowMicro()
+	portBoundRecorded := make(chan struct{})
+	go func() {
+		defer close(portBoundRecorded)
+		csh.recordIPTimeline(ipName, map[kubeovnv1.IPTimelinePhase]*metav1.MicroTime{
+			kubeovnv1.IPTimelinePortBound:        &portBoundTime,
+			kubeovnv1.IPTimelineGatewayReachable: nil,
+		})
+	}()
 
 	// For underlay subnets, wait for the localnet patch port to be created by
 	// ovn-controller before configuring the container NIC. This ensures L2
@@ -272,7 +273,7 @@ func (csh cniServerHandler) configureNic(podName, podNamespace, provider, netns,
 	// Solicitation packets, preventing false DAD success due to NS packets
 	// being black-holed when the patch port does not yet exist.
 	if localnetSubnet != "" {
-		if err := waitForLocalnetPatchPort(localnetSubnet); err != nil {
+		if err := csh.waitForLocalnetPatchPort(localnetSubnet); err != nil {
 			klog.Error(err)
 			return nil, err
 		}
@@ -290,13 +291,31 @@ func (csh cniServerHandler) configureNic(podName, podNamespace, provider, netns,
 		klog.Error(err)
 		return nil, err
 	}
+	if ip != "" && (gwCheckMode == gatewayCheckModePing || gwCheckMode == gatewayCheckModeArping) {
+		gatewayReachableTime := metav1.NowMicro()
+		go func() {
+			// the gateway reachable time of the previous setup is cleared with the port bound time
+			<-portBoundRecorded
+			csh.recordIPTimeline(ipName, map[kubeovnv1.IPTimelinePhase]*metav1.MicroTime{
+				kubeovnv1.IPTimelineGatewayReachable: &gatewayReachableTime,
+			})
+		}()
+	}
 	return finalRoutes, nil
 }
 
-func waitForLocalnetPatchPort(subnetName string) error {
+func (csh cniServerHandler) waitForLocalnetPatchPort(subnetName string) error {
 	patchPort := fmt.Sprintf("patch-localnet.%s-to-br-int", subnetName)
 	klog.Infof("waiting for localnet patch port %s to be ready", patchPort)
-	if _, err := ovs.Exec("wait-until", "interface", patchPort, "name="+patchPort); err != nil {
+	err := wait.PollUntilContextTimeout(context.Background(), 100*time.Millisecond, 30*time.Second, true, func(_ context.Context) (bool, error) {
+		iface, err := csh.Controller.vswitchClient.GetInterface(patchPort, true)
+		if err != nil {
+			klog.Errorf("failed to get ovs interface %s: %v", patchPort, err)
+			return false, nil
+		}
+		return iface != nil, nil
+	})
+	if err != nil {
 		return fmt.Errorf("failed waiting for localnet patch port %s: %w", patchPort, err)
 	}
 	klog.Infof("localnet patch port %s is ready", patchPort)
@@ -391,16 +410,11 @@ func (csh cniServerHandler) deleteNic(podName, podNamespace, containerID, netns,
 		}
 	}
 	// Remove ovs port
-	output, err := ovs.Exec(ovs.IfExists, "--with-iface", "del-port", "br-int", nicName)
-	if err != nil {
-		return fmt.Errorf("failed to delete ovs port %w, %q", err, output)
+	if err := csh.Controller.vswitchClient.DeletePort("br-int", nicName); err != nil {
+		return fmt.Errorf("failed to delete ovs port: %w", err)
 	}
 
-	if err = ovs.ClearPodBandwidth(podName, podNamespace, ""); err != nil {
-		klog.Error(err)
-		return err
-	}
-	if err = ovs.ClearHtbQosQueue(podName, podNamespace, ""); err != nil {
+	if err := csh.Controller.vswitchClient.ClearPodBandwidth(podName, podNamespace); err != nil {
 		klog.Error(err)
 		return err
 	}
@@ -438,10 +452,105 @@ func (csh cniServerHandler) deleteNic(podName, podNamespace, containerID, netns,
 	return nil
 }
 
-func (csh cniServerHandler) rollbackOvsPort(hostNicName string) (err error) {
-	output, err := ovs.Exec(ovs.IfExists, "--with-iface", "del-port", "br-int", hostNicName)
+// checkNic verifies that the OVS port and the container nic configured by configureNic are still in place.
+// The container nic is not checked if netns is empty.
+func (csh cniServerHandler) checkNic(hostNicName, ifaceID, netns, ifName, mac, ipAddr, gateway string, isDefaultRoute bool, routes []request.Route) error {
+	iface, err := csh.Controller.vswitchClient.GetInterface(hostNicName, true)
+	if err != nil {
+		return fmt.Errorf("failed to get ovs interface %s: %w", hostNicName, err)
+	}
+	if iface == nil {
+		return fmt.Errorf("ovs interface %s does not exist", hostNicName)
+	}
+	if id := iface.ExternalIDs[ovs.ExternalIDIfaceID]; id != ifaceID {
+		return fmt.Errorf("iface-id of ovs interface %s is %s, expected %s", hostNicName, id, ifaceID)
+	}
+	if netns == "" {
+		return nil
+	}
+
+	if _, err = netlink.LinkByName(hostNicName); err != nil {
+		return fmt.Errorf("can not find host nic %s: %w", hostNicName, err)
+	}
+	return ns.WithNetNSPath(netns, func(_ ns.NetNS) error {
+		return checkContainerNic(ifName, mac, ipAddr, gateway, isDefaultRoute, routes)
+	})
+}
+
+func checkContainerNic(ifName, mac, ipAddr, gateway string, isDefaultRoute bool, routes []request.Route) error {
+	link, err := netlink.LinkByName(ifName)
+	if err != nil {
+		return fmt.Errorf("can not find container nic %s: %w", ifName, err)
+	}
+	if link.Attrs().Flags&net.FlagUp == 0 {
+		return fmt.Errorf("container nic %s is down", ifName)
+	}
+	if mac != "" && !strings.EqualFold(link.Attrs().HardwareAddr.String(), mac) {
+		return fmt.Errorf("mac address of container nic %s is %s, expected %s", ifName, link.Attrs().HardwareAddr, mac)
+	}
+	if ipAddr == "" {
+		return nil
+	}
+
+	addrs, err := netlink.AddrList(link, netlink.FAMILY_ALL)
+	if err != nil {
+		return fmt.Errorf("failed to get addresses of container nic %s: %w", ifName, err)
+	}
+	for _, addr := range util.SplitTrimmed(ipAddr, ",") {
+		ip, ipNet, err := net.ParseCIDR(addr)
+		if err != nil {
+			return fmt.Errorf("failed to parse address %s: %w", addr, err)
+		}
+		if !slices.ContainsFunc(addrs, func(a netlink.Addr) bool {
+			return a.IP.Equal(ip) && slices.Equal(a.Mask, ipNet.Mask)
+		}) {
+			return fmt.Errorf("address %s is not configured on container nic %s", addr, ifName)
+		}
+	}
+
+	linkRoutes, err := netlink.RouteList(link, netlink.FAMILY_ALL)
 	if err != nil {
-		klog.Warningf("failed to delete down ovs port %v, %q", err, output)
+		return fmt.Errorf("failed to get routes on container nic %s: %w", ifName, err)
+	}
+	hasRoute := func(dst string) bool {
+		return slices.ContainsFunc(linkRoutes, func(r netlink.Route) bool {
+			if r.Dst == nil {
+				return (dst == "0.0.0.0/0" && r.Family == netlink.FAMILY_V4) || (dst == "::/0" && r.Family == netlink.FAMILY_V6)
+			}
+			return r.Dst.String() == dst
+		})
+	}
+	if isDefaultRoute {
+		// the default gateway may be replaced by the u2o interconnection ip or a custom route,
+		// so only the existence of the default route is checked
+		for _, gw := range util.SplitTrimmed(gateway, ",") {
+			dst := "0.0.0.0/0"
+			if util.CheckProtocol(gw) == kubeovnv1.ProtocolIPv6 {
+				dst = "::/0"
+			}
+			if !hasRoute(dst) {
+				return fmt.Errorf("default route via %s is missing on container nic %s", gw, ifName)
+			}
+		}
+	}
+	for _, r := range routes {
+		if r.Destination == "" {
+			continue
+		}
+		_, dst, err := net.ParseCIDR(r.Destination)
+		if err != nil {
+			continue
+		}
+		if !hasRoute(dst.String()) {
+			return fmt.Errorf("route to %s is missing on container nic %s", r.Destination, ifName)
+		}
+	}
+	return nil
+}
+
+func (csh cniServerHandler) rollbackOvsPort(hostNicName string) (err error) {
+	if err = csh.Controller.vswitchClient.DeletePort("br-int", hostNicName); err != nil {
+		klog.Warningf("failed to delete down ovs port %v", err)
 	}
 	klog.Infof("rollback ovs port success %s", hostNicName)
 	return err
@@ -1604,7 +1713,7 @@ func (c *Controller) transferAddrsAndRoutes(nicName, brName string, delNonExiste
 // Add host nic to external bridge
 // Mac address, MTU, IP addresses & routes will be copied/transferred to the external bridge
 func (c *Controller) configProviderNic(nicName, brName string, trunks []string) (int, error) {
-	isUserspaceDP, err := ovs.IsUserspaceDataPath()
+	isUserspaceDP, err := c.vswitchClient.IsUserspaceDataPath()
 	if err != nil {
 		klog.Error(err)
 		return 0, err
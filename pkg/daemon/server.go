@@ -61,6 +61,20 @@ func createHandler(csh *cniServerHandler) http.Handler {
 			To(csh.handleDel).
 			Reads(request.CniRequest{}),
 	)
+	ws.Route(
+		ws.POST("/check").
+			To(csh.handleCheck).
+			Reads(request.CniRequest{}),
+	)
+	ws.Route(
+		ws.POST("/gc").
+			To(csh.handleGC).
+			Reads(request.CniGCRequest{}),
+	)
+	ws.Route(
+		ws.GET("/status").
+			To(csh.handleStatus),
+	)
 
 	ws.Filter(requestAndResponseLogger)
 
@@ -4,9 +4,11 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"net/http/pprof"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -67,6 +69,68 @@ func CipherSuitesFromNames(suites []string) ([]uint16, error) {
 	return cipherSuites, nil
 }
 
+var (
+	handlersMutex sync.RWMutex
+	handlers      = make(map[string]http.Handler)
+)
+
+// RegisterHandler registers an additional handler on path which is served by
+// the metrics or health check servers started afterwards. The handler is always
+// protected by the authentication and authorization filter, even if secure
+// serving is disabled, as it may expose the internals of the cluster.
+func RegisterHandler(path string, handler http.Handler) {
+	handlersMutex.Lock()
+	defer handlersMutex.Unlock()
+	handlers[path] = handler
+}
+
+func registeredHandlers() map[string]http.Handler {
+	handlersMutex.RLock()
+	defer handlersMutex.RUnlock()
+	result := make(map[string]http.Handler, len(handlers))
+	maps.Copy(result, handlers)
+	return result
+}
+
+func newAuthFilter(config *rest.Config) (func(logr.Logger, http.Handler) (http.Handler, error), error) {
+	client, err := rest.HTTPClientFor(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create http client: %w", err)
+	}
+	authFilter, err := filters.WithAuthenticationAndAuthorization(config, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metrics filter: %w", err)
+	}
+	return authFilter, nil
+}
+
+// handleRegistered adds the registered handlers to mux, wrapped with the auth filter.
+// A nil config is only loaded if any handler has been registered.
+func handleRegistered(mux *http.ServeMux, config *rest.Config, authFilter func(logr.Logger, http.Handler) (http.Handler, error)) error {
+	registered := registeredHandlers()
+	if len(registered) == 0 {
+		return nil
+	}
+	if authFilter == nil {
+		if config == nil {
+			config = ctrl.GetConfigOrDie()
+		}
+		var err error
+		if authFilter, err = newAuthFilter(config); err != nil {
+			return err
+		}
+	}
+	for path, h := range registered {
+		log := klog.NewKlogr().WithValues("path", path)
+		h, err := authFilter(log, h)
+		if err != nil {
+			return fmt.Errorf("failed to apply auth filter to handler %s: %w", path, err)
+		}
+		mux.Handle(path, h)
+	}
+	return nil
+}
+
 // Run creates a listener on addr and starts serving metrics.
 // The listener is created synchronously before this function blocks on
 // serving, so callers can rely on the bind completing before Run returns
@@ -98,13 +162,9 @@ func ServeWithListener(ctx context.Context, config *rest.Config, listener net.Li
 	// behavior of the filterProvider used in the controller-runtime path.
 	var authFilter func(logr.Logger, http.Handler) (http.Handler, error)
 	if secureServing {
-		client, err := rest.HTTPClientFor(config)
-		if err != nil {
-			return fmt.Errorf("failed to create http client: %w", err)
-		}
-		authFilter, err = filters.WithAuthenticationAndAuthorization(config, client)
-		if err != nil {
-			return fmt.Errorf("failed to create metrics filter: %w", err)
+		var err error
+		if authFilter, err = newAuthFilter(config); err != nil {
+			return err
 		}
 		log := klog.NewKlogr()
 		metricsHandler, err = authFilter(log, metricsHandler)
@@ -138,6 +198,10 @@ func ServeWithListener(ctx context.Context, config *rest.Config, listener net.Li
 		}
 	}
 
+	if err := handleRegistered(mux, config, authFilter); err != nil {
+		return err
+	}
+
 	if secureServing {
 		minVersion, err := TLSVersionFromString(tlsMinVersion)
 		if err != nil {
@@ -1,9 +1,16 @@
 package metrics
 
 import (
+	"context"
 	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
 	"slices"
 	"testing"
+	"time"
+
+	"k8s.io/client-go/rest"
 )
 
 func TestTLSVersionFromString(t *testing.T) {
@@ -112,3 +119,45 @@ func TestCipherSuitesFromNames(t *testing.T) {
 		}
 	}
 }
+
+func TestRegisterHandler(t *testing.T) {
+	RegisterHandler("/test-register-handler", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- ServeWithListener(ctx, &rest.Config{}, listener, false, false, "", "", nil)
+	}()
+
+	// registered handlers require authentication even if secure serving is disabled
+	baseURL := "http://" + listener.Addr().String()
+	for path, code := range map[string]int{"/healthz": http.StatusOK, "/test-register-handler": http.StatusUnauthorized} {
+		url := baseURL + path
+		var resp *http.Response
+		for range 50 {
+			if resp, err = http.Get(url); err == nil { // #nosec G107
+				break
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		if err != nil {
+			t.Fatalf("failed to get %s: %v", url, err)
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		if resp.StatusCode != code {
+			t.Errorf("GET %s = %d, want %d", url, resp.StatusCode, code)
+		}
+	}
+
+	cancel()
+	if err = <-errCh; err != nil {
+		t.Errorf("ServeWithListener() error = %v", err)
+	}
+}
@@ -48,7 +48,9 @@ func NewPprofServer(host string, port int) (*manager.Server, error) {
 	}, nil
 }
 
-func NewHealthOnlyServer(addr string, port int) (*manager.Server, error) {
+// NewHealthOnlyServer creates the server used when metrics are disabled, which serves the health checks
+// and the handlers registered by RegisterHandler
+func NewHealthOnlyServer(config *rest.Config, addr string, port int) (*manager.Server, error) {
 	ip := net.ParseIP(addr)
 	if ip == nil {
 		return nil, fmt.Errorf("failed to parse health server address %q", addr)
@@ -61,6 +63,10 @@ func NewHealthOnlyServer(addr string, port int) (*manager.Server, error) {
 	mux.HandleFunc("/healthz", util.DefaultHealthCheckHandler)
 	mux.HandleFunc("/livez", util.LivezHandler)
 	mux.HandleFunc("/readyz", util.DefaultHealthCheckHandler)
+	if err = handleRegistered(mux, config, nil); err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
 	return &manager.Server{
 		Name: "health-check",
 		Server: &http.Server{
@@ -119,7 +125,7 @@ func StartMetricsOrHealthServer(ctx context.Context, enableMetrics bool, addrs [
 		return
 	}
 	klog.Info("metrics server is disabled")
-	svr, err := NewHealthOnlyServer(addrs[0], port)
+	svr, err := NewHealthOnlyServer(config, addrs[0], port)
 	if err != nil {
 		util.LogFatalAndExit(err, "failed to run health check server")
 	}
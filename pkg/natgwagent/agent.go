@@ -0,0 +1,501 @@
+package natgwagent
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	listerv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	applyv1 "github.com/kubeovn/kube-ovn/pkg/client/applyconfiguration/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/client/clientset/versioned"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+const (
+	syncKey   = "sync"
+	resyncKey = "resync"
+
+	leaseDuration = 15 * time.Second
+	renewDeadline = 10 * time.Second
+	retryPeriod   = 2 * time.Second
+
+	reasonApplied     = "Applied"
+	reasonApplyFailed = "ApplyFailed"
+)
+
+// Executor runs a nat-gateway.sh operation and returns its standard output
+type Executor interface {
+	Exec(operation string, args ...string) (string, error)
+}
+
+type scriptExecutor struct {
+	script string
+}
+
+// NewScriptExecutor returns an executor running the operations with the given nat-gateway.sh
+func NewScriptExecutor(script string) Executor {
+	return scriptExecutor{script: script}
+}
+
+func (e scriptExecutor) Exec(operation string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("bash", append([]string{e.script, operation}, args...)...) // #nosec G204
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	klog.V(3).Infof("executing NAT gateway command: %s", cmd.String())
+	err := cmd.Run()
+
+	// tc commands may output warnings to stderr, only the other lines are considered as errors
+	var errorLines []string
+	for line := range strings.SplitSeq(stderr.String(), "\n") {
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "Warning:") {
+			klog.Warningf("NAT gateway command warning: %v", line)
+			continue
+		}
+		errorLines = append(errorLines, line)
+	}
+	if err != nil {
+		if len(errorLines) != 0 {
+			return stdout.String(), fmt.Errorf("%w: %s", err, strings.Join(errorLines, "; "))
+		}
+		return stdout.String(), err
+	}
+	if len(errorLines) != 0 {
+		return stdout.String(), errors.New(strings.Join(errorLines, "; "))
+	}
+	return stdout.String(), nil
+}
+
+// Options configures the NAT gateway agent
+type Options struct {
+	KubeClient    kubernetes.Interface
+	KubeOvnClient versioned.Interface
+	Executor      Executor
+	// Namespace and ConfigMap locate the desired rules of the gateway
+	Namespace string
+	ConfigMap string
+	// StateFile records the applied rules so that rules removed while the agent was down are cleaned up
+	StateFile string
+	// ResyncPeriod is the interval at which the rule status is reported again
+	ResyncPeriod time.Duration
+	// Identity is the name of the gateway pod. Every replica of the gateway programs the rules, but only
+	// the replica holding the lease named after the ConfigMap reports the rule status. The status is
+	// reported unconditionally if Identity is empty.
+	Identity string
+}
+
+// Agent programs the rules rendered by kube-ovn-controller into the NAT gateway it runs in
+type Agent struct {
+	opts        Options
+	cmLister    listerv1.ConfigMapLister
+	randomFully bool
+
+	// applied holds the rules programmed into the gateway
+	applied map[string]Rule
+	// reported holds the last status reported for each rule
+	reported map[string]string
+	// leading is set while the agent holds the lease of the gateway
+	leading atomic.Bool
+}
+
+// NewAgent returns an agent for the given options
+func NewAgent(opts Options) *Agent {
+	agent := &Agent{
+		opts:     opts,
+		applied:  make(map[string]Rule),
+		reported: make(map[string]string),
+	}
+	agent.leading.Store(opts.Identity == "")
+	return agent
+}
+
+// runLeaderElection campaigns for the lease of the gateway until the context is cancelled,
+// the status of every rule is reported again once the lease is acquired
+func (a *Agent) runLeaderElection(ctx context.Context, queue workqueue.TypedRateLimitingInterface[string]) {
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta:  metav1.ObjectMeta{Namespace: a.opts.Namespace, Name: a.opts.ConfigMap},
+		Client:     a.opts.KubeClient.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{Identity: a.opts.Identity},
+	}
+	for ctx.Err() == nil {
+		leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+			Lock:          lock,
+			LeaseDuration: leaseDuration,
+			RenewDeadline: renewDeadline,
+			RetryPeriod:   retryPeriod,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(context.Context) {
+					klog.Infof("%s acquired the lease, reporting the rule status", a.opts.Identity)
+					a.leading.Store(true)
+					queue.Add(resyncKey)
+				},
+				OnStoppedLeading: func() {
+					klog.Infof("%s lost the lease, stop reporting the rule status", a.opts.Identity)
+					a.leading.Store(false)
+				},
+			},
+			ReleaseOnCancel: true,
+			Name:            a.opts.ConfigMap,
+		})
+	}
+}
+
+// Run watches the desired rules ConfigMap and reconciles the gateway until the context is cancelled
+func Run(ctx context.Context, opts Options) error {
+	agent := NewAgent(opts)
+	if err := agent.loadState(); err != nil {
+		return err
+	}
+	agent.detectRandomFully()
+
+	factory := informers.NewSharedInformerFactoryWithOptions(opts.KubeClient, opts.ResyncPeriod,
+		informers.WithNamespace(opts.Namespace),
+		informers.WithTweakListOptions(func(listOption *metav1.ListOptions) {
+			listOption.FieldSelector = fields.OneTermEqualSelector("metadata.name", opts.ConfigMap).String()
+		}),
+	)
+	informer := factory.Core().V1().ConfigMaps()
+	agent.cmLister = informer.Lister()
+
+	queue := workqueue.NewTypedRateLimitingQueueWithConfig(
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "NatGwAgent"},
+	)
+	defer queue.ShutDown()
+	if _, err := informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(any) { queue.Add(syncKey) },
+		UpdateFunc: func(oldObj, newObj any) {
+			if oldObj.(*corev1.ConfigMap).ResourceVersion == newObj.(*corev1.ConfigMap).ResourceVersion {
+				queue.Add(resyncKey)
+				return
+			}
+			queue.Add(syncKey)
+		},
+		DeleteFunc: func(any) { queue.Add(syncKey) },
+	}); err != nil {
+		return fmt.Errorf("failed to add configmap event handler: %w", err)
+	}
+
+	factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.Informer().HasSynced) {
+		return errors.New("failed to wait for configmap cache to sync")
+	}
+	queue.Add(resyncKey)
+	klog.Infof("NAT gateway agent started, desired rules from configmap %s/%s", opts.Namespace, opts.ConfigMap)
+
+	go func() {
+		<-ctx.Done()
+		queue.ShutDown()
+	}()
+	if opts.Identity != "" {
+		go agent.runLeaderElection(ctx, queue)
+	}
+	for {
+		key, shutdown := queue.Get()
+		if shutdown {
+			return nil
+		}
+		if err := agent.sync(ctx, key == resyncKey); err != nil {
+			klog.Errorf("failed to reconcile nat gateway rules: %v", err)
+			queue.AddRateLimited(key)
+		} else {
+			queue.Forget(key)
+		}
+		queue.Done(key)
+	}
+}
+
+var iptablesVersionMatcher = regexp.MustCompile(`v([0-9]+(\.[0-9]+)+)`)
+
+// detectRandomFully checks whether the iptables of the gateway supports fully randomized SNAT source ports
+func (a *Agent) detectRandomFully() {
+	output, err := a.opts.Executor.Exec(iptablesVersionOp)
+	if err != nil {
+		klog.Warningf("failed to check iptables version: %v", err)
+		return
+	}
+	match := iptablesVersionMatcher.FindStringSubmatch(output)
+	if match == nil {
+		klog.Warningf("no iptables version found in string: %s", output)
+		return
+	}
+	a.randomFully = util.CompareVersion(match[1], randomFullyVersion) >= 1
+}
+
+func (a *Agent) loadState() error {
+	if a.opts.StateFile == "" {
+		return nil
+	}
+	buf, err := os.ReadFile(a.opts.StateFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to read applied rules from %s: %w", a.opts.StateFile, err)
+	}
+	if err = json.Unmarshal(buf, &a.applied); err != nil {
+		return fmt.Errorf("failed to decode applied rules from %s: %w", a.opts.StateFile, err)
+	}
+	return nil
+}
+
+func (a *Agent) saveState() error {
+	if a.opts.StateFile == "" {
+		return nil
+	}
+	buf, err := json.Marshal(a.applied)
+	if err != nil {
+		return fmt.Errorf("failed to encode applied rules: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(a.opts.StateFile), filepath.Base(a.opts.StateFile)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to save applied rules: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(buf); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to save applied rules: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to save applied rules: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), a.opts.StateFile); err != nil {
+		return fmt.Errorf("failed to save applied rules: %w", err)
+	}
+	return nil
+}
+
+// plan returns the keys of the rules to delete and to add, in the order they should be processed.
+// A rule whose argument has changed is deleted and added again.
+func plan(applied, desired map[string]Rule) (dels, adds []string) {
+	for key, rule := range applied {
+		if d, ok := desired[key]; !ok || d.Operation != rule.Operation || d.Args != rule.Args {
+			dels = append(dels, key)
+		}
+	}
+	for key, rule := range desired {
+		if a, ok := applied[key]; !ok || a.Operation != rule.Operation || a.Args != rule.Args {
+			adds = append(adds, key)
+		}
+	}
+	order := func(rules map[string]Rule, key string) int {
+		op, _, _ := lookupOperation(rules[key].Operation)
+		return op.order
+	}
+	slices.SortFunc(dels, func(x, y string) int {
+		return cmp.Or(cmp.Compare(order(applied, y), order(applied, x)), strings.Compare(x, y))
+	})
+	slices.SortFunc(adds, func(x, y string) int {
+		return cmp.Or(cmp.Compare(order(desired, x), order(desired, y)), strings.Compare(x, y))
+	})
+	return dels, adds
+}
+
+func (a *Agent) exec(rule Rule, del bool) error {
+	op, _, _ := lookupOperation(rule.Operation)
+	if del {
+		if op.del == "" {
+			return nil
+		}
+		_, err := a.opts.Executor.Exec(op.del, op.delArgs(rule.Args))
+		return err
+	}
+	args := rule.Args
+	if rule.Operation == snatAddOperation && a.randomFully {
+		args += "," + randomFullyFlag
+	}
+	_, err := a.opts.Executor.Exec(rule.Operation, args)
+	return err
+}
+
+// sync programs the desired rules into the gateway and reports the result to the rule owners,
+// the status of every rule is reported again on resync
+func (a *Agent) sync(ctx context.Context, resync bool) error {
+	cm, err := a.cmLister.ConfigMaps(a.opts.Namespace).Get(a.opts.ConfigMap)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			// keep the applied rules, the configmap is only removed along with the gateway
+			klog.V(3).Infof("configmap %s/%s not found, waiting for it", a.opts.Namespace, a.opts.ConfigMap)
+			return nil
+		}
+		return err
+	}
+	desired, err := DecodeRules(cm.Data)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	failed := make(map[string]error)
+	dels, adds := plan(a.applied, desired)
+	for _, key := range dels {
+		rule := a.applied[key]
+		if err = a.exec(rule, true); err != nil {
+			err = fmt.Errorf("failed to delete rule %s: %w", key, err)
+			klog.Error(err)
+			errs, failed[key] = append(errs, err), err
+			continue
+		}
+		klog.Infof("deleted rule %s: %s %s", key, rule.Operation, rule.Args)
+		delete(a.applied, key)
+	}
+	for _, key := range adds {
+		if _, ok := a.applied[key]; ok {
+			// the previous version of the rule has not been deleted
+			continue
+		}
+		rule := desired[key]
+		if err = a.exec(rule, false); err != nil {
+			err = fmt.Errorf("failed to add rule %s: %w", key, err)
+			klog.Error(err)
+			errs, failed[key] = append(errs, err), err
+			continue
+		}
+		klog.Infof("added rule %s: %s %s", key, rule.Operation, rule.Args)
+		a.applied[key] = rule
+	}
+	for key, rule := range desired {
+		// keep the owner generation up to date for the unchanged rules
+		if applied, ok := a.applied[key]; ok && applied.Operation == rule.Operation && applied.Args == rule.Args {
+			a.applied[key] = rule
+		}
+	}
+	if err = a.saveState(); err != nil {
+		klog.Error(err)
+		errs = append(errs, err)
+	}
+
+	for key := range a.reported {
+		if _, ok := desired[key]; !ok {
+			delete(a.reported, key)
+		}
+	}
+	if !a.leading.Load() {
+		// the status is reported by the replica holding the lease
+		clear(a.reported)
+		return errors.Join(errs...)
+	}
+	for key, rule := range desired {
+		if rule.Owner == nil {
+			continue
+		}
+		applyErr := failed[key]
+		reported := fmt.Sprintf("%d/%v", rule.Owner.Generation, applyErr)
+		if !resync && a.reported[key] == reported {
+			continue
+		}
+		if err = a.report(ctx, rule.Owner, applyErr); err != nil {
+			err = fmt.Errorf("failed to report status of rule %s to %s %s: %w", key, rule.Owner.Kind, rule.Owner.Name, err)
+			klog.Error(err)
+			errs = append(errs, err)
+			continue
+		}
+		a.reported[key] = reported
+	}
+	return errors.Join(errs...)
+}
+
+type statusClient[T, A any] interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (T, error)
+	ApplyStatus(ctx context.Context, obj A, opts metav1.ApplyOptions) (T, error)
+}
+
+// setApplied sets the Applied condition of the named object. Only the Applied condition is applied
+// with the field manager of the agent, so the status fields and the conditions written by
+// kube-ovn-controller are left untouched.
+func setApplied[T, A any](ctx context.Context, client statusClient[T, A], name string, conditions func(T) kubeovnv1.Conditions,
+	apply func(name string, condition *applyv1.ConditionApplyConfiguration) A, generation int64, applyErr error,
+) error {
+	status, reason, message := corev1.ConditionTrue, reasonApplied, ""
+	if applyErr != nil {
+		status, reason, message = corev1.ConditionFalse, reasonApplyFailed, applyErr.Error()
+	}
+	obj, err := client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	current := conditions(obj)
+	updated := slices.Clone(current)
+	updated.SetCondition(kubeovnv1.NatGwRuleApplied, status, reason, message, generation)
+	if slices.Equal(updated, current) {
+		return nil
+	}
+
+	c := updated.GetCondition(kubeovnv1.NatGwRuleApplied)
+	condition := applyv1.Condition().
+		WithType(c.Type).
+		WithStatus(c.Status).
+		WithReason(c.Reason).
+		WithMessage(c.Message).
+		WithObservedGeneration(c.ObservedGeneration).
+		WithLastUpdateTime(c.LastUpdateTime).
+		WithLastTransitionTime(c.LastTransitionTime)
+	_, err = client.ApplyStatus(ctx, apply(name, condition), metav1.ApplyOptions{FieldManager: FieldManager, Force: true})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// report sets the Applied condition of the rule owner
+func (a *Agent) report(ctx context.Context, owner *Owner, applyErr error) error {
+	client := a.opts.KubeOvnClient.KubeovnV1()
+	switch owner.Kind {
+	case util.KindIptablesEIP:
+		return setApplied(ctx, client.IptablesEIPs(), owner.Name,
+			func(eip *kubeovnv1.IptablesEIP) kubeovnv1.Conditions { return eip.Status.Conditions },
+			func(name string, condition *applyv1.ConditionApplyConfiguration) *applyv1.IptablesEIPApplyConfiguration {
+				return applyv1.IptablesEIP(name).WithStatus(applyv1.IptablesEIPStatus().WithConditions(condition))
+			}, owner.Generation, applyErr)
+	case util.KindIptablesFIPRule:
+		return setApplied(ctx, client.IptablesFIPRules(), owner.Name,
+			func(fip *kubeovnv1.IptablesFIPRule) kubeovnv1.Conditions { return fip.Status.Conditions },
+			func(name string, condition *applyv1.ConditionApplyConfiguration) *applyv1.IptablesFIPRuleApplyConfiguration {
+				return applyv1.IptablesFIPRule(name).WithStatus(applyv1.IptablesFIPRuleStatus().WithConditions(condition))
+			}, owner.Generation, applyErr)
+	case util.KindIptablesDnatRule:
+		return setApplied(ctx, client.IptablesDnatRules(), owner.Name,
+			func(dnat *kubeovnv1.IptablesDnatRule) kubeovnv1.Conditions { return dnat.Status.Conditions },
+			func(name string, condition *applyv1.ConditionApplyConfiguration) *applyv1.IptablesDnatRuleApplyConfiguration {
+				return applyv1.IptablesDnatRule(name).WithStatus(applyv1.IptablesDnatRuleStatus().WithConditions(condition))
+			}, owner.Generation, applyErr)
+	case util.KindIptablesSnatRule:
+		return setApplied(ctx, client.IptablesSnatRules(), owner.Name,
+			func(snat *kubeovnv1.IptablesSnatRule) kubeovnv1.Conditions { return snat.Status.Conditions },
+			func(name string, condition *applyv1.ConditionApplyConfiguration) *applyv1.IptablesSnatRuleApplyConfiguration {
+				return applyv1.IptablesSnatRule(name).WithStatus(applyv1.IptablesSnatRuleStatus().WithConditions(condition))
+			}, owner.Generation, applyErr)
+	default:
+		klog.Warningf("unsupported owner kind %s of rule %s", owner.Kind, owner.Name)
+		return nil
+	}
+}
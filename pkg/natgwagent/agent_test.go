@@ -0,0 +1,280 @@
+package natgwagent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8stesting "k8s.io/client-go/testing"
+	listerv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	kubeovnfake "github.com/kubeovn/kube-ovn/pkg/client/clientset/versioned/fake"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+type fakeExecutor struct {
+	calls []string
+	fail  map[string]error
+}
+
+func (e *fakeExecutor) Exec(operation string, args ...string) (string, error) {
+	call := strings.Join(append([]string{operation}, args...), " ")
+	if operation == iptablesVersionOp {
+		return "iptables v1.8.10 (nf_tables)", nil
+	}
+	e.calls = append(e.calls, call)
+	return "", e.fail[call]
+}
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		operation string
+		args      string
+		key       string
+		add       bool
+		ok        bool
+	}{
+		{"init", "net1,net2", "init", true, true},
+		{"eip-add", "172.18.0.10/16", "eip-add.172.18.0.10_16", true, true},
+		{"eip-del", "172.18.0.10/16", "eip-add.172.18.0.10_16", false, true},
+		{"snat-add", "172.18.0.10,10.0.0.0/24", "snat-add.172.18.0.10_10.0.0.0_24", true, true},
+		{"snat-del", "172.18.0.10,10.0.0.0/24", "snat-add.172.18.0.10_10.0.0.0_24", false, true},
+		{"snat-port-block-add", "172.18.0.10,10.0.0.0/24,1024,256", "snat-port-block-add.172.18.0.10_10.0.0.0_24", true, true},
+		{"dnat-add", "172.18.0.10,8080,tcp,10.0.0.5,80", "dnat-add.172.18.0.10_8080_tcp", true, true},
+		{"dnat-del", "172.18.0.10,8080,tcp", "dnat-add.172.18.0.10_8080_tcp", false, true},
+		{"floating-ip-del", "172.18.0.10", "floating-ip-add.172.18.0.10", false, true},
+		{"eip-ingress-qos-add", "172.18.0.10,1,10,1", "eip-ingress-qos-add.172.18.0.10", true, true},
+		{"get-iptables-version", "", "", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.operation, func(t *testing.T) {
+			key, add, ok := Key(tt.operation, tt.args)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.add, add)
+			require.Equal(t, tt.key, key)
+		})
+	}
+}
+
+func TestPlan(t *testing.T) {
+	applied := map[string]Rule{
+		"init":                     {Operation: "init"},
+		"eip-add.1":                {Operation: "eip-add", Args: "1"},
+		"snat-add.1_10.0.0.0_24":   {Operation: "snat-add", Args: "1,10.0.0.0/24"},
+		"dnat-add.1_8080_tcp":      {Operation: "dnat-add", Args: "1,8080,tcp,10.0.0.5,80"},
+		"eip-ingress-qos-add.1":    {Operation: "eip-ingress-qos-add", Args: "1,1,10,1"},
+		"subnet-route-add.0.0.0.0": {Operation: "subnet-route-add", Args: "0.0.0.0/0,10.0.0.1"},
+	}
+	desired := map[string]Rule{
+		"init":                     {Operation: "init"},
+		"eip-add.1":                {Operation: "eip-add", Args: "1", Owner: &Owner{Kind: util.KindIptablesEIP, Name: "eip1", Generation: 2}},
+		"dnat-add.1_8080_tcp":      {Operation: "dnat-add", Args: "1,8080,tcp,10.0.0.6,80"},
+		"floating-ip-add.2":        {Operation: "floating-ip-add", Args: "2,10.0.0.7"},
+		"subnet-route-add.0.0.0.0": {Operation: "subnet-route-add", Args: "0.0.0.0/0,10.0.0.1"},
+	}
+	dels, adds := plan(applied, desired)
+	require.Equal(t, []string{"eip-ingress-qos-add.1", "dnat-add.1_8080_tcp", "snat-add.1_10.0.0.0_24"}, dels)
+	require.Equal(t, []string{"dnat-add.1_8080_tcp", "floating-ip-add.2"}, adds)
+}
+
+func newTestAgent(t *testing.T, executor Executor, cm *corev1.ConfigMap, objects ...*kubeovnv1.IptablesSnatRule) (*Agent, *kubeovnfake.Clientset) {
+	t.Helper()
+	client := kubeovnfake.NewSimpleClientset()
+	// the fake clientset does not support server-side apply of custom resources,
+	// merge the applied conditions by type as the API server does for the list map
+	client.PrependReactor("patch", "iptables-snat-rules", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patch := action.(k8stesting.PatchAction)
+		if patch.GetPatchType() != types.ApplyPatchType {
+			return false, nil, nil
+		}
+		require.Equal(t, "status", patch.GetSubresource())
+		var applied kubeovnv1.IptablesSnatRule
+		require.NoError(t, json.Unmarshal(patch.GetPatch(), &applied))
+		obj, err := client.Tracker().Get(action.GetResource(), "", patch.GetName())
+		if err != nil {
+			return true, nil, err
+		}
+		snat := obj.(*kubeovnv1.IptablesSnatRule).DeepCopy()
+		conditions := (*kubeovnv1.Conditions)(&snat.Status.Conditions)
+		for _, c := range applied.Status.Conditions {
+			conditions.SetCondition(c.Type, c.Status, c.Reason, c.Message, c.ObservedGeneration)
+		}
+		return true, snat, client.Tracker().Update(action.GetResource(), snat, "")
+	})
+	for _, obj := range objects {
+		_, err := client.KubeovnV1().IptablesSnatRules().Create(context.Background(), obj, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	require.NoError(t, indexer.Add(cm))
+	agent := NewAgent(Options{
+		KubeOvnClient: client,
+		Executor:      executor,
+		Namespace:     cm.Namespace,
+		ConfigMap:     cm.Name,
+		StateFile:     filepath.Join(t.TempDir(), "state.json"),
+	})
+	agent.cmLister = listerv1.NewConfigMapLister(indexer)
+	agent.detectRandomFully()
+	return agent, client
+}
+
+func encodeRules(t *testing.T, rules ...Rule) map[string]string {
+	t.Helper()
+	data := make(map[string]string, len(rules))
+	for _, rule := range rules {
+		key, add, ok := Key(rule.Operation, rule.Args)
+		require.True(t, ok)
+		require.True(t, add)
+		value, err := rule.Encode()
+		require.NoError(t, err)
+		data[key] = value
+	}
+	return data
+}
+
+func TestSync(t *testing.T) {
+	snat := &kubeovnv1.IptablesSnatRule{ObjectMeta: metav1.ObjectMeta{Name: "snat1", Generation: 3}}
+	owner := &Owner{Kind: util.KindIptablesSnatRule, Name: snat.Name, Generation: snat.Generation}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "vpc-nat-gw-gw1-rules", Namespace: "kube-system"},
+		Data: encodeRules(t,
+			Rule{Operation: "init", Args: "net1,net2"},
+			Rule{Operation: "eip-add", Args: "172.18.0.10/16"},
+			Rule{Operation: "snat-add", Args: "172.18.0.10,10.0.0.0/24", Owner: owner},
+		),
+	}
+	executor := &fakeExecutor{fail: map[string]error{}}
+	agent, client := newTestAgent(t, executor, cm, snat)
+
+	getApplied := func() *kubeovnv1.Condition {
+		snat, err := client.KubeovnV1().IptablesSnatRules().Get(context.Background(), snat.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		for i := range snat.Status.Conditions {
+			if snat.Status.Conditions[i].Type == kubeovnv1.NatGwRuleApplied {
+				return &snat.Status.Conditions[i]
+			}
+		}
+		return nil
+	}
+
+	// the rules are added in order and the snat rule is reported as applied
+	require.NoError(t, agent.sync(context.Background(), false))
+	require.Equal(t, []string{
+		"init net1,net2",
+		"eip-add 172.18.0.10/16",
+		"snat-add 172.18.0.10,10.0.0.0/24,--random-fully",
+	}, executor.calls)
+	condition := getApplied()
+	require.NotNil(t, condition)
+	require.Equal(t, corev1.ConditionTrue, condition.Status)
+	require.EqualValues(t, 3, condition.ObservedGeneration)
+
+	// nothing is executed when the rules are unchanged
+	executor.calls = nil
+	require.NoError(t, agent.sync(context.Background(), false))
+	require.Empty(t, executor.calls)
+
+	// the applied rules survive an agent restart
+	restarted := NewAgent(agent.opts)
+	require.NoError(t, restarted.loadState())
+	require.Equal(t, agent.applied, restarted.applied)
+
+	// a new generation of an unchanged rule is only reported
+	executor.calls = nil
+	owner.Generation = 4
+	cm.Data = encodeRules(t,
+		Rule{Operation: "init", Args: "net1,net2"},
+		Rule{Operation: "eip-add", Args: "172.18.0.10/16"},
+		Rule{Operation: "snat-add", Args: "172.18.0.10,10.0.0.0/24", Owner: owner},
+		Rule{Operation: "dnat-add", Args: "172.18.0.10,8080,tcp,10.0.0.5,80"},
+	)
+	require.NoError(t, agent.sync(context.Background(), false))
+	require.Equal(t, []string{"dnat-add 172.18.0.10,8080,tcp,10.0.0.5,80"}, executor.calls)
+	require.EqualValues(t, 4, getApplied().ObservedGeneration)
+
+	// a changed rule is deleted and added again, a failure is reported to the owner
+	executor.calls = nil
+	owner.Generation = 5
+	cm.Data = encodeRules(t,
+		Rule{Operation: "init", Args: "net1,net2"},
+		Rule{Operation: "eip-add", Args: "172.18.0.10/16"},
+		Rule{Operation: "snat-add", Args: "172.18.0.10,10.0.1.0/24", Owner: owner},
+		Rule{Operation: "dnat-add", Args: "172.18.0.10,8080,tcp,10.0.0.6,80"},
+	)
+	executor.fail["snat-add 172.18.0.10,10.0.1.0/24,--random-fully"] = errors.New("iptables failure")
+	err := agent.sync(context.Background(), false)
+	require.ErrorContains(t, err, "iptables failure")
+	require.Equal(t, []string{
+		"dnat-del 172.18.0.10,8080,tcp",
+		"snat-del 172.18.0.10,10.0.0.0/24",
+		"dnat-add 172.18.0.10,8080,tcp,10.0.0.6,80",
+		"snat-add 172.18.0.10,10.0.1.0/24,--random-fully",
+	}, executor.calls)
+	condition = getApplied()
+	require.Equal(t, corev1.ConditionFalse, condition.Status)
+	require.Contains(t, condition.Message, "iptables failure")
+	require.EqualValues(t, 5, condition.ObservedGeneration)
+
+	// removed rules are deleted in reverse order
+	executor.calls = nil
+	cm.Data = encodeRules(t, Rule{Operation: "init", Args: "net1,net2"})
+	require.NoError(t, agent.sync(context.Background(), false))
+	require.Equal(t, []string{"dnat-del 172.18.0.10,8080,tcp", "eip-del 172.18.0.10/16"}, executor.calls)
+	require.Len(t, agent.applied, 1)
+	require.Empty(t, agent.reported)
+}
+
+func TestSyncReportStatus(t *testing.T) {
+	snat := &kubeovnv1.IptablesSnatRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "snat1", Generation: 1},
+		Status:     kubeovnv1.IptablesSnatRuleStatus{Ready: true, V4ip: "172.18.0.10"},
+	}
+	(*kubeovnv1.Conditions)(&snat.Status.Conditions).SetCondition(kubeovnv1.Ready, corev1.ConditionTrue, "Synced", "", 1)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "vpc-nat-gw-gw1-rules", Namespace: "kube-system"},
+		Data: encodeRules(t, Rule{
+			Operation: "snat-add",
+			Args:      "172.18.0.10,10.0.0.0/24",
+			Owner:     &Owner{Kind: util.KindIptablesSnatRule, Name: snat.Name, Generation: snat.Generation},
+		}),
+	}
+	agent, client := newTestAgent(t, &fakeExecutor{}, cm, snat)
+	getStatus := func() kubeovnv1.IptablesSnatRuleStatus {
+		snat, err := client.KubeovnV1().IptablesSnatRules().Get(context.Background(), snat.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		return snat.Status
+	}
+
+	// a replica not holding the lease programs the rules without reporting the status
+	agent.leading.Store(false)
+	require.NoError(t, agent.sync(context.Background(), false))
+	require.Contains(t, agent.applied, "snat-add.172.18.0.10_10.0.0.0_24")
+	conditions := kubeovnv1.Conditions(getStatus().Conditions)
+	require.Nil(t, conditions.GetCondition(kubeovnv1.NatGwRuleApplied))
+
+	// the status is reported once the lease is acquired, the status written by the controller is kept
+	agent.leading.Store(true)
+	require.NoError(t, agent.sync(context.Background(), true))
+	status := getStatus()
+	require.True(t, status.Ready)
+	require.Equal(t, "172.18.0.10", status.V4ip)
+	conditions = kubeovnv1.Conditions(status.Conditions)
+	require.True(t, conditions.IsConditionTrue(kubeovnv1.Ready, 1))
+	require.True(t, conditions.IsConditionTrue(kubeovnv1.NatGwRuleApplied, 1))
+
+	// the status patch of the controller leaves the conditions applied by the agent untouched
+	patch, err := status.Bytes()
+	require.NoError(t, err)
+	require.NotContains(t, string(patch), "conditions")
+}
@@ -0,0 +1,150 @@
+package natgwagent
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// BinaryPath is the path of the agent in the NAT gateway image
+	BinaryPath = "/kube-ovn/vpc-nat-gw-agent"
+	// ScriptPath is the path of the script programming the NAT gateway rules
+	ScriptPath = "/kube-ovn/nat-gateway.sh"
+	// StateDir holds the interfaces written by the script and the rules applied by the agent,
+	// it is backed by an emptyDir volume so that both survive container restarts
+	StateDir = "/etc/kube-ovn"
+	// StateFile is the file recording the rules applied by the agent
+	StateFile = StateDir + "/nat-gw-agent-state.json"
+
+	// ConfigMapEnv is the environment variable carrying the name of the desired rules ConfigMap
+	ConfigMapEnv = "NAT_GW_RULES_CONFIGMAP"
+	// NamespaceEnv is the environment variable carrying the namespace of the gateway pod
+	NamespaceEnv = "POD_NAMESPACE"
+	// PodNameEnv is the environment variable carrying the name of the gateway pod
+	PodNameEnv = "POD_NAME"
+	// FieldManager is the field manager of the conditions applied by the agent
+	FieldManager = "vpc-nat-gw-agent"
+
+	initOperation      = "init"
+	snatAddOperation   = "snat-add"
+	randomFullyFlag    = "--random-fully"
+	iptablesVersionOp  = "get-iptables-version"
+	randomFullyVersion = "1.6.2"
+)
+
+// Owner identifies the kube-ovn resource a rule has been rendered from
+type Owner struct {
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Generation int64  `json:"generation"`
+}
+
+// Rule is a single desired rule of a NAT gateway, stored as one ConfigMap entry.
+// Operation is always the "add" operation of nat-gateway.sh and Args its single argument.
+type Rule struct {
+	Operation string `json:"operation"`
+	Args      string `json:"args"`
+	Owner     *Owner `json:"owner,omitempty"`
+}
+
+// operation describes how a nat-gateway.sh "add" operation is identified and removed
+type operation struct {
+	add string
+	del string
+	// identity is the number of leading comma separated fields identifying a rule,
+	// 0 means the whole argument and -1 a singleton rule
+	identity int
+	// delFields is the number of leading fields passed to the "del" operation, 0 means all
+	delFields int
+	// order in which rules are added, rules are deleted in reverse order
+	order int
+}
+
+var operations = []operation{
+	{add: initOperation, identity: -1, order: 0},
+	{add: "eip-add", del: "eip-del", identity: 0, order: 1},
+	{add: "subnet-route-add", del: "subnet-route-del", identity: 1, delFields: 1, order: 2},
+	{add: "floating-ip-add", del: "floating-ip-del", identity: 1, delFields: 1, order: 3},
+	{add: snatAddOperation, del: "snat-del", identity: 2, delFields: 2, order: 3},
+	{add: "snat-port-block-add", del: "snat-port-block-del", identity: 2, delFields: 2, order: 3},
+	{add: "dnat-add", del: "dnat-del", identity: 3, delFields: 3, order: 3},
+	{add: "nft-dnat-map-add", del: "nft-dnat-map-del", identity: 3, delFields: 3, order: 3},
+	{add: "eip-ingress-qos-add", del: "eip-ingress-qos-del", identity: 1, delFields: 1, order: 4},
+	{add: "eip-egress-qos-add", del: "eip-egress-qos-del", identity: 1, delFields: 1, order: 4},
+	{add: "qos-add", del: "qos-del", identity: 7, order: 4},
+}
+
+func lookupOperation(name string) (op operation, isDel, ok bool) {
+	for _, op = range operations {
+		switch name {
+		case op.add:
+			return op, false, true
+		case op.del:
+			if op.del != "" {
+				return op, true, true
+			}
+		}
+	}
+	return operation{}, false, false
+}
+
+func leadingFields(args string, n int) string {
+	if n <= 0 {
+		return args
+	}
+	fields := strings.Split(args, ",")
+	if len(fields) > n {
+		fields = fields[:n]
+	}
+	return strings.Join(fields, ",")
+}
+
+var invalidKeyChars = regexp.MustCompile(`[^-._a-zA-Z0-9]`)
+
+func (op operation) key(args string) string {
+	if op.identity < 0 {
+		return op.add
+	}
+	return op.add + "." + invalidKeyChars.ReplaceAllString(leadingFields(args, op.identity), "_")
+}
+
+func (op operation) delArgs(args string) string {
+	return leadingFields(args, op.delFields)
+}
+
+// Key returns the ConfigMap key of the rule affected by the given nat-gateway.sh operation and argument,
+// and whether the operation adds or removes the rule. It returns false if the operation is not declarative.
+func Key(operation, args string) (key string, add, ok bool) {
+	op, isDel, ok := lookupOperation(operation)
+	if !ok {
+		return "", false, false
+	}
+	return op.key(args), !isDel, true
+}
+
+// Encode returns the ConfigMap value of the rule
+func (r Rule) Encode() (string, error) {
+	buf, err := json.Marshal(r)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode nat gateway rule %s %s: %w", r.Operation, r.Args, err)
+	}
+	return string(buf), nil
+}
+
+// DecodeRules parses the desired rules stored in a ConfigMap
+func DecodeRules(data map[string]string) (map[string]Rule, error) {
+	rules := make(map[string]Rule, len(data))
+	for key, value := range data {
+		var rule Rule
+		if err := json.Unmarshal([]byte(value), &rule); err != nil {
+			return nil, fmt.Errorf("failed to decode nat gateway rule %s: %w", key, err)
+		}
+		if _, isDel, ok := lookupOperation(rule.Operation); !ok || isDel {
+			return nil, fmt.Errorf("unsupported operation %q of nat gateway rule %s", rule.Operation, key)
+		}
+		rules[key] = rule
+	}
+	return rules, nil
+}
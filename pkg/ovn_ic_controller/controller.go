@@ -1,6 +1,7 @@
 package ovn_ic_controller
 
 import (
+	"sync"
 	"time"
 
 	"github.com/scylladb/go-set/strset"
@@ -36,6 +37,8 @@ type Controller struct {
 	configMapsSynced cache.InformerSynced
 	vpcsLister       kubeovnlister.VpcLister
 	vpcSynced        cache.InformerSynced
+	icConfigsLister  kubeovnlister.InterconnectionConfigLister
+	icConfigsSynced  cache.InformerSynced
 
 	informerFactory        kubeinformers.SharedInformerFactory
 	kubeovnInformerFactory kubeovninformer.SharedInformerFactory
@@ -45,7 +48,16 @@ type Controller struct {
 	OVNNbClient     ovs.NbClient
 	OVNSbClient     ovs.SbClient
 
-	icConflictCIDRs *strset.Set
+	icConflictCIDRs     *strset.Set
+	icConflictsRestored bool
+	icRouteFilter       *kubeovnv1.InterconnectionRouteFilter
+
+	icFilteredRoutesLock sync.Mutex
+	icFilteredRoutes     []kubeovnv1.InterconnectionFilteredRoute
+
+	// only accessed by the interconnection resync loop
+	vpcTsConnectErrors map[string]string
+	vpcTsUnusedSince   map[string]time.Time
 }
 
 func NewController(config *Configuration) *Controller {
@@ -71,6 +83,7 @@ func NewController(config *Configuration) *Controller {
 	nodeInformer := informerFactory.Core().V1().Nodes()
 	subnetInformer := kubeovnInformerFactory.Kubeovn().V1().Subnets()
 	configMapInformer := informerFactory.Core().V1().ConfigMaps()
+	icConfigInformer := kubeovnInformerFactory.Kubeovn().V1().InterconnectionConfigs()
 
 	controller := &Controller{
 		config: config,
@@ -83,6 +96,8 @@ func NewController(config *Configuration) *Controller {
 		nodesSynced:      nodeInformer.Informer().HasSynced,
 		configMapsLister: configMapInformer.Lister(),
 		configMapsSynced: configMapInformer.Informer().HasSynced,
+		icConfigsLister:  icConfigInformer.Lister(),
+		icConfigsSynced:  icConfigInformer.Informer().HasSynced,
 
 		informerFactory:        informerFactory,
 		kubeovnInformerFactory: kubeovnInformerFactory,
@@ -90,6 +105,9 @@ func NewController(config *Configuration) *Controller {
 
 		ovnLegacyClient: ovs.NewLegacyClient(config.OvnTimeout),
 		icConflictCIDRs: strset.New(),
+
+		vpcTsConnectErrors: make(map[string]string),
+		vpcTsUnusedSince:   make(map[string]time.Time),
 	}
 
 	var err error
@@ -120,13 +138,14 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 	c.informerFactory.Start(stopCh)
 	c.kubeovnInformerFactory.Start(stopCh)
 
-	if !cache.WaitForCacheSync(stopCh, c.subnetSynced, c.nodesSynced, c.configMapsSynced, c.vpcSynced) {
+	if !cache.WaitForCacheSync(stopCh, c.subnetSynced, c.nodesSynced, c.configMapsSynced, c.vpcSynced, c.icConfigsSynced) {
 		util.LogFatalAndExit(nil, "failed to wait for caches to sync")
 		return
 	}
 
 	klog.Info("Started workers")
 	go wait.Until(c.resyncInterConnection, time.Second, stopCh)
+	go wait.Until(c.syncInterconnectionConfigStatus, 10*time.Second, stopCh)
 	go wait.Until(c.SynRouteToPolicy, 5*time.Second, stopCh)
 	<-stopCh
 	klog.Info("Shutting down workers")
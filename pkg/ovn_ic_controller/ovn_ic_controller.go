@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/scylladb/go-set/strset"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/klog/v2"
@@ -24,6 +25,9 @@ var (
 	lastIcCm  map[string]string
 	lastTSs   []string
 	curTSs    []string
+
+	lastInvalidIcCmVersion        string
+	lastInvalidIcConfigGeneration int64
 )
 
 func (c *Controller) disableOVNIC(azName string) error {
@@ -43,22 +47,33 @@ func (c *Controller) disableOVNIC(azName string) error {
 	return nil
 }
 
-func (c *Controller) setAutoRoute(autoRoute bool) error {
-	var blackList []string
+func (c *Controller) setAutoRoute(autoRoute bool, azName string) error {
 	subnets, err := c.subnetsLister.List(labels.Everything())
 	if err != nil {
 		klog.Errorf("failed to list subnets, %v", err)
 		return err
 	}
+	var filtered []kubeovnv1.InterconnectionFilteredRoute
+	if autoRoute {
+		if filtered, err = c.filterRoutes(subnets, azName); err != nil {
+			klog.Errorf("failed to filter routes, %v", err)
+			return err
+		}
+		for _, cidr := range c.conflictCIDRList() {
+			filtered = append(filtered, kubeovnv1.InterconnectionFilteredRoute{Prefix: cidr, Direction: icRouteDirectionLearn, Reason: icRouteFilterConflict})
+		}
+	} else if c.icConflictCIDRs != nil {
+		c.icConflictCIDRs.Clear()
+	}
+
+	var blackList []string
 	for _, subnet := range subnets {
-		if subnet.Spec.DisableInterConnection || subnet.Name == c.config.NodeSwitch {
+		if subnet.Name == c.config.NodeSwitch {
 			blackList = append(blackList, subnet.Spec.CIDRBlock)
 		}
 	}
-	if autoRoute {
-		blackList = append(blackList, c.conflictCIDRList()...)
-	} else if c.icConflictCIDRs != nil {
-		c.icConflictCIDRs.Clear()
+	for _, route := range filtered {
+		blackList = append(blackList, route.Prefix)
 	}
 	nodes, err := c.nodesLister.List(labels.Everything())
 	if err != nil {
@@ -79,13 +94,50 @@ func (c *Controller) setAutoRoute(autoRoute bool) error {
 		return err
 	}
 
+	c.icFilteredRoutesLock.Lock()
+	c.icFilteredRoutes = filtered
+	c.icFilteredRoutesLock.Unlock()
 	return nil
 }
 
+// filterRoutes returns the local prefixes not to advertise and the remote prefixes not to learn
+func (c *Controller) filterRoutes(subnets []*kubeovnv1.Subnet, azName string) ([]kubeovnv1.InterconnectionFilteredRoute, error) {
+	filter := c.icRouteFilter
+	if filter == nil {
+		filter = &kubeovnv1.InterconnectionRouteFilter{}
+	}
+	filtered := filterAdvertisedRoutes(subnets, c.config.NodeSwitch, filter.Advertise)
+	if len(filter.Learn) == 0 {
+		return filtered, nil
+	}
+
+	azs, err := c.ovnLegacyClient.ListAvailabilityZones()
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+	azPrefixes := make(map[string][]string, len(azs))
+	for name, uuid := range azs {
+		if name == azName {
+			continue
+		}
+		if azPrefixes[name], err = c.ovnLegacyClient.GetRoutePrefixesInOneAZ(uuid); err != nil {
+			klog.Error(err)
+			return nil, err
+		}
+	}
+	return append(filtered, filterLearnedRoutes(filter.Learn, azPrefixes)...), nil
+}
+
 func (c *Controller) DeleteICResources(azName string) error {
 	icTSs := make([]string, 0)
 	if err := c.OVNNbClient.DeleteLogicalSwitchPorts(nil, func(lsp *ovnnb.LogicalSwitchPort) bool {
 		// add the code below because azName may have multi "-"
+		if tsName, ok := strings.CutSuffix(lsp.Name, "-"+azName); ok && strings.HasPrefix(tsName, util.InterconnectionSwitch+"-") {
+			// port on the transit switch dedicated to a custom vpc
+			icTSs = append(icTSs, tsName)
+			return true
+		}
 		firstIndex := strings.Index(lsp.Name, "-")
 		if firstIndex != -1 {
 			firstPart := lsp.Name[:firstIndex]
@@ -102,6 +154,9 @@ func (c *Controller) DeleteICResources(azName string) error {
 	}
 
 	if err := c.OVNNbClient.DeleteLogicalRouterPorts(nil, func(lrp *ovnnb.LogicalRouterPort) bool {
+		if tsName, ok := strings.CutPrefix(lrp.Name, azName+"-"); ok && strings.HasPrefix(tsName, util.InterconnectionSwitch+"-") {
+			return true
+		}
 		lastIndex := strings.LastIndex(lrp.Name, "-")
 		if lastIndex != -1 {
 			firstPart := lrp.Name[:lastIndex]
@@ -141,6 +196,32 @@ func (c *Controller) getICState(cmData, lastcmData map[string]string) int {
 }
 
 func (c *Controller) resyncInterConnection() {
+	icConfig, err := c.icConfigsLister.Get(util.InterconnectionConfig)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		klog.Errorf("failed to get interconnection config %s, %v", util.InterconnectionConfig, err)
+		return
+	}
+	if err == nil {
+		// the InterconnectionConfig takes precedence over the ConfigMap
+		if err = validateRouteFilter(icConfig.Spec.RouteFilter); err != nil {
+			if icConfig.Generation != lastInvalidIcConfigGeneration {
+				klog.Errorf("invalid interconnection config %s, ignoring it: %v", icConfig.Name, err)
+				c.recorder.Event(icConfig, corev1.EventTypeWarning, "InvalidConfig", err.Error())
+				lastInvalidIcConfigGeneration = icConfig.Generation
+			}
+			return
+		}
+		lastInvalidIcConfigGeneration = 0
+		c.icRouteFilter = icConfig.Spec.RouteFilter
+		config := icConfigFromSpec(&icConfig.Spec)
+		if !icConfig.Spec.Enabled {
+			c.disableInterConnection(config)
+			return
+		}
+		c.reconcileInterConnection(config)
+		return
+	}
+
 	cm, err := c.configMapsLister.ConfigMaps(c.config.PodNamespace).Get(util.InterconnectionConfig)
 	if err != nil && !k8serrors.IsNotFound(err) {
 		klog.Errorf("failed to get ovn-ic-config, %v", err)
@@ -151,6 +232,17 @@ func (c *Controller) resyncInterConnection() {
 		c.disableInterConnection(nil)
 		return
 	}
+	if err = validateICConfigMap(cm.Data); err != nil {
+		// keep the current state rather than disabling interconnection on a typo
+		if cm.ResourceVersion != lastInvalidIcCmVersion {
+			klog.Errorf("invalid ovn-ic-config, ignoring it: %v", err)
+			c.recorder.Event(cm, corev1.EventTypeWarning, "InvalidConfig", err.Error())
+			lastInvalidIcCmVersion = cm.ResourceVersion
+		}
+		return
+	}
+	lastInvalidIcCmVersion = ""
+	c.icRouteFilter = nil
 	if cm.Data["enable-ic"] == "false" {
 		c.disableInterConnection(cm.Data)
 		return
@@ -170,7 +262,7 @@ func (c *Controller) disableInterConnection(config map[string]string) {
 		c.ovnLegacyClient.OvnICSbAddress = genHostAddress(config["ic-db-host"], config["ic-sb-port"])
 		c.ovnLegacyClient.OvnICNbAddress = genHostAddress(config["ic-db-host"], config["ic-nb-port"])
 	}
-	if err := c.setAutoRoute(false); err != nil {
+	if err := c.setAutoRoute(false, ""); err != nil {
 		klog.Errorf("failed to disable auto route: %v", err)
 		return
 	}
@@ -189,17 +281,25 @@ func (c *Controller) disableInterConnection(config map[string]string) {
 
 func (c *Controller) reconcileInterConnection(config map[string]string) {
 	autoRoute := config["auto-route"] == "true"
+	c.ovnLegacyClient.OvnICSbAddress = genHostAddress(config["ic-db-host"], config["ic-sb-port"])
 	if autoRoute {
 		if err := c.refreshConflictCIDRs(); err != nil {
 			klog.Errorf("failed to refresh conflicting learned routes: %v", err)
 			return
 		}
 	}
-	if err := c.setAutoRoute(autoRoute); err != nil {
+	if err := c.setAutoRoute(autoRoute, config["az-name"]); err != nil {
 		klog.Errorf("failed to set auto route: %v", err)
 		return
 	}
 
+	c.reconcileICState(config)
+	if icEnabled == "true" {
+		c.syncVpcInterConnection(config)
+	}
+}
+
+func (c *Controller) reconcileICState(config map[string]string) {
 	switch c.getICState(config, lastIcCm) {
 	case icNoAction:
 		return
@@ -668,10 +768,33 @@ func (c *Controller) refreshConflictCIDRs() error {
 		klog.Errorf("failed to list subnets, %v", err)
 		return err
 	}
-	localCIDRs := subnetCIDRs(subnets)
-	persisted, err := c.persistedConflictCIDRs(localCIDRs)
-	if err != nil {
-		return err
+	conflictSubnets := make([]*kubeovnv1.Subnet, 0, len(subnets))
+	for _, subnet := range subnets {
+		if subnet.Spec.InterConnectionPolicy == nil || !subnet.Spec.InterConnectionPolicy.IgnoreConflicts {
+			conflictSubnets = append(conflictSubnets, subnet)
+		}
+	}
+	localCIDRs := subnetCIDRs(conflictSubnets)
+	var persisted []string
+	if !c.icConflictsRestored {
+		// restore the conflicts detected before restarting from ic-route-blacklist,
+		// which also contains the prefixes filtered by the subnet policies and the route filters
+		if persisted, err = c.persistedConflictCIDRs(localCIDRs); err != nil {
+			return err
+		}
+		var advertiseRule *kubeovnv1.InterconnectionRouteFilterRule
+		if c.icRouteFilter != nil {
+			advertiseRule = c.icRouteFilter.Advertise
+		}
+		excluded := strset.New()
+		for _, route := range filterAdvertisedRoutes(subnets, c.config.NodeSwitch, advertiseRule) {
+			excluded.Add(route.Prefix)
+		}
+		if nodeSwitch, err := c.subnetsLister.Get(c.config.NodeSwitch); err == nil {
+			excluded.Add(subnetCIDRs([]*kubeovnv1.Subnet{nodeSwitch})...)
+		}
+		persisted = slices.DeleteFunc(persisted, func(cidr string) bool { return excluded.Has(cidr) })
+		c.icConflictsRestored = true
 	}
 
 	lrList, err := c.OVNNbClient.ListLogicalRouter(false, nil)
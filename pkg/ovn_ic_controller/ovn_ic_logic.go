@@ -1,8 +1,14 @@
 package ovn_ic_controller
 
 import (
+	"cmp"
+	"errors"
+	"fmt"
 	"maps"
+	"net"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/scylladb/go-set/strset"
@@ -11,6 +17,8 @@ import (
 	"github.com/kubeovn/kube-ovn/pkg/util"
 )
 
+var icConfigKeys = []string{"enable-ic", "az-name", "ic-db-host", "ic-nb-port", "ic-sb-port", "gw-nodes", "auto-route"}
+
 const (
 	icNoAction = iota
 	icFirstEstablish
@@ -117,3 +125,176 @@ func filterPersistedConflictCIDRs(localCIDRs []string, blacklist string) []strin
 	}
 	return persisted
 }
+
+// icConfigFromSpec converts the InterconnectionConfig spec into the ovn-ic-config ConfigMap format
+func icConfigFromSpec(spec *kubeovnv1.InterconnectionConfigSpec) map[string]string {
+	nbPort, sbPort := spec.ICNbPort, spec.ICSbPort
+	if nbPort == 0 {
+		nbPort = util.ICNBDatabasePort
+	}
+	if sbPort == 0 {
+		sbPort = util.ICSBDatabasePort
+	}
+	return map[string]string{
+		"enable-ic":  strconv.FormatBool(spec.Enabled),
+		"az-name":    spec.AzName,
+		"ic-db-host": strings.Join(spec.ICDBHosts, ","),
+		"ic-nb-port": strconv.Itoa(int(nbPort)),
+		"ic-sb-port": strconv.Itoa(int(sbPort)),
+		"gw-nodes":   strings.Join(spec.GatewayNodes, ","),
+		"auto-route": strconv.FormatBool(spec.AutoRoute),
+	}
+}
+
+// validateICConfigMap checks the data of the ovn-ic-config ConfigMap,
+// so that a misspelled key or value is reported instead of changing the interconnection state
+func validateICConfigMap(data map[string]string) error {
+	var errs []error
+	for key := range data {
+		if !slices.Contains(icConfigKeys, key) {
+			errs = append(errs, fmt.Errorf("unknown key %q", key))
+		}
+	}
+	switch data["enable-ic"] {
+	case "true":
+	case "false":
+		return errors.Join(errs...)
+	default:
+		errs = append(errs, fmt.Errorf("invalid enable-ic %q, must be true or false", data["enable-ic"]))
+	}
+
+	for _, key := range []string{"az-name", "ic-db-host"} {
+		if strings.TrimSpace(data[key]) == "" {
+			errs = append(errs, fmt.Errorf("%s is required", key))
+		}
+	}
+	for _, key := range []string{"ic-nb-port", "ic-sb-port"} {
+		if port, err := strconv.Atoi(data[key]); err != nil || port < 1 || port > 65535 {
+			errs = append(errs, fmt.Errorf("invalid %s %q", key, data[key]))
+		}
+	}
+	if autoRoute, ok := data["auto-route"]; ok && autoRoute != "true" && autoRoute != "false" {
+		errs = append(errs, fmt.Errorf("invalid auto-route %q, must be true or false", autoRoute))
+	}
+	return errors.Join(errs...)
+}
+
+// countLearnedRoutes counts the learned routes of each remote availability zone,
+// a learned route refers to the route in ovn-ic-sb db by its external id ic-learned-route
+func countLearnedRoutes(azRoutes map[string][]string, learnedRoutes []string) []kubeovnv1.InterconnectionRemoteAz {
+	learned := strset.New(learnedRoutes...)
+	remoteAzs := make([]kubeovnv1.InterconnectionRemoteAz, 0, len(azRoutes))
+	for name, routes := range azRoutes {
+		az := kubeovnv1.InterconnectionRemoteAz{Name: name}
+		for _, route := range routes {
+			if learned.Has(route) {
+				az.LearnedRoutes++
+			}
+		}
+		remoteAzs = append(remoteAzs, az)
+	}
+	slices.SortFunc(remoteAzs, func(a, b kubeovnv1.InterconnectionRemoteAz) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return remoteAzs
+}
+
+const (
+	icRouteDirectionAdvertise = "Advertise"
+	icRouteDirectionLearn     = "Learn"
+
+	icRouteFilterDenied       = "Denied"
+	icRouteFilterNotAllowed   = "NotAllowed"
+	icRouteFilterSubnetPolicy = "SubnetPolicy"
+	icRouteFilterConflict     = "Conflict"
+)
+
+func validateRouteFilter(filter *kubeovnv1.InterconnectionRouteFilter) error {
+	if filter == nil {
+		return nil
+	}
+	rules := make([]*kubeovnv1.InterconnectionRouteFilterRule, 0, len(filter.Learn)+1)
+	if filter.Advertise != nil {
+		rules = append(rules, filter.Advertise)
+	}
+	for i := range filter.Learn {
+		rules = append(rules, &filter.Learn[i].InterconnectionRouteFilterRule)
+	}
+	var errs []error
+	for _, rule := range rules {
+		for _, cidr := range slices.Concat(rule.Allow, rule.Deny) {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				errs = append(errs, fmt.Errorf("invalid route filter cidr %q", cidr))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// routeFilterReason returns why the prefix is rejected by the rule, or an empty string if it is accepted
+func routeFilterReason(rule *kubeovnv1.InterconnectionRouteFilterRule, prefix string) string {
+	if rule == nil {
+		return ""
+	}
+	for _, cidr := range rule.Deny {
+		if util.CIDROverlap(cidr, prefix) {
+			return icRouteFilterDenied
+		}
+	}
+	if len(rule.Allow) == 0 {
+		return ""
+	}
+	for _, cidr := range rule.Allow {
+		if contains, err := util.CIDRContainsCIDR(cidr, prefix); err == nil && contains {
+			return ""
+		}
+	}
+	return icRouteFilterNotAllowed
+}
+
+// filterAdvertisedRoutes returns the subnet CIDRs not advertised because of the subnet policies or the advertise rule
+func filterAdvertisedRoutes(subnets []*kubeovnv1.Subnet, nodeSwitch string, rule *kubeovnv1.InterconnectionRouteFilterRule) []kubeovnv1.InterconnectionFilteredRoute {
+	var filtered []kubeovnv1.InterconnectionFilteredRoute
+	for _, subnet := range subnets {
+		if subnet.Name == nodeSwitch {
+			continue
+		}
+		for _, cidr := range subnetCIDRs([]*kubeovnv1.Subnet{subnet}) {
+			reason := routeFilterReason(rule, cidr)
+			if !subnet.Spec.InterConnectionAdvertised() {
+				reason = icRouteFilterSubnetPolicy
+			}
+			if reason != "" {
+				filtered = append(filtered, kubeovnv1.InterconnectionFilteredRoute{Prefix: cidr, Direction: icRouteDirectionAdvertise, Reason: reason})
+			}
+		}
+	}
+	sortFilteredRoutes(filtered)
+	return filtered
+}
+
+// filterLearnedRoutes returns the prefixes advertised by remote AZs rejected by the learn rules applying to the AZ
+func filterLearnedRoutes(rules []kubeovnv1.InterconnectionLearnRouteFilter, azPrefixes map[string][]string) []kubeovnv1.InterconnectionFilteredRoute {
+	var filtered []kubeovnv1.InterconnectionFilteredRoute
+	for az, prefixes := range azPrefixes {
+		for _, prefix := range prefixes {
+			for i := range rules {
+				if rules[i].Az != "" && rules[i].Az != az {
+					continue
+				}
+				if reason := routeFilterReason(&rules[i].InterconnectionRouteFilterRule, prefix); reason != "" {
+					filtered = append(filtered, kubeovnv1.InterconnectionFilteredRoute{Prefix: prefix, Direction: icRouteDirectionLearn, Az: az, Reason: reason})
+					break
+				}
+			}
+		}
+	}
+	sortFilteredRoutes(filtered)
+	return filtered
+}
+
+func sortFilteredRoutes(routes []kubeovnv1.InterconnectionFilteredRoute) {
+	slices.SortFunc(routes, func(a, b kubeovnv1.InterconnectionFilteredRoute) int {
+		return cmp.Or(strings.Compare(a.Direction, b.Direction), strings.Compare(a.Az, b.Az), strings.Compare(a.Prefix, b.Prefix))
+	})
+}
@@ -1,9 +1,11 @@
 package ovn_ic_controller
 
 import (
+	"maps"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
 )
@@ -113,3 +115,175 @@ func TestGenerateNewOrderGwNodesEmpty(t *testing.T) {
 
 	require.Empty(t, generateNewOrderGwNodes(nil, 0))
 }
+
+func TestICConfigFromSpec(t *testing.T) {
+	t.Parallel()
+
+	spec := &kubeovnv1.InterconnectionConfigSpec{
+		Enabled:      true,
+		AzName:       "az1",
+		ICDBHosts:    []string{"192.168.0.1", "192.168.0.2"},
+		GatewayNodes: []string{"node1", "node2"},
+		AutoRoute:    true,
+	}
+	config := icConfigFromSpec(spec)
+	require.Equal(t, map[string]string{
+		"enable-ic":  "true",
+		"az-name":    "az1",
+		"ic-db-host": "192.168.0.1,192.168.0.2",
+		"ic-nb-port": "6645",
+		"ic-sb-port": "6646",
+		"gw-nodes":   "node1,node2",
+		"auto-route": "true",
+	}, config)
+	require.NoError(t, validateICConfigMap(config))
+
+	spec.ICNbPort, spec.ICSbPort = 16645, 16646
+	config = icConfigFromSpec(spec)
+	require.Equal(t, "16645", config["ic-nb-port"])
+	require.Equal(t, "16646", config["ic-sb-port"])
+}
+
+func TestValidateICConfigMap(t *testing.T) {
+	t.Parallel()
+
+	valid := map[string]string{
+		"enable-ic":  "true",
+		"az-name":    "az1",
+		"ic-db-host": "192.168.0.1",
+		"ic-nb-port": "6645",
+		"ic-sb-port": "6646",
+		"auto-route": "true",
+		"gw-nodes":   "node1",
+	}
+
+	tests := []struct {
+		name   string
+		update map[string]string
+		remove string
+		errMsg string
+	}{
+		{name: "valid"},
+		{name: "disabled without other keys", update: map[string]string{"enable-ic": "false", "az-name": ""}},
+		{name: "misspelled key", update: map[string]string{"az_name": "az1"}, errMsg: `unknown key "az_name"`},
+		{name: "misspelled enable-ic", update: map[string]string{"enable-ic": "ture"}, errMsg: `invalid enable-ic "ture"`},
+		{name: "missing enable-ic", remove: "enable-ic", errMsg: `invalid enable-ic ""`},
+		{name: "missing az name", remove: "az-name", errMsg: "az-name is required"},
+		{name: "invalid port", update: map[string]string{"ic-nb-port": "66450"}, errMsg: `invalid ic-nb-port "66450"`},
+		{name: "invalid auto route", update: map[string]string{"auto-route": "yes"}, errMsg: `invalid auto-route "yes"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			data := cloneICConfig(valid)
+			maps.Copy(data, tt.update)
+			delete(data, tt.remove)
+			err := validateICConfigMap(data)
+			if tt.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestCountLearnedRoutes(t *testing.T) {
+	t.Parallel()
+
+	azRoutes := map[string][]string{
+		"az3": nil,
+		"az2": {"route1", "route2", "route3"},
+	}
+	require.Equal(t, []kubeovnv1.InterconnectionRemoteAz{
+		{Name: "az2", LearnedRoutes: 2},
+		{Name: "az3"},
+	}, countLearnedRoutes(azRoutes, []string{"route1", "route3", "route4"}))
+}
+
+func TestValidateRouteFilter(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, validateRouteFilter(nil))
+	require.NoError(t, validateRouteFilter(&kubeovnv1.InterconnectionRouteFilter{
+		Advertise: &kubeovnv1.InterconnectionRouteFilterRule{Allow: []string{"10.0.0.0/8"}, Deny: []string{"fd00::/64"}},
+	}))
+	err := validateRouteFilter(&kubeovnv1.InterconnectionRouteFilter{
+		Learn: []kubeovnv1.InterconnectionLearnRouteFilter{{
+			InterconnectionRouteFilterRule: kubeovnv1.InterconnectionRouteFilterRule{Deny: []string{"10.0.0.1"}},
+		}},
+	})
+	require.ErrorContains(t, err, `invalid route filter cidr "10.0.0.1"`)
+}
+
+func TestRouteFilterReason(t *testing.T) {
+	t.Parallel()
+
+	rule := &kubeovnv1.InterconnectionRouteFilterRule{
+		Allow: []string{"10.0.0.0/8"},
+		Deny:  []string{"10.1.0.0/16"},
+	}
+	tests := []struct {
+		name   string
+		rule   *kubeovnv1.InterconnectionRouteFilterRule
+		prefix string
+		reason string
+	}{
+		{name: "no rule", prefix: "192.168.0.0/24"},
+		{name: "allowed", rule: rule, prefix: "10.2.0.0/16"},
+		{name: "denied overlap", rule: rule, prefix: "10.1.1.0/24", reason: icRouteFilterDenied},
+		{name: "deny takes precedence", rule: rule, prefix: "10.0.0.0/8", reason: icRouteFilterDenied},
+		{name: "not allowed", rule: rule, prefix: "192.168.0.0/24", reason: icRouteFilterNotAllowed},
+		{name: "not allowed family", rule: rule, prefix: "fd00::/64", reason: icRouteFilterNotAllowed},
+		{name: "deny only", rule: &kubeovnv1.InterconnectionRouteFilterRule{Deny: []string{"10.1.0.0/16"}}, prefix: "192.168.0.0/24"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.reason, routeFilterReason(tt.rule, tt.prefix))
+		})
+	}
+}
+
+func TestFilterAdvertisedRoutes(t *testing.T) {
+	t.Parallel()
+
+	subnets := []*kubeovnv1.Subnet{
+		{ObjectMeta: metav1.ObjectMeta{Name: "join"}, Spec: kubeovnv1.SubnetSpec{CIDRBlock: "100.64.0.0/16", DisableInterConnection: true}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "s1"}, Spec: kubeovnv1.SubnetSpec{CIDRBlock: "10.16.0.0/16,fd00::/64"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "s2"}, Spec: kubeovnv1.SubnetSpec{CIDRBlock: "10.17.0.0/16", DisableInterConnection: true}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "s3"}, Spec: kubeovnv1.SubnetSpec{
+			CIDRBlock:              "10.18.0.0/16",
+			DisableInterConnection: true,
+			InterConnectionPolicy:  &kubeovnv1.SubnetInterConnectionPolicy{Advertise: true},
+		}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "s4"}, Spec: kubeovnv1.SubnetSpec{
+			CIDRBlock:             "10.19.0.0/16",
+			InterConnectionPolicy: &kubeovnv1.SubnetInterConnectionPolicy{Advertise: false},
+		}},
+	}
+	rule := &kubeovnv1.InterconnectionRouteFilterRule{Allow: []string{"10.0.0.0/8"}}
+	require.Equal(t, []kubeovnv1.InterconnectionFilteredRoute{
+		{Prefix: "10.17.0.0/16", Direction: icRouteDirectionAdvertise, Reason: icRouteFilterSubnetPolicy},
+		{Prefix: "10.19.0.0/16", Direction: icRouteDirectionAdvertise, Reason: icRouteFilterSubnetPolicy},
+		{Prefix: "fd00::/64", Direction: icRouteDirectionAdvertise, Reason: icRouteFilterNotAllowed},
+	}, filterAdvertisedRoutes(subnets, "join", rule))
+}
+
+func TestFilterLearnedRoutes(t *testing.T) {
+	t.Parallel()
+
+	rules := []kubeovnv1.InterconnectionLearnRouteFilter{
+		{InterconnectionRouteFilterRule: kubeovnv1.InterconnectionRouteFilterRule{Deny: []string{"192.168.0.0/16"}}},
+		{Az: "az2", InterconnectionRouteFilterRule: kubeovnv1.InterconnectionRouteFilterRule{Allow: []string{"10.2.0.0/16"}}},
+	}
+	azPrefixes := map[string][]string{
+		"az2": {"10.2.1.0/24", "10.3.0.0/16", "192.168.1.0/24"},
+		"az3": {"10.3.0.0/16", "192.168.2.0/24"},
+	}
+	require.Equal(t, []kubeovnv1.InterconnectionFilteredRoute{
+		{Prefix: "10.3.0.0/16", Direction: icRouteDirectionLearn, Az: "az2", Reason: icRouteFilterNotAllowed},
+		{Prefix: "192.168.1.0/24", Direction: icRouteDirectionLearn, Az: "az2", Reason: icRouteFilterDenied},
+		{Prefix: "192.168.2.0/24", Direction: icRouteDirectionLearn, Az: "az3", Reason: icRouteFilterDenied},
+	}, filterLearnedRoutes(rules, azPrefixes))
+}
@@ -0,0 +1,165 @@
+package ovn_ic_controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/ovs"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+// syncInterconnectionConfigStatus reports the state of the interconnection in the status of the InterconnectionConfig
+func (c *Controller) syncInterconnectionConfigStatus() {
+	icConfig, err := c.icConfigsLister.Get(util.InterconnectionConfig)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			klog.Errorf("failed to get interconnection config %s: %v", util.InterconnectionConfig, err)
+		}
+		return
+	}
+
+	status := *icConfig.Status.DeepCopy()
+	status.ObservedGeneration = icConfig.Generation
+	if icConfig.Spec.Enabled {
+		c.buildInterconnectionConfigStatus(icConfig, &status)
+	} else {
+		status.TransitSwitches, status.Gateways, status.RemoteAzs, status.FilteredRoutes = nil, nil, nil, nil
+		for _, ctype := range []kubeovnv1.ConditionType{kubeovnv1.ICDBConnected, kubeovnv1.TransitSwitchesReady, kubeovnv1.GatewaysReady} {
+			status.Conditions.RemoveCondition(ctype)
+		}
+		status.Conditions.SetCondition(kubeovnv1.Ready, corev1.ConditionFalse, "Disabled", "interconnection is disabled", icConfig.Generation)
+	}
+	if equality.Semantic.DeepEqual(icConfig.Status, status) {
+		return
+	}
+
+	icConfig = icConfig.DeepCopy()
+	icConfig.Status = status
+	icConfig.Status.LastUpdateTime = metav1.Now()
+	if _, err = c.config.KubeOvnClient.KubeovnV1().InterconnectionConfigs().UpdateStatus(context.Background(), icConfig, metav1.UpdateOptions{}); err != nil {
+		klog.Errorf("failed to update status of interconnection config %s: %v", icConfig.Name, err)
+	}
+}
+
+func (c *Controller) buildInterconnectionConfigStatus(icConfig *kubeovnv1.InterconnectionConfig, status *kubeovnv1.InterconnectionConfigStatus) {
+	generation := icConfig.Generation
+	config := icConfigFromSpec(&icConfig.Spec)
+	azName := icConfig.Spec.AzName
+
+	c.icFilteredRoutesLock.Lock()
+	status.FilteredRoutes = slices.Clone(c.icFilteredRoutes)
+	c.icFilteredRoutesLock.Unlock()
+
+	status.Gateways = make([]kubeovnv1.InterconnectionGateway, 0, len(icConfig.Spec.GatewayNodes))
+	var notReady []string
+	for _, node := range icConfig.Spec.GatewayNodes {
+		gw := kubeovnv1.InterconnectionGateway{Node: node}
+		if chassis, err := c.OVNSbClient.GetChassisByHost(node); err == nil && chassis.Name != "" {
+			gw.Chassis, gw.Ready = chassis.Name, true
+		} else {
+			notReady = append(notReady, node)
+		}
+		status.Gateways = append(status.Gateways, gw)
+	}
+	switch {
+	case len(status.Gateways) == 0:
+		status.Conditions.SetCondition(kubeovnv1.GatewaysReady, corev1.ConditionFalse, "NoGateway", "no gateway node is specified", generation)
+	case len(notReady) != 0:
+		status.Conditions.SetCondition(kubeovnv1.GatewaysReady, corev1.ConditionFalse, "ChassisNotFound", "no chassis for gateway nodes: "+strings.Join(notReady, ", "), generation)
+	default:
+		status.Conditions.SetCondition(kubeovnv1.GatewaysReady, corev1.ConditionTrue, "ChassisFound", "", generation)
+	}
+
+	ovnLegacyClient := *c.ovnLegacyClient
+	ovnLegacyClient.OvnICNbAddress = genHostAddress(config["ic-db-host"], config["ic-nb-port"])
+	ovnLegacyClient.OvnICSbAddress = genHostAddress(config["ic-db-host"], config["ic-sb-port"])
+	tsNames, err := ovnLegacyClient.GetTs()
+	if err != nil {
+		status.TransitSwitches, status.RemoteAzs = nil, nil
+		status.Conditions.SetCondition(kubeovnv1.ICDBConnected, corev1.ConditionFalse, "ConnectFailed", err.Error(), generation)
+		status.Conditions.SetCondition(kubeovnv1.TransitSwitchesReady, corev1.ConditionUnknown, "ICDBDisconnected", "", generation)
+		status.Conditions.SetCondition(kubeovnv1.Ready, corev1.ConditionFalse, "ICDBDisconnected", "", generation)
+		return
+	}
+
+	status.TransitSwitches = nil
+	var missing []string
+	for _, ts := range tsNames {
+		tsPort := fmt.Sprintf("%s-%s", ts, azName)
+		exist, err := c.OVNNbClient.LogicalSwitchPortExists(tsPort)
+		if err != nil {
+			klog.Errorf("failed to check logical switch port %q: %v", tsPort, err)
+		}
+		if exist {
+			status.TransitSwitches = append(status.TransitSwitches, ts)
+		} else {
+			missing = append(missing, ts)
+		}
+	}
+	if len(missing) != 0 {
+		status.Conditions.SetCondition(kubeovnv1.TransitSwitchesReady, corev1.ConditionFalse, "NotAttached", "not attached to transit switches: "+strings.Join(missing, ", "), generation)
+	} else {
+		status.Conditions.SetCondition(kubeovnv1.TransitSwitchesReady, corev1.ConditionTrue, "Attached", "", generation)
+	}
+
+	remoteAzs, err := c.remoteAzStatus(&ovnLegacyClient, azName)
+	if err != nil {
+		status.Conditions.SetCondition(kubeovnv1.ICDBConnected, corev1.ConditionFalse, "ConnectFailed", err.Error(), generation)
+		status.Conditions.SetCondition(kubeovnv1.Ready, corev1.ConditionFalse, "ICDBDisconnected", "", generation)
+		return
+	}
+	status.RemoteAzs = remoteAzs
+	status.Conditions.SetCondition(kubeovnv1.ICDBConnected, corev1.ConditionTrue, "Connected", "", generation)
+
+	if status.Conditions.IsConditionTrue(kubeovnv1.TransitSwitchesReady, generation) && status.Conditions.IsConditionTrue(kubeovnv1.GatewaysReady, generation) {
+		status.Conditions.SetReady("Established", generation)
+	} else {
+		status.Conditions.SetCondition(kubeovnv1.Ready, corev1.ConditionFalse, "NotEstablished", "", generation)
+	}
+}
+
+// remoteAzStatus lists the other availability zones in ovn-ic-sb db with the number of routes learned from each of them
+func (c *Controller) remoteAzStatus(ovnLegacyClient *ovs.LegacyClient, localAz string) ([]kubeovnv1.InterconnectionRemoteAz, error) {
+	azs, err := ovnLegacyClient.ListAvailabilityZones()
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+	azRoutes := make(map[string][]string, len(azs))
+	for name, uuid := range azs {
+		if name == localAz {
+			continue
+		}
+		if azRoutes[name], err = ovnLegacyClient.GetRouteUUIDsInOneAZ(uuid); err != nil {
+			klog.Error(err)
+			return nil, err
+		}
+	}
+
+	lrList, err := c.OVNNbClient.ListLogicalRouter(false, nil)
+	if err != nil {
+		klog.Errorf("failed to list logical routers: %v", err)
+		return nil, err
+	}
+	var learnedRoutes []string
+	for _, lr := range lrList {
+		routeList, err := c.OVNNbClient.ListLogicalRouterStaticRoutes(lr.Name, nil, nil, "", map[string]string{"ic-learned-route": ""})
+		if err != nil {
+			klog.Errorf("failed to list learned static routes on logical router %s: %v", lr.Name, err)
+			return nil, err
+		}
+		for _, route := range routeList {
+			learnedRoutes = append(learnedRoutes, route.ExternalIDs["ic-learned-route"])
+		}
+	}
+	return countLearnedRoutes(azRoutes, learnedRoutes), nil
+}
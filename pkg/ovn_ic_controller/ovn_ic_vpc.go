@@ -0,0 +1,190 @@
+package ovn_ic_controller
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+// vpcTsDeletionGracePeriod is how long a transit switch of vpcs stays unused before it is deleted,
+// so that the transit switch created by another AZ is not deleted before that AZ binds its port
+const vpcTsDeletionGracePeriod = time.Minute
+
+// syncVpcInterConnection connects the routers of custom vpcs with interconnection enabled to the transit switches
+// dedicated to them, so that routes are only exchanged between the routers of the same vpc in different AZs
+func (c *Controller) syncVpcInterConnection(config map[string]string) {
+	vpcs, err := c.vpcsLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list vpcs: %v", err)
+		return
+	}
+	tsNames, err := c.ovnLegacyClient.GetVpcTs()
+	if err != nil {
+		klog.Errorf("failed to list transit switches of vpcs: %v", err)
+		return
+	}
+
+	azName := config["az-name"]
+	gwNodes := parseGwNodes(config["gw-nodes"])
+	expected := make(map[string]bool)
+	for _, vpc := range vpcs {
+		if vpc.Name == c.config.ClusterRouter || !vpc.Spec.Interconnection.IsEnabled() || vpc.Status.Router == "" {
+			continue
+		}
+		tsName := util.VpcTransitSwitchName(vpc.Name)
+		expected[tsName] = true
+		if err = c.connectVpcToTs(vpc.Name, vpc.Status.Router, tsName, vpc.Spec.Interconnection.Subnet, azName, gwNodes); err != nil {
+			klog.Errorf("failed to connect vpc %s to transit switch %s: %v", vpc.Name, tsName, err)
+			// the connection is retried every second, so only warn when the error changes
+			if c.vpcTsConnectErrors[vpc.Name] != err.Error() {
+				c.recorder.Eventf(vpc, corev1.EventTypeWarning, "ConnectTransitSwitchFailed", "failed to connect to transit switch %s: %v", tsName, err)
+				c.vpcTsConnectErrors[vpc.Name] = err.Error()
+			}
+			continue
+		}
+		delete(c.vpcTsConnectErrors, vpc.Name)
+	}
+	for vpc := range c.vpcTsConnectErrors {
+		if !expected[util.VpcTransitSwitchName(vpc)] {
+			delete(c.vpcTsConnectErrors, vpc)
+		}
+	}
+
+	for tsName := range c.vpcTsUnusedSince {
+		if expected[tsName] || !slices.Contains(tsNames, tsName) {
+			delete(c.vpcTsUnusedSince, tsName)
+		}
+	}
+	for _, tsName := range tsNames {
+		if expected[tsName] {
+			continue
+		}
+		// the transit switch is deleted only when it is not used by the vpc in other AZs
+		if err = c.disconnectVpcFromTs(tsName, azName); err != nil {
+			klog.Errorf("failed to disconnect from transit switch %s: %v", tsName, err)
+		}
+	}
+}
+
+func (c *Controller) connectVpcToTs(vpc, lrName, tsName, tsSubnet, azName string, gwNodes []string) error {
+	if len(gwNodes) == 0 {
+		err := errors.New("no gw-nodes are configured in the ovn-ic config")
+		klog.Error(err)
+		return err
+	}
+	if err := c.ovnLegacyClient.CreateVpcTs(tsName, vpc, tsSubnet); err != nil {
+		klog.Error(err)
+		return err
+	}
+	// the logical switch is created by ovn-ic after the transit switch is added to ovn-ic-nb db
+	exist, err := c.OVNNbClient.LogicalSwitchExists(tsName)
+	if err != nil {
+		klog.Errorf("failed to check logical switch %s: %v", tsName, err)
+		return err
+	}
+	if !exist {
+		return nil
+	}
+
+	tsPort := fmt.Sprintf("%s-%s", tsName, azName)
+	lrpName := fmt.Sprintf("%s-%s", azName, tsName)
+	chassises, err := c.gatewayChassisNames(gwNodes, 0)
+	if err != nil {
+		return err
+	}
+	if exist, err = c.OVNNbClient.LogicalSwitchPortExists(tsPort); err != nil {
+		klog.Errorf("failed to check logical switch port %s: %v", tsPort, err)
+		return err
+	}
+	if exist {
+		if err = c.OVNNbClient.ReconcileGatewayChassises(lrpName, chassises); err != nil {
+			klog.Errorf("failed to reconcile gateway chassis for ic lrp %s: %v", lrpName, err)
+			return err
+		}
+		return nil
+	}
+
+	lrpAddr, err := c.acquireLrpAddress(tsName)
+	if err != nil {
+		klog.Errorf("failed to acquire lrp address for ts %s: %v", tsName, err)
+		return err
+	}
+	klog.Infof("connecting vpc %s to transit switch %s", vpc, tsName)
+	if err = c.OVNNbClient.CreateLogicalPatchPort(tsName, lrName, tsPort, lrpName, lrpAddr, util.GenerateMac(), chassises...); err != nil {
+		klog.Errorf("failed to create ovn-ic lrp %s: %v", lrpName, err)
+		return err
+	}
+	return nil
+}
+
+// disconnectVpcFromTs removes the local ports of the transit switch, and deletes the transit switch
+// once no other AZ has been connected to it for vpcTsDeletionGracePeriod, whether the local AZ was connected or not
+func (c *Controller) disconnectVpcFromTs(tsName, azName string) error {
+	tsPort := fmt.Sprintf("%s-%s", tsName, azName)
+	exist, err := c.OVNNbClient.LogicalSwitchPortExists(tsPort)
+	if err != nil {
+		klog.Errorf("failed to check logical switch port %s: %v", tsPort, err)
+		return err
+	}
+	if exist {
+		klog.Infof("disconnecting from transit switch %s", tsName)
+		lrpName := fmt.Sprintf("%s-%s", azName, tsName)
+		if err = c.OVNNbClient.DeleteLogicalRouterPort(lrpName); err != nil {
+			klog.Errorf("failed to delete logical router port %s: %v", lrpName, err)
+			return err
+		}
+		if err = c.OVNNbClient.DeleteLogicalSwitchPort(tsPort); err != nil {
+			klog.Errorf("failed to delete logical switch port %s: %v", tsPort, err)
+			return err
+		}
+	}
+
+	// the port binding of the local AZ is removed by ovn-ic asynchronously, so only the other AZs are checked
+	inUse, err := c.tsUsedByOtherAZs(tsName, azName)
+	if err != nil {
+		klog.Errorf("failed to check the availability zones connected to transit switch %s: %v", tsName, err)
+		return err
+	}
+	if inUse {
+		delete(c.vpcTsUnusedSince, tsName)
+		return nil
+	}
+	unusedSince, ok := c.vpcTsUnusedSince[tsName]
+	if !ok {
+		c.vpcTsUnusedSince[tsName] = time.Now()
+		return nil
+	}
+	if time.Since(unusedSince) < vpcTsDeletionGracePeriod {
+		return nil
+	}
+
+	klog.Infof("deleting transit switch %s which is no longer used by any vpc", tsName)
+	if err = c.ovnLegacyClient.DeleteVpcTs(tsName); err != nil {
+		klog.Error(err)
+		return err
+	}
+	delete(c.vpcTsUnusedSince, tsName)
+	return nil
+}
+
+// tsUsedByOtherAZs returns whether an AZ other than the local one has a port on the transit switch
+func (c *Controller) tsUsedByOtherAZs(tsName, azName string) (bool, error) {
+	azUUID, err := c.ovnLegacyClient.GetAzUUID(azName)
+	if err != nil {
+		klog.Error(err)
+		return false, err
+	}
+	azs, err := c.ovnLegacyClient.GetTsPortBindingAZs(tsName)
+	if err != nil {
+		klog.Error(err)
+		return false, err
+	}
+	return slices.ContainsFunc(azs, func(az string) bool { return az != azUUID }), nil
+}
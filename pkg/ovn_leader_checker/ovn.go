@@ -678,14 +678,17 @@ func getTSCidr(index int) (string, error) {
 }
 
 func updateTS() error {
-	cmd := exec.Command("ovn-ic-nbctl", "show")
+	cmd := exec.Command("ovn-ic-nbctl", "--format=csv", "--data=bare", "--no-heading", "--columns=name", "list", "Transit_Switch")
 	output, err := cmd.CombinedOutput()
 	if err != nil {
-		return fmt.Errorf("ovn-ic-nbctl show output: %s, err: %w", output, err)
+		return fmt.Errorf("ovn-ic-nbctl list Transit_Switch output: %s, err: %w", output, err)
 	}
+	// transit switches dedicated to custom vpcs are managed by ovn-ic-controller
 	var existTSCount int
-	if lines := strings.TrimSpace(string(output)); lines != "" {
-		existTSCount = len(strings.Split(lines, "\n"))
+	for name := range strings.FieldsSeq(string(output)) {
+		if util.IsDefaultTransitSwitch(name) {
+			existTSCount++
+		}
 	}
 	expectTSCount, err := strconv.Atoi(os.Getenv("TS_NUM"))
 	if err != nil {
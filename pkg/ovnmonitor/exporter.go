@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/kubeovn/ovsdb"
+	"github.com/ovn-kubernetes/libovsdb/client"
 	"k8s.io/klog/v2"
 
 	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnnb"
@@ -32,6 +33,9 @@ type Exporter struct {
 	pollInterval int
 	errors       int64
 	errorsLocker sync.RWMutex
+
+	// lflowClient monitors the southbound tables used to count logical flows
+	lflowClient client.Client
 }
 
 // OVNDBClusterStatus contains information about a cluster.
@@ -155,6 +159,9 @@ func (e *Exporter) ovnMetricsUpdate() {
 		e.exportLogicalSwitchGauge()
 		e.exportLogicalSwitchPortGauge()
 
+		e.exportOvnPerformanceGauge()
+		e.exportLogicalFlowCountGauge()
+
 		if e.exportOvnClusterEnableGauge() {
 			e.exportOvnClusterInfoGauge()
 		} else {
@@ -247,6 +254,16 @@ func (e *Exporter) exportLogicalSwitchPortGauge() {
 	e.setLogicalSwitchPortInfoMetric()
 }
 
+func (e *Exporter) exportOvnPerformanceGauge() {
+	resetOvnPerformanceMetrics()
+	e.setOvnPerformanceMetric()
+}
+
+func (e *Exporter) exportLogicalFlowCountGauge() {
+	metricLogicalFlowCount.Reset()
+	e.setLogicalFlowCountMetric()
+}
+
 func (e *Exporter) exportOvnClusterEnableGauge() bool {
 	metricClusterEnabled.Reset()
 	isClusterEnabled, err := getClusterEnableState(e.Client.Database.Northbound.File.Data.Path)
@@ -0,0 +1,168 @@
+package ovnmonitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ovn-kubernetes/libovsdb/client"
+	"github.com/ovn-kubernetes/libovsdb/model"
+	"github.com/ovn-kubernetes/libovsdb/ovsdb"
+	"k8s.io/klog/v2"
+
+	ovsclient "github.com/kubeovn/kube-ovn/pkg/ovsdb/client"
+	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnsb"
+)
+
+// The models below only contain the columns used to count logical flows. The tables are monitored
+// with these columns only, so the cache stays small, and only the changes of the rows are sent by
+// the database server after the initial dump instead of the whole tables in every poll.
+
+type logicalFlow struct {
+	UUID            string  `ovsdb:"_uuid"`
+	LogicalDatapath *string `ovsdb:"logical_datapath"`
+	LogicalDPGroup  *string `ovsdb:"logical_dp_group"`
+}
+
+type logicalDPGroup struct {
+	UUID      string   `ovsdb:"_uuid"`
+	Datapaths []string `ovsdb:"datapaths"`
+}
+
+type datapathBinding struct {
+	UUID        string            `ovsdb:"_uuid"`
+	ExternalIDs map[string]string `ovsdb:"external_ids"`
+}
+
+type portBinding struct {
+	UUID        string            `ovsdb:"_uuid"`
+	LogicalPort string            `ovsdb:"logical_port"`
+	Datapath    string            `ovsdb:"datapath"`
+	Type        string            `ovsdb:"type"`
+	Options     map[string]string `ovsdb:"options"`
+}
+
+// logicalFlowDatapath is a southbound datapath with the number of logical flows on it
+type logicalFlowDatapath struct {
+	name   string
+	dpType string
+	// the subnet and vpc which the datapath belongs to
+	subnet string
+	vpc    string
+	flows  int
+}
+
+// newLogicalFlowClient creates the client which monitors the tables used to count logical flows.
+// The local database server is monitored no matter whether it is the leader of the cluster.
+func newLogicalFlowClient(address string, timeout int) (client.Client, error) {
+	dbModel, err := model.NewClientDBModel(ovnsb.DatabaseName, map[string]model.Model{
+		ovnsb.LogicalFlowTable:     &logicalFlow{},
+		ovnsb.LogicalDPGroupTable:  &logicalDPGroup{},
+		ovnsb.DatapathBindingTable: &datapathBinding{},
+		ovnsb.PortBindingTable:     &portBinding{},
+	})
+	if err != nil {
+		klog.Error(err)
+		return nil, fmt.Errorf("failed to create client db model: %w", err)
+	}
+
+	lflow, group, dp, pb := &logicalFlow{}, &logicalDPGroup{}, &datapathBinding{}, &portBinding{}
+	monitors := []client.MonitorOption{
+		client.WithTable(lflow, &lflow.LogicalDatapath, &lflow.LogicalDPGroup),
+		client.WithTable(group, &group.Datapaths),
+		client.WithTable(dp, &dp.ExternalIDs),
+		client.WithConditionalTable(pb, []model.Condition{{
+			Field:    &pb.Type,
+			Function: ovsdb.ConditionEqual,
+			Value:    "patch",
+		}}, &pb.LogicalPort, &pb.Datapath, &pb.Type, &pb.Options),
+	}
+	return ovsclient.NewOvsDbClient(ovnsb.DatabaseName, address, dbModel, monitors, timeout, 0, client.WithLeaderOnly(false))
+}
+
+// countLogicalFlows counts the logical flows of each datapath, including the flows shared by datapath groups.
+// The logical switch of a subnet is named after the subnet, and the logical router of a vpc is named after the vpc.
+// The vpc of a logical switch is the logical router which the patch port of the switch peers with.
+func countLogicalFlows(datapathList []datapathBinding, patchPorts []portBinding, groupList []logicalDPGroup, flows []logicalFlow) map[string]*logicalFlowDatapath {
+	datapaths := make(map[string]*logicalFlowDatapath, len(datapathList))
+	for _, row := range datapathList {
+		dp := &logicalFlowDatapath{name: row.ExternalIDs["name"]}
+		switch {
+		case row.ExternalIDs["logical-switch"] != "":
+			dp.dpType, dp.subnet = "switch", dp.name
+		case row.ExternalIDs["logical-router"] != "":
+			dp.dpType, dp.vpc = "router", dp.name
+		}
+		datapaths[row.UUID] = dp
+	}
+
+	// find the logical router which each logical switch connects to by patch ports
+	portDatapaths := make(map[string]string, len(patchPorts))
+	for _, port := range patchPorts {
+		portDatapaths[port.LogicalPort] = port.Datapath
+	}
+	for _, port := range patchPorts {
+		dp := datapaths[port.Datapath]
+		if dp == nil || dp.dpType != "switch" {
+			continue
+		}
+		if peer := datapaths[portDatapaths[port.Options["peer"]]]; peer != nil && peer.dpType == "router" {
+			dp.vpc = peer.name
+		}
+	}
+
+	groups := make(map[string][]string, len(groupList))
+	for _, group := range groupList {
+		groups[group.UUID] = group.Datapaths
+	}
+	for _, flow := range flows {
+		var dpUUIDs []string
+		if flow.LogicalDatapath != nil {
+			dpUUIDs = append(dpUUIDs, *flow.LogicalDatapath)
+		}
+		if flow.LogicalDPGroup != nil {
+			dpUUIDs = append(dpUUIDs, groups[*flow.LogicalDPGroup]...)
+		}
+		for _, uuid := range dpUUIDs {
+			if dp := datapaths[uuid]; dp != nil {
+				dp.flows++
+			}
+		}
+	}
+
+	return datapaths
+}
+
+func (e *Exporter) setLogicalFlowCountMetric() {
+	// the initial dump of the logical flows may take a long time in large scale clusters
+	timeout := max(e.timeout, e.pollInterval)
+	if e.lflowClient == nil {
+		c, err := newLogicalFlowClient(e.Client.Database.Southbound.Socket.Remote, timeout)
+		if err != nil {
+			klog.Errorf("failed to create client to count logical flows: %v", err)
+			e.IncrementErrorCounter()
+			return
+		}
+		e.lflowClient = c
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
+	defer cancel()
+	var (
+		datapaths []datapathBinding
+		ports     []portBinding
+		groups    []logicalDPGroup
+		flows     []logicalFlow
+	)
+	for _, result := range []any{&datapaths, &ports, &groups, &flows} {
+		if err := e.lflowClient.List(ctx, result); err != nil {
+			klog.Errorf("failed to list rows to count logical flows: %v", err)
+			e.IncrementErrorCounter()
+			return
+		}
+	}
+
+	for _, dp := range countLogicalFlows(datapaths, ports, groups, flows) {
+		metricLogicalFlowCount.WithLabelValues(e.Client.System.Hostname, dp.name, dp.dpType, dp.subnet, dp.vpc).Set(float64(dp.flows))
+	}
+}
@@ -0,0 +1,55 @@
+package ovnmonitor
+
+import (
+	"testing"
+
+	"github.com/ovn-kubernetes/libovsdb/model"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnsb"
+)
+
+func TestLogicalFlowModels(t *testing.T) {
+	dbModel, err := model.NewClientDBModel(ovnsb.DatabaseName, map[string]model.Model{
+		ovnsb.LogicalFlowTable:     &logicalFlow{},
+		ovnsb.LogicalDPGroupTable:  &logicalDPGroup{},
+		ovnsb.DatapathBindingTable: &datapathBinding{},
+		ovnsb.PortBindingTable:     &portBinding{},
+	})
+	require.NoError(t, err)
+	_, errs := model.NewDatabaseModel(ovnsb.Schema(), dbModel)
+	require.Empty(t, errs)
+}
+
+func TestCountLogicalFlows(t *testing.T) {
+	datapaths := []datapathBinding{
+		{UUID: "dp-ls1", ExternalIDs: map[string]string{"name": "subnet1", "logical-switch": "ls1"}},
+		{UUID: "dp-ls2", ExternalIDs: map[string]string{"name": "subnet2", "logical-switch": "ls2"}},
+		{UUID: "dp-lr1", ExternalIDs: map[string]string{"name": "vpc1", "logical-router": "lr1"}},
+	}
+	ports := []portBinding{
+		{LogicalPort: "subnet1-vpc1", Datapath: "dp-ls1", Type: "patch", Options: map[string]string{"peer": "vpc1-subnet1"}},
+		{LogicalPort: "vpc1-subnet1", Datapath: "dp-lr1", Type: "patch", Options: map[string]string{"peer": "subnet1-vpc1"}},
+		// the peer of the port does not exist
+		{LogicalPort: "subnet2-vpc2", Datapath: "dp-ls2", Type: "patch", Options: map[string]string{"peer": "vpc2-subnet2"}},
+	}
+	groups := []logicalDPGroup{
+		{UUID: "group1", Datapaths: []string{"dp-ls1", "dp-ls2"}},
+	}
+	flows := []logicalFlow{
+		{UUID: "flow1", LogicalDatapath: ptr.To("dp-ls1")},
+		{UUID: "flow2", LogicalDatapath: ptr.To("dp-lr1")},
+		{UUID: "flow3", LogicalDatapath: ptr.To("dp-lr1")},
+		{UUID: "flow4", LogicalDPGroup: ptr.To("group1")},
+		// flows of unknown datapaths and groups are ignored
+		{UUID: "flow5", LogicalDatapath: ptr.To("dp-unknown")},
+		{UUID: "flow6", LogicalDPGroup: ptr.To("group-unknown")},
+	}
+
+	require.Equal(t, map[string]*logicalFlowDatapath{
+		"dp-ls1": {name: "subnet1", dpType: "switch", subnet: "subnet1", vpc: "vpc1", flows: 2},
+		"dp-ls2": {name: "subnet2", dpType: "switch", subnet: "subnet2", flows: 1},
+		"dp-lr1": {name: "vpc1", dpType: "router", vpc: "vpc1", flows: 2},
+	}, countLogicalFlows(datapaths, ports, groups, flows))
+}
@@ -500,6 +500,76 @@ var (
 		},
 	)
 
+	// OVN performance metrics
+	metricCoverageHits = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Name:      "ovn_coverage_hits",
+			Help:      "The number of times a coverage event of ovn-northd is hit since it starts, such as txn_success of the ovsdb transactions.",
+		},
+		[]string{
+			"hostname",
+			"component",
+			"event",
+		},
+	)
+
+	metricStopwatchMilliseconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Name:      "ovn_stopwatch_milliseconds",
+			Help:      "The statistics of a stopwatch of an OVN component, such as the time of the ovn-northd main loop (ovnnb_db_run). The unit is Milliseconds.",
+		},
+		[]string{
+			"hostname",
+			"component",
+			"stopwatch",
+			"stat",
+		},
+	)
+
+	metricStopwatchSamples = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Name:      "ovn_stopwatch_samples",
+			Help:      "The total number of samples of a stopwatch of an OVN component.",
+		},
+		[]string{
+			"hostname",
+			"component",
+			"stopwatch",
+		},
+	)
+
+	metricIncEngineStats = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Name:      "ovn_inc_engine_stats",
+			Help:      "The number of full recomputes, incremental computes and cancels of an incremental processing engine node of an OVN component.",
+		},
+		[]string{
+			"hostname",
+			"component",
+			"node",
+			"stat",
+		},
+	)
+
+	metricLogicalFlowCount = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Name:      "logical_flow_count",
+			Help:      "The number of logical flows in OVN SB DB of a datapath, including the flows shared with other datapaths by datapath groups.",
+		},
+		[]string{
+			"hostname",
+			"datapath",
+			"datapath_type",
+			"subnet",
+			"vpc",
+		},
+	)
+
 	metricDBStatus = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: metricNamespace,
@@ -532,6 +602,13 @@ func registerOvnMetrics() {
 	metrics.Registry.MustRegister(metricLogicalSwitchPortInfo)
 	metrics.Registry.MustRegister(metricLogicalSwitchPortTunnelKey)
 
+	// ovn performance metrics
+	metrics.Registry.MustRegister(metricCoverageHits)
+	metrics.Registry.MustRegister(metricStopwatchMilliseconds)
+	metrics.Registry.MustRegister(metricStopwatchSamples)
+	metrics.Registry.MustRegister(metricIncEngineStats)
+	metrics.Registry.MustRegister(metricLogicalFlowCount)
+
 	// OVN Cluster basic info metrics
 	metrics.Registry.MustRegister(metricClusterEnabled)
 	metrics.Registry.MustRegister(metricClusterRole)
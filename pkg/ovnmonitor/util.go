@@ -338,3 +338,46 @@ func resetOvnClusterMetrics() {
 	metricClusterInConnErrTotal.Reset()
 	metricClusterOutConnErrTotal.Reset()
 }
+
+func (e *Exporter) setOvnPerformanceMetric() {
+	const component = "ovn-northd"
+	output, err := ovs.Appctl(component, "coverage/show")
+	if err != nil {
+		klog.Errorf("failed to get coverage of %s: %v", component, err)
+		e.IncrementErrorCounter()
+		return
+	}
+	for event, hits := range ovs.ParseCoverage(output) {
+		metricCoverageHits.WithLabelValues(e.Client.System.Hostname, component, event).Set(hits)
+	}
+
+	if output, err = ovs.Appctl(component, "stopwatch/show"); err != nil {
+		klog.Errorf("failed to get stopwatches of %s: %v", component, err)
+		e.IncrementErrorCounter()
+	} else {
+		for name, stats := range ovs.ParseStopwatch(output) {
+			metricStopwatchSamples.WithLabelValues(e.Client.System.Hostname, component, name).Set(stats.Samples)
+			for stat, value := range stats.Values {
+				metricStopwatchMilliseconds.WithLabelValues(e.Client.System.Hostname, component, name, stat).Set(value)
+			}
+		}
+	}
+
+	if output, err = ovs.Appctl(component, "inc-engine/show-stats"); err != nil {
+		klog.Errorf("failed to get incremental processing engine stats of %s: %v", component, err)
+		e.IncrementErrorCounter()
+	} else {
+		for node, stats := range ovs.ParseIncEngineStats(output) {
+			for stat, value := range stats {
+				metricIncEngineStats.WithLabelValues(e.Client.System.Hostname, component, node, stat).Set(value)
+			}
+		}
+	}
+}
+
+func resetOvnPerformanceMetrics() {
+	metricCoverageHits.Reset()
+	metricStopwatchMilliseconds.Reset()
+	metricStopwatchSamples.Reset()
+	metricIncEngineStats.Reset()
+}
@@ -0,0 +1,73 @@
+package ovs
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"k8s.io/klog/v2"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+)
+
+// computeIngressPolicingBurstKbit returns the ingress_policing_burst value (kbit) to write.
+// burstMbit is the user-supplied value in Mbit. An empty string preserves the historical
+// default of 80% of rate; an explicit "0" is honored as-is (strict policing, no burst).
+// When the rate is non-positive the burst is forced to 0 to keep ingress_policing_rate
+// and ingress_policing_burst consistent. Unparseable input falls back to the default so
+// a typo cannot silently disable the burst budget.
+func computeIngressPolicingBurstKbit(rateKbit int64, burstMbit string) int64 {
+	if rateKbit <= 0 {
+		return 0
+	}
+	if burstMbit == "" {
+		return defaultIngressPolicingBurstKbit(rateKbit)
+	}
+	v, err := strconv.ParseInt(burstMbit, 10, 64)
+	if err != nil || v > math.MaxInt64/1000 || v < math.MinInt64/1000 {
+		klog.Warningf("invalid ingress burst value %q, falling back to default", burstMbit)
+		return defaultIngressPolicingBurstKbit(rateKbit)
+	}
+	return v * 1000
+}
+
+func defaultIngressPolicingBurstKbit(rateKbit int64) int64 {
+	return rateKbit/10*8 + rateKbit%10*8/10
+}
+
+// computeHtbBurstBytes returns the linux-htb other_config:burst value (bytes) to write.
+// burstMbit is the user-supplied value in Mbit. An empty string defaults to 80% of one
+// second worth of rate (rate*0.8/8 bytes); an explicit "0" is honored as-is. A
+// non-positive rate forces burst to 0, and unparseable input falls back to the default.
+func computeHtbBurstBytes(rateBPS int64, burstMbit string) int64 {
+	if rateBPS <= 0 {
+		return 0
+	}
+	if burstMbit == "" {
+		return rateBPS / 10
+	}
+	v, err := strconv.ParseInt(burstMbit, 10, 64)
+	if err != nil || v > math.MaxInt64/125000 || v < math.MinInt64/125000 {
+		klog.Warningf("invalid egress burst value %q, falling back to default", burstMbit)
+		return rateBPS / 10
+	}
+	return v * 125000
+}
+
+func parseAndScaleBandwidthRate(rate string, scale int64) (int64, error) {
+	if rate == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseInt(rate, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid bandwidth rate %q: %w", rate, err)
+	}
+	if value < 0 {
+		return 0, fmt.Errorf("bandwidth rate %q must not be negative", rate)
+	}
+	if value > kubeovnv1.MaxBandwidthMbps || value > math.MaxInt64/scale {
+		return 0, fmt.Errorf("bandwidth rate %q overflows when scaled by %d", rate, scale)
+	}
+	return value * scale, nil
+}
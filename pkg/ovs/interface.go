@@ -20,6 +20,21 @@ type Vswitch interface {
 	ListBridge(needVendorFilter bool, filter func(sw *vswitch.Bridge) bool) ([]vswitch.Bridge, error)
 	ListPort(filter func(sp *vswitch.Port) bool) ([]vswitch.Port, error)
 	ListInterface(filter func(si *vswitch.Interface) bool) ([]vswitch.Interface, error)
+	ListQos(filter func(qos *vswitch.QoS) bool) ([]vswitch.QoS, error)
+	ListQueue(filter func(queue *vswitch.Queue) bool) ([]vswitch.Queue, error)
+	GetBridge(name string, ignoreNotFound bool) (*vswitch.Bridge, error)
+	GetPort(name string, ignoreNotFound bool) (*vswitch.Port, error)
+	GetInterface(name string, ignoreNotFound bool) (*vswitch.Interface, error)
+	CreatePort(bridgeName, portName string, iface *vswitch.Interface) error
+	DeletePort(bridgeName, portName string) error
+	CleanDuplicatePort(ifaceID, portName string) error
+	SetInterfaceBandwidth(podName, podNamespace, ifaceID, ingress, egress, ingressBurst, egressBurst string) error
+	SetNetemQos(podName, podNamespace, ifaceID, latency, limit, loss, jitter string) error
+	IsHtbQos(ifaceID string) (bool, error)
+	ClearPodBandwidth(podName, podNamespace string) error
+	CreateMirror(bridgeName, portName string, internal, selectAll bool) error
+	ConfigInterfaceMirror(globalMirror bool, open, ifaceID string) error
+	IsUserspaceDataPath() (bool, error)
 }
 
 type NBGlobal interface {
@@ -177,10 +192,10 @@ type PortGroup interface {
 type ACL interface {
 	UpdateDefaultBlockACLOps(npName, pgName, direction string, loggingEnabled, lax bool, logRate int) ([]ovsdb.Operation, error)
 	UpdateDefaultBlockExceptionsACLOps(npName, pgName, npNamespace, direction string) ([]ovsdb.Operation, error)
-	UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName string, npp []netv1.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo) ([]ovsdb.Operation, error)
-	UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName string, npp []netv1.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo) ([]ovsdb.Operation, error)
-	UpdateIngressIPBlockACLOps(pgName, protocol, aclName string, ipBlocks []netv1.IPBlock, npp []netv1.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo) ([]ovsdb.Operation, error)
-	UpdateEgressIPBlockACLOps(pgName, protocol, aclName string, ipBlocks []netv1.IPBlock, npp []netv1.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo) ([]ovsdb.Operation, error)
+	UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName string, npp []netv1.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo, stateless bool) ([]ovsdb.Operation, error)
+	UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName string, npp []netv1.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo, stateless bool) ([]ovsdb.Operation, error)
+	UpdateIngressIPBlockACLOps(pgName, protocol, aclName string, ipBlocks []netv1.IPBlock, npp []netv1.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo, stateless bool) ([]ovsdb.Operation, error)
+	UpdateEgressIPBlockACLOps(pgName, protocol, aclName string, ipBlocks []netv1.IPBlock, npp []netv1.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo, stateless bool) ([]ovsdb.Operation, error)
 	CreateGatewayACL(lsName, pgName string) error
 	CreateNodeACL(pgName, nodeIPStr, joinIPStr string) error
 	CreateSgDenyAllACL(sgName string) error
@@ -190,8 +205,10 @@ type ACL interface {
 	SetNetPolACLLog(pgName string, logEnable, isIngress bool) error
 	SetLogicalSwitchPrivate(lsName, cidrBlock, nodeSwitchCIDR string, allowSubnets []string) error
 	SGLostACL(sg *kubeovnv1.SecurityGroup) (bool, error)
+	ListPortGroupAcls(pgName, direction string) ([]ovnnb.ACL, error)
 	DeleteAcls(parentName, parentType, direction string, externalIDs map[string]string) error
 	DeleteAclsOps(parentName, parentType, direction string, externalIDs map[string]string) ([]ovsdb.Operation, error)
+	DeleteNetpolAclsOps(pgName, direction string) ([]ovsdb.Operation, error)
 	UpdateAnpRuleACLOps(pgName, asName, protocol, aclName string, priority int, aclAction ovnnb.ACLAction, logACLActions []ovnnb.ACLAction, rulePorts []v1alpha1.AdminNetworkPolicyPort, isIngress, isBanp bool) ([]ovsdb.Operation, error)
 	UpdateCnpRuleACLOps(pgName, asName, protocol, aclName string, priority int, aclAction ovnnb.ACLAction, logACLActions []ovnnb.ACLAction, rulePorts []v1alpha2.ClusterNetworkPolicyPort, isIngress bool, tier int) ([]ovsdb.Operation, error)
 	MigrateACLTier() error
@@ -245,6 +262,7 @@ type NAT interface {
 	DeleteNat(lrName, natType, externalIP, logicalIP string) error
 	NatExists(lrName, natType, externalIP, logicalIP string) (bool, error)
 	ListNats(lrName, natType, logicalIP string, externalIDs map[string]string) ([]*ovnnb.NAT, error)
+	UpdateSnatPortBlocks(lrName, owner string, mappings []util.SnatPortBlockMapping) error
 }
 
 type DHCPOptions interface {
@@ -287,6 +305,8 @@ type NbClient interface {
 	RemoveLogicalPatchPort(lspName, lrpName string) error
 	DeleteLogicalGatewaySwitch(lsName, lrName string) error
 	DeleteSecurityGroup(sgName string) error
+	GetEntityByUUIDPrefix(prefix string) (*NbEntity, error)
+	WithContext(ctx context.Context) NbClient
 	Common
 }
 
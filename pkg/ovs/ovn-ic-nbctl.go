@@ -3,6 +3,7 @@ package ovs
 import (
 	"fmt"
 	"os/exec"
+	"slices"
 	"strings"
 	"time"
 
@@ -48,7 +49,49 @@ func (c LegacyClient) GetTsSubnet(ts string) (string, error) {
 	return subnet, nil
 }
 
+// GetTs returns the transit switches shared by the default vpc
 func (c LegacyClient) GetTs() ([]string, error) {
+	tsNames, err := c.listTs()
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(tsNames, func(ts string) bool { return !util.IsDefaultTransitSwitch(ts) }), nil
+}
+
+// GetVpcTs returns the transit switches dedicated to custom vpcs
+func (c LegacyClient) GetVpcTs() ([]string, error) {
+	tsNames, err := c.listTs()
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(tsNames, util.IsDefaultTransitSwitch), nil
+}
+
+// CreateVpcTs creates the transit switch dedicated to the custom vpc if it does not exist
+func (c LegacyClient) CreateVpcTs(ts, vpc, subnet string) error {
+	_, err := c.ovnIcNbCommand(MayExist, "ts-add", ts,
+		"--", "set", "Transit_Switch", ts,
+		fmt.Sprintf(`external_ids:subnet="%s"`, subnet),
+		fmt.Sprintf(`external_ids:vpc="%s"`, vpc),
+		fmt.Sprintf(`external_ids:%s="%s"`, ExternalIDVendor, util.CniTypeName),
+	)
+	if err != nil {
+		klog.Error(err)
+		return fmt.Errorf("failed to create transit switch %s for vpc %s: %w", ts, vpc, err)
+	}
+	return nil
+}
+
+// DeleteVpcTs deletes the transit switch dedicated to the custom vpc
+func (c LegacyClient) DeleteVpcTs(ts string) error {
+	if _, err := c.ovnIcNbCommand(IfExists, "ts-del", ts); err != nil {
+		klog.Error(err)
+		return fmt.Errorf("failed to delete transit switch %s: %w", ts, err)
+	}
+	return nil
+}
+
+func (c LegacyClient) listTs() ([]string, error) {
 	cmd := []string{
 		"--format=csv", "--data=bare", "--no-heading", "--columns=name",
 		"find", "Transit_Switch",
@@ -39,3 +39,34 @@ func (suite *OvnClientTestSuite) testGetTs() {
 	require.Error(t, err)
 	require.Empty(t, ts)
 }
+
+func (suite *OvnClientTestSuite) testGetVpcTs() {
+	t := suite.T()
+	t.Parallel()
+
+	ovnLegacyClient := suite.ovnLegacyClient
+	ts, err := ovnLegacyClient.GetVpcTs()
+	// ovn-ic-nbctl not found
+	require.Error(t, err)
+	require.Empty(t, ts)
+}
+
+func (suite *OvnClientTestSuite) testCreateVpcTs() {
+	t := suite.T()
+	t.Parallel()
+
+	ovnLegacyClient := suite.ovnLegacyClient
+	err := ovnLegacyClient.CreateVpcTs("ts-vpc1", "vpc1", "169.254.200.0/24")
+	// ovn-ic-nbctl not found
+	require.Error(t, err)
+}
+
+func (suite *OvnClientTestSuite) testDeleteVpcTs() {
+	t := suite.T()
+	t.Parallel()
+
+	ovnLegacyClient := suite.ovnLegacyClient
+	err := ovnLegacyClient.DeleteVpcTs("ts-vpc1")
+	// ovn-ic-nbctl not found
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os/exec"
+	"slices"
 	"strings"
 	"time"
 
@@ -80,6 +81,24 @@ func (c LegacyClient) GetAzUUID(az string) (string, error) {
 	return "", errors.New("two same-name chassises in one db is insane")
 }
 
+// ListAvailabilityZones returns the uuids of all availability zones in ovn-ic-sb db, keyed by name
+func (c LegacyClient) ListAvailabilityZones() (map[string]string, error) {
+	output, err := c.ovnIcSbCommand("--format=csv", "--no-heading", "--data=bare", "--columns=_uuid,name", "list", "availability_zone")
+	if err != nil {
+		klog.Error(err)
+		return nil, fmt.Errorf("failed to list ovn-ic-sb availability zones: %w", err)
+	}
+	azs := make(map[string]string)
+	for l := range strings.SplitSeq(output, "\n") {
+		uuid, name, found := strings.Cut(strings.TrimSpace(l), ",")
+		if !found || name == "" {
+			continue
+		}
+		azs[name] = uuid
+	}
+	return azs, nil
+}
+
 func (c LegacyClient) GetGatewayUUIDsInOneAZ(uuid string) ([]string, error) {
 	gateways, err := c.FindUUIDWithAttrInTable("availability_zone", uuid, "gateway")
 	if err != nil {
@@ -98,6 +117,38 @@ func (c LegacyClient) GetRouteUUIDsInOneAZ(uuid string) ([]string, error) {
 	return routes, nil
 }
 
+// GetRoutePrefixesInOneAZ returns the prefixes of the routes advertised by the availability zone
+func (c LegacyClient) GetRoutePrefixesInOneAZ(uuid string) ([]string, error) {
+	output, err := c.ovnIcSbCommand("--format=csv", "--no-heading", "--data=bare", "--columns=ip_prefix", "find", "route", "availability_zone="+uuid)
+	if err != nil {
+		klog.Error(err)
+		return nil, fmt.Errorf("failed to get ovn-ic-sb route prefixes with uuid %v: %w", uuid, err)
+	}
+	var prefixes []string
+	for l := range strings.SplitSeq(output, "\n") {
+		if l = strings.TrimSpace(l); l != "" {
+			prefixes = append(prefixes, l)
+		}
+	}
+	return prefixes, nil
+}
+
+// GetTsPortBindingAZs returns the uuids of the availability zones which have ports on the transit switch
+func (c LegacyClient) GetTsPortBindingAZs(ts string) ([]string, error) {
+	output, err := c.ovnIcSbCommand("--format=csv", "--no-heading", "--data=bare", "--columns=availability_zone", "find", "Port_Binding", "transit_switch="+ts)
+	if err != nil {
+		klog.Error(err)
+		return nil, fmt.Errorf("failed to get ovn-ic-sb Port_Binding of transit switch %s: %w", ts, err)
+	}
+	var azs []string
+	for l := range strings.SplitSeq(output, "\n") {
+		if l = strings.TrimSpace(l); l != "" && !slices.Contains(azs, l) {
+			azs = append(azs, l)
+		}
+	}
+	return azs, nil
+}
+
 func (c LegacyClient) GetPortBindingUUIDsInOneAZ(uuid string) ([]string, error) {
 	portBindings, err := c.FindUUIDWithAttrInTable("availability_zone", uuid, "Port_Binding")
 	if err != nil {
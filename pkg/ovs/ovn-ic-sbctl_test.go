@@ -48,6 +48,17 @@ func (suite *OvnClientTestSuite) testGetAzUUID() {
 	require.Empty(t, uuid)
 }
 
+func (suite *OvnClientTestSuite) testListAvailabilityZones() {
+	t := suite.T()
+	t.Parallel()
+
+	ovnLegacyClient := suite.ovnLegacyClient
+	azs, err := ovnLegacyClient.ListAvailabilityZones()
+	// ovn-ic-sbctl not found
+	require.Error(t, err)
+	require.Empty(t, azs)
+}
+
 func (suite *OvnClientTestSuite) testGetGatewayUUIDsInOneAZ() {
 	t := suite.T()
 	t.Parallel()
@@ -70,6 +81,17 @@ func (suite *OvnClientTestSuite) testGetRouteUUIDsInOneAZ() {
 	require.Empty(t, uuids)
 }
 
+func (suite *OvnClientTestSuite) testGetRoutePrefixesInOneAZ() {
+	t := suite.T()
+	t.Parallel()
+
+	ovnLegacyClient := suite.ovnLegacyClient
+	prefixes, err := ovnLegacyClient.GetRoutePrefixesInOneAZ("uuid")
+	// ovn-ic-sbctl not found
+	require.Error(t, err)
+	require.Empty(t, prefixes)
+}
+
 func (suite *OvnClientTestSuite) testGetPortBindingUUIDsInOneAZ() {
 	t := suite.T()
 	t.Parallel()
@@ -81,6 +103,17 @@ func (suite *OvnClientTestSuite) testGetPortBindingUUIDsInOneAZ() {
 	require.Empty(t, uuids)
 }
 
+func (suite *OvnClientTestSuite) testGetTsPortBindingAZs() {
+	t := suite.T()
+	t.Parallel()
+
+	ovnLegacyClient := suite.ovnLegacyClient
+	azs, err := ovnLegacyClient.GetTsPortBindingAZs("ts-vpc1")
+	// ovn-ic-sbctl not found
+	require.Error(t, err)
+	require.Empty(t, azs)
+}
+
 func (suite *OvnClientTestSuite) testDestroyGateways() {
 	t := suite.T()
 	t.Parallel()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
@@ -53,6 +54,51 @@ func setACLName(acl *ovnnb.ACL, name string) {
 	acl.Name = new(name)
 }
 
+// aclMatchReverseRegex matches the fields swapped by reverseACLMatch, along with the address set and port group
+// references and the strings, which are matched first so that the names in them are left alone
+var aclMatchReverseRegex = regexp.MustCompile(`[$@][\w.\-]+|"[^"]*"|\b(?:ip4|ip6|tcp|udp|sctp)\.(?:src|dst)\b|\b(?:inport|outport)\b`)
+
+// netpolAllowAction returns the action of network policy allow acls
+func netpolAllowAction(stateless bool) string {
+	if stateless {
+		return ovnnb.ACLActionAllowStateless
+	}
+	return ovnnb.ACLActionAllowRelated
+}
+
+// reverseACLMatch swaps the logical ports, addresses and layer 4 ports of an acl match,
+// the result matches the replies of the traffic matched by the original match
+func reverseACLMatch(match string) string {
+	return aclMatchReverseRegex.ReplaceAllStringFunc(match, func(s string) string {
+		switch {
+		case strings.ContainsAny(s[:1], `$@"`):
+			return s
+		case s == "inport":
+			return "outport"
+		case s == "outport":
+			return "inport"
+		case strings.HasSuffix(s, ".src"):
+			return strings.TrimSuffix(s, ".src") + ".dst"
+		default:
+			return strings.TrimSuffix(s, ".dst") + ".src"
+		}
+	})
+}
+
+// newStatelessReverseACL returns the allow-stateless acl in the opposite direction which passes the replies
+// of the traffic allowed by a stateless acl, since the replies are no longer allowed by connection tracking
+func (c *OVNNbClient) newStatelessReverseACL(acl *ovnnb.ACL) (*ovnnb.ACL, error) {
+	direction := ovnnb.ACLDirectionToLport
+	if acl.Direction == ovnnb.ACLDirectionToLport {
+		direction = ovnnb.ACLDirectionFromLport
+	}
+
+	// the replies are not logged, the traffic is already logged by the original acl
+	return c.newACLWithoutCheck(acl.ExternalIDs[aclParentKey], direction, strconv.Itoa(acl.Priority), reverseACLMatch(acl.Match), ovnnb.ACLActionAllowStateless, acl.Tier, func(reverseACL *ovnnb.ACL) {
+		reverseACL.ExternalIDs[aclReverseKey] = acl.Direction
+	})
+}
+
 // UpdateDefaultBlockACLOps returns operations to update/create the default block ACL
 func (c *OVNNbClient) UpdateDefaultBlockACLOps(npName, pgName, direction string, loggingEnabled, lax bool, logRate int) ([]ovsdb.Operation, error) {
 	portDirection := "outport"
@@ -187,7 +233,7 @@ func (c *OVNNbClient) UpdateDefaultBlockExceptionsACLOps(npName, pgName, npNames
 }
 
 // UpdateIngressACLOps return operation that creates an ingress ACL
-func (c *OVNNbClient) UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName string, npp []netv1.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo) ([]ovsdb.Operation, error) {
+func (c *OVNNbClient) UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName string, npp []netv1.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo, stateless bool) ([]ovsdb.Operation, error) {
 	acls := make([]*ovnnb.ACL, 0)
 	meterName := fmt.Sprintf("%s_%s_meter", pgName, ovnnb.ACLDirectionToLport)
 	if logEnable && logRate > 0 {
@@ -213,13 +259,21 @@ func (c *OVNNbClient) UpdateIngressACLOps(pgName, asIngressName, asExceptName, p
 			}
 		}
 
-		allowACL, err := c.newACLWithoutCheck(pgName, ovnnb.ACLDirectionToLport, util.IngressAllowPriority, m, ovnnb.ACLActionAllowRelated, util.NetpolACLTier, options)
+		allowACL, err := c.newACLWithoutCheck(pgName, ovnnb.ACLDirectionToLport, util.IngressAllowPriority, m, netpolAllowAction(stateless), util.NetpolACLTier, options)
 		if err != nil {
 			klog.Error(err)
 			return nil, fmt.Errorf("new allow ingress acl for port group %s: %w", pgName, err)
 		}
 
 		acls = append(acls, allowACL)
+		if stateless {
+			reverseACL, err := c.newStatelessReverseACL(allowACL)
+			if err != nil {
+				klog.Error(err)
+				return nil, fmt.Errorf("new reverse acl of ingress acl for port group %s: %w", pgName, err)
+			}
+			acls = append(acls, reverseACL)
+		}
 	}
 
 	ops, err := c.CreateAclsOps(pgName, portGroupKey, acls...)
@@ -232,7 +286,7 @@ func (c *OVNNbClient) UpdateIngressACLOps(pgName, asIngressName, asExceptName, p
 }
 
 // UpdateEgressACLOps return operation that creates an egress ACL
-func (c *OVNNbClient) UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName string, npp []netv1.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo) ([]ovsdb.Operation, error) {
+func (c *OVNNbClient) UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName string, npp []netv1.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo, stateless bool) ([]ovsdb.Operation, error) {
 	acls := make([]*ovnnb.ACL, 0)
 	meterName := fmt.Sprintf("%s_%s_meter", pgName, ovnnb.ACLDirectionFromLport)
 	if logEnable && logRate > 0 {
@@ -248,7 +302,7 @@ func (c *OVNNbClient) UpdateEgressACLOps(pgName, asEgressName, asExceptName, pro
 	/* allow acl */
 	matches := newNetworkPolicyACLMatch(pgName, asEgressName, asExceptName, protocol, ovnnb.ACLDirectionFromLport, npp, namedPortMap)
 	for _, m := range matches {
-		allowACL, err := c.newACLWithoutCheck(pgName, ovnnb.ACLDirectionFromLport, util.EgressAllowPriority, m, ovnnb.ACLActionAllowRelated, util.NetpolACLTier, func(acl *ovnnb.ACL) {
+		allowACL, err := c.newACLWithoutCheck(pgName, ovnnb.ACLDirectionFromLport, util.EgressAllowPriority, m, netpolAllowAction(stateless), util.NetpolACLTier, func(acl *ovnnb.ACL) {
 			setACLName(acl, aclName)
 			if acl.Options == nil {
 				acl.Options = make(map[string]string)
@@ -267,6 +321,14 @@ func (c *OVNNbClient) UpdateEgressACLOps(pgName, asEgressName, asExceptName, pro
 		}
 
 		acls = append(acls, allowACL)
+		if stateless {
+			reverseACL, err := c.newStatelessReverseACL(allowACL)
+			if err != nil {
+				klog.Error(err)
+				return nil, fmt.Errorf("new reverse acl of egress acl for port group %s: %w", pgName, err)
+			}
+			acls = append(acls, reverseACL)
+		}
 	}
 
 	ops, err := c.CreateAclsOps(pgName, portGroupKey, acls...)
@@ -518,10 +580,17 @@ func (c *OVNNbClient) UpdateSgACL(sg *kubeovnv1.SecurityGroup, direction string)
 
 	// ingress rule
 	srcOrDst, portDirection, sgRules := "src", "outport", sg.Spec.IngressRules
+	reverseDirection, reverseRules := ovnnb.ACLDirectionFromLport, sg.Spec.EgressRules
 	if direction == ovnnb.ACLDirectionFromLport { // egress rule
 		srcOrDst = "dst"
 		portDirection = "inport"
 		sgRules = sg.Spec.EgressRules
+		reverseDirection, reverseRules = ovnnb.ACLDirectionToLport, sg.Spec.IngressRules
+	}
+
+	allowAction := ovnnb.ACLActionAllowRelated
+	if sg.Spec.Stateless {
+		allowAction = ovnnb.ACLActionAllowStateless
 	}
 
 	/* create port_group associated acl */
@@ -537,7 +606,7 @@ func (c *OVNNbClient) UpdateSgACL(sg *kubeovnv1.SecurityGroup, direction string)
 				NewACLMatch(ipSuffix, "", "", ""),
 				NewACLMatch(ipSuffix+"."+srcOrDst, "==", "$"+asName, ""),
 			)
-			acl, err := c.newACL(pgName, direction, util.SecurityGroupAllowPriority, match.String(), ovnnb.ACLActionAllowRelated, util.ConvertSGTierToOvnTier(sg.Spec.Tier))
+			acl, err := c.newACL(pgName, direction, util.SecurityGroupAllowPriority, match.String(), allowAction, util.ConvertSGTierToOvnTier(sg.Spec.Tier))
 			if err != nil {
 				klog.Error(err)
 				return fmt.Errorf("new allow acl for security group %s: %w", sg.Name, err)
@@ -549,7 +618,7 @@ func (c *OVNNbClient) UpdateSgACL(sg *kubeovnv1.SecurityGroup, direction string)
 
 	/* create rule acl */
 	for _, rule := range sgRules {
-		acl, err := c.newSgRuleACL(sg.Name, direction, rule, util.ConvertSGTierToOvnTier(sg.Spec.Tier))
+		acl, err := c.newSgRuleACL(sg.Name, direction, rule, util.ConvertSGTierToOvnTier(sg.Spec.Tier), sg.Spec.Stateless)
 		if err != nil {
 			klog.Error(err)
 			return fmt.Errorf("new rule acl for security group %s: %w", sg.Name, err)
@@ -557,6 +626,34 @@ func (c *OVNNbClient) UpdateSgACL(sg *kubeovnv1.SecurityGroup, direction string)
 		acls = append(acls, acl)
 	}
 
+	/* create reverse acl of the stateless allow rules in the other direction */
+	if sg.Spec.Stateless {
+		highestPriority, _ := strconv.Atoi(util.SecurityGroupHighestPriority)
+		for _, rule := range reverseRules {
+			if rule.Policy != kubeovnv1.SgPolicyAllow {
+				continue
+			}
+			// the acl of the rule is only used to generate the reverse acl, it's not created here
+			acl, err := c.newACLWithoutCheck(pgName, reverseDirection, strconv.Itoa(highestPriority-rule.Priority), sgRuleMatch(sg.Name, reverseDirection, rule), ovnnb.ACLActionAllowStateless, util.ConvertSGTierToOvnTier(sg.Spec.Tier))
+			if err != nil {
+				klog.Error(err)
+				return fmt.Errorf("new rule acl for security group %s: %w", sg.Name, err)
+			}
+			reverseACL, err := c.newStatelessReverseACL(acl)
+			if err != nil {
+				klog.Error(err)
+				return fmt.Errorf("new reverse rule acl for security group %s: %w", sg.Name, err)
+			}
+			// skip the reverse acl if the same acl is generated by a rule in this direction
+			if slices.ContainsFunc(acls, func(a *ovnnb.ACL) bool {
+				return a != nil && a.Priority == reverseACL.Priority && a.Match == reverseACL.Match
+			}) {
+				continue
+			}
+			acls = append(acls, reverseACL)
+		}
+	}
+
 	if err := c.CreateAcls(pgName, portGroupKey, acls...); err != nil {
 		klog.Error(err)
 		return fmt.Errorf("add acl to port group %s: %w", pgName, err)
@@ -974,6 +1071,22 @@ func (c *OVNNbClient) ListAcls(direction string, externalIDs map[string]string)
 	return aclList, nil
 }
 
+// ListPortGroupAcls list acls which belong to the given port group,
+// result should include acls of both directions when direction is empty
+func (c *OVNNbClient) ListPortGroupAcls(pgName, direction string) ([]ovnnb.ACL, error) {
+	if pgName == "" {
+		return nil, errors.New("the port group name is required")
+	}
+
+	acls, err := c.ListAcls(direction, map[string]string{aclParentKey: pgName})
+	if err != nil {
+		klog.Error(err)
+		return nil, fmt.Errorf("list acls of port group %s: %w", pgName, err)
+	}
+
+	return acls, nil
+}
+
 func (c *OVNNbClient) ACLExists(parent, direction, priority, match string, tier int) (bool, error) {
 	acl, err := c.GetACL(parent, direction, priority, match, tier, true)
 	if err != nil {
@@ -1063,8 +1176,26 @@ func (c *OVNNbClient) newACLWithoutCheck(parent, direction, priority, match, act
 	return acl, nil
 }
 
-// createSgRuleACL create security group rule acl
-func (c *OVNNbClient) newSgRuleACL(sgName, direction string, rule kubeovnv1.SecurityGroupRule, tier int) (*ovnnb.ACL, error) {
+// sgRuleRemote returns the address, cidr or address set matched against the remote side of a security group rule
+func sgRuleRemote(sgName string, rule kubeovnv1.SecurityGroupRule) string {
+	switch rule.RemoteType {
+	case kubeovnv1.SgRemoteTypeSg:
+		if rule.IPVersion == "ipv6" {
+			return "$" + GetSgV6AssociatedName(rule.RemoteSecurityGroup)
+		}
+		return "$" + GetSgV4AssociatedName(rule.RemoteSecurityGroup)
+	case kubeovnv1.SgRemoteTypeFQDN:
+		return "$" + GetSgFQDNAddressSetName(sgName, rule.RemoteFQDN, rule.IPVersion)
+	case kubeovnv1.SgRemoteTypeIPPool:
+		// the ippool address set only holds addresses of a single family, which is validated to be the one of the rule
+		return "$" + util.IPPoolAddressSetName(rule.RemoteIPPool)
+	default:
+		return rule.RemoteAddress
+	}
+}
+
+// sgRuleMatch returns the acl match of a security group rule
+func sgRuleMatch(sgName, direction string, rule kubeovnv1.SecurityGroupRule) string {
 	ipSuffix := "ip4"
 	if rule.IPVersion == "ipv6" {
 		ipSuffix = "ip6"
@@ -1093,21 +1224,9 @@ func (c *OVNNbClient) newSgRuleACL(sgName, direction string, rule kubeovnv1.Secu
 	// type address
 	allowedIPMatch := NewAndACLMatch(
 		allIPMatch,
-		NewACLMatch(remoteIPKey, "==", rule.RemoteAddress, ""),
+		NewACLMatch(remoteIPKey, "==", sgRuleRemote(sgName, rule), ""),
 	)
 
-	// type securityGroup
-	remotePgName := GetSgV4AssociatedName(rule.RemoteSecurityGroup)
-	if rule.IPVersion == "ipv6" {
-		remotePgName = GetSgV6AssociatedName(rule.RemoteSecurityGroup)
-	}
-	if rule.RemoteType == kubeovnv1.SgRemoteTypeSg {
-		allowedIPMatch = NewAndACLMatch(
-			allIPMatch,
-			NewACLMatch(remoteIPKey, "==", "$"+remotePgName, ""),
-		)
-	}
-
 	// Add a rule to match local address only if it is set
 	if rule.LocalAddress != "" {
 		allowedIPMatch = NewAndACLMatch(
@@ -1147,10 +1266,21 @@ func (c *OVNNbClient) newSgRuleACL(sgName, direction string, rule kubeovnv1.Secu
 		}
 	}
 
+	return match.String()
+}
+
+// newSgRuleACL create security group rule acl
+func (c *OVNNbClient) newSgRuleACL(sgName, direction string, rule kubeovnv1.SecurityGroupRule, tier int, stateless bool) (*ovnnb.ACL, error) {
+	pgName := GetSgPortGroupName(sgName)
+	match := sgRuleMatch(sgName, direction, rule)
+
 	var action string
 	switch rule.Policy {
 	case kubeovnv1.SgPolicyAllow:
 		action = ovnnb.ACLActionAllowRelated
+		if stateless {
+			action = ovnnb.ACLActionAllowStateless
+		}
 	case kubeovnv1.SgPolicyPass:
 		action = ovnnb.ACLActionPass
 	default:
@@ -1159,7 +1289,7 @@ func (c *OVNNbClient) newSgRuleACL(sgName, direction string, rule kubeovnv1.Secu
 
 	highestPriority, _ := strconv.Atoi(util.SecurityGroupHighestPriority)
 
-	acl, err := c.newACL(pgName, direction, strconv.Itoa(highestPriority-rule.Priority), match.String(), action, tier)
+	acl, err := c.newACL(pgName, direction, strconv.Itoa(highestPriority-rule.Priority), match, action, tier)
 	if err != nil {
 		klog.Error(err)
 		return nil, fmt.Errorf("new security group acl for port group %s: %w", pgName, err)
@@ -1381,7 +1511,7 @@ func newIPBlockACLMatch(pgName, protocol, direction string, ipBlocks []netv1.IPB
 }
 
 // UpdateIngressIPBlockACLOps returns operations that create ingress ACLs for ipBlock peers
-func (c *OVNNbClient) UpdateIngressIPBlockACLOps(pgName, protocol, aclName string, ipBlocks []netv1.IPBlock, npp []netv1.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo) ([]ovsdb.Operation, error) {
+func (c *OVNNbClient) UpdateIngressIPBlockACLOps(pgName, protocol, aclName string, ipBlocks []netv1.IPBlock, npp []netv1.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo, stateless bool) ([]ovsdb.Operation, error) {
 	meterName := fmt.Sprintf("%s_%s_meter", pgName, ovnnb.ACLDirectionToLport)
 	matches := newIPBlockACLMatch(pgName, protocol, ovnnb.ACLDirectionToLport, ipBlocks, npp, namedPortMap)
 	if len(matches) == 0 {
@@ -1400,18 +1530,25 @@ func (c *OVNNbClient) UpdateIngressIPBlockACLOps(pgName, protocol, aclName strin
 			}
 		}
 
-		allowACL, err := c.newACLWithoutCheck(pgName, ovnnb.ACLDirectionToLport, util.IngressAllowPriority, m, ovnnb.ACLActionAllowRelated, util.NetpolACLTier, options)
+		allowACL, err := c.newACLWithoutCheck(pgName, ovnnb.ACLDirectionToLport, util.IngressAllowPriority, m, netpolAllowAction(stateless), util.NetpolACLTier, options)
 		if err != nil {
 			return nil, fmt.Errorf("new ipBlock ingress acl for port group %s: %w", pgName, err)
 		}
 		acls = append(acls, allowACL)
+		if stateless {
+			reverseACL, err := c.newStatelessReverseACL(allowACL)
+			if err != nil {
+				return nil, fmt.Errorf("new reverse acl of ipBlock ingress acl for port group %s: %w", pgName, err)
+			}
+			acls = append(acls, reverseACL)
+		}
 	}
 
 	return c.CreateAclsOps(pgName, portGroupKey, acls...)
 }
 
 // UpdateEgressIPBlockACLOps returns operations that create egress ACLs for ipBlock peers
-func (c *OVNNbClient) UpdateEgressIPBlockACLOps(pgName, protocol, aclName string, ipBlocks []netv1.IPBlock, npp []netv1.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo) ([]ovsdb.Operation, error) {
+func (c *OVNNbClient) UpdateEgressIPBlockACLOps(pgName, protocol, aclName string, ipBlocks []netv1.IPBlock, npp []netv1.NetworkPolicyPort, logEnable bool, logACLActions []ovnnb.ACLAction, logRate int, namedPortMap map[string]*util.NamedPortInfo, stateless bool) ([]ovsdb.Operation, error) {
 	meterName := fmt.Sprintf("%s_%s_meter", pgName, ovnnb.ACLDirectionFromLport)
 	matches := newIPBlockACLMatch(pgName, protocol, ovnnb.ACLDirectionFromLport, ipBlocks, npp, namedPortMap)
 	if len(matches) == 0 {
@@ -1420,7 +1557,7 @@ func (c *OVNNbClient) UpdateEgressIPBlockACLOps(pgName, protocol, aclName string
 
 	acls := make([]*ovnnb.ACL, 0, len(matches))
 	for _, m := range matches {
-		allowACL, err := c.newACLWithoutCheck(pgName, ovnnb.ACLDirectionFromLport, util.EgressAllowPriority, m, ovnnb.ACLActionAllowRelated, util.NetpolACLTier, func(acl *ovnnb.ACL) {
+		allowACL, err := c.newACLWithoutCheck(pgName, ovnnb.ACLDirectionFromLport, util.EgressAllowPriority, m, netpolAllowAction(stateless), util.NetpolACLTier, func(acl *ovnnb.ACL) {
 			setACLName(acl, aclName)
 			if acl.Options == nil {
 				acl.Options = make(map[string]string)
@@ -1437,6 +1574,13 @@ func (c *OVNNbClient) UpdateEgressIPBlockACLOps(pgName, protocol, aclName string
 			return nil, fmt.Errorf("new ipBlock egress acl for port group %s: %w", pgName, err)
 		}
 		acls = append(acls, allowACL)
+		if stateless {
+			reverseACL, err := c.newStatelessReverseACL(allowACL)
+			if err != nil {
+				return nil, fmt.Errorf("new reverse acl of ipBlock egress acl for port group %s: %w", pgName, err)
+			}
+			acls = append(acls, reverseACL)
+		}
 	}
 
 	return c.CreateAclsOps(pgName, portGroupKey, acls...)
@@ -1570,93 +1714,41 @@ func (c *OVNNbClient) DeleteAclsOps(parentName, parentType, direction string, ex
 	return removeACLOp, nil
 }
 
-// sgRuleNoACL check if security group rule has acl in a tier
-func (c *OVNNbClient) sgRuleNoACL(sgName, direction string, rule kubeovnv1.SecurityGroupRule, tier int) (bool, error) {
-	ipSuffix := "ip4"
-	if rule.IPVersion == "ipv6" {
-		ipSuffix = "ip6"
-	}
-
-	pgName := GetSgPortGroupName(sgName)
-
-	// ingress rule
-	localSrcOrDst, remoteSrcOrDst, portDirection := "dst", "src", "outport"
-	if direction == ovnnb.ACLDirectionFromLport { // egress rule
-		remoteSrcOrDst = "dst"
-		localSrcOrDst = "src"
-		portDirection = "inport"
+// DeleteNetpolAclsOps return operation which delete the acls generated for the rules of one direction of a network policy,
+// including the reverse acls of stateless rules which are in the opposite direction
+func (c *OVNNbClient) DeleteNetpolAclsOps(pgName, direction string) ([]ovsdb.Operation, error) {
+	if pgName == "" {
+		return nil, errors.New("the port group name is required")
 	}
 
-	ipKey := ipSuffix + "." + remoteSrcOrDst
-	localIPKey := ipSuffix + "." + localSrcOrDst
-
-	/* match all traffic to or from pgName */
-	allIPMatch := NewAndACLMatch(
-		NewACLMatch(portDirection, "==", "@"+pgName, ""),
-		NewACLMatch(ipSuffix, "", "", ""),
-	)
-
-	/* allow allowed ip traffic */
-	// type address
-	allowedIPMatch := NewAndACLMatch(
-		allIPMatch,
-		NewACLMatch(ipKey, "==", rule.RemoteAddress, ""),
-	)
-
-	// type securityGroup
-	remotePgName := GetSgV4AssociatedName(rule.RemoteSecurityGroup)
-	if rule.IPVersion == "ipv6" {
-		remotePgName = GetSgV6AssociatedName(rule.RemoteSecurityGroup)
-	}
-	if rule.RemoteType == kubeovnv1.SgRemoteTypeSg {
-		allowedIPMatch = NewAndACLMatch(
-			allIPMatch,
-			NewACLMatch(ipKey, "==", "$"+remotePgName, ""),
-		)
-	}
-
-	// Add a rule to match local address only if it is set
-	if rule.LocalAddress != "" {
-		allowedIPMatch = NewAndACLMatch(
-			allowedIPMatch,
-			NewACLMatch(localIPKey, "==", rule.LocalAddress, ""),
-		)
+	acls, err := c.ListAcls("", map[string]string{aclParentKey: pgName})
+	if err != nil {
+		klog.Error(err)
+		return nil, fmt.Errorf("list port group %s acls: %w", pgName, err)
 	}
 
-	/* allow layer 4 traffic */
-	// allow all layer 4 traffic
-	match := allowedIPMatch
-
-	switch rule.Protocol {
-	case kubeovnv1.SgProtocolICMP:
-		match = NewAndACLMatch(
-			allowedIPMatch,
-			NewACLMatch("icmp4", "", "", ""),
-		)
-		if ipSuffix == "ip6" {
-			match = NewAndACLMatch(
-				allowedIPMatch,
-				NewACLMatch("icmp6", "", "", ""),
-			)
+	aclUUIDs := make([]string, 0, len(acls))
+	for _, acl := range acls {
+		reverseOf := acl.ExternalIDs[aclReverseKey]
+		if reverseOf == direction || (reverseOf == "" && acl.Direction == direction) {
+			aclUUIDs = append(aclUUIDs, acl.UUID)
 		}
-	case kubeovnv1.SgProtocolTCP, kubeovnv1.SgProtocolUDP:
-		match = NewAndACLMatch(
-			allowedIPMatch,
-			NewACLMatch(string(rule.Protocol)+".dst", "<=", strconv.Itoa(rule.PortRangeMin), strconv.Itoa(rule.PortRangeMax)),
-		)
+	}
 
-		// Add a match on source port if a local address was provided.
-		if rule.LocalAddress != "" {
-			match = NewAndACLMatch(
-				match,
-				NewACLMatch(string(rule.Protocol)+".src", "<=", strconv.Itoa(rule.SourcePortRangeMin), strconv.Itoa(rule.SourcePortRangeMax)),
-			)
-		}
+	removeACLOp, err := c.portGroupUpdateACLOp(pgName, aclUUIDs, ovsdb.MutateOperationDelete)
+	if err != nil {
+		klog.Error(err)
+		return nil, fmt.Errorf("generate operations for deleting acls from port group %s: %w", pgName, err)
 	}
+	return removeACLOp, nil
+}
 
+// sgRuleNoACL check if security group rule has acl in a tier
+func (c *OVNNbClient) sgRuleNoACL(sgName, direction string, rule kubeovnv1.SecurityGroupRule, tier int) (bool, error) {
+	pgName := GetSgPortGroupName(sgName)
 	securityGroupHighestPriority, _ := strconv.Atoi(util.SecurityGroupHighestPriority)
 	priority := securityGroupHighestPriority - rule.Priority
-	exists, err := c.ACLExists(pgName, direction, strconv.Itoa(priority), match.String(), tier)
+	exists, err := c.ACLExists(pgName, direction, strconv.Itoa(priority), sgRuleMatch(sgName, direction, rule), tier)
 	if err != nil {
 		err = fmt.Errorf("failed to check acl rule for security group %s: %w", sgName, err)
 		klog.Error(err)
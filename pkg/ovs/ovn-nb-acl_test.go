@@ -2,6 +2,7 @@ package ovs
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -241,7 +242,7 @@ func (suite *OvnClientTestSuite) testUpdateIngressACLOps() {
 
 		npp := mockNetworkPolicyPort()
 
-		ops, err := nbClient.UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName, npp, true, nil, 0, nil)
+		ops, err := nbClient.UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName, npp, true, nil, 0, nil, false)
 		require.NoError(t, err)
 		require.Len(t, ops, 3)
 
@@ -266,7 +267,7 @@ func (suite *OvnClientTestSuite) testUpdateIngressACLOps() {
 		err := nbClient.CreatePortGroup(pgName, nil)
 		require.NoError(t, err)
 
-		ops, err := nbClient.UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName, nil, true, nil, 0, nil)
+		ops, err := nbClient.UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName, nil, true, nil, 0, nil, false)
 		require.NoError(t, err)
 		require.Len(t, ops, 2)
 
@@ -294,7 +295,7 @@ func (suite *OvnClientTestSuite) testUpdateIngressACLOps() {
 		logActions := []ovnnb.ACLAction{ovnnb.ACLActionAllow}
 		logRate := 99
 
-		ops, err := nbClient.UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName, nil, true, logActions, logRate, nil)
+		ops, err := nbClient.UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName, nil, true, logActions, logRate, nil, false)
 		require.NoError(t, err)
 		require.NotEmpty(t, ops)
 
@@ -309,6 +310,31 @@ func (suite *OvnClientTestSuite) testUpdateIngressACLOps() {
 		}
 	})
 
+	t.Run("stateless acl", func(t *testing.T) {
+		t.Parallel()
+
+		pgName := "test_create_stateless_ingress_acl_pg"
+		asIngressName := "test.default.ingress.allow.ipv4.all"
+		asExceptName := "test.default.ingress.except.ipv4.all"
+		protocol := kubeovnv1.ProtocolIPv4
+		aclName := "test_create_stateless_ingress_acl_pg"
+
+		err := nbClient.CreatePortGroup(pgName, nil)
+		require.NoError(t, err)
+
+		ops, err := nbClient.UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName, nil, true, nil, 0, nil, true)
+		require.NoError(t, err)
+		require.Len(t, ops, 3)
+
+		matches := newNetworkPolicyACLMatch(pgName, asIngressName, asExceptName, protocol, ovnnb.ACLDirectionToLport, nil, nil)
+		require.Len(t, matches, 1)
+		expect(ops[0].Row, ovnnb.ACLActionAllowStateless, ovnnb.ACLDirectionToLport, matches[0], util.IngressAllowPriority)
+		expect(ops[1].Row, ovnnb.ACLActionAllowStateless, ovnnb.ACLDirectionFromLport, reverseACLMatch(matches[0]), util.IngressAllowPriority)
+		require.NotEqual(t, ops[0].Row["name"], ops[1].Row["name"])
+		require.NotEqual(t, true, ops[1].Row["log"])
+		require.Contains(t, fmt.Sprint(ops[1].Row["external_ids"]), ovnnb.ACLDirectionToLport)
+	})
+
 	t.Run("test empty pgName", func(t *testing.T) {
 		t.Parallel()
 
@@ -318,7 +344,7 @@ func (suite *OvnClientTestSuite) testUpdateIngressACLOps() {
 		protocol := kubeovnv1.ProtocolIPv4
 		aclName := "test_create_v4_ingress_acl_pg"
 
-		_, err := nbClient.UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName, nil, true, nil, 0, nil)
+		_, err := nbClient.UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName, nil, true, nil, 0, nil, false)
 		require.ErrorContains(t, err, "the port group name or logical switch name is required")
 	})
 
@@ -331,7 +357,7 @@ func (suite *OvnClientTestSuite) testUpdateIngressACLOps() {
 		protocol := kubeovnv1.ProtocolIPv4
 		aclName := "test_create_v4_ingress_acl_pg"
 
-		_, err := nbClient.UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName, nil, true, nil, 0, nil)
+		_, err := nbClient.UpdateIngressACLOps(pgName, asIngressName, asExceptName, protocol, aclName, nil, true, nil, 0, nil, false)
 		require.ErrorContains(t, err, "the port group name or logical switch name is required")
 	})
 }
@@ -365,7 +391,7 @@ func (suite *OvnClientTestSuite) testUpdateEgressACLOps() {
 
 		npp := mockNetworkPolicyPort()
 
-		ops, err := nbClient.UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName, npp, true, nil, 0, nil)
+		ops, err := nbClient.UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName, npp, true, nil, 0, nil, false)
 		require.NoError(t, err)
 		require.Len(t, ops, 3)
 
@@ -390,7 +416,7 @@ func (suite *OvnClientTestSuite) testUpdateEgressACLOps() {
 		err := nbClient.CreatePortGroup(pgName, nil)
 		require.NoError(t, err)
 
-		ops, err := nbClient.UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName, nil, true, nil, 0, nil)
+		ops, err := nbClient.UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName, nil, true, nil, 0, nil, false)
 		require.NoError(t, err)
 		require.Len(t, ops, 2)
 
@@ -418,7 +444,7 @@ func (suite *OvnClientTestSuite) testUpdateEgressACLOps() {
 		logActions := []ovnnb.ACLAction{ovnnb.ACLActionAllow}
 		logRate := 88
 
-		ops, err := nbClient.UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName, nil, true, logActions, logRate, nil)
+		ops, err := nbClient.UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName, nil, true, logActions, logRate, nil, false)
 		require.NoError(t, err)
 		require.NotEmpty(t, ops)
 
@@ -442,7 +468,7 @@ func (suite *OvnClientTestSuite) testUpdateEgressACLOps() {
 		protocol := kubeovnv1.ProtocolIPv4
 		aclName := "test_create_v4_egress_acl_pg"
 
-		_, err := nbClient.UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName, nil, true, nil, 0, nil)
+		_, err := nbClient.UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName, nil, true, nil, 0, nil, false)
 		require.ErrorContains(t, err, "the port group name or logical switch name is required")
 	})
 
@@ -455,7 +481,7 @@ func (suite *OvnClientTestSuite) testUpdateEgressACLOps() {
 		protocol := kubeovnv1.ProtocolIPv4
 		aclName := "test_create_v4_egress_acl_pg"
 
-		_, err := nbClient.UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName, nil, true, nil, 0, nil)
+		_, err := nbClient.UpdateEgressACLOps(pgName, asEgressName, asExceptName, protocol, aclName, nil, true, nil, 0, nil, false)
 		require.ErrorContains(t, err, "the port group name or logical switch name is required")
 	})
 }
@@ -621,7 +647,7 @@ func (suite *OvnClientTestSuite) testUpdateIngressIPBlockACLOps() {
 		ipBlocks := []netv1.IPBlock{
 			{CIDR: "0.0.0.0/0", Except: []string{"10.42.0.0/16"}},
 		}
-		ops, err := nbClient.UpdateIngressIPBlockACLOps(pgName, kubeovnv1.ProtocolIPv4, aclName, ipBlocks, nil, false, nil, 0, nil)
+		ops, err := nbClient.UpdateIngressIPBlockACLOps(pgName, kubeovnv1.ProtocolIPv4, aclName, ipBlocks, nil, false, nil, 0, nil, false)
 		require.NoError(t, err)
 		require.NotEmpty(t, ops)
 
@@ -648,7 +674,7 @@ func (suite *OvnClientTestSuite) testUpdateEgressIPBlockACLOps() {
 		ipBlocks := []netv1.IPBlock{
 			{CIDR: "0.0.0.0/0", Except: []string{"10.42.0.0/16"}},
 		}
-		ops, err := nbClient.UpdateEgressIPBlockACLOps(pgName, kubeovnv1.ProtocolIPv4, aclName, ipBlocks, nil, false, nil, 0, nil)
+		ops, err := nbClient.UpdateEgressIPBlockACLOps(pgName, kubeovnv1.ProtocolIPv4, aclName, ipBlocks, nil, false, nil, 0, nil, false)
 		require.NoError(t, err)
 		require.NotEmpty(t, ops)
 
@@ -664,7 +690,7 @@ func (suite *OvnClientTestSuite) testUpdateEgressIPBlockACLOps() {
 		err := nbClient.CreatePortGroup(pgName, nil)
 		require.NoError(t, err)
 
-		ops, err := nbClient.UpdateEgressIPBlockACLOps(pgName, kubeovnv1.ProtocolIPv4, "test", nil, nil, false, nil, 0, nil)
+		ops, err := nbClient.UpdateEgressIPBlockACLOps(pgName, kubeovnv1.ProtocolIPv4, "test", nil, nil, false, nil, 0, nil, false)
 		require.NoError(t, err)
 		require.Empty(t, ops)
 	})
@@ -1196,6 +1222,41 @@ func (suite *OvnClientTestSuite) testUpdateSgACL() {
 		err = nbClient.UpdateSgACL(sg, ovnnb.ACLDirectionToLport)
 		require.ErrorContains(t, err, "the port group name or logical switch name is required")
 	})
+
+	t.Run("update stateless securityGroup ingress acl", func(t *testing.T) {
+		statelessSgName := "test_update_stateless_sg_acl_pg"
+		statelessPgName := GetSgPortGroupName(statelessSgName)
+		statelessSg := sg.DeepCopy()
+		statelessSg.Name = statelessSgName
+		statelessSg.Spec.Stateless = true
+
+		err := nbClient.CreatePortGroup(statelessPgName, nil)
+		require.NoError(t, err)
+
+		err = nbClient.UpdateSgACL(statelessSg, ovnnb.ACLDirectionToLport)
+		require.NoError(t, err)
+
+		// rule acl
+		match := fmt.Sprintf("outport == @%s && ip4 && ip4.src == 0.0.0.0/0 && icmp4", statelessPgName)
+		ruleACL, err := nbClient.GetACL(statelessPgName, ovnnb.ACLDirectionToLport, "18472", match, ovnTier, false)
+		require.NoError(t, err)
+		require.Equal(t, ovnnb.ACLActionAllowStateless, ruleACL.Action)
+
+		// reverse acl of the egress allow rule
+		match = fmt.Sprintf("outport == @%s && ip4 && ip4.src == 0.0.0.0/0", statelessPgName)
+		reverseACL, err := nbClient.GetACL(statelessPgName, ovnnb.ACLDirectionToLport, "18474", match, ovnTier, false)
+		require.NoError(t, err)
+		expect := newACL(statelessPgName, ovnnb.ACLDirectionToLport, "18474", match, ovnnb.ACLActionAllowStateless, ovnTier, func(acl *ovnnb.ACL) {
+			acl.ExternalIDs[aclReverseKey] = ovnnb.ACLDirectionFromLport
+		})
+		expect.UUID = reverseACL.UUID
+		require.Equal(t, expect, reverseACL)
+
+		// the egress pass rule has no reverse acl
+		acls, err := nbClient.ListAcls(ovnnb.ACLDirectionToLport, map[string]string{aclParentKey: statelessPgName, aclReverseKey: ovnnb.ACLDirectionFromLport})
+		require.NoError(t, err)
+		require.Len(t, acls, 1)
+	})
 }
 
 func (suite *OvnClientTestSuite) testUpdateLogicalSwitchACL() {
@@ -1522,7 +1583,7 @@ func (suite *OvnClientTestSuite) testNewSgRuleACL() {
 			Policy:              "allow",
 		}
 		priority := strconv.Itoa(highestPriority - sgRule.Priority)
-		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionToLport, sgRule, testTier)
+		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionToLport, sgRule, testTier, false)
 		require.NoError(t, err)
 
 		match := fmt.Sprintf("outport == @%s && ip4 && ip4.src == $%s && icmp4", pgName, GetSgV4AssociatedName(sgRule.RemoteSecurityGroup))
@@ -1543,7 +1604,7 @@ func (suite *OvnClientTestSuite) testNewSgRuleACL() {
 			Policy:        "allow",
 		}
 		priority := strconv.Itoa(highestPriority - sgRule.Priority)
-		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionToLport, sgRule, testTier)
+		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionToLport, sgRule, testTier, false)
 		require.NoError(t, err)
 
 		match := fmt.Sprintf("outport == @%s && ip4 && ip4.src == %s && icmp4", pgName, sgRule.RemoteAddress)
@@ -1552,6 +1613,58 @@ func (suite *OvnClientTestSuite) testNewSgRuleACL() {
 		require.Equal(t, expect, acl)
 	})
 
+	t.Run("create fqdn type sg acl", func(t *testing.T) {
+		t.Parallel()
+		testTier := 2
+		sgRule := kubeovnv1.SecurityGroupRule{
+			IPVersion:  "ipv6",
+			RemoteType: kubeovnv1.SgRemoteTypeFQDN,
+			RemoteFQDN: "www.example.com.",
+			Protocol:   "icmp",
+			Priority:   12,
+			Policy:     "allow",
+		}
+		priority := strconv.Itoa(highestPriority - sgRule.Priority)
+		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionFromLport, sgRule, testTier, false)
+		require.NoError(t, err)
+
+		match := fmt.Sprintf("inport == @%s && ip6 && ip6.dst == $%s && icmp6", pgName, GetSgFQDNAddressSetName(sgName, sgRule.RemoteFQDN, sgRule.IPVersion))
+		expect := newACL(pgName, ovnnb.ACLDirectionFromLport, priority, match, ovnnb.ACLActionAllowRelated, testTier)
+		expect.UUID = acl.UUID
+		require.Equal(t, expect, acl)
+	})
+
+	t.Run("create ippool type sg acl", func(t *testing.T) {
+		t.Parallel()
+		testTier := 2
+		sgRule := kubeovnv1.SecurityGroupRule{
+			IPVersion:    "ipv4",
+			RemoteType:   kubeovnv1.SgRemoteTypeIPPool,
+			RemoteIPPool: "test-pool",
+			Protocol:     "icmp",
+			Priority:     12,
+			Policy:       "allow",
+		}
+		priority := strconv.Itoa(highestPriority - sgRule.Priority)
+		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionToLport, sgRule, testTier, false)
+		require.NoError(t, err)
+
+		match := fmt.Sprintf("outport == @%s && ip4 && ip4.src == $test.pool && icmp4", pgName)
+		expect := newACL(pgName, ovnnb.ACLDirectionToLport, priority, match, ovnnb.ACLActionAllowRelated, testTier)
+		expect.UUID = acl.UUID
+		require.Equal(t, expect, acl)
+
+		// the acl references the address set created for the ippool
+		err = nbClient.CreateAddressSet(util.IPPoolAddressSetName(sgRule.RemoteIPPool), map[string]string{"ippool": sgRule.RemoteIPPool})
+		require.NoError(t, err)
+		_, asName, found := strings.Cut(acl.Match, "$")
+		require.True(t, found)
+		asName, _, _ = strings.Cut(asName, " ")
+		exists, err := nbClient.AddressSetExists(asName)
+		require.NoError(t, err)
+		require.True(t, exists)
+	})
+
 	t.Run("create ipv6 acl", func(t *testing.T) {
 		t.Parallel()
 
@@ -1565,7 +1678,7 @@ func (suite *OvnClientTestSuite) testNewSgRuleACL() {
 		}
 		priority := strconv.Itoa(highestPriority - sgRule.Priority)
 		testTier := 2
-		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionToLport, sgRule, testTier)
+		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionToLport, sgRule, testTier, false)
 		require.NoError(t, err)
 
 		match := fmt.Sprintf("outport == @%s && ip6 && ip6.src == %s && icmp6", pgName, sgRule.RemoteAddress)
@@ -1587,7 +1700,7 @@ func (suite *OvnClientTestSuite) testNewSgRuleACL() {
 		}
 		priority := strconv.Itoa(highestPriority - sgRule.Priority)
 		testTier := 2
-		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionFromLport, sgRule, testTier)
+		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionFromLport, sgRule, testTier, false)
 		require.NoError(t, err)
 
 		match := fmt.Sprintf("inport == @%s && ip4 && ip4.dst == %s && icmp4", pgName, sgRule.RemoteAddress)
@@ -1609,7 +1722,7 @@ func (suite *OvnClientTestSuite) testNewSgRuleACL() {
 		}
 		priority := strconv.Itoa(highestPriority - sgRule.Priority)
 		testTier := 2
-		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionToLport, sgRule, testTier)
+		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionToLport, sgRule, testTier, false)
 		require.NoError(t, err)
 
 		match := fmt.Sprintf("outport == @%s && ip4 && ip4.src == %s && icmp4", pgName, sgRule.RemoteAddress)
@@ -1633,7 +1746,7 @@ func (suite *OvnClientTestSuite) testNewSgRuleACL() {
 		}
 		priority := strconv.Itoa(highestPriority - sgRule.Priority)
 		testTier := 2
-		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionToLport, sgRule, testTier)
+		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionToLport, sgRule, testTier, false)
 		require.NoError(t, err)
 
 		match := fmt.Sprintf("outport == @%s && ip4 && ip4.src == %s && %d <= tcp.dst <= %d", pgName, sgRule.RemoteAddress, sgRule.PortRangeMin, sgRule.PortRangeMax)
@@ -1656,7 +1769,7 @@ func (suite *OvnClientTestSuite) testNewSgRuleACL() {
 		}
 		priority := strconv.Itoa(highestPriority - sgRule.Priority)
 
-		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionToLport, sgRule, util.NetpolACLTier)
+		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionToLport, sgRule, util.NetpolACLTier, false)
 		require.NoError(t, err)
 
 		match := fmt.Sprintf("outport == @%s && ip4 && ip4.src == %s && ip4.dst == %s", pgName, sgRule.RemoteAddress, sgRule.LocalAddress)
@@ -1683,7 +1796,7 @@ func (suite *OvnClientTestSuite) testNewSgRuleACL() {
 		}
 		priority := strconv.Itoa(highestPriority - sgRule.Priority)
 
-		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionToLport, sgRule, util.NetpolACLTier)
+		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionToLport, sgRule, util.NetpolACLTier, false)
 		require.NoError(t, err)
 
 		match := fmt.Sprintf("outport == @%s && ip4 && ip4.src == %s && ip4.dst == %s && %d <= tcp.dst <= %d && %d <= tcp.src <= %d",
@@ -1706,7 +1819,7 @@ func (suite *OvnClientTestSuite) testNewSgRuleACL() {
 		}
 		priority := strconv.Itoa(highestPriority - sgRule.Priority)
 
-		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionToLport, sgRule, util.NetpolACLTier)
+		acl, err := nbClient.newSgRuleACL(sgName, ovnnb.ACLDirectionToLport, sgRule, util.NetpolACLTier, false)
 		require.NoError(t, err)
 
 		match := fmt.Sprintf("outport == @%s && ip4 && ip4.src == %s && icmp4", pgName, sgRule.RemoteAddress)
@@ -1791,6 +1904,49 @@ func (suite *OvnClientTestSuite) testCreateAcls() {
 	})
 }
 
+func (suite *OvnClientTestSuite) testDeleteNetpolAclsOps() {
+	t := suite.T()
+	t.Parallel()
+
+	nbClient := suite.ovnNBClient
+	pgName := "test-del-netpol-acls-pg"
+
+	err := nbClient.CreatePortGroup(pgName, nil)
+	require.NoError(t, err)
+
+	ingressACL, err := nbClient.newACL(pgName, ovnnb.ACLDirectionToLport, util.IngressAllowPriority, "outport == @test.del.netpol.acls.pg && ip4 && ip4.src == 10.0.0.1", ovnnb.ACLActionAllowStateless, util.NetpolACLTier)
+	require.NoError(t, err)
+	ingressReverseACL, err := nbClient.newStatelessReverseACL(ingressACL)
+	require.NoError(t, err)
+	egressACL, err := nbClient.newACL(pgName, ovnnb.ACLDirectionFromLport, util.EgressAllowPriority, "inport == @test.del.netpol.acls.pg && ip4 && ip4.dst == 10.0.0.2", ovnnb.ACLActionAllowStateless, util.NetpolACLTier)
+	require.NoError(t, err)
+	egressReverseACL, err := nbClient.newStatelessReverseACL(egressACL)
+	require.NoError(t, err)
+
+	err = nbClient.CreateAcls(pgName, portGroupKey, ingressACL, ingressReverseACL, egressACL, egressReverseACL)
+	require.NoError(t, err)
+
+	ops, err := nbClient.DeleteNetpolAclsOps(pgName, ovnnb.ACLDirectionToLport)
+	require.NoError(t, err)
+	err = nbClient.Transact("delete-ingress-acls", ops)
+	require.NoError(t, err)
+
+	pg, err := nbClient.GetPortGroup(pgName, false)
+	require.NoError(t, err)
+	require.Len(t, pg.ACLs, 2)
+
+	acls, err := nbClient.ListAcls("", map[string]string{aclParentKey: pgName})
+	require.NoError(t, err)
+	for _, acl := range acls {
+		if slices.Contains(pg.ACLs, acl.UUID) {
+			require.True(t, acl.Match == egressACL.Match || acl.ExternalIDs[aclReverseKey] == ovnnb.ACLDirectionFromLport)
+		}
+	}
+
+	_, err = nbClient.DeleteNetpolAclsOps("", ovnnb.ACLDirectionToLport)
+	require.ErrorContains(t, err, "the port group name is required")
+}
+
 func (suite *OvnClientTestSuite) testDeleteAcls() {
 	t := suite.T()
 	t.Parallel()
@@ -2226,6 +2382,18 @@ func (suite *OvnClientTestSuite) testListAcls() {
 		}
 	}
 	require.Equal(t, count, 5)
+
+	/* list acls of port group */
+	out, err = nbClient.ListPortGroupAcls(pgName, ovnnb.ACLDirectionToLport)
+	require.NoError(t, err)
+	require.Len(t, out, 2)
+
+	out, err = nbClient.ListPortGroupAcls(pgName, "")
+	require.NoError(t, err)
+	require.Len(t, out, 5)
+
+	_, err = nbClient.ListPortGroupAcls("", "")
+	require.ErrorContains(t, err, "the port group name is required")
 }
 
 func (suite *OvnClientTestSuite) testNewACL() {
@@ -3115,3 +3283,38 @@ func (suite *OvnClientTestSuite) testUpdateACL() {
 		require.NoError(t, err)
 	})
 }
+
+func Test_reverseACLMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		match    string
+		expected string
+	}{
+		{
+			"outport == @pg && ip4 && ip4.src == $as && 80 <= tcp.dst <= 90",
+			"inport == @pg && ip4 && ip4.dst == $as && 80 <= tcp.src <= 90",
+		},
+		{
+			"inport == @pg && ip6 && ip6.dst == fd00::/64 && ip6.dst != fd00::1 && udp.dst == 53 && udp.src == 1000",
+			"outport == @pg && ip6 && ip6.src == fd00::/64 && ip6.src != fd00::1 && udp.src == 53 && udp.dst == 1000",
+		},
+		{
+			"outport == @pg && ip4 && ip4.src == $test.pool && icmp4",
+			"inport == @pg && ip4 && ip4.dst == $test.pool && icmp4",
+		},
+		{
+			// the names of the address sets and port groups built from user names are left alone
+			"outport == @ovn.sg.tcp.dst && ip4 && ip4.src == $ovn.sg.tcp.dst.associated.v4 && tcp.dst == 80",
+			"inport == @ovn.sg.tcp.dst && ip4 && ip4.dst == $ovn.sg.tcp.dst.associated.v4 && tcp.src == 80",
+		},
+		{
+			"inport == @pg.inport_ip4 && ip4.dst == $ns.udp.src-outport",
+			"outport == @pg.inport_ip4 && ip4.src == $ns.udp.src-outport",
+		},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.expected, reverseACLMatch(tt.match))
+		require.Equal(t, tt.match, reverseACLMatch(reverseACLMatch(tt.match)))
+	}
+}
@@ -0,0 +1,128 @@
+package ovs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/klog/v2"
+
+	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnnb"
+)
+
+// NbEntity is a northbound row which logical flows are generated from
+type NbEntity struct {
+	Table       string            `json:"table"`
+	UUID        string            `json:"uuid"`
+	Name        string            `json:"name,omitempty"`
+	Parent      string            `json:"parent,omitempty"`
+	ExternalIDs map[string]string `json:"externalIDs,omitempty"`
+}
+
+// GetEntityByUUIDPrefix returns the northbound row whose uuid starts with prefix,
+// such as the stage hint of a logical flow. Nil is returned if no row is found.
+// The parent of nat, static route and policy is the logical router which they belong to.
+func (c *OVNNbClient) GetEntityByUUIDPrefix(prefix string) (*NbEntity, error) {
+	if len(prefix) == 0 {
+		return nil, errors.New("the uuid prefix is required")
+	}
+
+	acl, err := getByUUIDPrefix(c, prefix, func(row *ovnnb.ACL) string { return row.UUID })
+	if err != nil {
+		return nil, err
+	}
+	if acl != nil {
+		entity := &NbEntity{Table: ovnnb.ACLTable, UUID: acl.UUID, Parent: acl.ExternalIDs[aclParentKey], ExternalIDs: acl.ExternalIDs}
+		if acl.Name != nil {
+			entity.Name = *acl.Name
+		}
+		return entity, nil
+	}
+
+	lb, err := getByUUIDPrefix(c, prefix, func(row *ovnnb.LoadBalancer) string { return row.UUID })
+	if err != nil {
+		return nil, err
+	}
+	if lb != nil {
+		return &NbEntity{Table: ovnnb.LoadBalancerTable, UUID: lb.UUID, Name: lb.Name, ExternalIDs: lb.ExternalIDs}, nil
+	}
+
+	lsp, err := getByUUIDPrefix(c, prefix, func(row *ovnnb.LogicalSwitchPort) string { return row.UUID })
+	if err != nil {
+		return nil, err
+	}
+	if lsp != nil {
+		return &NbEntity{Table: ovnnb.LogicalSwitchPortTable, UUID: lsp.UUID, Name: lsp.Name, ExternalIDs: lsp.ExternalIDs}, nil
+	}
+
+	lrp, err := getByUUIDPrefix(c, prefix, func(row *ovnnb.LogicalRouterPort) string { return row.UUID })
+	if err != nil {
+		return nil, err
+	}
+	if lrp != nil {
+		return &NbEntity{Table: ovnnb.LogicalRouterPortTable, UUID: lrp.UUID, Name: lrp.Name, ExternalIDs: lrp.ExternalIDs}, nil
+	}
+
+	var entity *NbEntity
+	nat, err := getByUUIDPrefix(c, prefix, func(row *ovnnb.NAT) string { return row.UUID })
+	if err != nil {
+		return nil, err
+	}
+	if nat != nil {
+		entity = &NbEntity{Table: ovnnb.NATTable, UUID: nat.UUID, Name: fmt.Sprintf("%s %s %s", nat.Type, nat.ExternalIP, nat.LogicalIP), ExternalIDs: nat.ExternalIDs}
+	}
+	if entity == nil {
+		route, err := getByUUIDPrefix(c, prefix, func(row *ovnnb.LogicalRouterStaticRoute) string { return row.UUID })
+		if err != nil {
+			return nil, err
+		}
+		if route != nil {
+			entity = &NbEntity{Table: ovnnb.LogicalRouterStaticRouteTable, UUID: route.UUID, Name: fmt.Sprintf("%s via %s", route.IPPrefix, route.Nexthop), ExternalIDs: route.ExternalIDs}
+		}
+	}
+	if entity == nil {
+		policy, err := getByUUIDPrefix(c, prefix, func(row *ovnnb.LogicalRouterPolicy) string { return row.UUID })
+		if err != nil {
+			return nil, err
+		}
+		if policy != nil {
+			entity = &NbEntity{Table: ovnnb.LogicalRouterPolicyTable, UUID: policy.UUID, Name: fmt.Sprintf("%d %s", policy.Priority, policy.Match), ExternalIDs: policy.ExternalIDs}
+		}
+	}
+	if entity == nil {
+		return nil, nil
+	}
+
+	lrs, err := c.ListLogicalRouter(false, func(lr *ovnnb.LogicalRouter) bool {
+		return slices.Contains(lr.Nat, entity.UUID) || slices.Contains(lr.StaticRoutes, entity.UUID) || slices.Contains(lr.Policies, entity.UUID)
+	})
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+	if len(lrs) != 0 {
+		entity.Parent = lrs[0].Name
+	}
+	return entity, nil
+}
+
+// getByUUIDPrefix returns the first row in cache whose uuid starts with prefix, rows with empty uuid are ignored
+func getByUUIDPrefix[T any](c *OVNNbClient, prefix string, uuid func(row *T) string) (*T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.Timeout)
+	defer cancel()
+
+	var rows []T
+	if err := c.WhereCache(func(row *T) bool {
+		id := uuid(row)
+		return id != "" && strings.HasPrefix(id, prefix)
+	}).List(ctx, &rows); err != nil {
+		klog.Error(err)
+		return nil, fmt.Errorf("failed to list rows with uuid prefix %q: %w", prefix, err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return &rows[0], nil
+}
@@ -0,0 +1,54 @@
+package ovs
+
+import (
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnnb"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+func (suite *OvnClientTestSuite) testGetEntityByUUIDPrefix() {
+	t := suite.T()
+	t.Parallel()
+
+	nbClient := suite.ovnNBClient
+	lrName := "test-get-entity-lr"
+	pgName := "test-get-entity-pg"
+
+	err := nbClient.CreateLogicalRouter(lrName)
+	require.NoError(t, err)
+	err = nbClient.AddLogicalRouterStaticRoute(lrName, util.MainRouteTable, ovnnb.LogicalRouterStaticRoutePolicyDstIP, "192.168.50.0/24", nil, nil, "192.168.50.1")
+	require.NoError(t, err)
+	route, err := nbClient.GetLogicalRouterStaticRoute(lrName, util.MainRouteTable, ovnnb.LogicalRouterStaticRoutePolicyDstIP, "192.168.50.0/24", "192.168.50.1", false)
+	require.NoError(t, err)
+
+	entity, err := nbClient.GetEntityByUUIDPrefix(route.UUID[:8])
+	require.NoError(t, err)
+	require.NotNil(t, entity)
+	require.Equal(t, ovnnb.LogicalRouterStaticRouteTable, entity.Table)
+	require.Equal(t, route.UUID, entity.UUID)
+	require.Equal(t, lrName, entity.Parent)
+
+	err = nbClient.CreatePortGroup(pgName, nil)
+	require.NoError(t, err)
+	acl, err := nbClient.newACL(pgName, ovnnb.ACLDirectionToLport, "1000", "outport == @test.get.entity.pg && ip", ovnnb.ACLActionDrop, util.NetpolACLTier)
+	require.NoError(t, err)
+	err = nbClient.CreateAcls(pgName, portGroupKey, acl)
+	require.NoError(t, err)
+	acls, err := nbClient.ListPortGroupAcls(pgName, ovnnb.ACLDirectionToLport)
+	require.NoError(t, err)
+	require.Len(t, acls, 1)
+
+	entity, err = nbClient.GetEntityByUUIDPrefix(acls[0].UUID[:8])
+	require.NoError(t, err)
+	require.NotNil(t, entity)
+	require.Equal(t, ovnnb.ACLTable, entity.Table)
+	require.Equal(t, pgName, entity.Parent)
+
+	entity, err = nbClient.GetEntityByUUIDPrefix("zzzzzzzz")
+	require.NoError(t, err)
+	require.Nil(t, entity)
+
+	_, err = nbClient.GetEntityByUUIDPrefix("")
+	require.ErrorContains(t, err, "the uuid prefix is required")
+}
@@ -14,6 +14,7 @@ import (
 	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
 	ovsclient "github.com/kubeovn/kube-ovn/pkg/ovsdb/client"
 	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnnb"
+	"github.com/kubeovn/kube-ovn/pkg/util"
 )
 
 func (c *OVNNbClient) AddNat(lrName, natType, externalIP, logicalIP, logicalMac, port string, options map[string]string) error {
@@ -170,6 +171,95 @@ func (c *OVNNbClient) EnsureSnat(lrName, externalIP, logicalIP string) error {
 	return nil
 }
 
+type snatPortBlockKey struct {
+	internalIP, externalIP, portRange string
+}
+
+// UpdateSnatPortBlocks makes the per address snat rules with an external port range owned by owner
+// the same as the given port block mappings, the rules are all deleted when mappings is empty.
+// The rules match a single internal address by its /32 logical ip, port blocks are IPv4 only.
+func (c *OVNNbClient) UpdateSnatPortBlocks(lrName, owner string, mappings []util.SnatPortBlockMapping) error {
+	if owner == "" {
+		err := errors.New("snat port block owner is required")
+		klog.Error(err)
+		return err
+	}
+
+	externalIDs := map[string]string{ExternalIDSnatPortBlock: owner}
+	nats, err := c.ListNats(lrName, ovnnb.NATTypeSNAT, "", externalIDs)
+	if err != nil {
+		klog.Error(err)
+		return fmt.Errorf("list logical router %s snat port blocks of %s: %w", lrName, owner, err)
+	}
+
+	// rules with a changed external ip or port range are replaced
+	existing := make(map[snatPortBlockKey]string, len(nats))
+	for _, nat := range nats {
+		existing[snatPortBlockKey{internalIP: nat.LogicalIP, externalIP: nat.ExternalIP, portRange: nat.ExternalPortRange}] = nat.UUID
+	}
+
+	models := make([]model.Model, 0, len(mappings))
+	natUUIDs := make([]string, 0, len(mappings))
+	for _, mapping := range mappings {
+		key := snatPortBlockKey{internalIP: mapping.InternalIP, externalIP: mapping.ExternalIP, portRange: mapping.PortRange()}
+		if _, ok := existing[key]; ok {
+			delete(existing, key)
+			continue
+		}
+		nat := &ovnnb.NAT{
+			UUID:              ovsclient.NamedUUID(),
+			Type:              ovnnb.NATTypeSNAT,
+			ExternalIP:        mapping.ExternalIP,
+			LogicalIP:         mapping.InternalIP,
+			ExternalPortRange: mapping.PortRange(),
+			ExternalIDs: map[string]string{
+				ExternalIDVendor:        util.CniTypeName,
+				ExternalIDSnatPortBlock: owner,
+			},
+		}
+		models = append(models, model.Model(nat))
+		natUUIDs = append(natUUIDs, nat.UUID)
+	}
+
+	staleUUIDs := make([]string, 0, len(existing))
+	for _, uuid := range existing {
+		staleUUIDs = append(staleUUIDs, uuid)
+	}
+	if len(models) == 0 && len(staleUUIDs) == 0 {
+		return nil
+	}
+
+	var ops []ovsdb.Operation
+	if len(staleUUIDs) != 0 {
+		delOps, err := c.LogicalRouterUpdateNatOp(lrName, staleUUIDs, ovsdb.MutateOperationDelete)
+		if err != nil {
+			klog.Error(err)
+			return fmt.Errorf("generate operations for deleting snat port blocks from logical router %s: %w", lrName, err)
+		}
+		ops = append(ops, delOps...)
+	}
+	if len(models) != 0 {
+		createOps, err := c.Create(models...)
+		if err != nil {
+			klog.Error(err)
+			return fmt.Errorf("generate operations for creating snat port blocks: %w", err)
+		}
+		addOps, err := c.LogicalRouterUpdateNatOp(lrName, natUUIDs, ovsdb.MutateOperationInsert)
+		if err != nil {
+			klog.Error(err)
+			return fmt.Errorf("generate operations for adding snat port blocks to logical router %s: %w", lrName, err)
+		}
+		ops = append(ops, createOps...)
+		ops = append(ops, addOps...)
+	}
+
+	if err = c.Transact("lr-snat-port-blocks-update", ops); err != nil {
+		klog.Error(err)
+		return fmt.Errorf("update snat port blocks of %s on logical router %s: %w", owner, lrName, err)
+	}
+	return nil
+}
+
 // UpdateDnatAndSnat update dnat_and_snat rule
 func (c *OVNNbClient) UpdateDnatAndSnat(lrName, externalIP, logicalIP, lspName, externalMac, gatewayType string) error {
 	if externalIP == "" {
@@ -364,7 +454,8 @@ func (c *OVNNbClient) GetNat(lrName, natType, externalIP, logicalIP string, igno
 			return nat.LogicalIP == logicalIP
 		}
 		if natType == ovnnb.NATTypeSNAT {
-			return nat.Type == natType && nat.ExternalIP == externalIP && nat.LogicalIP == logicalIP
+			// the per address rules of snat port blocks are managed by UpdateSnatPortBlocks
+			return nat.Type == natType && nat.ExternalIP == externalIP && nat.LogicalIP == logicalIP && nat.ExternalIDs[ExternalIDSnatPortBlock] == ""
 		}
 		// For DNATAndSNAT: if logicalIP given, require externalIP+logicalIP.
 		// Prevents stale Delete (old logicalIP) from clobbering new row.
@@ -8,6 +8,7 @@ import (
 	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
 	ovsclient "github.com/kubeovn/kube-ovn/pkg/ovsdb/client"
 	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnnb"
+	"github.com/kubeovn/kube-ovn/pkg/util"
 )
 
 func newNat(natType, externalIP, logicalIP string, options ...func(nat *ovnnb.NAT)) *ovnnb.NAT {
@@ -150,6 +151,81 @@ func (suite *OvnClientTestSuite) testEnsureSnat() {
 	})
 }
 
+func (suite *OvnClientTestSuite) testUpdateSnatPortBlocks() {
+	t := suite.T()
+	t.Parallel()
+
+	nbClient := suite.ovnNBClient
+	lrName := "test-snat-port-blocks-lr"
+	owner := "test-snat-port-blocks"
+	externalIDs := map[string]string{ExternalIDSnatPortBlock: owner}
+
+	err := nbClient.CreateLogicalRouter(lrName)
+	require.NoError(t, err)
+
+	mappings := []util.SnatPortBlockMapping{
+		{InternalIP: "10.251.0.0", ExternalIP: "192.168.40.254", PortStart: 1024, PortEnd: 2047},
+		{InternalIP: "10.251.0.1", ExternalIP: "192.168.40.254", PortStart: 2048, PortEnd: 3071},
+	}
+
+	t.Run("create snat port blocks", func(t *testing.T) {
+		err = nbClient.UpdateSnatPortBlocks(lrName, owner, mappings)
+		require.NoError(t, err)
+
+		nats, err := nbClient.ListNats(lrName, ovnnb.NATTypeSNAT, "", externalIDs)
+		require.NoError(t, err)
+		require.Len(t, nats, 2)
+		for _, nat := range nats {
+			require.Equal(t, "192.168.40.254", nat.ExternalIP)
+			switch nat.LogicalIP {
+			case "10.251.0.0":
+				require.Equal(t, "1024-2047", nat.ExternalPortRange)
+			case "10.251.0.1":
+				require.Equal(t, "2048-3071", nat.ExternalPortRange)
+			default:
+				t.Fatalf("unexpected snat port block for %s", nat.LogicalIP)
+			}
+		}
+	})
+
+	t.Run("update snat port blocks", func(t *testing.T) {
+		unchanged, err := nbClient.ListNats(lrName, ovnnb.NATTypeSNAT, "10.251.0.0", externalIDs)
+		require.NoError(t, err)
+		require.Len(t, unchanged, 1)
+
+		err = nbClient.UpdateSnatPortBlocks(lrName, owner, []util.SnatPortBlockMapping{
+			mappings[0],
+			{InternalIP: "10.251.0.1", ExternalIP: "192.168.40.254", PortStart: 3072, PortEnd: 4095},
+		})
+		require.NoError(t, err)
+
+		nats, err := nbClient.ListNats(lrName, ovnnb.NATTypeSNAT, "", externalIDs)
+		require.NoError(t, err)
+		require.Len(t, nats, 2)
+		for _, nat := range nats {
+			if nat.LogicalIP == "10.251.0.0" {
+				require.Equal(t, unchanged[0].UUID, nat.UUID)
+			} else {
+				require.Equal(t, "3072-4095", nat.ExternalPortRange)
+			}
+		}
+	})
+
+	t.Run("delete snat port blocks", func(t *testing.T) {
+		err = nbClient.UpdateSnatPortBlocks(lrName, owner, nil)
+		require.NoError(t, err)
+
+		nats, err := nbClient.ListNats(lrName, ovnnb.NATTypeSNAT, "", externalIDs)
+		require.NoError(t, err)
+		require.Empty(t, nats)
+	})
+
+	t.Run("update snat port blocks without owner", func(t *testing.T) {
+		err = nbClient.UpdateSnatPortBlocks(lrName, "", mappings)
+		require.ErrorContains(t, err, "owner is required")
+	})
+}
+
 func (suite *OvnClientTestSuite) testUpdateDnatAndSnat() {
 	t := suite.T()
 	t.Parallel()
@@ -779,6 +779,10 @@ func (suite *OvnClientTestSuite) Test_DeleteAcls() {
 	suite.testDeleteAcls()
 }
 
+func (suite *OvnClientTestSuite) Test_DeleteNetpolAclsOps() {
+	suite.testDeleteNetpolAclsOps()
+}
+
 func (suite *OvnClientTestSuite) Test_DeleteAcl() {
 	suite.testDeleteACL()
 }
@@ -913,6 +917,10 @@ func (suite *OvnClientTestSuite) Test_EnsureSnat() {
 	suite.testEnsureSnat()
 }
 
+func (suite *OvnClientTestSuite) Test_UpdateSnatPortBlocks() {
+	suite.testUpdateSnatPortBlocks()
+}
+
 func (suite *OvnClientTestSuite) Test_UpdateDnatAndSnat() {
 	suite.testUpdateDnatAndSnat()
 }
@@ -1088,6 +1096,14 @@ func (suite *OvnClientTestSuite) Test_DeleteSecurityGroup() {
 	suite.testDeleteSecurityGroup()
 }
 
+func (suite *OvnClientTestSuite) Test_GetEntityByUUIDPrefix() {
+	suite.testGetEntityByUUIDPrefix()
+}
+
+func (suite *OvnClientTestSuite) Test_WithContext() {
+	suite.testWithContext()
+}
+
 func (suite *OvnClientTestSuite) Test_GetEntityInfo() {
 	suite.testGetEntityInfo()
 }
@@ -1171,6 +1187,18 @@ func (suite *OvnClientTestSuite) Test_GetTs() {
 	suite.testGetTs()
 }
 
+func (suite *OvnClientTestSuite) Test_GetVpcTs() {
+	suite.testGetVpcTs()
+}
+
+func (suite *OvnClientTestSuite) Test_CreateVpcTs() {
+	suite.testCreateVpcTs()
+}
+
+func (suite *OvnClientTestSuite) Test_DeleteVpcTs() {
+	suite.testDeleteVpcTs()
+}
+
 func (suite *OvnClientTestSuite) Test_FindUUIDWithAttrInTable() {
 	suite.testFindUUIDWithAttrInTable()
 }
@@ -1183,6 +1211,10 @@ func (suite *OvnClientTestSuite) Test_GetAzUUID() {
 	suite.testGetAzUUID()
 }
 
+func (suite *OvnClientTestSuite) Test_ListAvailabilityZones() {
+	suite.testListAvailabilityZones()
+}
+
 func (suite *OvnClientTestSuite) Test_GetGatewayUUIDsInOneAZ() {
 	suite.testGetGatewayUUIDsInOneAZ()
 }
@@ -1191,10 +1223,18 @@ func (suite *OvnClientTestSuite) Test_GetRouteUUIDsInOneAZ() {
 	suite.testGetRouteUUIDsInOneAZ()
 }
 
+func (suite *OvnClientTestSuite) Test_GetRoutePrefixesInOneAZ() {
+	suite.testGetRoutePrefixesInOneAZ()
+}
+
 func (suite *OvnClientTestSuite) Test_GetPortBindingUUIDsInOneAZ() {
 	suite.testGetPortBindingUUIDsInOneAZ()
 }
 
+func (suite *OvnClientTestSuite) Test_GetTsPortBindingAZs() {
+	suite.testGetTsPortBindingAZs()
+}
+
 func (suite *OvnClientTestSuite) Test_DestroyGateways() {
 	suite.testDestroyGateways()
 }
@@ -1212,46 +1252,6 @@ func (suite *OvnClientTestSuite) Test_DestroyChassis() {
 }
 
 // ovs
-func (suite *OvnClientTestSuite) Test_SetInterfaceBandwidth() {
-	suite.testSetInterfaceBandwidth()
-}
-
-func (suite *OvnClientTestSuite) Test_ClearHtbQosQueue() {
-	suite.testClearHtbQosQueue()
-}
-
-func (suite *OvnClientTestSuite) Test_IsHtbQos() {
-	suite.testIsHtbQos()
-}
-
-func (suite *OvnClientTestSuite) Test_SetHtbQosQueueRecord() {
-	suite.testSetHtbQosQueueRecord()
-}
-
-func (suite *OvnClientTestSuite) Test_SetQosQueueBinding() {
-	suite.testSetQosQueueBinding()
-}
-
-func (suite *OvnClientTestSuite) Test_SetNetemQos() {
-	suite.testSetNetemQos()
-}
-
-func (suite *OvnClientTestSuite) Test_GetNetemQosConfig() {
-	suite.testGetNetemQosConfig()
-}
-
-func (suite *OvnClientTestSuite) Test_DeleteNetemQosByID() {
-	suite.testDeleteNetemQosByID()
-}
-
-func (suite *OvnClientTestSuite) Test_IsUserspaceDataPath() {
-	suite.testIsUserspaceDataPath()
-}
-
-func (suite *OvnClientTestSuite) Test_CheckAndUpdateHtbQos() {
-	suite.testCheckAndUpdateHtbQos()
-}
-
 func (suite *OvnClientTestSuite) Test_UpdateOVSVsctlLimiter() {
 	suite.testUpdateOVSVsctlLimiter()
 }
@@ -1308,18 +1308,6 @@ func (suite *OvnClientTestSuite) Test_OvsPortExists() {
 	suite.testOvsPortExists()
 }
 
-func (suite *OvnClientTestSuite) Test_GetOvsQosList() {
-	suite.testGetOvsQosList()
-}
-
-func (suite *OvnClientTestSuite) Test_OvsClearPodBandwidth() {
-	suite.testOvsClearPodBandwidth()
-}
-
-func (suite *OvnClientTestSuite) Test_OvsCleanDuplicatePort() {
-	suite.testOvsCleanDuplicatePort()
-}
-
 func (suite *OvnClientTestSuite) Test_ValidatePortVendor() {
 	suite.testValidatePortVendor()
 }
@@ -1328,22 +1316,6 @@ func (suite *OvnClientTestSuite) Test_GetInterfacePodNs() {
 	suite.testGetInterfacePodNs()
 }
 
-func (suite *OvnClientTestSuite) Test_ConfigInterfaceMirror() {
-	suite.testConfigInterfaceMirror()
-}
-
-func (suite *OvnClientTestSuite) Test_ClearPortQosBinding() {
-	suite.testClearPortQosBinding()
-}
-
-func (suite *OvnClientTestSuite) Test_OvsListExternalIDs() {
-	suite.testOvsListExternalIDs()
-}
-
-func (suite *OvnClientTestSuite) Test_ListQosQueueIDs() {
-	suite.testListQosQueueIDs()
-}
-
 func Test_scratch(t *testing.T) {
 	t.SkipNow()
 	endpoint := "tcp:[172.20.149.35]:6641"
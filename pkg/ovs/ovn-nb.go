@@ -17,6 +17,7 @@ const (
 	LogicalSwitchKey      = "ls"
 	portGroupKey          = "pg"
 	aclParentKey          = "parent"
+	aclReverseKey         = "reverse_of"
 	associatedSgKeyPrefix = "associated_sg_"
 	sgsKey                = "security_groups"
 	sgKey                 = "sg"
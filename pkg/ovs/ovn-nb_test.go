@@ -1,10 +1,14 @@
 package ovs
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 
 	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnnb"
 	"github.com/kubeovn/kube-ovn/pkg/util"
@@ -349,3 +353,33 @@ func (suite *OvnClientTestSuite) testGetEntityInfo() {
 		require.ErrorContains(t, err, "entity must be pointer")
 	})
 }
+
+func (suite *OvnClientTestSuite) testWithContext() {
+	t := suite.T()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(provider) })
+
+	nbClient := suite.ovnNBClient
+	ctx, span := otel.Tracer("test").Start(context.Background(), "handler")
+	client := nbClient.WithContext(ctx)
+	err := client.CreateLogicalRouter("test-with-context-lr")
+	require.NoError(t, err)
+	span.End()
+
+	lr, err := nbClient.GetLogicalRouter("test-with-context-lr", false)
+	require.NoError(t, err)
+	require.NotNil(t, lr)
+
+	// the transaction is traced as a child of the span in ctx
+	var found bool
+	for _, s := range recorder.Ended() {
+		if s.Parent().SpanID() == span.SpanContext().SpanID() {
+			found = true
+			require.Equal(t, span.SpanContext().TraceID(), s.SpanContext().TraceID())
+		}
+	}
+	require.True(t, found)
+}
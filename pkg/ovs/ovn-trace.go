@@ -0,0 +1,173 @@
+package ovs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	OVNTrace = "ovn-trace"
+	OVNSbCtl = "ovn-sbctl"
+)
+
+var (
+	ovnTracePipelineRegexp = regexp.MustCompile(`^\s*(ingress|egress)\(dp="([^"]+)"`)
+	ovnTraceStepRegexp     = regexp.MustCompile(`^\s*(\d+)\. (\S+)(?: \([^)]*\))?: (.*), priority (\d+), uuid ([0-9a-f]+)$`)
+)
+
+// OVNTraceStep is a logical flow matched by the packet traced by ovn-trace
+type OVNTraceStep struct {
+	Datapath string   `json:"datapath"`
+	Pipeline string   `json:"pipeline"`
+	Table    int      `json:"table"`
+	Stage    string   `json:"stage"`
+	Match    string   `json:"match"`
+	Priority int      `json:"priority"`
+	FlowUUID string   `json:"flowUUID"`
+	Actions  []string `json:"actions,omitempty"`
+}
+
+// RunOVNTrace runs ovn-trace against the southbound database at address,
+// every connection tracking lookup of the packet is treated as a new connection
+func RunOVNTrace(address string, timeout int, datapath, microflow string) (string, error) {
+	args := []string{"--db=" + address, "--detailed"}
+	for range 4 {
+		args = append(args, "--ct=new")
+	}
+	args = append(args, datapath, microflow)
+	if strings.HasPrefix(address, "ssl:") {
+		args = slices.Insert(args, 0, CmdSSLArgs()...)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
+	defer cancel()
+	output, err := exec.CommandContext(ctx, OVNTrace, args...).CombinedOutput() // #nosec G204
+	if err != nil {
+		klog.Error(err)
+		return "", fmt.Errorf("failed to execute %s with args %v: %w\noutput: %s", OVNTrace, args, err, string(output))
+	}
+	return string(output), nil
+}
+
+// ParseOVNTrace parses the detailed output of ovn-trace into the logical flows matched by the packet
+func ParseOVNTrace(output string) []OVNTraceStep {
+	var (
+		steps              []OVNTraceStep
+		datapath, pipeline string
+		current            *OVNTraceStep
+		stepIndent         int
+	)
+	for line := range strings.SplitSeq(output, "\n") {
+		trimmed := strings.TrimSpace(line)
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		if match := ovnTracePipelineRegexp.FindStringSubmatch(line); match != nil {
+			pipeline, datapath = match[1], match[2]
+			current = nil
+			continue
+		}
+		if match := ovnTraceStepRegexp.FindStringSubmatch(line); match != nil {
+			table, _ := strconv.Atoi(match[1])
+			priority, _ := strconv.Atoi(match[4])
+			steps = append(steps, OVNTraceStep{
+				Datapath: datapath,
+				Pipeline: pipeline,
+				Table:    table,
+				Stage:    match[2],
+				Match:    match[3],
+				Priority: priority,
+				FlowUUID: match[5],
+			})
+			current, stepIndent = &steps[len(steps)-1], indent
+			continue
+		}
+		if current == nil || trimmed == "" || strings.Trim(trimmed, "-") == "" || indent <= stepIndent {
+			current = nil
+			continue
+		}
+		current.Actions = append(current.Actions, trimmed)
+	}
+	return steps
+}
+
+// LogicalFlowStageHints returns the stage hints of the logical flows with the given (abbreviated) uuids,
+// the stage hint of a logical flow is the abbreviated uuid of the northbound row which the flow is generated from
+func LogicalFlowStageHints(address string, timeout int, uuids ...string) (map[string]string, error) {
+	hints := make(map[string]string, len(uuids))
+	if len(uuids) == 0 {
+		return hints, nil
+	}
+
+	uuids = slices.Compact(slices.Sorted(slices.Values(uuids)))
+	args := []string{fmt.Sprintf("--timeout=%d", timeout), "--db=" + address, "--format=json", "--columns=_uuid,external_ids", "list", "Logical_Flow"}
+	args = append(args, uuids...)
+	if strings.HasPrefix(address, "ssl:") {
+		args = slices.Insert(args, 0, CmdSSLArgs()...)
+	}
+	output, err := exec.Command(OVNSbCtl, args...).CombinedOutput() // #nosec G204
+	if err != nil {
+		klog.Error(err)
+		return nil, fmt.Errorf("failed to execute %s with args %v: %w\noutput: %s", OVNSbCtl, args, err, string(output))
+	}
+
+	externalIDs, err := parseCtlExternalIDs(output)
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+	for uuid, ids := range externalIDs {
+		hint := ids["stage-hint"]
+		if hint == "" {
+			continue
+		}
+		for _, prefix := range uuids {
+			if strings.HasPrefix(uuid, prefix) {
+				hints[prefix] = hint
+			}
+		}
+	}
+	return hints, nil
+}
+
+// parseCtlExternalIDs parses the json output of `*-ctl --format=json --columns=_uuid,external_ids list`
+func parseCtlExternalIDs(output []byte) (map[string]map[string]string, error) {
+	var table struct {
+		Data [][]json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(output, &table); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %q: %w", string(output), err)
+	}
+
+	result := make(map[string]map[string]string, len(table.Data))
+	for _, row := range table.Data {
+		if len(row) != 2 {
+			return nil, fmt.Errorf("unexpected row %v", row)
+		}
+		var uuid [2]string
+		if err := json.Unmarshal(row[0], &uuid); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal uuid %s: %w", string(row[0]), err)
+		}
+		var ovsMap [2]json.RawMessage
+		if err := json.Unmarshal(row[1], &ovsMap); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal map %s: %w", string(row[1]), err)
+		}
+		var pairs [][2]string
+		if err := json.Unmarshal(ovsMap[1], &pairs); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal map pairs %s: %w", string(ovsMap[1]), err)
+		}
+		ids := make(map[string]string, len(pairs))
+		for _, pair := range pairs {
+			ids[pair[0]] = pair[1]
+		}
+		result[uuid[1]] = ids
+	}
+	return result, nil
+}
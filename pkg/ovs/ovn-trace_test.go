@@ -0,0 +1,79 @@
+package ovs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOVNTrace(t *testing.T) {
+	output := `# tcp,reg14=0x4,vlan_tci=0x0000,dl_src=0a:58:0a:10:00:05,dl_dst=0a:58:0a:10:00:01,nw_src=10.16.0.5,nw_dst=10.96.0.10,nw_ttl=255,tp_src=30000,tp_dst=53,tcp_flags=syn
+
+ingress(dp="ovn-default", inport="pod1.default")
+------------------------------------------------
+ 0. ls_in_check_port_sec (northd/northd.c:8691): 1, priority 50, uuid 6b2c3a41
+    reg0[15] = check_in_port_sec();
+    next;
+ 6. ls_in_pre_stateful (northd/northd.c:6126): reg0[2] == 1 && ip4 && tcp, priority 120, uuid 1f2e3d4c
+    reg1 = ip4.dst;
+    reg2[0..15] = tcp.dst;
+    ct_lb_mark;
+
+ct_lb_mark
+----------
+ 9. ls_in_acl_eval (northd/northd.c:6650): reg0[7] == 1 && (inport == @ovn.sg.test && ip4), priority 2300, uuid 3c4d5e6f
+    reg8[16] = 1;
+    next;
+14. ls_in_lb (northd/northd.c:7500): ct.new && reg1 == 10.96.0.10 && reg2[0..15] == 53, priority 120, uuid abcdef12
+    reg0[1] = 0;
+    ct_lb_mark(backends=10.16.0.7:53,10.16.0.8:53);
+
+ct_lb_mark /* default (use --ct to customize) */
+------------------------------------------------
+27. ls_in_l2_lkup (northd/northd.c:9000): eth.dst == 0a:58:0a:10:00:01, priority 50, uuid 11223344
+    outport = "ovn-default-ovn-cluster";
+    output;
+
+egress(dp="ovn-default", inport="pod1.default", outport="ovn-default-ovn-cluster")
+---------------------------------------------------------------------------------
+10. ls_out_apply_port_sec (northd/northd.c:5710): 1, priority 0, uuid 99aabbcc
+    output;
+    /* output to "ovn-default-ovn-cluster", type "patch" */
+`
+
+	steps := ParseOVNTrace(output)
+	require.Len(t, steps, 6)
+
+	require.Equal(t, OVNTraceStep{
+		Datapath: "ovn-default",
+		Pipeline: "ingress",
+		Table:    9,
+		Stage:    "ls_in_acl_eval",
+		Match:    "reg0[7] == 1 && (inport == @ovn.sg.test && ip4)",
+		Priority: 2300,
+		FlowUUID: "3c4d5e6f",
+		Actions:  []string{"reg8[16] = 1;", "next;"},
+	}, steps[2])
+	require.Equal(t, "ls_in_lb", steps[3].Stage)
+	require.Equal(t, 14, steps[3].Table)
+	require.Equal(t, []string{"reg0[1] = 0;", "ct_lb_mark(backends=10.16.0.7:53,10.16.0.8:53);"}, steps[3].Actions)
+	require.Equal(t, "egress", steps[5].Pipeline)
+	require.Equal(t, "99aabbcc", steps[5].FlowUUID)
+	require.Len(t, steps[5].Actions, 2)
+
+	require.Empty(t, ParseOVNTrace(""))
+}
+
+func TestParseCtlExternalIDs(t *testing.T) {
+	output := []byte(`{"data":[[["uuid","3c4d5e6f-0000-4000-8000-000000000001"],["map",[["source","northd.c:6650"],["stage-hint","a1b2c3d4"],["stage-name","ls_in_acl_eval"]]]],[["uuid","6b2c3a41-0000-4000-8000-000000000002"],["map",[]]]],"headings":["_uuid","external_ids"]}`)
+
+	result, err := parseCtlExternalIDs(output)
+	require.NoError(t, err)
+	require.Equal(t, map[string]map[string]string{
+		"3c4d5e6f-0000-4000-8000-000000000001": {"source": "northd.c:6650", "stage-hint": "a1b2c3d4", "stage-name": "ls_in_acl_eval"},
+		"6b2c3a41-0000-4000-8000-000000000002": {},
+	}, result)
+
+	_, err = parseCtlExternalIDs([]byte("invalid"))
+	require.Error(t, err)
+}
@@ -14,11 +14,14 @@ import (
 	"github.com/ovn-kubernetes/libovsdb/model"
 	"github.com/ovn-kubernetes/libovsdb/modelgen"
 	"github.com/ovn-kubernetes/libovsdb/ovsdb"
+	"go.opentelemetry.io/otel/attribute"
 	"k8s.io/klog/v2"
 
 	ovsclient "github.com/kubeovn/kube-ovn/pkg/ovsdb/client"
 	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnnb"
 	"github.com/kubeovn/kube-ovn/pkg/ovsdb/ovnsb"
+	"github.com/kubeovn/kube-ovn/pkg/ovsdb/vswitch"
+	"github.com/kubeovn/kube-ovn/pkg/tracing"
 )
 
 // LegacyClient is the legacy ovn client
@@ -39,6 +42,8 @@ type OVNSbClient struct {
 type ovsDbClient struct {
 	client.Client
 	Timeout time.Duration
+	// ctx is the parent context of the transactions, which carries the span of the caller
+	ctx context.Context
 }
 
 const (
@@ -51,8 +56,10 @@ const (
 	OVSDBWaitTimeout = 0
 
 	ExternalIDVendor           = "vendor"
+	ExternalIDIfaceID          = "iface-id"
 	ExternalIDVpcEgressGateway = "vpc-egress-gateway"
 	ExternalIDVpcNatGateway    = "vpc-nat-gateway"
+	ExternalIDSnatPortBlock    = "snat-port-block"
 )
 
 // NewLegacyClient init a legacy ovn client
@@ -270,26 +277,53 @@ func ConstructWaitForUniqueOperation(table, column string, value any) ovsdb.Oper
 	}
 }
 
-func (c *ovsDbClient) Transact(method string, operations []ovsdb.Operation) error {
+// WithContext returns a client sharing the connection of c, whose transactions are traced
+// as children of the span in ctx
+func (c *OVNNbClient) WithContext(ctx context.Context) NbClient {
+	return &OVNNbClient{ovsDbClient: ovsDbClient{Client: c.Client, Timeout: c.Timeout, ctx: ctx}}
+}
+
+func (c *ovsDbClient) Transact(method string, operations []ovsdb.Operation) (err error) {
 	if len(operations) == 0 {
 		klog.V(6).Info("operations should not be empty")
 		return nil
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), c.Timeout)
-	defer cancel()
-
-	start := time.Now()
-	results, err := c.Client.Transact(ctx, operations...)
-	elapsed := float64(time.Since(start) / time.Millisecond)
-
 	var dbType string
 	switch c.Schema().Name {
 	case ovnnb.DatabaseName:
 		dbType = "ovn-nb"
 	case ovnsb.DatabaseName:
 		dbType = "ovn-sb"
+	case vswitch.DatabaseName:
+		dbType = "ovs"
+	}
+
+	tables := make([]string, 0, len(operations))
+	for _, op := range operations {
+		if !slices.Contains(tables, op.Table) {
+			tables = append(tables, op.Table)
+		}
+	}
+	parent := c.ctx
+	if parent == nil {
+		parent = context.Background()
 	}
+	ctx, span := tracing.Start(parent, "ovsdb transact "+method,
+		attribute.String("db.system", "ovsdb"),
+		attribute.String("db.name", dbType),
+		attribute.String("db.operation", method),
+		attribute.StringSlice("ovsdb.tables", tables),
+		attribute.Int("ovsdb.operations", len(operations)),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	results, err := c.Client.Transact(ctx, operations...)
+	elapsed := float64(time.Since(start) / time.Millisecond)
 
 	code := "0"
 	defer func() {
@@ -1,7 +1,104 @@
 package ovs
 
+import (
+	"strconv"
+	"strings"
+)
+
 const (
 	OvsdbServer   = "ovsdb-server"
 	OvsVswitchd   = "ovs-vswitchd"
 	OvnController = "ovn-controller"
 )
+
+// ParseCoverage parses the output of `coverage/show` into the total hits of each event
+func ParseCoverage(output string) map[string]float64 {
+	// hmap_expand              0.0/sec     0.000/sec        0.0150/sec   total: 54
+	result := make(map[string]float64)
+	for line := range strings.SplitSeq(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 3 || fields[len(fields)-2] != "total:" {
+			continue
+		}
+		if value, err := strconv.ParseFloat(fields[len(fields)-1], 64); err == nil {
+			result[fields[0]] = value
+		}
+	}
+	return result
+}
+
+// StopwatchStats is the statistics of a stopwatch reported by `stopwatch/show`
+type StopwatchStats struct {
+	Samples float64
+	// values in milliseconds indexed by the stat label
+	Values map[string]float64
+}
+
+var stopwatchStatLabels = map[string]string{
+	"Maximum":            "max",
+	"Minimum":            "min",
+	"95th percentile":    "p95",
+	"Short term average": "short_term_avg",
+	"Long term average":  "long_term_avg",
+}
+
+// ParseStopwatch parses the output of `stopwatch/show` into the statistics of each stopwatch
+func ParseStopwatch(output string) map[string]*StopwatchStats {
+	// Statistics for 'ovnnb_db_run'
+	//   Total samples: 16
+	//   Maximum: 46 msec
+	//   95th percentile: 0.000000 msec
+	result := make(map[string]*StopwatchStats)
+	var current *StopwatchStats
+	for line := range strings.SplitSeq(output, "\n") {
+		if name, found := strings.CutPrefix(line, "Statistics for "); found {
+			current = &StopwatchStats{Values: make(map[string]float64)}
+			result[strings.Trim(name, "'")] = current
+			continue
+		}
+		key, value, found := strings.Cut(strings.TrimSpace(line), ":")
+		if current == nil || !found {
+			continue
+		}
+		number, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(value), " msec"), 64)
+		if err != nil {
+			continue
+		}
+		if key == "Total samples" {
+			current.Samples = number
+		} else if label := stopwatchStatLabels[key]; label != "" {
+			current.Values[label] = number
+		}
+	}
+	return result
+}
+
+// ParseIncEngineStats parses the output of `inc-engine/show-stats` into the stats of each engine node
+func ParseIncEngineStats(output string) map[string]map[string]float64 {
+	// Node: northd
+	// - recompute:            3
+	// - compute:              0
+	// - cancel:               0
+	result := make(map[string]map[string]float64)
+	var current map[string]float64
+	for line := range strings.SplitSeq(output, "\n") {
+		line = strings.TrimSpace(line)
+		if node, found := strings.CutPrefix(line, "Node:"); found {
+			current = make(map[string]float64)
+			result[strings.TrimSpace(node)] = current
+			continue
+		}
+		stat, found := strings.CutPrefix(line, "-")
+		if current == nil || !found {
+			continue
+		}
+		key, value, found := strings.Cut(stat, ":")
+		if !found {
+			continue
+		}
+		if number, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+			current[strings.TrimSpace(key)] = number
+		}
+	}
+	return result
+}
@@ -0,0 +1,68 @@
+package ovs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCoverage(t *testing.T) {
+	output := `Event coverage, avg rate over last: 5 seconds, last minute, last hour,  hash=2d8a1ac9:
+hmap_expand                0.0/sec     0.000/sec        0.0150/sec   total: 54
+txn_success                0.2/sec     0.183/sec        0.1775/sec   total: 1273
+lflow_run                  0.0/sec     0.000/sec        0.0000/sec   total: 3
+92 events never hit
+`
+	require.Equal(t, map[string]float64{
+		"hmap_expand": 54,
+		"txn_success": 1273,
+		"lflow_run":   3,
+	}, ParseCoverage(output))
+	require.Empty(t, ParseCoverage(""))
+}
+
+func TestParseStopwatch(t *testing.T) {
+	output := `Statistics for 'ovnnb_db_run'
+  Total samples: 16
+  Maximum: 46 msec
+  Minimum: 0 msec
+  95th percentile: 12.500000 msec
+  Short term average: 1.250000 msec
+  Long term average: 2.375000 msec
+Statistics for 'ovnsb_db_run'
+  Total samples: 0
+  Maximum: 0 msec
+`
+	stats := ParseStopwatch(output)
+	require.Len(t, stats, 2)
+	require.Equal(t, &StopwatchStats{
+		Samples: 16,
+		Values: map[string]float64{
+			"max":            46,
+			"min":            0,
+			"p95":            12.5,
+			"short_term_avg": 1.25,
+			"long_term_avg":  2.375,
+		},
+	}, stats["ovnnb_db_run"])
+	require.Equal(t, &StopwatchStats{Values: map[string]float64{"max": 0}}, stats["ovnsb_db_run"])
+
+	// lines before the first stopwatch are ignored
+	require.Empty(t, ParseStopwatch("Maximum: 1 msec\n"))
+}
+
+func TestParseIncEngineStats(t *testing.T) {
+	output := `Node: northd
+- recompute:            3
+- compute:              12
+- cancel:               0
+Node: lflow
+- recompute:            1
+- compute:              invalid
+`
+	require.Equal(t, map[string]map[string]float64{
+		"northd": {"recompute": 3, "compute": 12, "cancel": 0},
+		"lflow":  {"recompute": 1},
+	}, ParseIncEngineStats(output))
+	require.Empty(t, ParseIncEngineStats("- recompute: 1\n"))
+}
@@ -34,6 +34,23 @@ func DumpFlows(client *ovs.Client, bridgeName string) ([]string, error) {
 	return flowStrings, nil
 }
 
+// DumpFlowsWithStats returns the flows of the bridge with the n_packets and n_bytes statistics,
+// which are not provided by the flows of go-openvswitch.
+func DumpFlowsWithStats(bridgeName string) ([]string, error) {
+	output, err := exec.Command("ovs-ofctl", "dump-flows", bridgeName).CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("ovs-ofctl dump-flows failed: %w, output: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	var flows []string
+	for line := range strings.Lines(string(output)) {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "NXST_FLOW") {
+			flows = append(flows, line)
+		}
+	}
+	return flows, nil
+}
+
 // ReplaceFlows uses ovs-ofctl replace-flows because go-openvswitch does not provide a native API.
 func ReplaceFlows(bridgeName string, flows []string) error {
 	flowData := strings.Join(flows, "\n")
@@ -204,56 +204,6 @@ func PortExists(name string) (bool, error) {
 	return len(result) != 0, nil
 }
 
-func GetQosList(podName, podNamespace, ifaceID string) ([]string, error) {
-	var qosList []string
-	var err error
-
-	if ifaceID != "" {
-		qosList, err = ovsFind("qos", "_uuid", fmt.Sprintf(`external-ids:iface-id="%s"`, ifaceID))
-		if err != nil {
-			klog.Error(err)
-			return qosList, err
-		}
-	} else {
-		qosList, err = ovsFind("qos", "_uuid", fmt.Sprintf(`external-ids:pod="%s/%s"`, podNamespace, podName))
-		if err != nil {
-			klog.Error(err)
-			return qosList, err
-		}
-	}
-
-	return qosList, nil
-}
-
-// ClearPodBandwidth remove qos related to this pod.
-func ClearPodBandwidth(podName, podNamespace, ifaceID string) error {
-	qosList, err := GetQosList(podName, podNamespace, ifaceID)
-	if err != nil {
-		klog.Error(err)
-		return err
-	}
-
-	// https://github.com/kubeovn/kube-ovn/issues/1191
-	usedQosList, err := ovsFind("port", "qos", "qos!=[]")
-	if err != nil {
-		klog.Error(err)
-		return err
-	}
-
-	for _, qosID := range qosList {
-		found := slices.Contains(usedQosList, qosID)
-		if found {
-			continue
-		}
-
-		if err := ovsDestroy("qos", qosID); err != nil {
-			klog.Error(err)
-			return err
-		}
-	}
-	return nil
-}
-
 var lastInterfacePodMap map[string]string
 
 func ListInterfacePodMap() (map[string]string, error) {
@@ -294,6 +244,49 @@ func ListInterfacePodMap() (map[string]string, error) {
 	return result, nil
 }
 
+// PodInterface is an OVS interface created by kube-ovn-cni for a pod
+type PodInterface struct {
+	Name         string
+	IfaceID      string
+	PodName      string
+	PodNamespace string
+	PodNetns     string
+}
+
+// ListPodInterfaces returns the OVS interfaces created by kube-ovn-cni, including the ones
+// whose iface-id has been removed because the pod has been attached to a new sandbox
+func ListPodInterfaces() ([]PodInterface, error) {
+	output, err := Exec("--data=bare", "--format=csv", "--no-heading", "--columns=name,external_ids", "find",
+		"interface", "external_ids:pod_netns!=[]")
+	if err != nil {
+		klog.Errorf("failed to list interface, %v", err)
+		return nil, err
+	}
+	var result []PodInterface
+	for l := range strings.SplitSeq(output, "\n") {
+		parts := strings.SplitN(strings.TrimSpace(l), ",", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		iface := PodInterface{Name: strings.TrimSpace(parts[0])}
+		for externalID := range strings.FieldsSeq(parts[1]) {
+			key, value, _ := strings.Cut(strings.TrimSpace(externalID), "=")
+			switch key {
+			case "iface-id":
+				iface.IfaceID = value
+			case "pod_name":
+				iface.PodName = value
+			case "pod_namespace":
+				iface.PodNamespace = value
+			case "pod_netns":
+				iface.PodNetns = value
+			}
+		}
+		result = append(result, iface)
+	}
+	return result, nil
+}
+
 func CleanInterface(name string) error {
 	qosList, err := ovsFind("port", "qos", "name="+name)
 	if err != nil {
@@ -320,19 +313,6 @@ func CleanInterface(name string) error {
 	return nil
 }
 
-// Find and remove any existing OVS port with this iface-id. Pods can
-// have multiple sandboxes if some are waiting for garbage collection,
-// but only the latest one should have the iface-id set.
-// See: https://github.com/ovn-org/ovn-kubernetes/pull/869
-func CleanDuplicatePort(ifaceID, portName string) {
-	uuids, _ := ovsFind("Interface", "_uuid", "external-ids:iface-id="+ifaceID, "name!="+portName)
-	for _, uuid := range uuids {
-		if out, err := Exec("remove", "Interface", uuid, "external-ids", "iface-id"); err != nil {
-			klog.Errorf("failed to clear stale OVS port %q iface-id %q: %v\n  %q", uuid, ifaceID, err, out)
-		}
-	}
-}
-
 // ValidatePortVendor returns true if the port's external_ids:vendor=kube-ovn
 func ValidatePortVendor(port string) (bool, error) {
 	output, err := ovsFind("Port", "name", "external_ids:vendor="+util.CniTypeName)
@@ -358,132 +338,3 @@ func GetInterfacePodNs(iface string) (string, error) {
 
 	return podNetNs, nil
 }
-
-// config mirror for interface by pod annotations and install param
-func ConfigInterfaceMirror(globalMirror bool, open, iface string) error {
-	if globalMirror {
-		return nil
-	}
-	// find interface name for port
-	interfaceList, err := ovsFind("interface", "name", "external-ids:iface-id="+iface)
-	if err != nil {
-		klog.Error(err)
-		return err
-	}
-	for _, ifName := range interfaceList {
-		// ifName example: xxx_h
-		// find port uuid by interface name
-		portUUIDs, err := ovsFind("port", "_uuid", "name="+ifName)
-		if err != nil {
-			klog.Error(err)
-			return err
-		}
-		if len(portUUIDs) != 1 {
-			return fmt.Errorf("find port failed, portName=%s", ifName)
-		}
-		portID := portUUIDs[0]
-		if open == "true" {
-			// add port to mirror
-			err = ovsAdd("mirror", util.MirrorDefaultName, "select_dst_port", portID)
-			if err != nil {
-				klog.Error(err)
-				return err
-			}
-		} else {
-			mirrorPorts, err := ovsFind("mirror", "select_dst_port", "name="+util.MirrorDefaultName)
-			if err != nil {
-				klog.Error(err)
-				return err
-			}
-			if len(mirrorPorts) == 0 {
-				return fmt.Errorf("find mirror failed, mirror name=%s", util.MirrorDefaultName)
-			}
-			if len(mirrorPorts) > 1 {
-				return fmt.Errorf("repeated mirror data, mirror name=%s", util.MirrorDefaultName)
-			}
-			for _, mirrorPortIDs := range mirrorPorts {
-				if strings.Contains(mirrorPortIDs, portID) {
-					// remove port from mirror
-					_, err := Exec("remove", "mirror", util.MirrorDefaultName, "select_dst_port", portID)
-					if err != nil {
-						klog.Error(err)
-						return err
-					}
-				}
-			}
-		}
-	}
-	return nil
-}
-
-// remove qos related to this port.
-func ClearPortQosBinding(ifaceID string) error {
-	interfaceList, err := ovsFind("interface", "name", fmt.Sprintf(`external-ids:iface-id="%s"`, ifaceID))
-	if err != nil {
-		klog.Error(err)
-		return err
-	}
-
-	for _, ifName := range interfaceList {
-		if err = ovsClear("port", ifName, "qos"); err != nil {
-			klog.Error(err)
-			return err
-		}
-	}
-	return nil
-}
-
-func ListExternalIDs(table string) (map[string]string, error) {
-	output, err := Exec("--data=bare", "--format=csv", "--no-heading", "--columns=_uuid,external_ids", "find", table, "external_ids:iface-id!=[]")
-	if err != nil {
-		klog.Errorf("failed to list %s, %v", table, err)
-		return nil, err
-	}
-	lines := strings.Split(output, "\n")
-	result := make(map[string]string, len(lines))
-	for _, l := range lines {
-		if len(strings.TrimSpace(l)) == 0 {
-			continue
-		}
-		parts := strings.Split(strings.TrimSpace(l), ",")
-		if len(parts) != 2 {
-			continue
-		}
-		uuid := strings.TrimSpace(parts[0])
-		for externalID := range strings.FieldsSeq(parts[1]) {
-			if !strings.Contains(externalID, "iface-id=") {
-				continue
-			}
-			iface := strings.TrimPrefix(strings.TrimSpace(externalID), "iface-id=")
-			result[iface] = uuid
-			break
-		}
-	}
-	return result, nil
-}
-
-func ListQosQueueIDs() (map[string]string, error) {
-	output, err := Exec("--data=bare", "--format=csv", "--no-heading", "--columns=_uuid,queues", "find", "qos", "queues:0!=[]")
-	if err != nil {
-		klog.Errorf("failed to list qos, %v", err)
-		return nil, err
-	}
-	lines := strings.Split(output, "\n")
-	result := make(map[string]string, len(lines))
-	for _, l := range lines {
-		if len(strings.TrimSpace(l)) == 0 {
-			continue
-		}
-		parts := strings.Split(strings.TrimSpace(l), ",")
-		if len(parts) != 2 {
-			continue
-		}
-		qosID := strings.TrimSpace(parts[0])
-		if !strings.Contains(strings.TrimSpace(parts[1]), "0=") {
-			continue
-		}
-		queueID := strings.TrimPrefix(strings.TrimSpace(parts[1]), "0=")
-		result[qosID] = queueID
-	}
-	return result, nil
-}
@@ -31,3 +31,31 @@ func (c *VswitchClient) ListBridge(needVendorFilter bool, filter func(bridge *vs
 
 	return bridgeList, nil
 }
+
+// GetBridge gets the ovs bridge by name
+func (c *VswitchClient) GetBridge(name string, ignoreNotFound bool) (*vswitch.Bridge, error) {
+	bridgeList, err := c.ListBridge(false, func(bridge *vswitch.Bridge) bool {
+		return bridge.Name == name
+	})
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+	if len(bridgeList) == 0 {
+		if ignoreNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("not found bridge %s", name)
+	}
+	return &bridgeList[0], nil
+}
+
+// IsUserspaceDataPath returns whether br-int uses the userspace datapath
+func (c *VswitchClient) IsUserspaceDataPath() (bool, error) {
+	bridge, err := c.GetBridge("br-int", true)
+	if err != nil {
+		klog.Error(err)
+		return false, err
+	}
+	return bridge != nil && bridge.DatapathType == "netdev", nil
+}
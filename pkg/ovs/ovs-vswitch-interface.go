@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ovn-kubernetes/libovsdb/model"
+	"github.com/ovn-kubernetes/libovsdb/ovsdb"
 	"k8s.io/klog/v2"
 
 	"github.com/kubeovn/kube-ovn/pkg/ovsdb/vswitch"
@@ -27,3 +29,61 @@ func (c *VswitchClient) ListInterface(filter func(sw *vswitch.Interface) bool) (
 
 	return ifaceList, nil
 }
+
+// GetInterface gets the ovs interface by name
+func (c *VswitchClient) GetInterface(name string, ignoreNotFound bool) (*vswitch.Interface, error) {
+	ifaceList, err := c.ListInterface(func(iface *vswitch.Interface) bool {
+		return iface.Name == name
+	})
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+
+	if len(ifaceList) == 0 {
+		if ignoreNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("not found interface %s", name)
+	}
+	if len(ifaceList) > 1 {
+		return nil, fmt.Errorf("more than one interface with same name %s", name)
+	}
+
+	return &ifaceList[0], nil
+}
+
+// CleanDuplicatePort removes the iface-id from the external_ids of interfaces other than portName.
+// Pods can have multiple sandboxes if some are waiting for garbage collection,
+// but only the latest one should have the iface-id set.
+// See: https://github.com/ovn-org/ovn-kubernetes/pull/869
+func (c *VswitchClient) CleanDuplicatePort(ifaceID, portName string) error {
+	ifaceList, err := c.ListInterface(func(iface *vswitch.Interface) bool {
+		return iface.Name != portName && iface.ExternalIDs[ExternalIDIfaceID] == ifaceID
+	})
+	if err != nil {
+		klog.Error(err)
+		return err
+	}
+
+	ops := make([]ovsdb.Operation, 0, len(ifaceList))
+	for _, iface := range ifaceList {
+		klog.Infof("clear iface-id %s of stale ovs interface %s", ifaceID, iface.Name)
+		op, err := c.Where(&iface).Mutate(&iface, model.Mutation{
+			Field:   &iface.ExternalIDs,
+			Value:   []string{ExternalIDIfaceID},
+			Mutator: ovsdb.MutateOperationDelete,
+		})
+		if err != nil {
+			klog.Error(err)
+			return fmt.Errorf("failed to generate operations for clearing iface-id of interface %s: %w", iface.Name, err)
+		}
+		ops = append(ops, op...)
+	}
+
+	if err = c.Transact("iface-clean-duplicate", ops); err != nil {
+		klog.Error(err)
+		return fmt.Errorf("failed to clear iface-id %s of stale interfaces: %w", ifaceID, err)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package ovs
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ovn-kubernetes/libovsdb/model"
+	"github.com/ovn-kubernetes/libovsdb/ovsdb"
+	"k8s.io/klog/v2"
+
+	ovsclient "github.com/kubeovn/kube-ovn/pkg/ovsdb/client"
+	"github.com/kubeovn/kube-ovn/pkg/ovsdb/vswitch"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+// mirror is the part of the Mirror table used by kube-ovn. The generated vswitch.Mirror model
+// can not be used as its filter column is missing in the schema of older ovs releases.
+type mirror struct {
+	UUID          string   `ovsdb:"_uuid"`
+	Name          string   `ovsdb:"name"`
+	OutputPort    *string  `ovsdb:"output_port"`
+	SelectAll     bool     `ovsdb:"select_all"`
+	SelectDstPort []string `ovsdb:"select_dst_port"`
+}
+
+// getMirror gets the ovs mirror by name
+func (c *VswitchClient) getMirror(name string, ignoreNotFound bool) (*mirror, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.Timeout)
+	defer cancel()
+
+	var mirrorList []mirror
+	if err := c.ovsDbClient.WhereCache(func(m *mirror) bool {
+		return m.Name == name
+	}).List(ctx, &mirrorList); err != nil {
+		klog.Error(err)
+		return nil, fmt.Errorf("failed to list mirror: %w", err)
+	}
+
+	if len(mirrorList) == 0 {
+		if ignoreNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("not found mirror %s", name)
+	}
+	if len(mirrorList) > 1 {
+		return nil, fmt.Errorf("more than one mirror with same name %s", name)
+	}
+	return &mirrorList[0], nil
+}
+
+// CreateMirror adds the port to the bridge, and replaces the mirrors of the bridge with the default mirror
+// which outputs to the port. The port is created as an internal interface if internal is true,
+// and the mirror selects all packets of the bridge if selectAll is true.
+func (c *VswitchClient) CreateMirror(bridgeName, portName string, internal, selectAll bool) error {
+	port, err := c.GetPort(portName, true)
+	if err != nil {
+		klog.Error(err)
+		return err
+	}
+	if port == nil {
+		iface := &vswitch.Interface{}
+		if internal {
+			iface.Type = "internal"
+		}
+		if err = c.CreatePort(bridgeName, portName, iface); err != nil {
+			klog.Error(err)
+			return err
+		}
+		if port, err = c.GetPort(portName, false); err != nil {
+			klog.Error(err)
+			return err
+		}
+	}
+
+	bridge, err := c.GetBridge(bridgeName, false)
+	if err != nil {
+		klog.Error(err)
+		return err
+	}
+	if !slices.Contains(bridge.Ports, port.UUID) {
+		return fmt.Errorf("port %s already exists but not on bridge %s", portName, bridgeName)
+	}
+
+	// the mirrors removed from the bridge are garbage collected by ovsdb-server
+	m := &mirror{UUID: ovsclient.NamedUUID(), Name: util.MirrorDefaultName, OutputPort: &port.UUID, SelectAll: selectAll}
+	ops, err := c.Create(m)
+	if err != nil {
+		klog.Error(err)
+		return fmt.Errorf("failed to generate operations for creating mirror %s: %w", m.Name, err)
+	}
+	bridge.Mirrors = []string{m.UUID}
+	op, err := c.Where(bridge).Update(bridge, &bridge.Mirrors)
+	if err != nil {
+		klog.Error(err)
+		return fmt.Errorf("failed to generate operations for setting mirrors of bridge %s: %w", bridgeName, err)
+	}
+	ops = append(ops, op...)
+
+	if err = c.Transact("mirror-add", ops); err != nil {
+		klog.Error(err)
+		return fmt.Errorf("failed to configure mirror of bridge %s with output port %s: %w", bridgeName, portName, err)
+	}
+	return nil
+}
+
+// ConfigInterfaceMirror adds the ports of the interfaces with the iface-id to the destination ports of the default mirror
+// if open is "true", or removes them from it otherwise. Nothing is done if the global mirror is enabled.
+func (c *VswitchClient) ConfigInterfaceMirror(globalMirror bool, open, ifaceID string) error {
+	if globalMirror {
+		return nil
+	}
+
+	ifaceList, err := c.ListInterface(func(iface *vswitch.Interface) bool {
+		return iface.ExternalIDs[ExternalIDIfaceID] == ifaceID
+	})
+	if err != nil {
+		klog.Error(err)
+		return err
+	}
+	if len(ifaceList) == 0 {
+		return nil
+	}
+
+	m, err := c.getMirror(util.MirrorDefaultName, false)
+	if err != nil {
+		klog.Error(err)
+		return err
+	}
+
+	portUUIDs := make([]string, 0, len(ifaceList))
+	for _, iface := range ifaceList {
+		port, err := c.GetPort(iface.Name, false)
+		if err != nil {
+			klog.Error(err)
+			return err
+		}
+		if (open == "true") != slices.Contains(m.SelectDstPort, port.UUID) {
+			portUUIDs = append(portUUIDs, port.UUID)
+		}
+	}
+	if len(portUUIDs) == 0 {
+		return nil
+	}
+
+	mutator := ovsdb.MutateOperationDelete
+	if open == "true" {
+		mutator = ovsdb.MutateOperationInsert
+	}
+	ops, err := c.Where(m).Mutate(m, model.Mutation{
+		Field:   &m.SelectDstPort,
+		Value:   portUUIDs,
+		Mutator: mutator,
+	})
+	if err != nil {
+		klog.Error(err)
+		return fmt.Errorf("failed to generate operations for updating mirror %s: %w", m.Name, err)
+	}
+	if err = c.Transact("mirror-update", ops); err != nil {
+		klog.Error(err)
+		return fmt.Errorf("failed to update mirror %s for interface %s: %w", m.Name, ifaceID, err)
+	}
+	return nil
+}
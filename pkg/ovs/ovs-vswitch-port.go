@@ -3,9 +3,14 @@ package ovs
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 
+	"github.com/ovn-kubernetes/libovsdb/model"
+	"github.com/ovn-kubernetes/libovsdb/ovsdb"
 	"k8s.io/klog/v2"
 
+	ovsclient "github.com/kubeovn/kube-ovn/pkg/ovsdb/client"
 	"github.com/kubeovn/kube-ovn/pkg/ovsdb/vswitch"
 )
 
@@ -27,3 +32,190 @@ func (c *VswitchClient) ListPort(filter func(sw *vswitch.Port) bool) ([]vswitch.
 
 	return portList, nil
 }
+
+// GetPort gets the ovs port by name
+func (c *VswitchClient) GetPort(name string, ignoreNotFound bool) (*vswitch.Port, error) {
+	portList, err := c.ListPort(func(port *vswitch.Port) bool {
+		return port.Name == name
+	})
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+
+	if len(portList) == 0 {
+		if ignoreNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("not found port %s", name)
+	}
+	if len(portList) > 1 {
+		return nil, fmt.Errorf("more than one port with same name %s", name)
+	}
+
+	return &portList[0], nil
+}
+
+// CreatePort adds a port with a single interface named portName to the bridge, like `ovs-vsctl --may-exist add-port`.
+// If the port already exists, the type, options, mtu_request and external_ids of the interface are updated,
+// and the options and external_ids are merged into the existing ones.
+func (c *VswitchClient) CreatePort(bridgeName, portName string, iface *vswitch.Interface) error {
+	bridge, err := c.GetBridge(bridgeName, false)
+	if err != nil {
+		klog.Error(err)
+		return err
+	}
+
+	port, err := c.GetPort(portName, true)
+	if err != nil {
+		klog.Error(err)
+		return err
+	}
+
+	var ops []ovsdb.Operation
+	if port != nil {
+		if !slices.Contains(bridge.Ports, port.UUID) {
+			return fmt.Errorf("port %s already exists but not on bridge %s", portName, bridgeName)
+		}
+		if ops, err = c.updateInterfaceOp(portName, iface); err != nil {
+			klog.Error(err)
+			return err
+		}
+	} else {
+		newIface := &vswitch.Interface{
+			UUID:        ovsclient.NamedUUID(),
+			Name:        portName,
+			Type:        iface.Type,
+			Options:     iface.Options,
+			ExternalIDs: iface.ExternalIDs,
+			MTURequest:  iface.MTURequest,
+		}
+		newPort := &vswitch.Port{
+			UUID:       ovsclient.NamedUUID(),
+			Name:       portName,
+			Interfaces: []string{newIface.UUID},
+		}
+		for _, m := range []model.Model{newIface, newPort} {
+			createOps, err := c.Create(m)
+			if err != nil {
+				klog.Error(err)
+				return fmt.Errorf("failed to generate operations for creating port %s: %w", portName, err)
+			}
+			ops = append(ops, createOps...)
+		}
+		mutateOps, err := c.Where(bridge).Mutate(bridge, model.Mutation{
+			Field:   &bridge.Ports,
+			Value:   []string{newPort.UUID},
+			Mutator: ovsdb.MutateOperationInsert,
+		})
+		if err != nil {
+			klog.Error(err)
+			return fmt.Errorf("failed to generate operations for adding port %s to bridge %s: %w", portName, bridgeName, err)
+		}
+		ops = append(ops, mutateOps...)
+	}
+
+	if err = c.Transact("add-port", ops); err != nil {
+		klog.Error(err)
+		return fmt.Errorf("failed to add port %s to bridge %s: %w", portName, bridgeName, err)
+	}
+	return nil
+}
+
+func (c *VswitchClient) updateInterfaceOp(name string, iface *vswitch.Interface) ([]ovsdb.Operation, error) {
+	existing, err := c.GetInterface(name, false)
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+
+	fields := []any{&existing.Type}
+	existing.Type = iface.Type
+	if len(iface.Options) != 0 {
+		if existing.Options == nil {
+			existing.Options = make(map[string]string, len(iface.Options))
+		}
+		maps.Copy(existing.Options, iface.Options)
+		fields = append(fields, &existing.Options)
+	}
+	if len(iface.ExternalIDs) != 0 {
+		if existing.ExternalIDs == nil {
+			existing.ExternalIDs = make(map[string]string, len(iface.ExternalIDs))
+		}
+		maps.Copy(existing.ExternalIDs, iface.ExternalIDs)
+		fields = append(fields, &existing.ExternalIDs)
+	}
+	if iface.MTURequest != nil {
+		existing.MTURequest = iface.MTURequest
+		fields = append(fields, &existing.MTURequest)
+	}
+
+	ops, err := c.Where(existing).Update(existing, fields...)
+	if err != nil {
+		klog.Error(err)
+		return nil, fmt.Errorf("failed to generate operations for updating interface %s: %w", name, err)
+	}
+	return ops, nil
+}
+
+// DeletePort deletes the port and its interfaces from the bridge, like `ovs-vsctl --if-exists --with-iface del-port`.
+// The qos and queues created by kube-ovn for the port are deleted as well if not used by other ports.
+func (c *VswitchClient) DeletePort(bridgeName, portName string) error {
+	port, err := c.GetPort(portName, true)
+	if err != nil {
+		klog.Error(err)
+		return err
+	}
+	if port == nil {
+		return nil
+	}
+
+	bridge, err := c.GetBridge(bridgeName, false)
+	if err != nil {
+		klog.Error(err)
+		return err
+	}
+	if !slices.Contains(bridge.Ports, port.UUID) {
+		return fmt.Errorf("port %s is not on bridge %s", portName, bridgeName)
+	}
+
+	ops, err := c.Where(bridge).Mutate(bridge, model.Mutation{
+		Field:   &bridge.Ports,
+		Value:   []string{port.UUID},
+		Mutator: ovsdb.MutateOperationDelete,
+	})
+	if err != nil {
+		klog.Error(err)
+		return fmt.Errorf("failed to generate operations for removing port %s from bridge %s: %w", portName, bridgeName, err)
+	}
+	delOps, err := c.Where(port).Delete()
+	if err != nil {
+		klog.Error(err)
+		return fmt.Errorf("failed to generate operations for deleting port %s: %w", portName, err)
+	}
+	ops = append(ops, delOps...)
+	for _, uuid := range port.Interfaces {
+		if delOps, err = c.Where(&vswitch.Interface{UUID: uuid}).Delete(); err != nil {
+			klog.Error(err)
+			return fmt.Errorf("failed to generate operations for deleting interface %s: %w", uuid, err)
+		}
+		ops = append(ops, delOps...)
+	}
+
+	if port.QOS != nil {
+		qosOps, err := c.deleteUnusedQosOp(map[string]bool{port.UUID: true}, func(qos *vswitch.QoS) bool {
+			return qos.UUID == *port.QOS && qos.ExternalIDs[ExternalIDIfaceID] != ""
+		}, nil)
+		if err != nil {
+			klog.Error(err)
+			return err
+		}
+		ops = append(ops, qosOps...)
+	}
+
+	if err = c.Transact("del-port", ops); err != nil {
+		klog.Error(err)
+		return fmt.Errorf("failed to delete port %s from bridge %s: %w", portName, bridgeName, err)
+	}
+	return nil
+}
@@ -0,0 +1,479 @@
+package ovs
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+
+	"github.com/ovn-kubernetes/libovsdb/ovsdb"
+	"k8s.io/klog/v2"
+
+	ovsclient "github.com/kubeovn/kube-ovn/pkg/ovsdb/client"
+	"github.com/kubeovn/kube-ovn/pkg/ovsdb/vswitch"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+// ListQos lists ovs qos
+func (c *VswitchClient) ListQos(filter func(qos *vswitch.QoS) bool) ([]vswitch.QoS, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.Timeout)
+	defer cancel()
+
+	var qosList []vswitch.QoS
+	if err := c.ovsDbClient.WhereCache(func(qos *vswitch.QoS) bool {
+		if filter != nil {
+			return filter(qos)
+		}
+		return true
+	}).List(ctx, &qosList); err != nil {
+		klog.Error(err)
+		return nil, fmt.Errorf("failed to list qos: %w", err)
+	}
+
+	return qosList, nil
+}
+
+// ListQueue lists ovs queues
+func (c *VswitchClient) ListQueue(filter func(queue *vswitch.Queue) bool) ([]vswitch.Queue, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.Timeout)
+	defer cancel()
+
+	var queueList []vswitch.Queue
+	if err := c.ovsDbClient.WhereCache(func(queue *vswitch.Queue) bool {
+		if filter != nil {
+			return filter(queue)
+		}
+		return true
+	}).List(ctx, &queueList); err != nil {
+		klog.Error(err)
+		return nil, fmt.Errorf("failed to list queue: %w", err)
+	}
+
+	return queueList, nil
+}
+
+// SetInterfaceBandwidth sets the ingress policing and the htb egress queue of the interfaces with the iface-id in one transaction.
+// As SetInterfaceBandwidth of ovs-vsctl, ingress/egress are from the point of view of the ovs interface,
+// rates are in Mbps and bursts are in Mbit.
+func (c *VswitchClient) SetInterfaceBandwidth(podName, podNamespace, ifaceID, ingress, egress, ingressBurst, egressBurst string) error {
+	ingressKPS, err := parseAndScaleBandwidthRate(ingress, 1000)
+	if err != nil {
+		return fmt.Errorf("invalid ingress bandwidth: %w", err)
+	}
+	egressBPS, err := parseAndScaleBandwidthRate(egress, 1000*1000)
+	if err != nil {
+		return fmt.Errorf("invalid egress bandwidth: %w", err)
+	}
+	ingressBurstKbit := computeIngressPolicingBurstKbit(ingressKPS, ingressBurst)
+	egressBurstBytes := computeHtbBurstBytes(egressBPS, egressBurst)
+
+	ifaceList, err := c.ListInterface(func(iface *vswitch.Interface) bool {
+		return iface.ExternalIDs[ExternalIDIfaceID] == ifaceID
+	})
+	if err != nil {
+		klog.Error(err)
+		return err
+	}
+	if len(ifaceList) == 0 {
+		return nil
+	}
+
+	var ops []ovsdb.Operation
+	for _, iface := range ifaceList {
+		// ingress_policing_rate and ingress_policing_burst are in Kbit
+		iface.IngressPolicingRate, iface.IngressPolicingBurst = int(ingressKPS), int(ingressBurstKbit)
+		op, err := c.Where(&iface).Update(&iface, &iface.IngressPolicingRate, &iface.IngressPolicingBurst)
+		if err != nil {
+			klog.Error(err)
+			return fmt.Errorf("failed to generate operations for setting ingress policing of interface %s: %w", iface.Name, err)
+		}
+		ops = append(ops, op...)
+	}
+
+	egressOps, err := c.setHtbQosOp(podName, podNamespace, ifaceID, ifaceList, egressBPS, egressBurstBytes)
+	if err != nil {
+		klog.Error(err)
+		return err
+	}
+	ops = append(ops, egressOps...)
+
+	if err = c.Transact("set-bandwidth", ops); err != nil {
+		klog.Error(err)
+		return fmt.Errorf("failed to set bandwidth of interface %s: %w", ifaceID, err)
+	}
+	return nil
+}
+
+// setHtbQosOp generates operations to create or update the htb qos and queue for the egress rate limit,
+// or to remove the rate limit and the qos without any other config if the egress rate is not set
+func (c *VswitchClient) setHtbQosOp(podName, podNamespace, ifaceID string, ifaceList []vswitch.Interface, rateBPS, burstBytes int64) ([]ovsdb.Operation, error) {
+	qosList, err := c.ListQos(func(qos *vswitch.QoS) bool {
+		return qos.ExternalIDs[ExternalIDIfaceID] == ifaceID
+	})
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+	var qos *vswitch.QoS
+	if len(qosList) != 0 {
+		qos = &qosList[0]
+	}
+
+	if rateBPS <= 0 {
+		if qos == nil || qos.Type != util.HtbQos {
+			return nil, nil
+		}
+		return c.clearHtbQueueRateOp(qos)
+	}
+
+	queueList, err := c.ListQueue(func(queue *vswitch.Queue) bool {
+		return queue.ExternalIDs[ExternalIDIfaceID] == ifaceID
+	})
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+
+	externalIDs := map[string]string{ExternalIDIfaceID: ifaceID}
+	if podNamespace != "" && podName != "" {
+		externalIDs["pod"] = podNamespace + "/" + podName
+	}
+	otherConfig := map[string]string{
+		"max-rate": strconv.FormatInt(rateBPS, 10),
+		// always write burst so an explicit "0" from the user is honored
+		"burst": strconv.FormatInt(burstBytes, 10),
+	}
+
+	var ops []ovsdb.Operation
+	var queueUUID string
+	if len(queueList) != 0 {
+		queue := &queueList[0]
+		queueUUID = queue.UUID
+		if queue.OtherConfig == nil {
+			queue.OtherConfig = make(map[string]string, len(otherConfig))
+		}
+		maps.Copy(queue.OtherConfig, otherConfig)
+		if ops, err = c.Where(queue).Update(queue, &queue.OtherConfig); err != nil {
+			klog.Error(err)
+			return nil, fmt.Errorf("failed to generate operations for updating queue of interface %s: %w", ifaceID, err)
+		}
+	} else {
+		queue := &vswitch.Queue{UUID: ovsclient.NamedUUID(), OtherConfig: otherConfig, ExternalIDs: externalIDs}
+		queueUUID = queue.UUID
+		if ops, err = c.Create(queue); err != nil {
+			klog.Error(err)
+			return nil, fmt.Errorf("failed to generate operations for creating queue of interface %s: %w", ifaceID, err)
+		}
+	}
+
+	if qos != nil {
+		if qos.Type == util.HtbQos && qos.Queues[0] == queueUUID {
+			return ops, nil
+		}
+		if qos.Type != util.HtbQos {
+			klog.Errorf("netem qos exists for pod %s/%s, conflict with current qos, will be changed to htb qos", podNamespace, podName)
+		}
+		qos.Type, qos.Queues = util.HtbQos, map[int]string{0: queueUUID}
+		op, err := c.Where(qos).Update(qos, &qos.Type, &qos.Queues)
+		if err != nil {
+			klog.Error(err)
+			return nil, fmt.Errorf("failed to generate operations for updating qos of interface %s: %w", ifaceID, err)
+		}
+		return append(ops, op...), nil
+	}
+
+	qos = &vswitch.QoS{
+		UUID:        ovsclient.NamedUUID(),
+		Type:        util.HtbQos,
+		Queues:      map[int]string{0: queueUUID},
+		ExternalIDs: maps.Clone(externalIDs),
+	}
+	op, err := c.Create(qos)
+	if err != nil {
+		klog.Error(err)
+		return nil, fmt.Errorf("failed to generate operations for creating qos of interface %s: %w", ifaceID, err)
+	}
+	ops = append(ops, op...)
+	for _, iface := range ifaceList {
+		port, err := c.GetPort(iface.Name, false)
+		if err != nil {
+			klog.Error(err)
+			return nil, err
+		}
+		port.QOS = &qos.UUID
+		if op, err = c.Where(port).Update(port, &port.QOS); err != nil {
+			klog.Error(err)
+			return nil, fmt.Errorf("failed to generate operations for binding qos to port %s: %w", port.Name, err)
+		}
+		ops = append(ops, op...)
+	}
+	return ops, nil
+}
+
+// clearHtbQueueRateOp removes the rate limit from the queue of the htb qos,
+// and deletes the qos and the queue if the queue has no other config
+func (c *VswitchClient) clearHtbQueueRateOp(qos *vswitch.QoS) ([]ovsdb.Operation, error) {
+	queueUUID, ok := qos.Queues[0]
+	if !ok {
+		return nil, nil
+	}
+	queueList, err := c.ListQueue(func(queue *vswitch.Queue) bool {
+		return queue.UUID == queueUUID
+	})
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+	if len(queueList) == 0 {
+		return nil, nil
+	}
+
+	queue := &queueList[0]
+	delete(queue.OtherConfig, "max-rate")
+	delete(queue.OtherConfig, "burst")
+	if len(queue.OtherConfig) != 0 {
+		ops, err := c.Where(queue).Update(queue, &queue.OtherConfig)
+		if err != nil {
+			klog.Error(err)
+			return nil, fmt.Errorf("failed to generate operations for removing rate limit of queue %s: %w", queue.UUID, err)
+		}
+		return ops, nil
+	}
+
+	// neither bandwidth nor priority exists, delete the qos and the queue
+	ops, err := c.deleteQosOp(qos)
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+	op, err := c.Where(queue).Delete()
+	if err != nil {
+		klog.Error(err)
+		return nil, fmt.Errorf("failed to generate operations for deleting queue %s: %w", queue.UUID, err)
+	}
+	return append(ops, op...), nil
+}
+
+// ClearPodBandwidth deletes the qos and queues of the pod which are not used by any port
+func (c *VswitchClient) ClearPodBandwidth(podName, podNamespace string) error {
+	key := podNamespace + "/" + podName
+	ops, err := c.deleteUnusedQosOp(nil, func(qos *vswitch.QoS) bool {
+		return qos.ExternalIDs["pod"] == key
+	}, func(queue *vswitch.Queue) bool {
+		return queue.ExternalIDs["pod"] == key
+	})
+	if err != nil {
+		klog.Error(err)
+		return err
+	}
+
+	if err = c.Transact("clear-pod-bandwidth", ops); err != nil {
+		klog.Error(err)
+		return fmt.Errorf("failed to clear qos of pod %s: %w", key, err)
+	}
+	return nil
+}
+
+// deleteUnusedQosOp generates operations to delete the qos matching qosFilter and not used by ports other than excludedPorts,
+// and to delete the queues of the deleted qos or matching queueFilter if not used by the remaining qos
+func (c *VswitchClient) deleteUnusedQosOp(excludedPorts map[string]bool, qosFilter func(qos *vswitch.QoS) bool, queueFilter func(queue *vswitch.Queue) bool) ([]ovsdb.Operation, error) {
+	portList, err := c.ListPort(func(port *vswitch.Port) bool {
+		return port.QOS != nil && !excludedPorts[port.UUID]
+	})
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+	usedQos := make(map[string]bool, len(portList))
+	for _, port := range portList {
+		usedQos[*port.QOS] = true
+	}
+
+	qosList, err := c.ListQos(nil)
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+	var ops []ovsdb.Operation
+	usedQueues := make(map[string]bool)
+	candidateQueues := make(map[string]bool)
+	for _, qos := range qosList {
+		if usedQos[qos.UUID] || !qosFilter(&qos) {
+			for _, queue := range qos.Queues {
+				usedQueues[queue] = true
+			}
+			continue
+		}
+		klog.Infof("delete qos %s", qos.UUID)
+		op, err := c.Where(&qos).Delete()
+		if err != nil {
+			klog.Error(err)
+			return nil, fmt.Errorf("failed to generate operations for deleting qos %s: %w", qos.UUID, err)
+		}
+		ops = append(ops, op...)
+		for _, queue := range qos.Queues {
+			candidateQueues[queue] = true
+		}
+	}
+
+	queueList, err := c.ListQueue(func(queue *vswitch.Queue) bool {
+		return !usedQueues[queue.UUID] && (candidateQueues[queue.UUID] || (queueFilter != nil && queueFilter(queue)))
+	})
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+	for _, queue := range queueList {
+		klog.Infof("delete queue %s", queue.UUID)
+		op, err := c.Where(&queue).Delete()
+		if err != nil {
+			klog.Error(err)
+			return nil, fmt.Errorf("failed to generate operations for deleting queue %s: %w", queue.UUID, err)
+		}
+		ops = append(ops, op...)
+	}
+	return ops, nil
+}
+
+// IsHtbQos returns whether the interfaces with the iface-id have an htb qos
+func (c *VswitchClient) IsHtbQos(ifaceID string) (bool, error) {
+	qosList, err := c.ListQos(func(qos *vswitch.QoS) bool {
+		return qos.ExternalIDs[ExternalIDIfaceID] == ifaceID
+	})
+	if err != nil {
+		klog.Error(err)
+		return false, err
+	}
+	return len(qosList) != 0 && qosList[0].Type == util.HtbQos, nil
+}
+
+// SetNetemQos sets the netem qos of the interfaces with the iface-id in one transaction,
+// or deletes the netem qos if none of the parameters is set.
+// latency and jitter are in ms, limit is in packets and loss is in percent.
+// An existing htb qos takes precedence over the netem qos and is left untouched.
+func (c *VswitchClient) SetNetemQos(podName, podNamespace, ifaceID, latency, limit, loss, jitter string) error {
+	latencyMs, _ := strconv.Atoi(latency)
+	jitterMs, _ := strconv.Atoi(jitter)
+	limitPkts, _ := strconv.Atoi(limit)
+	lossPercent, _ := strconv.ParseFloat(loss, 64)
+
+	ifaceList, err := c.ListInterface(func(iface *vswitch.Interface) bool {
+		return iface.ExternalIDs[ExternalIDIfaceID] == ifaceID
+	})
+	if err != nil {
+		klog.Error(err)
+		return err
+	}
+	if len(ifaceList) == 0 {
+		return nil
+	}
+	qosList, err := c.ListQos(func(qos *vswitch.QoS) bool {
+		return qos.ExternalIDs[ExternalIDIfaceID] == ifaceID
+	})
+	if err != nil {
+		klog.Error(err)
+		return err
+	}
+	var qos *vswitch.QoS
+	if len(qosList) != 0 {
+		qos = &qosList[0]
+	}
+
+	// the latency and jitter of the netem qos are in us
+	otherConfig := make(map[string]string, 4)
+	if latencyMs > 0 {
+		otherConfig["latency"] = strconv.Itoa(latencyMs * 1000)
+	}
+	if jitterMs > 0 {
+		otherConfig["jitter"] = strconv.Itoa(jitterMs * 1000)
+	}
+	if limitPkts > 0 {
+		otherConfig["limit"] = strconv.Itoa(limitPkts)
+	}
+	if lossPercent > 0 {
+		otherConfig["loss"] = strconv.FormatFloat(lossPercent, 'f', -1, 64)
+	}
+
+	var ops []ovsdb.Operation
+	switch {
+	case qos != nil && qos.Type != util.NetemQos:
+		if len(otherConfig) != 0 {
+			klog.Errorf("htb qos with higher priority exists for pod %s/%s, conflict with netem qos config, please delete htb qos first", podNamespace, podName)
+		}
+		return nil
+	case len(otherConfig) == 0:
+		if qos == nil {
+			return nil
+		}
+		if ops, err = c.deleteQosOp(qos); err != nil {
+			klog.Error(err)
+			return err
+		}
+	case qos != nil:
+		if maps.Equal(qos.OtherConfig, otherConfig) {
+			return nil
+		}
+		qos.OtherConfig = otherConfig
+		if ops, err = c.Where(qos).Update(qos, &qos.OtherConfig); err != nil {
+			klog.Error(err)
+			return fmt.Errorf("failed to generate operations for updating netem qos of interface %s: %w", ifaceID, err)
+		}
+	default:
+		externalIDs := map[string]string{ExternalIDIfaceID: ifaceID}
+		if podNamespace != "" && podName != "" {
+			externalIDs["pod"] = podNamespace + "/" + podName
+		}
+		qos = &vswitch.QoS{UUID: ovsclient.NamedUUID(), Type: util.NetemQos, OtherConfig: otherConfig, ExternalIDs: externalIDs}
+		if ops, err = c.Create(qos); err != nil {
+			klog.Error(err)
+			return fmt.Errorf("failed to generate operations for creating netem qos of interface %s: %w", ifaceID, err)
+		}
+		for _, iface := range ifaceList {
+			port, err := c.GetPort(iface.Name, false)
+			if err != nil {
+				klog.Error(err)
+				return err
+			}
+			port.QOS = &qos.UUID
+			op, err := c.Where(port).Update(port, &port.QOS)
+			if err != nil {
+				klog.Error(err)
+				return fmt.Errorf("failed to generate operations for binding qos to port %s: %w", port.Name, err)
+			}
+			ops = append(ops, op...)
+		}
+	}
+
+	if err = c.Transact("set-netem-qos", ops); err != nil {
+		klog.Error(err)
+		return fmt.Errorf("failed to set netem qos of interface %s: %w", ifaceID, err)
+	}
+	return nil
+}
+
+// deleteQosOp generates operations to unbind the qos from the ports and to delete it
+func (c *VswitchClient) deleteQosOp(qos *vswitch.QoS) ([]ovsdb.Operation, error) {
+	portList, err := c.ListPort(func(port *vswitch.Port) bool {
+		return port.QOS != nil && *port.QOS == qos.UUID
+	})
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+	var ops []ovsdb.Operation
+	for _, port := range portList {
+		port.QOS = nil
+		op, err := c.Where(&port).Update(&port, &port.QOS)
+		if err != nil {
+			klog.Error(err)
+			return nil, fmt.Errorf("failed to generate operations for clearing qos of port %s: %w", port.Name, err)
+		}
+		ops = append(ops, op...)
+	}
+	op, err := c.Where(qos).Delete()
+	if err != nil {
+		klog.Error(err)
+		return nil, fmt.Errorf("failed to generate operations for deleting qos %s: %w", qos.UUID, err)
+	}
+	return append(ops, op...), nil
+}
@@ -22,8 +22,11 @@ func NewVswitchClient(addr string, connTimeout, transactTimeout int) (*VswitchCl
 	dbModel, err := model.NewClientDBModel(vswitch.DatabaseName, map[string]model.Model{
 		vswitch.BridgeTable:      &vswitch.Bridge{},
 		vswitch.InterfaceTable:   &vswitch.Interface{},
+		vswitch.MirrorTable:      &mirror{},
 		vswitch.OpenvSwitchTable: &vswitch.OpenvSwitch{},
 		vswitch.PortTable:        &vswitch.Port{},
+		vswitch.QoSTable:         &vswitch.QoS{},
+		vswitch.QueueTable:       &vswitch.Queue{},
 	})
 	if err != nil {
 		klog.Error(err)
@@ -33,8 +36,11 @@ func NewVswitchClient(addr string, connTimeout, transactTimeout int) (*VswitchCl
 	monitors := []client.MonitorOption{
 		client.WithTable(&vswitch.Bridge{}),
 		client.WithTable(&vswitch.Interface{}),
+		client.WithTable(&mirror{}),
 		client.WithTable(&vswitch.OpenvSwitch{}),
 		client.WithTable(&vswitch.Port{}),
+		client.WithTable(&vswitch.QoS{}),
+		client.WithTable(&vswitch.Queue{}),
 	}
 	c, err := ovsclient.NewOvsDbClient(
 		vswitch.DatabaseName,
@@ -6,7 +6,9 @@ import (
 	"github.com/ovn-kubernetes/libovsdb/model"
 	"github.com/stretchr/testify/require"
 
+	ovsclient "github.com/kubeovn/kube-ovn/pkg/ovsdb/client"
 	"github.com/kubeovn/kube-ovn/pkg/ovsdb/vswitch"
+	"github.com/kubeovn/kube-ovn/pkg/util"
 )
 
 func TestNewVswitchClientWithLegacySchema(t *testing.T) {
@@ -17,6 +19,7 @@ func TestNewVswitchClientWithLegacySchema(t *testing.T) {
 	dbModel, err := model.NewClientDBModel(vswitch.DatabaseName, map[string]model.Model{
 		vswitch.BridgeTable:      &vswitch.Bridge{},
 		vswitch.InterfaceTable:   &vswitch.Interface{},
+		vswitch.MirrorTable:      &mirror{},
 		vswitch.OpenvSwitchTable: &vswitch.OpenvSwitch{},
 		vswitch.PortTable:        &vswitch.Port{},
 	})
@@ -30,3 +33,302 @@ func TestNewVswitchClientWithLegacySchema(t *testing.T) {
 	_, err = client.ListBridge(false, nil)
 	require.NoError(t, err)
 }
+
+func newVswitchClient(t *testing.T, name string) *VswitchClient {
+	t.Helper()
+
+	dbModel, err := model.NewClientDBModel(vswitch.DatabaseName, map[string]model.Model{
+		vswitch.BridgeTable:      &vswitch.Bridge{},
+		vswitch.InterfaceTable:   &vswitch.Interface{},
+		vswitch.MirrorTable:      &vswitch.Mirror{},
+		vswitch.OpenvSwitchTable: &vswitch.OpenvSwitch{},
+		vswitch.PortTable:        &vswitch.Port{},
+		vswitch.QoSTable:         &vswitch.QoS{},
+		vswitch.QueueTable:       &vswitch.Queue{},
+	})
+	require.NoError(t, err)
+
+	_, sock := newOVSDBServer(t, name, dbModel, vswitch.Schema())
+	client, err := NewVswitchClient("unix:"+sock, 1, 1)
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+
+	// bridges are garbage collected unless referenced by the root table
+	bridge := &vswitch.Bridge{UUID: ovsclient.NamedUUID(), Name: "br-int"}
+	ops, err := client.Create(bridge)
+	require.NoError(t, err)
+	op, err := client.Create(&vswitch.OpenvSwitch{UUID: ovsclient.NamedUUID(), Bridges: []string{bridge.UUID}})
+	require.NoError(t, err)
+	ops = append(ops, op...)
+	require.NoError(t, client.Transact("add-br", ops))
+	return client
+}
+
+func TestVswitchClientPort(t *testing.T) {
+	client := newVswitchClient(t, "vswitch-port")
+
+	err := client.CreatePort("br-missing", "p1_h", &vswitch.Interface{})
+	require.ErrorContains(t, err, "not found bridge br-missing")
+
+	require.NoError(t, client.CreatePort("br-int", "p1_h", &vswitch.Interface{
+		ExternalIDs: map[string]string{ExternalIDIfaceID: "pod1.ns1", ExternalIDVendor: util.CniTypeName},
+	}))
+	port, err := client.GetPort("p1_h", false)
+	require.NoError(t, err)
+	require.Len(t, port.Interfaces, 1)
+	bridge, err := client.GetBridge("br-int", false)
+	require.NoError(t, err)
+	require.Equal(t, []string{port.UUID}, bridge.Ports)
+
+	// may exist: the external ids are merged into the existing interface
+	require.NoError(t, client.CreatePort("br-int", "p1_h", &vswitch.Interface{
+		ExternalIDs: map[string]string{"ip": "10.16.0.2"},
+	}))
+	iface, err := client.GetInterface("p1_h", false)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{ExternalIDIfaceID: "pod1.ns1", ExternalIDVendor: util.CniTypeName, "ip": "10.16.0.2"}, iface.ExternalIDs)
+	ports, err := client.ListPort(nil)
+	require.NoError(t, err)
+	require.Len(t, ports, 1)
+
+	// the iface-id of the stale sandbox port is cleared
+	require.NoError(t, client.CreatePort("br-int", "p2_h", &vswitch.Interface{
+		ExternalIDs: map[string]string{ExternalIDIfaceID: "pod1.ns1"},
+	}))
+	require.NoError(t, client.CleanDuplicatePort("pod1.ns1", "p2_h"))
+	iface, err = client.GetInterface("p1_h", false)
+	require.NoError(t, err)
+	require.NotContains(t, iface.ExternalIDs, ExternalIDIfaceID)
+	iface, err = client.GetInterface("p2_h", false)
+	require.NoError(t, err)
+	require.Equal(t, "pod1.ns1", iface.ExternalIDs[ExternalIDIfaceID])
+
+	require.NoError(t, client.DeletePort("br-int", "p1_h"))
+	port, err = client.GetPort("p1_h", true)
+	require.NoError(t, err)
+	require.Nil(t, port)
+	iface, err = client.GetInterface("p1_h", true)
+	require.NoError(t, err)
+	require.Nil(t, iface)
+	bridge, err = client.GetBridge("br-int", false)
+	require.NoError(t, err)
+	require.Len(t, bridge.Ports, 1)
+
+	// if exists
+	require.NoError(t, client.DeletePort("br-int", "p1_h"))
+}
+
+func TestVswitchClientBandwidth(t *testing.T) {
+	client := newVswitchClient(t, "vswitch-bandwidth")
+	require.NoError(t, client.CreatePort("br-int", "p1_h", &vswitch.Interface{
+		ExternalIDs: map[string]string{ExternalIDIfaceID: "pod1.ns1"},
+	}))
+
+	err := client.SetInterfaceBandwidth("pod1", "ns1", "pod1.ns1", "-1", "", "", "")
+	require.ErrorContains(t, err, "invalid ingress bandwidth")
+
+	require.NoError(t, client.SetInterfaceBandwidth("pod1", "ns1", "pod1.ns1", "10", "20", "", "1"))
+	iface, err := client.GetInterface("p1_h", false)
+	require.NoError(t, err)
+	require.Equal(t, 10000, iface.IngressPolicingRate)
+	require.Equal(t, 8000, iface.IngressPolicingBurst)
+
+	qosList, err := client.ListQos(nil)
+	require.NoError(t, err)
+	require.Len(t, qosList, 1)
+	qos := qosList[0]
+	require.Equal(t, util.HtbQos, qos.Type)
+	require.Equal(t, map[string]string{ExternalIDIfaceID: "pod1.ns1", "pod": "ns1/pod1"}, qos.ExternalIDs)
+	port, err := client.GetPort("p1_h", false)
+	require.NoError(t, err)
+	require.NotNil(t, port.QOS)
+	require.Equal(t, qos.UUID, *port.QOS)
+	queueList, err := client.ListQueue(nil)
+	require.NoError(t, err)
+	require.Len(t, queueList, 1)
+	require.Equal(t, qos.Queues[0], queueList[0].UUID)
+	require.Equal(t, map[string]string{"max-rate": "20000000", "burst": "125000"}, queueList[0].OtherConfig)
+
+	// updating the rate reuses the qos and the queue
+	require.NoError(t, client.SetInterfaceBandwidth("pod1", "ns1", "pod1.ns1", "10", "30", "", "1"))
+	queueList, err = client.ListQueue(nil)
+	require.NoError(t, err)
+	require.Len(t, queueList, 1)
+	require.Equal(t, "30000000", queueList[0].OtherConfig["max-rate"])
+
+	// removing the egress rate deletes the qos and the queue without other config
+	require.NoError(t, client.SetInterfaceBandwidth("pod1", "ns1", "pod1.ns1", "", "", "", ""))
+	iface, err = client.GetInterface("p1_h", false)
+	require.NoError(t, err)
+	require.Zero(t, iface.IngressPolicingRate)
+	require.Zero(t, iface.IngressPolicingBurst)
+	qosList, err = client.ListQos(nil)
+	require.NoError(t, err)
+	require.Empty(t, qosList)
+	queueList, err = client.ListQueue(nil)
+	require.NoError(t, err)
+	require.Empty(t, queueList)
+	port, err = client.GetPort("p1_h", false)
+	require.NoError(t, err)
+	require.Nil(t, port.QOS)
+
+	// the qos of the port is deleted together with the port
+	require.NoError(t, client.SetInterfaceBandwidth("pod1", "ns1", "pod1.ns1", "", "20", "", ""))
+	require.NoError(t, client.DeletePort("br-int", "p1_h"))
+	qosList, err = client.ListQos(nil)
+	require.NoError(t, err)
+	require.Empty(t, qosList)
+	queueList, err = client.ListQueue(nil)
+	require.NoError(t, err)
+	require.Empty(t, queueList)
+}
+
+func TestVswitchClientClearPodBandwidth(t *testing.T) {
+	client := newVswitchClient(t, "vswitch-clear-bandwidth")
+	require.NoError(t, client.CreatePort("br-int", "p1_h", &vswitch.Interface{
+		ExternalIDs: map[string]string{ExternalIDIfaceID: "pod1.ns1"},
+	}))
+	require.NoError(t, client.SetInterfaceBandwidth("pod1", "ns1", "pod1.ns1", "", "20", "", ""))
+
+	// the qos still bound to a port is kept
+	require.NoError(t, client.ClearPodBandwidth("pod1", "ns1"))
+	qosList, err := client.ListQos(nil)
+	require.NoError(t, err)
+	require.Len(t, qosList, 1)
+
+	// stale qos and queues of the pod are deleted
+	ops, err := client.Create(&vswitch.Queue{UUID: ovsclient.NamedUUID(), ExternalIDs: map[string]string{"pod": "ns1/pod1"}})
+	require.NoError(t, err)
+	require.NoError(t, client.Transact("queue-add", ops))
+	port, err := client.GetPort("p1_h", false)
+	require.NoError(t, err)
+	port.QOS = nil
+	ops, err = client.Where(port).Update(port, &port.QOS)
+	require.NoError(t, err)
+	require.NoError(t, client.Transact("port-update", ops))
+
+	require.NoError(t, client.ClearPodBandwidth("pod1", "ns1"))
+	qosList, err = client.ListQos(nil)
+	require.NoError(t, err)
+	require.Empty(t, qosList)
+	queueList, err := client.ListQueue(nil)
+	require.NoError(t, err)
+	require.Empty(t, queueList)
+}
+
+func TestVswitchClientNetemQos(t *testing.T) {
+	client := newVswitchClient(t, "vswitch-netem")
+	require.NoError(t, client.CreatePort("br-int", "p1_h", &vswitch.Interface{
+		ExternalIDs: map[string]string{ExternalIDIfaceID: "pod1.ns1"},
+	}))
+
+	require.NoError(t, client.SetNetemQos("pod1", "ns1", "pod1.ns1", "10", "100", "0.5", "2"))
+	qosList, err := client.ListQos(nil)
+	require.NoError(t, err)
+	require.Len(t, qosList, 1)
+	qos := qosList[0]
+	require.Equal(t, util.NetemQos, qos.Type)
+	require.Equal(t, map[string]string{"latency": "10000", "jitter": "2000", "limit": "100", "loss": "0.5"}, qos.OtherConfig)
+	port, err := client.GetPort("p1_h", false)
+	require.NoError(t, err)
+	require.NotNil(t, port.QOS)
+	require.Equal(t, qos.UUID, *port.QOS)
+	isHtbQos, err := client.IsHtbQos("pod1.ns1")
+	require.NoError(t, err)
+	require.False(t, isHtbQos)
+
+	// updating the config reuses the qos
+	require.NoError(t, client.SetNetemQos("pod1", "ns1", "pod1.ns1", "20", "", "", ""))
+	qosList, err = client.ListQos(nil)
+	require.NoError(t, err)
+	require.Len(t, qosList, 1)
+	require.Equal(t, qos.UUID, qosList[0].UUID)
+	require.Equal(t, map[string]string{"latency": "20000"}, qosList[0].OtherConfig)
+
+	// the htb qos takes precedence over the netem qos
+	require.NoError(t, client.SetInterfaceBandwidth("pod1", "ns1", "pod1.ns1", "", "20", "", ""))
+	isHtbQos, err = client.IsHtbQos("pod1.ns1")
+	require.NoError(t, err)
+	require.True(t, isHtbQos)
+	require.NoError(t, client.SetNetemQos("pod1", "ns1", "pod1.ns1", "30", "", "", ""))
+	qosList, err = client.ListQos(nil)
+	require.NoError(t, err)
+	require.Len(t, qosList, 1)
+	require.Equal(t, util.HtbQos, qosList[0].Type)
+
+	// clearing the config deletes the netem qos
+	require.NoError(t, client.SetInterfaceBandwidth("pod1", "ns1", "pod1.ns1", "", "", "", ""))
+	require.NoError(t, client.SetNetemQos("pod1", "ns1", "pod1.ns1", "30", "", "", ""))
+	require.NoError(t, client.SetNetemQos("pod1", "ns1", "pod1.ns1", "", "", "", ""))
+	qosList, err = client.ListQos(nil)
+	require.NoError(t, err)
+	require.Empty(t, qosList)
+	port, err = client.GetPort("p1_h", false)
+	require.NoError(t, err)
+	require.Nil(t, port.QOS)
+}
+
+func TestVswitchClientMirror(t *testing.T) {
+	client := newVswitchClient(t, "vswitch-mirror")
+	require.NoError(t, client.CreatePort("br-int", "p1_h", &vswitch.Interface{
+		ExternalIDs: map[string]string{ExternalIDIfaceID: "pod1.ns1"},
+	}))
+
+	err := client.ConfigInterfaceMirror(false, "true", "pod1.ns1")
+	require.ErrorContains(t, err, "not found mirror "+util.MirrorDefaultName)
+	// nothing is done for the global mirror
+	require.NoError(t, client.ConfigInterfaceMirror(true, "true", "pod1.ns1"))
+
+	require.NoError(t, client.CreateMirror("br-int", "mirror0", true, false))
+	iface, err := client.GetInterface("mirror0", false)
+	require.NoError(t, err)
+	require.Equal(t, "internal", iface.Type)
+	outputPort, err := client.GetPort("mirror0", false)
+	require.NoError(t, err)
+	m, err := client.getMirror(util.MirrorDefaultName, false)
+	require.NoError(t, err)
+	require.False(t, m.SelectAll)
+	require.Equal(t, &outputPort.UUID, m.OutputPort)
+	bridge, err := client.GetBridge("br-int", false)
+	require.NoError(t, err)
+	require.Equal(t, []string{m.UUID}, bridge.Mirrors)
+
+	port, err := client.GetPort("p1_h", false)
+	require.NoError(t, err)
+	require.NoError(t, client.ConfigInterfaceMirror(false, "true", "pod1.ns1"))
+	require.NoError(t, client.ConfigInterfaceMirror(false, "true", "pod1.ns1"))
+	m, err = client.getMirror(util.MirrorDefaultName, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{port.UUID}, m.SelectDstPort)
+	require.NoError(t, client.ConfigInterfaceMirror(false, "false", "pod1.ns1"))
+	m, err = client.getMirror(util.MirrorDefaultName, false)
+	require.NoError(t, err)
+	require.Empty(t, m.SelectDstPort)
+
+	// the mirror is replaced on restart and the existing output port is reused
+	require.NoError(t, client.CreateMirror("br-int", "mirror0", false, true))
+	m, err = client.getMirror(util.MirrorDefaultName, false)
+	require.NoError(t, err)
+	require.True(t, m.SelectAll)
+	require.Equal(t, &outputPort.UUID, m.OutputPort)
+	bridge, err = client.GetBridge("br-int", false)
+	require.NoError(t, err)
+	require.Equal(t, []string{m.UUID}, bridge.Mirrors)
+}
+
+func TestVswitchClientIsUserspaceDataPath(t *testing.T) {
+	client := newVswitchClient(t, "vswitch-datapath")
+	isUserspaceDP, err := client.IsUserspaceDataPath()
+	require.NoError(t, err)
+	require.False(t, isUserspaceDP)
+
+	bridge, err := client.GetBridge("br-int", false)
+	require.NoError(t, err)
+	bridge.DatapathType = "netdev"
+	ops, err := client.Where(bridge).Update(bridge, &bridge.DatapathType)
+	require.NoError(t, err)
+	require.NoError(t, client.Transact("br-set", ops))
+	isUserspaceDP, err = client.IsUserspaceDataPath()
+	require.NoError(t, err)
+	require.True(t, isUserspaceDP)
+}
@@ -57,6 +57,17 @@ func GetSgV6AssociatedName(sgName string) string {
 	return strings.ReplaceAll(fmt.Sprintf("ovn.sg.%s.associated.v6", sgName), "-", ".")
 }
 
+// GetSgFQDNAddressSetName returns the name of the address set holding the resolved
+// addresses of a domain name referenced by the rules of a security group
+func GetSgFQDNAddressSetName(sgName, domainName, ipVersion string) string {
+	af := "v4"
+	if ipVersion == "ipv6" {
+		af = "v6"
+	}
+	hash := util.Sha256Hash([]byte(domainName))[:8]
+	return strings.ReplaceAll(fmt.Sprintf("ovn.sg.%s.fqdn.%s.%s", sgName, hash, af), "-", ".")
+}
+
 // parseIpv6RaConfigs parses the ipv6 ra config,
 // return default Ipv6RaConfigs when raw="",
 // the raw config's format is: address_mode=dhcpv6_stateful,max_interval=30,min_interval=5,send_periodic=true
@@ -55,7 +55,8 @@ func NamedUUID() string {
 	return fmt.Sprintf("u%010d", atomic.AddUint32(&namedUUIDCounter, 1))
 }
 
-// NewOvsDbClient creates a new ovsdb client
+// NewOvsDbClient creates a new ovsdb client which only connects to the leader of a clustered database.
+// The default options can be overridden by extraOptions, e.g. client.WithLeaderOnly(false).
 func NewOvsDbClient(
 	db string,
 	addr string,
@@ -63,6 +64,7 @@ func NewOvsDbClient(
 	monitors []client.MonitorOption,
 	ovsDbConTimeout int,
 	ovsDbInactivityTimeout int,
+	extraOptions ...client.Option,
 ) (client.Client, error) {
 	klog.Infof("creating ovsdb client for %s database at %s", db, addr)
 
@@ -107,6 +109,7 @@ func NewOvsDbClient(
 	} else {
 		options = append(options, client.WithReconnect(connectTimeout, backOff))
 	}
+	options = append(options, extraOptions...)
 	c, err := client.NewOVSDBClient(dbModel, options...)
 	if err != nil {
 		klog.Error(err)
@@ -0,0 +1,129 @@
+package pinger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	goping "github.com/prometheus-community/pro-bing"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+var logicalSwitchAnnotationSuffix = strings.TrimPrefix(util.LogicalSwitchAnnotationTemplate, "%s")
+
+// podAttachmentIPs returns the IPs allocated to the pod in the Kube-OVN subnets attached via Multus, keyed by subnet
+func podAttachmentIPs(pod *v1.Pod) map[string][]string {
+	attachments := make(map[string][]string)
+	for key, subnet := range pod.Annotations {
+		provider, found := strings.CutSuffix(key, logicalSwitchAnnotationSuffix)
+		if !found || provider == util.OvnProvider || subnet == "" {
+			continue
+		}
+		if ips := pod.Annotations[fmt.Sprintf(util.IPAddressAnnotationTemplate, provider)]; ips != "" {
+			attachments[subnet] = append(attachments[subnet], strings.Split(ips, ",")...)
+		}
+	}
+	return attachments
+}
+
+// pingAttachments pings the peer pinger pods over each subnet in --attachment-subnets, using the IP of this pod
+// in the same subnet as source, so that custom VPC subnets and secondary networks are checked as well
+func pingAttachments(config *Configuration, setMetrics bool, result *connectivityResult) error {
+	klog.Infof("start to check pod connectivity over attached subnets")
+	pods, err := config.KubeClient.CoreV1().Pods(config.PodNamespace).List(context.Background(), metav1.ListOptions{LabelSelector: config.LabelSelector})
+	if err != nil {
+		klog.Errorf("failed to list peer pods: %v", err)
+		return err
+	}
+
+	var localIPs map[string][]string
+	for i := range pods.Items {
+		if pods.Items[i].Name == config.PodName {
+			localIPs = podAttachmentIPs(&pods.Items[i])
+			break
+		}
+	}
+
+	var pingErr error
+	for _, subnetName := range config.AttachmentSubnets {
+		if len(localIPs[subnetName]) == 0 {
+			klog.Warningf("subnet %s is not attached to pod %s/%s", subnetName, config.PodNamespace, config.PodName)
+			continue
+		}
+		subnet, err := config.KubeOvnClient.KubeovnV1().Subnets().Get(context.Background(), subnetName, metav1.GetOptions{})
+		if err != nil {
+			klog.Errorf("failed to get subnet %s: %v", subnetName, err)
+			pingErr = err
+			continue
+		}
+		vpc := subnet.Spec.Vpc
+
+		for i := range pods.Items {
+			pod := &pods.Items[i]
+			if pod.Name == config.PodName {
+				continue
+			}
+			for _, podIP := range podAttachmentIPs(pod)[subnetName] {
+				var sourceIP string
+				for _, ip := range localIPs[subnetName] {
+					if util.CheckProtocol(ip) == util.CheckProtocol(podIP) {
+						sourceIP = ip
+						break
+					}
+				}
+				if sourceIP == "" {
+					continue
+				}
+
+				peer := kubeovnv1.ConnectivityPeer{
+					Type:     kubeovnv1.ConnectivityPeerTypePod,
+					NodeName: pod.Spec.NodeName,
+					IP:       podIP,
+					Vpc:      vpc,
+					Subnet:   subnetName,
+				}
+				pinger, err := goping.NewPinger(podIP)
+				if err != nil {
+					klog.Errorf("failed to init pinger, %v", err)
+					result.addPeer(peer, 0, 0, 0, err)
+					pingErr = err
+					continue
+				}
+				pinger.SetPrivileged(true)
+				pinger.Source = sourceIP
+				pinger.Timeout = 1 * time.Second
+				pinger.Debug = true
+				pinger.Count = 3
+				pinger.Interval = 100 * time.Millisecond
+				if err = pinger.Run(); err != nil {
+					klog.Errorf("failed to run pinger for destination %s in subnet %s: %v", podIP, subnetName, err)
+					result.addPeer(peer, 0, 0, 0, err)
+					pingErr = err
+					continue
+				}
+
+				stats := pinger.Statistics()
+				result.addPeer(peer, stats.PacketsSent, stats.PacketsRecv, stats.AvgRtt, nil)
+				lost := int(math.Abs(float64(stats.PacketsSent - stats.PacketsRecv)))
+				klog.Infof("ping pod: %s %s in vpc %s subnet %s, count: %d, loss count %d, average rtt %.2fms",
+					pod.Name, podIP, vpc, subnetName, pinger.Count, lost, float64(stats.AvgRtt)/float64(time.Millisecond))
+				if lost != 0 {
+					pingErr = errors.New("ping failed")
+				}
+				if setMetrics {
+					SetSubnetPingMetrics(vpc, subnetName, config.NodeName, pod.Spec.NodeName,
+						float64(stats.AvgRtt)/float64(time.Millisecond), lost, stats.PacketsSent)
+				}
+			}
+		}
+	}
+	return pingErr
+}
@@ -0,0 +1,29 @@
+package pinger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodAttachmentIPs(t *testing.T) {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		// the default network is not an attachment
+		"ovn.kubernetes.io/logical_switch": "ovn-default",
+		"ovn.kubernetes.io/ip_address":     "10.16.0.2",
+		// custom VPC subnet attached via Multus, dual stack
+		"vpc1-attach.kube-system.ovn.kubernetes.io/logical_switch": "vpc1-subnet",
+		"vpc1-attach.kube-system.ovn.kubernetes.io/ip_address":     "192.168.0.2,fd00::2",
+		// attachment without an allocated IP yet
+		"pending.kube-system.ovn.kubernetes.io/logical_switch": "pending-subnet",
+		// attachment with an empty subnet
+		"empty.kube-system.ovn.kubernetes.io/logical_switch": "",
+		"empty.kube-system.ovn.kubernetes.io/ip_address":     "172.16.0.2",
+		"k8s.v1.cni.cncf.io/networks":                        "kube-system/vpc1-attach",
+	}}}
+
+	require.Equal(t, map[string][]string{"vpc1-subnet": {"192.168.0.2", "fd00::2"}}, podAttachmentIPs(pod))
+	require.Empty(t, podAttachmentIPs(&v1.Pod{}))
+}
@@ -16,12 +16,14 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog/v2"
 
+	clientset "github.com/kubeovn/kube-ovn/pkg/client/clientset/versioned"
 	"github.com/kubeovn/kube-ovn/pkg/util"
 )
 
 type Configuration struct {
 	KubeConfigFile  string
 	KubeClient      kubernetes.Interface
+	KubeOvnClient   clientset.Interface
 	Port            int32
 	Interval        int
 	Mode            string
@@ -33,12 +35,15 @@ type Configuration struct {
 	PodName         string
 	PodNamespace    string
 	PodIP           string
+	PodSubnet       string
 	PodProtocols    []string
 	LabelSelector   string
 	ExternalAddress string
 	NetworkMode     string
 	EnableMetrics   bool
 
+	EnableConnectivityReport bool
+
 	// Used for OVS Monitor
 	PollTimeout                     int
 	PollInterval                    int
@@ -58,6 +63,13 @@ type Configuration struct {
 	UDPConnCheckPort                int32
 	TargetIPPorts                   string
 	LogPerm                         string
+
+	// Used for mesh check
+	EnableMeshCheck   bool
+	MeshCheckServices []string
+
+	// Used for custom VPC and secondary network check
+	AttachmentSubnets []string
 }
 
 func ParseFlags() (*Configuration, error) {
@@ -67,6 +79,9 @@ func ParseFlags() (*Configuration, error) {
 		argEnableVerboseConnCheck   = pflag.Bool("enable-verbose-conn-check", false, "enable TCP/UDP connectivity check")
 		argTCPConnectivityCheckPort = pflag.Int32("tcp-conn-check-port", 8100, "TCP connectivity Check Port")
 		argUDPConnectivityCheckPort = pflag.Int32("udp-conn-check-port", 8101, "UDP connectivity Check Port")
+		argEnableMeshCheck          = pflag.Bool("enable-mesh-check", false, "enable TCP/UDP latency probes to every peer pinger pod and to the services in --mesh-check-services")
+		argAttachmentSubnets        = pflag.StringSlice("attachment-subnets", nil, "Kube-OVN subnets attached to the pinger pods via Multus to be checked, eg: 'vpc1-subnet1,vpc2-subnet1'; empty disables the check")
+		argMeshCheckServices        = pflag.StringSlice("mesh-check-services", nil, "services probed via ClusterIP and NodePort in mesh mode, eg: 'kube-system/kube-ovn-pinger'; ports named http or with appProtocol http are probed by HTTP GET, UDP ports are only probed when they target --udp-conn-check-port")
 
 		argKubeConfigFile  = pflag.String("kubeconfig", "", "Path to kubeconfig file with authorization and master location information. If not set use the inCluster token.")
 		argInterval        = pflag.Int("interval", 5, "interval seconds between consecutive pings")
@@ -79,6 +94,8 @@ func ParseFlags() (*Configuration, error) {
 		argNetworkMode     = pflag.String("network-mode", "kube-ovn", "The CNI plugin currently used by the cluster")
 		argEnableMetrics   = pflag.Bool("enable-metrics", true, "Whether to support metrics query")
 
+		argEnableConnectivityReport = pflag.Bool("enable-connectivity-report", false, "Whether to publish check results into the ConnectivityReport named after the node")
+
 		argPollTimeout                     = pflag.Int("ovs.timeout", 2, "Timeout on JSON-RPC requests to OVS.")
 		argPollInterval                    = pflag.Int("ovs.poll-interval", 15, "The minimum interval (in seconds) between collections from OVS server.")
 		argSystemRunDir                    = pflag.String("system.run.dir", "/var/run/openvswitch", "OVS default run directory.")
@@ -131,10 +148,15 @@ func ParseFlags() (*Configuration, error) {
 		NetworkMode:     *argNetworkMode,
 		EnableMetrics:   *argEnableMetrics,
 
+		EnableConnectivityReport: *argEnableConnectivityReport,
+
 		EnableVerboseConnCheck: *argEnableVerboseConnCheck,
 		TCPConnCheckPort:       *argTCPConnectivityCheckPort,
 		UDPConnCheckPort:       *argUDPConnectivityCheckPort,
 		TargetIPPorts:          *argTargetIPPorts,
+		EnableMeshCheck:        *argEnableMeshCheck,
+		MeshCheckServices:      *argMeshCheckServices,
+		AttachmentSubnets:      *argAttachmentSubnets,
 
 		// OVS Monitor
 		PollTimeout:                     *argPollTimeout,
@@ -179,6 +201,7 @@ func ParseFlags() (*Configuration, error) {
 			config.LabelSelector = labels.Set(ds.Spec.Selector.MatchLabels).String()
 		}
 
+		config.PodSubnet = pod.Annotations[util.LogicalSwitchAnnotation]
 		if len(pod.Status.PodIPs) != 0 {
 			config.PodProtocols = make([]string, len(pod.Status.PodIPs))
 			for i, podIP := range pod.Status.PodIPs {
@@ -222,6 +245,14 @@ func (config *Configuration) initKubeClient() error {
 	cfg.Timeout = 15 * time.Second
 	cfg.QPS = 1000
 	cfg.Burst = 2000
+
+	kubeOvnClient, err := clientset.NewForConfig(cfg)
+	if err != nil {
+		klog.Errorf("init kubeovn client failed %v", err)
+		return err
+	}
+	config.KubeOvnClient = kubeOvnClient
+
 	cfg.ContentType = util.ContentTypeProtobuf
 	cfg.AcceptContentTypes = util.AcceptContentTypes
 	kubeClient, err := kubernetes.NewForConfig(cfg)
@@ -112,6 +112,8 @@ func (e *Exporter) ovsMetricsUpdate() {
 
 	e.exportOvsDpGauge()
 	e.exportOvsInterfaceGauge()
+
+	e.exportOvnControllerPerformanceGauge()
 }
 
 func (e *Exporter) exportOvsStatusGauge() {
@@ -198,3 +200,8 @@ func (e *Exporter) exportOvsInterfaceGauge() {
 		e.setOvsInterfaceMetric(intf)
 	}
 }
+
+func (e *Exporter) exportOvnControllerPerformanceGauge() {
+	resetOvnControllerPerformanceMetrics()
+	e.setOvnControllerPerformanceMetric()
+}
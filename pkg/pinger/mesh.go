@@ -0,0 +1,264 @@
+package pinger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+const (
+	meshProbeTimeout = 3 * time.Second
+	// maximum probes in flight, so that a round does not take the sum of all probe timeouts
+	meshProbeConcurrency = 16
+
+	probeProtocolTCP  = "tcp"
+	probeProtocolUDP  = "udp"
+	probeProtocolHTTP = "http"
+
+	probeTargetClusterIP = "cluster_ip"
+	probeTargetNodePort  = "node_port"
+)
+
+// probeTCP returns the time taken to complete a TCP handshake with addr
+func probeTCP(addr string) (time.Duration, error) {
+	t1 := time.Now()
+	conn, err := net.DialTimeout("tcp", addr, meshProbeTimeout)
+	if err != nil {
+		return 0, err
+	}
+	elapsed := time.Since(t1)
+	_ = conn.Close()
+	return elapsed, nil
+}
+
+// probeUDP returns the round trip time of a datagram sent to the UDP echo listener at addr
+func probeUDP(addr string) (time.Duration, error) {
+	t1 := time.Now()
+	if err := util.UDPConnectivityCheck(addr); err != nil {
+		return 0, err
+	}
+	return time.Since(t1), nil
+}
+
+// probeHTTP returns the time taken to receive the response headers of a GET request to addr.
+// Any HTTP response is considered successful since only the data path is checked.
+func probeHTTP(addr string) (time.Duration, error) {
+	client := &http.Client{
+		Timeout: meshProbeTimeout,
+		// use a new connection for every probe so that the handshake goes through the load balancer
+		Transport: &http.Transport{DisableKeepAlives: true},
+	}
+	t1 := time.Now()
+	resp, err := client.Get("http://" + addr + "/")
+	if err != nil {
+		return 0, err
+	}
+	elapsed := time.Since(t1)
+	_ = resp.Body.Close()
+	return elapsed, nil
+}
+
+func probe(protocol, addr string) (time.Duration, error) {
+	switch protocol {
+	case probeProtocolTCP:
+		return probeTCP(addr)
+	case probeProtocolUDP:
+		return probeUDP(addr)
+	case probeProtocolHTTP:
+		return probeHTTP(addr)
+	default:
+		return 0, fmt.Errorf("unsupported probe protocol %s", protocol)
+	}
+}
+
+// servicePortProbeProtocol returns the probe protocol used for a service port, or an empty string if
+// the port can not be probed. UDP has no handshake, so UDP ports are only probed when they target the
+// UDP connectivity check port of the pinger pods, which echo the probe datagrams.
+func servicePortProbeProtocol(port v1.ServicePort, udpEchoPort int32) string {
+	switch port.Protocol {
+	case v1.ProtocolUDP:
+		targetPort := port.TargetPort.IntVal
+		if port.TargetPort.Type == intstr.String {
+			return ""
+		}
+		if targetPort == 0 {
+			targetPort = port.Port
+		}
+		if targetPort != udpEchoPort {
+			return ""
+		}
+		return probeProtocolUDP
+	case v1.ProtocolTCP, "":
+		if (port.AppProtocol != nil && *port.AppProtocol == probeProtocolHTTP) ||
+			port.Name == probeProtocolHTTP || strings.HasPrefix(port.Name, probeProtocolHTTP+"-") {
+			return probeProtocolHTTP
+		}
+		return probeProtocolTCP
+	default:
+		return ""
+	}
+}
+
+// runProbes runs the probes with bounded concurrency and returns the error of a failed probe, if any
+func runProbes(probes []func() error) error {
+	var (
+		wg       sync.WaitGroup
+		mutex    sync.Mutex
+		probeErr error
+	)
+	semaphore := make(chan struct{}, meshProbeConcurrency)
+	for _, probe := range probes {
+		semaphore <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-semaphore }()
+			if err := probe(); err != nil {
+				mutex.Lock()
+				probeErr = err
+				mutex.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+	return probeErr
+}
+
+func checkMesh(config *Configuration, setMetrics bool) error {
+	errHappens := probePods(config, setMetrics) != nil
+	if len(config.MeshCheckServices) != 0 {
+		if probeServices(config, setMetrics) != nil {
+			errHappens = true
+		}
+	}
+	if errHappens {
+		return errors.New("mesh check failed")
+	}
+	return nil
+}
+
+// probePods measures TCP handshake and UDP round trip latency to the connectivity check ports of every peer pinger pod
+func probePods(config *Configuration, setMetrics bool) error {
+	klog.Infof("start to probe pod TCP/UDP connectivity")
+	pods, err := config.KubeClient.CoreV1().Pods(config.PodNamespace).List(context.Background(), metav1.ListOptions{LabelSelector: config.LabelSelector})
+	if err != nil {
+		klog.Errorf("failed to list peer pods: %v", err)
+		return err
+	}
+
+	var probes []func() error
+	for _, pod := range pods.Items {
+		if pod.Name == config.PodName {
+			continue
+		}
+		subnet := pod.Annotations[util.LogicalSwitchAnnotation]
+		for _, podIP := range pod.Status.PodIPs {
+			if !slices.Contains(config.PodProtocols, util.CheckProtocol(podIP.IP)) {
+				continue
+			}
+			for _, target := range []struct {
+				protocol string
+				port     int32
+			}{{probeProtocolTCP, config.TCPConnCheckPort}, {probeProtocolUDP, config.UDPConnCheckPort}} {
+				protocol, addr := target.protocol, util.JoinHostPort(podIP.IP, target.port)
+				probes = append(probes, func() error {
+					latency, err := probe(protocol, addr)
+					if err != nil {
+						klog.Errorf("%s probe to pod %s %s failed: %v", protocol, pod.Name, addr, err)
+					} else {
+						klog.Infof("%s probe to pod %s %s success in %.2fms", protocol, pod.Name, addr, float64(latency)/float64(time.Millisecond))
+					}
+					if setMetrics {
+						SetPodProbeMetrics(protocol, config.NodeName, config.PodSubnet, pod.Spec.NodeName, subnet,
+							float64(latency)/float64(time.Millisecond), err != nil)
+					}
+					return err
+				})
+			}
+		}
+	}
+	return runProbes(probes)
+}
+
+// probeServices probes every port of the configured services through their ClusterIPs and, for services
+// exposing node ports, through the internal IP of every node
+func probeServices(config *Configuration, setMetrics bool) error {
+	klog.Infof("start to probe service connectivity")
+	nodes, err := config.KubeClient.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		klog.Errorf("failed to list nodes, %v", err)
+		return err
+	}
+
+	var probeErr error
+	var probes []func() error
+	for _, key := range config.MeshCheckServices {
+		namespace, name, found := strings.Cut(key, "/")
+		if !found {
+			namespace, name = config.PodNamespace, key
+		}
+		svc, err := config.KubeClient.CoreV1().Services(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			klog.Errorf("failed to get service %s/%s: %v", namespace, name, err)
+			probeErr = err
+			continue
+		}
+		key = svc.Namespace + "/" + svc.Name
+
+		for _, port := range svc.Spec.Ports {
+			protocol := servicePortProbeProtocol(port, config.UDPConnCheckPort)
+			if protocol == "" {
+				continue
+			}
+
+			check := func(targetType, targetNodeName, addr string) {
+				probes = append(probes, func() error {
+					latency, err := probe(protocol, addr)
+					if err != nil {
+						klog.Errorf("%s probe to service %s %s %s failed: %v", protocol, key, targetType, addr, err)
+					} else {
+						klog.Infof("%s probe to service %s %s %s success in %.2fms", protocol, key, targetType, addr, float64(latency)/float64(time.Millisecond))
+					}
+					if setMetrics {
+						SetServiceProbeMetrics(protocol, config.NodeName, config.PodSubnet, key, targetType, targetNodeName,
+							float64(latency)/float64(time.Millisecond), err != nil)
+					}
+					return err
+				})
+			}
+
+			for _, clusterIP := range svc.Spec.ClusterIPs {
+				if clusterIP == v1.ClusterIPNone || !slices.Contains(config.PodProtocols, util.CheckProtocol(clusterIP)) {
+					continue
+				}
+				check(probeTargetClusterIP, "", util.JoinHostPort(clusterIP, port.Port))
+			}
+
+			if port.NodePort == 0 {
+				continue
+			}
+			for _, node := range nodes.Items {
+				for _, addr := range node.Status.Addresses {
+					if addr.Type == v1.NodeInternalIP && slices.Contains(config.PodProtocols, util.CheckProtocol(addr.Address)) {
+						check(probeTargetNodePort, node.Name, util.JoinHostPort(addr.Address, port.NodePort))
+					}
+				}
+			}
+		}
+	}
+	if err = runProbes(probes); err != nil {
+		probeErr = err
+	}
+	return probeErr
+}
@@ -0,0 +1,65 @@
+package pinger
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+func TestServicePortProbeProtocol(t *testing.T) {
+	const udpEchoPort = 8101
+	tests := []struct {
+		name     string
+		port     v1.ServicePort
+		expected string
+	}{
+		{"tcp", v1.ServicePort{Protocol: v1.ProtocolTCP, Port: 80}, probeProtocolTCP},
+		{"default protocol", v1.ServicePort{Port: 80}, probeProtocolTCP},
+		{"http by name", v1.ServicePort{Name: "http", Protocol: v1.ProtocolTCP, Port: 80}, probeProtocolHTTP},
+		{"http by name prefix", v1.ServicePort{Name: "http-metrics", Protocol: v1.ProtocolTCP, Port: 8080}, probeProtocolHTTP},
+		{"http by app protocol", v1.ServicePort{Name: "web", Protocol: v1.ProtocolTCP, Port: 80, AppProtocol: ptr.To("http")}, probeProtocolHTTP},
+		{"name not prefixed by http", v1.ServicePort{Name: "https", Protocol: v1.ProtocolTCP, Port: 443}, probeProtocolTCP},
+		{"udp echo target port", v1.ServicePort{Protocol: v1.ProtocolUDP, Port: 53, TargetPort: intstr.FromInt32(udpEchoPort)}, probeProtocolUDP},
+		{"udp echo port without target port", v1.ServicePort{Protocol: v1.ProtocolUDP, Port: udpEchoPort}, probeProtocolUDP},
+		{"udp without echo", v1.ServicePort{Protocol: v1.ProtocolUDP, Port: 53, TargetPort: intstr.FromInt32(53)}, ""},
+		{"udp named target port", v1.ServicePort{Protocol: v1.ProtocolUDP, Port: udpEchoPort, TargetPort: intstr.FromString("dns")}, ""},
+		{"sctp", v1.ServicePort{Protocol: v1.ProtocolSCTP, Port: 9999}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, servicePortProbeProtocol(tt.port, udpEchoPort))
+		})
+	}
+}
+
+func TestRunProbes(t *testing.T) {
+	var count, inFlight, maxInFlight atomic.Int32
+	probes := make([]func() error, 0, 3*meshProbeConcurrency)
+	for i := range 3 * meshProbeConcurrency {
+		probes = append(probes, func() error {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				observed := maxInFlight.Load()
+				if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+					break
+				}
+			}
+			count.Add(1)
+			if i == 7 {
+				return errors.New("probe failed")
+			}
+			return nil
+		})
+	}
+	require.EqualError(t, runProbes(probes), "probe failed")
+	require.Equal(t, int32(len(probes)), count.Load())
+	require.LessOrEqual(t, maxInFlight.Load(), int32(meshProbeConcurrency))
+
+	require.NoError(t, runProbes(nil))
+}
@@ -239,6 +239,121 @@ var (
 		},
 	)
 
+	subnetPingLatencyHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "pinger_subnet_ping_latency_ms",
+			Help:    "The latency ms histogram for pod peer ping over subnets attached via Multus",
+			Buckets: []float64{.25, .5, 1, 2, 5, 10, 30},
+		},
+		[]string{
+			"vpc",
+			"subnet",
+			"src_node_name",
+			"target_node_name",
+		},
+	)
+	subnetPingLostCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "pinger_subnet_ping_lost_total",
+			Help: "The lost count for pod peer ping over subnets attached via Multus",
+		}, []string{
+			"vpc",
+			"subnet",
+			"src_node_name",
+			"target_node_name",
+		},
+	)
+	subnetPingTotalCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "pinger_subnet_ping_count_total",
+			Help: "The total count for pod peer ping over subnets attached via Multus",
+		}, []string{
+			"vpc",
+			"subnet",
+			"src_node_name",
+			"target_node_name",
+		},
+	)
+	podProbeLatencyHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "pinger_pod_probe_latency_ms",
+			Help:    "The latency ms histogram for TCP handshake or UDP round trip to peer pinger pods",
+			Buckets: []float64{.25, .5, 1, 2, 5, 10, 30, 50, 100, 500},
+		},
+		[]string{
+			"protocol",
+			"src_node_name",
+			"src_subnet",
+			"target_node_name",
+			"target_subnet",
+		},
+	)
+	podProbeFailedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "pinger_pod_probe_failed_total",
+			Help: "The failed count for TCP/UDP probes to peer pinger pods",
+		}, []string{
+			"protocol",
+			"src_node_name",
+			"src_subnet",
+			"target_node_name",
+			"target_subnet",
+		},
+	)
+	podProbeTotalCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "pinger_pod_probe_count_total",
+			Help: "The total count for TCP/UDP probes to peer pinger pods",
+		}, []string{
+			"protocol",
+			"src_node_name",
+			"src_subnet",
+			"target_node_name",
+			"target_subnet",
+		},
+	)
+	serviceProbeLatencyHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "pinger_service_probe_latency_ms",
+			Help:    "The latency ms histogram for TCP/HTTP/UDP probes to service ClusterIPs and NodePorts",
+			Buckets: []float64{.25, .5, 1, 2, 5, 10, 30, 50, 100, 500},
+		},
+		[]string{
+			"protocol",
+			"src_node_name",
+			"src_subnet",
+			"service",
+			"target_type",
+			"target_node_name",
+		},
+	)
+	serviceProbeFailedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "pinger_service_probe_failed_total",
+			Help: "The failed count for TCP/HTTP/UDP probes to service ClusterIPs and NodePorts",
+		}, []string{
+			"protocol",
+			"src_node_name",
+			"src_subnet",
+			"service",
+			"target_type",
+			"target_node_name",
+		},
+	)
+	serviceProbeTotalCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "pinger_service_probe_count_total",
+			Help: "The total count for TCP/HTTP/UDP probes to service ClusterIPs and NodePorts",
+		}, []string{
+			"protocol",
+			"src_node_name",
+			"src_subnet",
+			"service",
+			"target_type",
+			"target_node_name",
+		},
+	)
+
 	// OVS basic info
 	metricOvsHealthyStatus = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -305,6 +420,61 @@ var (
 		},
 	)
 
+	// ovn-controller performance metrics
+	metricOvnControllerCoverageHits = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Name:      "ovn_coverage_hits",
+			Help:      "The number of times a coverage event of ovn-controller is hit since it starts, such as lflow_run of the logical flow computations.",
+		},
+		[]string{
+			"hostname",
+			"component",
+			"event",
+		},
+	)
+
+	metricOvnControllerStopwatchMilliseconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Name:      "ovn_stopwatch_milliseconds",
+			Help:      "The statistics of a stopwatch of ovn-controller, such as the time of the flow generation (flow-generation). The unit is Milliseconds.",
+		},
+		[]string{
+			"hostname",
+			"component",
+			"stopwatch",
+			"stat",
+		},
+	)
+
+	metricOvnControllerStopwatchSamples = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Name:      "ovn_stopwatch_samples",
+			Help:      "The total number of samples of a stopwatch of ovn-controller.",
+		},
+		[]string{
+			"hostname",
+			"component",
+			"stopwatch",
+		},
+	)
+
+	metricOvnControllerIncEngineStats = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Name:      "ovn_inc_engine_stats",
+			Help:      "The number of full recomputes, incremental computes and cancels of an incremental processing engine node of ovn-controller.",
+		},
+		[]string{
+			"hostname",
+			"component",
+			"node",
+			"stat",
+		},
+	)
+
 	// OVS datapath metrics
 	metricOvsDp = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -751,6 +921,15 @@ func InitPingerMetrics() {
 	metrics.Registry.MustRegister(nodePingTotalCounter)
 	metrics.Registry.MustRegister(externalPingLatencyHistogram)
 	metrics.Registry.MustRegister(externalPingLostCounter)
+	metrics.Registry.MustRegister(subnetPingLatencyHistogram)
+	metrics.Registry.MustRegister(subnetPingLostCounter)
+	metrics.Registry.MustRegister(subnetPingTotalCounter)
+	metrics.Registry.MustRegister(podProbeLatencyHistogram)
+	metrics.Registry.MustRegister(podProbeFailedCounter)
+	metrics.Registry.MustRegister(podProbeTotalCounter)
+	metrics.Registry.MustRegister(serviceProbeLatencyHistogram)
+	metrics.Registry.MustRegister(serviceProbeFailedCounter)
+	metrics.Registry.MustRegister(serviceProbeTotalCounter)
 
 	// ovs status metrics
 	metrics.Registry.MustRegister(metricOvsHealthyStatus)
@@ -759,6 +938,12 @@ func InitPingerMetrics() {
 	metrics.Registry.MustRegister(metricLogFileSize)
 	metrics.Registry.MustRegister(metricDbFileSize)
 
+	// ovn-controller performance metrics
+	metrics.Registry.MustRegister(metricOvnControllerCoverageHits)
+	metrics.Registry.MustRegister(metricOvnControllerStopwatchMilliseconds)
+	metrics.Registry.MustRegister(metricOvnControllerStopwatchSamples)
+	metrics.Registry.MustRegister(metricOvnControllerIncEngineStats)
+
 	// ovs datapath metrics
 	metrics.Registry.MustRegister(metricOvsDp)
 	metrics.Registry.MustRegister(metricOvsDpTotal)
@@ -916,3 +1101,81 @@ func SetExternalPingMetrics(srcNodeName, srcNodeIP, srcPodIP, targetAddress stri
 		targetAddress,
 	).Add(float64(lost))
 }
+
+func SetSubnetPingMetrics(vpc, subnet, srcNodeName, targetNodeName string, latency float64, lost, total int) {
+	subnetPingLatencyHistogram.WithLabelValues(
+		vpc,
+		subnet,
+		srcNodeName,
+		targetNodeName,
+	).Observe(latency)
+	subnetPingLostCounter.WithLabelValues(
+		vpc,
+		subnet,
+		srcNodeName,
+		targetNodeName,
+	).Add(float64(lost))
+	subnetPingTotalCounter.WithLabelValues(
+		vpc,
+		subnet,
+		srcNodeName,
+		targetNodeName,
+	).Add(float64(total))
+}
+
+func SetPodProbeMetrics(protocol, srcNodeName, srcSubnet, targetNodeName, targetSubnet string, latency float64, failed bool) {
+	podProbeTotalCounter.WithLabelValues(
+		protocol,
+		srcNodeName,
+		srcSubnet,
+		targetNodeName,
+		targetSubnet,
+	).Inc()
+	if failed {
+		podProbeFailedCounter.WithLabelValues(
+			protocol,
+			srcNodeName,
+			srcSubnet,
+			targetNodeName,
+			targetSubnet,
+		).Inc()
+		return
+	}
+	podProbeLatencyHistogram.WithLabelValues(
+		protocol,
+		srcNodeName,
+		srcSubnet,
+		targetNodeName,
+		targetSubnet,
+	).Observe(latency)
+}
+
+func SetServiceProbeMetrics(protocol, srcNodeName, srcSubnet, service, targetType, targetNodeName string, latency float64, failed bool) {
+	serviceProbeTotalCounter.WithLabelValues(
+		protocol,
+		srcNodeName,
+		srcSubnet,
+		service,
+		targetType,
+		targetNodeName,
+	).Inc()
+	if failed {
+		serviceProbeFailedCounter.WithLabelValues(
+			protocol,
+			srcNodeName,
+			srcSubnet,
+			service,
+			targetType,
+			targetNodeName,
+		).Inc()
+		return
+	}
+	serviceProbeLatencyHistogram.WithLabelValues(
+		protocol,
+		srcNodeName,
+		srcSubnet,
+		service,
+		targetType,
+		targetNodeName,
+	).Observe(latency)
+}
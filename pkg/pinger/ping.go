@@ -23,6 +23,10 @@ import (
 func StartPinger(config *Configuration, stopCh <-chan struct{}) {
 	errHappens := false
 	var exporter *Exporter
+	var reporter *connectivityReporter
+	if config.EnableConnectivityReport {
+		reporter = newConnectivityReporter(config)
+	}
 	withMetrics := config.Mode == "server" && config.EnableMetrics
 	interval := time.Duration(config.Interval) * time.Second
 	timer := time.NewTimer(interval)
@@ -46,7 +50,7 @@ LOOP:
 				exporter.ovsMetricsUpdate()
 			}
 		}
-		if ping(config, withMetrics) != nil {
+		if ping(config, withMetrics, reporter) != nil {
 			errHappens = true
 		}
 		if config.Mode != "server" {
@@ -66,21 +70,29 @@ LOOP:
 	}
 }
 
-func ping(config *Configuration, withMetrics bool) error {
-	errHappens := checkAPIServer(config, withMetrics) != nil
+func ping(config *Configuration, withMetrics bool, reporter *connectivityReporter) error {
+	result := reporter.newResult()
+	defer reporter.publish(result)
+
+	errHappens := checkAPIServer(config, withMetrics, result) != nil
 
-	if pingPods(config, withMetrics) != nil {
+	if pingPods(config, withMetrics, result) != nil {
 		errHappens = true
 	}
-	if pingNodes(config, withMetrics) != nil {
+	if pingNodes(config, withMetrics, result) != nil {
 		errHappens = true
 	}
-	if internalNslookup(config, withMetrics) != nil {
+	if len(config.AttachmentSubnets) != 0 {
+		if pingAttachments(config, withMetrics, result) != nil {
+			errHappens = true
+		}
+	}
+	if internalNslookup(config, withMetrics, result) != nil {
 		errHappens = true
 	}
 
 	if config.ExternalDNS != "" {
-		if externalNslookup(config, withMetrics) != nil {
+		if externalNslookup(config, withMetrics, result) != nil {
 			errHappens = true
 		}
 	}
@@ -91,6 +103,12 @@ func ping(config *Configuration, withMetrics bool) error {
 		}
 	}
 
+	if config.EnableMeshCheck {
+		if checkMesh(config, withMetrics) != nil {
+			errHappens = true
+		}
+	}
+
 	if config.ExternalAddress != "" {
 		if pingExternal(config, withMetrics) != nil {
 			errHappens = true
@@ -102,7 +120,7 @@ func ping(config *Configuration, withMetrics bool) error {
 	return nil
 }
 
-func pingNodes(config *Configuration, setMetrics bool) error {
+func pingNodes(config *Configuration, setMetrics bool, result *connectivityResult) error {
 	klog.Infof("start to check node connectivity")
 	nodes, err := config.KubeClient.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{})
 	if err != nil {
@@ -133,6 +151,7 @@ func pingNodes(config *Configuration, setMetrics bool) error {
 					pinger, err := goping.NewPinger(nodeIP)
 					if err != nil {
 						klog.Errorf("failed to init pinger, %v", err)
+						result.addPeer(kubeovnv1.ConnectivityPeer{Type: kubeovnv1.ConnectivityPeerTypeNode, NodeName: nodeName, IP: nodeIP}, 0, 0, 0, err)
 						pingErr = err
 						return
 					}
@@ -143,11 +162,13 @@ func pingNodes(config *Configuration, setMetrics bool) error {
 					pinger.Debug = true
 					if err = pinger.Run(); err != nil {
 						klog.Errorf("failed to run pinger for destination %s: %v", nodeIP, err)
+						result.addPeer(kubeovnv1.ConnectivityPeer{Type: kubeovnv1.ConnectivityPeerTypeNode, NodeName: nodeName, IP: nodeIP}, 0, 0, 0, err)
 						pingErr = err
 						return
 					}
 
 					stats := pinger.Statistics()
+					result.addPeer(kubeovnv1.ConnectivityPeer{Type: kubeovnv1.ConnectivityPeerTypeNode, NodeName: nodeName, IP: nodeIP}, stats.PacketsSent, stats.PacketsRecv, stats.AvgRtt, nil)
 					klog.Infof("ping node: %s %s, count: %d, loss count %d, average rtt %.2fms",
 						nodeName, nodeIP, pinger.Count, int(math.Abs(float64(stats.PacketsSent-stats.PacketsRecv))), float64(stats.AvgRtt)/float64(time.Millisecond))
 					if int(math.Abs(float64(stats.PacketsSent-stats.PacketsRecv))) != 0 {
@@ -171,7 +192,7 @@ func pingNodes(config *Configuration, setMetrics bool) error {
 	return pingErr
 }
 
-func pingPods(config *Configuration, setMetrics bool) error {
+func pingPods(config *Configuration, setMetrics bool, result *connectivityResult) error {
 	klog.Infof("start to check pod connectivity")
 	pods, err := config.KubeClient.CoreV1().Pods(config.PodNamespace).List(context.Background(), metav1.ListOptions{LabelSelector: config.LabelSelector})
 	if err != nil {
@@ -203,6 +224,7 @@ func pingPods(config *Configuration, setMetrics bool) error {
 					pinger, err := goping.NewPinger(podIP)
 					if err != nil {
 						klog.Errorf("failed to init pinger, %v", err)
+						result.addPeer(kubeovnv1.ConnectivityPeer{Type: kubeovnv1.ConnectivityPeerTypePod, NodeName: nodeName, IP: podIP}, 0, 0, 0, err)
 						pingErr = err
 						return
 					}
@@ -213,11 +235,13 @@ func pingPods(config *Configuration, setMetrics bool) error {
 					pinger.Interval = 100 * time.Millisecond
 					if err = pinger.Run(); err != nil {
 						klog.Errorf("failed to run pinger for destination %s: %v", podIP, err)
+						result.addPeer(kubeovnv1.ConnectivityPeer{Type: kubeovnv1.ConnectivityPeerTypePod, NodeName: nodeName, IP: podIP}, 0, 0, 0, err)
 						pingErr = err
 						return
 					}
 
 					stats := pinger.Statistics()
+					result.addPeer(kubeovnv1.ConnectivityPeer{Type: kubeovnv1.ConnectivityPeerTypePod, NodeName: nodeName, IP: podIP}, stats.PacketsSent, stats.PacketsRecv, stats.AvgRtt, nil)
 					klog.Infof("ping pod: %s %s, count: %d, loss count %d, average rtt %.2fms",
 						podName, podIP, pinger.Count, int(math.Abs(float64(stats.PacketsSent-stats.PacketsRecv))), float64(stats.AvgRtt)/float64(time.Millisecond))
 					if int(math.Abs(float64(stats.PacketsSent-stats.PacketsRecv))) != 0 {
@@ -338,7 +362,7 @@ func checkAccessTargetIPPorts(config *Configuration) error {
 	return checkErr
 }
 
-func internalNslookup(config *Configuration, setMetrics bool) error {
+func internalNslookup(config *Configuration, setMetrics bool, result *connectivityResult) error {
 	klog.Infof("start to check dns connectivity")
 	t1 := time.Now()
 	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
@@ -346,6 +370,7 @@ func internalNslookup(config *Configuration, setMetrics bool) error {
 	var r net.Resolver
 	addrs, err := r.LookupHost(ctx, config.InternalDNS)
 	elapsed := time.Since(t1)
+	result.setInternalDNS(config.InternalDNS, elapsed, err)
 	if err != nil {
 		klog.Errorf("failed to resolve dns %s, %v", config.InternalDNS, err)
 		if setMetrics {
@@ -360,7 +385,7 @@ func internalNslookup(config *Configuration, setMetrics bool) error {
 	return nil
 }
 
-func externalNslookup(config *Configuration, setMetrics bool) error {
+func externalNslookup(config *Configuration, setMetrics bool, result *connectivityResult) error {
 	klog.Infof("start to check dns connectivity")
 	t1 := time.Now()
 	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
@@ -368,6 +393,7 @@ func externalNslookup(config *Configuration, setMetrics bool) error {
 	var r net.Resolver
 	addrs, err := r.LookupHost(ctx, config.ExternalDNS)
 	elapsed := time.Since(t1)
+	result.setExternalDNS(config.ExternalDNS, elapsed, err)
 	if err != nil {
 		klog.Errorf("failed to resolve dns %s, %v", config.ExternalDNS, err)
 		if setMetrics {
@@ -382,11 +408,12 @@ func externalNslookup(config *Configuration, setMetrics bool) error {
 	return nil
 }
 
-func checkAPIServer(config *Configuration, setMetrics bool) error {
+func checkAPIServer(config *Configuration, setMetrics bool, result *connectivityResult) error {
 	klog.Infof("start to check apiserver connectivity")
 	t1 := time.Now()
 	_, err := config.KubeClient.Discovery().ServerVersion()
 	elapsed := time.Since(t1)
+	result.setAPIServer(elapsed, err)
 	if err != nil {
 		klog.Errorf("failed to connect to apiserver: %v", err)
 		if setMetrics {
@@ -0,0 +1,216 @@
+package pinger
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+// reportRefreshInterval is the maximum age of the published report when no check result has changed,
+// so that a stale lastUpdateTime tells users the pinger is no longer running
+const reportRefreshInterval = time.Minute
+
+// connectivityResult collects the results of one check round.
+// All methods are no-op on a nil receiver so that checks can record results unconditionally.
+type connectivityResult struct {
+	status kubeovnv1.ConnectivityReportStatus
+}
+
+func newConnectivityCheckResult(target string, latency time.Duration, err error) kubeovnv1.ConnectivityCheckResult {
+	result := kubeovnv1.ConnectivityCheckResult{Target: target, Healthy: err == nil}
+	if err != nil {
+		result.Error = err.Error()
+	} else {
+		result.Latency = metav1.Duration{Duration: latency}
+	}
+	return result
+}
+
+func (r *connectivityResult) setAPIServer(latency time.Duration, err error) {
+	if r != nil {
+		r.status.APIServer = newConnectivityCheckResult("", latency, err)
+	}
+}
+
+func (r *connectivityResult) setInternalDNS(target string, latency time.Duration, err error) {
+	if r != nil {
+		r.status.InternalDNS = newConnectivityCheckResult(target, latency, err)
+	}
+}
+
+func (r *connectivityResult) setExternalDNS(target string, latency time.Duration, err error) {
+	if r != nil {
+		result := newConnectivityCheckResult(target, latency, err)
+		r.status.ExternalDNS = &result
+	}
+}
+
+// addPeer records the result of pinging a peer identified by its type, node, IP and optionally subnet;
+// err is set when the ping could not be run at all
+func (r *connectivityResult) addPeer(peer kubeovnv1.ConnectivityPeer, sent, received int, avgRTT time.Duration, err error) {
+	if r == nil {
+		return
+	}
+
+	peer.LossPercent = 100
+	if err == nil && sent > 0 {
+		peer.LossPercent = (sent - received) * 100 / sent
+		peer.Reachable = received > 0
+		if peer.Reachable {
+			peer.AvgRTT = metav1.Duration{Duration: avgRTT}
+		}
+	}
+	if peer.LossPercent != 0 {
+		peer.LastFailureTime = metav1.Now()
+	}
+	r.status.Peers = append(r.status.Peers, peer)
+}
+
+// finish sorts the recorded peers, counts reachable ones and keeps the last failure time of peers
+// that have recovered since the previous report
+func (r *connectivityResult) finish(previous []kubeovnv1.ConnectivityPeer) {
+	// IPs of custom VPC subnets may overlap, so peers are identified by subnet as well
+	peerKey := func(peer kubeovnv1.ConnectivityPeer) string {
+		return peer.Type + "/" + peer.Subnet + "/" + peer.IP
+	}
+	lastFailures := make(map[string]metav1.Time, len(previous))
+	for _, peer := range previous {
+		lastFailures[peerKey(peer)] = peer.LastFailureTime
+	}
+
+	for i := range r.status.Peers {
+		peer := &r.status.Peers[i]
+		if peer.LastFailureTime.IsZero() {
+			peer.LastFailureTime = lastFailures[peerKey(*peer)]
+		}
+		if peer.Reachable {
+			r.status.ReachablePeers++
+		} else {
+			r.status.UnreachablePeers++
+		}
+	}
+	slices.SortFunc(r.status.Peers, func(a, b kubeovnv1.ConnectivityPeer) int {
+		return cmp.Or(
+			cmp.Compare(a.Type, b.Type),
+			cmp.Compare(a.Subnet, b.Subnet),
+			cmp.Compare(a.NodeName, b.NodeName),
+			cmp.Compare(a.IP, b.IP),
+		)
+	})
+}
+
+// connectivityReportChanged returns whether the check results differ, ignoring latencies and timestamps
+// which change on every round
+func connectivityReportChanged(old, new kubeovnv1.ConnectivityReportStatus) bool {
+	strip := func(status kubeovnv1.ConnectivityReportStatus) kubeovnv1.ConnectivityReportStatus {
+		status = *status.DeepCopy()
+		status.LastUpdateTime = metav1.Time{}
+		status.APIServer.Latency = metav1.Duration{}
+		status.InternalDNS.Latency = metav1.Duration{}
+		if status.ExternalDNS != nil {
+			status.ExternalDNS.Latency = metav1.Duration{}
+		}
+		for i := range status.Peers {
+			status.Peers[i].AvgRTT = metav1.Duration{}
+			status.Peers[i].LastFailureTime = metav1.Time{}
+		}
+		return status
+	}
+	return !equality.Semantic.DeepEqual(strip(old), strip(new))
+}
+
+// connectivityReporter publishes check results into the ConnectivityReport named after the node
+type connectivityReporter struct {
+	config *Configuration
+	report *kubeovnv1.ConnectivityReport
+}
+
+func newConnectivityReporter(config *Configuration) *connectivityReporter {
+	return &connectivityReporter{config: config}
+}
+
+func (r *connectivityReporter) newResult() *connectivityResult {
+	if r == nil {
+		return nil
+	}
+	return &connectivityResult{status: kubeovnv1.ConnectivityReportStatus{
+		NodeName: r.config.NodeName,
+		PodName:  r.config.PodName,
+		PodIP:    r.config.PodIP,
+	}}
+}
+
+func (r *connectivityReporter) publish(result *connectivityResult) {
+	if r == nil {
+		return
+	}
+	if err := r.update(result); err != nil {
+		klog.Errorf("failed to update connectivity report %s: %v", r.config.NodeName, err)
+		// force a refresh from the API server on the next round
+		r.report = nil
+	}
+}
+
+func (r *connectivityReporter) update(result *connectivityResult) error {
+	client := r.config.KubeOvnClient.KubeovnV1().ConnectivityReports()
+	if r.report == nil {
+		report, err := client.Get(context.Background(), r.config.NodeName, metav1.GetOptions{})
+		if err != nil {
+			if !k8serrors.IsNotFound(err) {
+				return err
+			}
+			if report, err = r.create(); err != nil {
+				return err
+			}
+		}
+		r.report = report
+	}
+
+	result.finish(r.report.Status.Peers)
+	if !connectivityReportChanged(r.report.Status, result.status) &&
+		time.Since(r.report.Status.LastUpdateTime.Time) < reportRefreshInterval {
+		return nil
+	}
+
+	report := r.report.DeepCopy()
+	report.Status = result.status
+	report.Status.LastUpdateTime = metav1.Now()
+	report, err := client.UpdateStatus(context.Background(), report, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	r.report = report
+	return nil
+}
+
+// create creates the ConnectivityReport of this node.
+// The object is owned by the node so that it is garbage collected when the node is deleted.
+func (r *connectivityReporter) create() (*kubeovnv1.ConnectivityReport, error) {
+	node, err := r.config.KubeClient.CoreV1().Nodes().Get(context.Background(), r.config.NodeName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node %s: %w", r.config.NodeName, err)
+	}
+
+	report := &kubeovnv1.ConnectivityReport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: r.config.NodeName,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "v1",
+				Kind:       util.KindNode,
+				Name:       node.Name,
+				UID:        node.UID,
+			}},
+		},
+	}
+	return r.config.KubeOvnClient.KubeovnV1().ConnectivityReports().Create(context.Background(), report, metav1.CreateOptions{})
+}
@@ -0,0 +1,89 @@
+package pinger
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+)
+
+func TestConnectivityResultNilReceiver(t *testing.T) {
+	var result *connectivityResult
+	require.NotPanics(t, func() {
+		result.setAPIServer(time.Millisecond, nil)
+		result.setInternalDNS("kubernetes.default", time.Millisecond, nil)
+		result.setExternalDNS("kube-ovn.io", 0, errors.New("timeout"))
+		result.addPeer(kubeovnv1.ConnectivityPeer{Type: "Pod", IP: "10.16.0.2"}, 3, 3, time.Millisecond, nil)
+	})
+}
+
+func TestConnectivityResultChecks(t *testing.T) {
+	result := &connectivityResult{}
+	result.setAPIServer(5*time.Millisecond, nil)
+	result.setExternalDNS("kube-ovn.io", time.Second, errors.New("i/o timeout"))
+
+	require.Equal(t, kubeovnv1.ConnectivityCheckResult{Healthy: true, Latency: metav1.Duration{Duration: 5 * time.Millisecond}}, result.status.APIServer)
+	require.NotNil(t, result.status.ExternalDNS)
+	require.Equal(t, kubeovnv1.ConnectivityCheckResult{Target: "kube-ovn.io", Error: "i/o timeout"}, *result.status.ExternalDNS)
+}
+
+func TestConnectivityResultPeers(t *testing.T) {
+	previousFailure := metav1.NewTime(time.Now().Add(-time.Hour))
+	previous := []kubeovnv1.ConnectivityPeer{
+		{Type: "Pod", NodeName: "node2", IP: "10.16.0.3", LastFailureTime: previousFailure},
+		{Type: "Pod", NodeName: "node2", IP: "10.16.0.3", Subnet: "vpc1-subnet", LastFailureTime: metav1.NewTime(time.Now())},
+	}
+
+	result := &connectivityResult{}
+	result.addPeer(kubeovnv1.ConnectivityPeer{Type: "Pod", NodeName: "node2", IP: "10.16.0.3"}, 3, 3, 2*time.Millisecond, nil)
+	result.addPeer(kubeovnv1.ConnectivityPeer{Type: "Pod", NodeName: "node3", IP: "10.16.0.4"}, 4, 1, time.Millisecond, nil)
+	result.addPeer(kubeovnv1.ConnectivityPeer{Type: "Node", NodeName: "node3", IP: "172.18.0.3"}, 3, 0, 0, nil)
+	result.addPeer(kubeovnv1.ConnectivityPeer{Type: "Node", NodeName: "node4", IP: "172.18.0.4"}, 0, 0, 0, errors.New("failed to run ping"))
+	result.finish(previous)
+
+	status := result.status
+	require.Equal(t, 2, status.ReachablePeers)
+	require.Equal(t, 2, status.UnreachablePeers)
+	require.Len(t, status.Peers, 4)
+
+	// sorted by type, subnet, node and IP
+	nodePeer, failedPeer, healthyPeer, lossyPeer := status.Peers[0], status.Peers[1], status.Peers[2], status.Peers[3]
+	require.Equal(t, "172.18.0.3", nodePeer.IP)
+	require.False(t, nodePeer.Reachable)
+	require.Equal(t, 100, nodePeer.LossPercent)
+	require.Equal(t, "172.18.0.4", failedPeer.IP)
+	require.False(t, failedPeer.Reachable)
+	require.Equal(t, 100, failedPeer.LossPercent)
+
+	require.Equal(t, "10.16.0.3", healthyPeer.IP)
+	require.True(t, healthyPeer.Reachable)
+	require.Zero(t, healthyPeer.LossPercent)
+	require.Equal(t, 2*time.Millisecond, healthyPeer.AvgRTT.Duration)
+	// a recovered peer keeps the last failure time of the same peer in the previous report
+	require.True(t, previousFailure.Equal(&healthyPeer.LastFailureTime))
+
+	require.Equal(t, "10.16.0.4", lossyPeer.IP)
+	require.True(t, lossyPeer.Reachable)
+	require.Equal(t, 75, lossyPeer.LossPercent)
+	require.False(t, lossyPeer.LastFailureTime.IsZero())
+}
+
+func TestConnectivityReportChanged(t *testing.T) {
+	old := kubeovnv1.ConnectivityReportStatus{
+		APIServer: kubeovnv1.ConnectivityCheckResult{Healthy: true, Latency: metav1.Duration{Duration: time.Millisecond}},
+		Peers:     []kubeovnv1.ConnectivityPeer{{Type: "Pod", IP: "10.16.0.3", Reachable: true, AvgRTT: metav1.Duration{Duration: time.Millisecond}}},
+	}
+	latencyOnly := *old.DeepCopy()
+	latencyOnly.APIServer.Latency = metav1.Duration{Duration: 2 * time.Millisecond}
+	latencyOnly.Peers[0].AvgRTT = metav1.Duration{Duration: 3 * time.Millisecond}
+	latencyOnly.LastUpdateTime = metav1.Now()
+	require.False(t, connectivityReportChanged(old, latencyOnly))
+
+	unreachable := *old.DeepCopy()
+	unreachable.Peers[0].Reachable = false
+	require.True(t, connectivityReportChanged(old, unreachable))
+}
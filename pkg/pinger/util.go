@@ -229,6 +229,49 @@ func (e *Exporter) setOvsInterfaceStatisticsMetric(intf *ovsdb.OvsInterface) {
 	}
 }
 
+func (e *Exporter) setOvnControllerPerformanceMetric() {
+	component := ovs.OvnController
+	output, err := ovs.Appctl(component, "coverage/show")
+	if err != nil {
+		klog.Errorf("failed to get coverage of %s: %v", component, err)
+		e.IncrementErrorCounter()
+		return
+	}
+	for event, hits := range ovs.ParseCoverage(output) {
+		metricOvnControllerCoverageHits.WithLabelValues(e.Client.System.Hostname, component, event).Set(hits)
+	}
+
+	if output, err = ovs.Appctl(component, "stopwatch/show"); err != nil {
+		klog.Errorf("failed to get stopwatches of %s: %v", component, err)
+		e.IncrementErrorCounter()
+	} else {
+		for name, stats := range ovs.ParseStopwatch(output) {
+			metricOvnControllerStopwatchSamples.WithLabelValues(e.Client.System.Hostname, component, name).Set(stats.Samples)
+			for stat, value := range stats.Values {
+				metricOvnControllerStopwatchMilliseconds.WithLabelValues(e.Client.System.Hostname, component, name, stat).Set(value)
+			}
+		}
+	}
+
+	if output, err = ovs.Appctl(component, "inc-engine/show-stats"); err != nil {
+		klog.Errorf("failed to get incremental processing engine stats of %s: %v", component, err)
+		e.IncrementErrorCounter()
+	} else {
+		for node, stats := range ovs.ParseIncEngineStats(output) {
+			for stat, value := range stats {
+				metricOvnControllerIncEngineStats.WithLabelValues(e.Client.System.Hostname, component, node, stat).Set(value)
+			}
+		}
+	}
+}
+
+func resetOvnControllerPerformanceMetrics() {
+	metricOvnControllerCoverageHits.Reset()
+	metricOvnControllerStopwatchMilliseconds.Reset()
+	metricOvnControllerStopwatchSamples.Reset()
+	metricOvnControllerIncEngineStats.Reset()
+}
+
 func resetOvsDatapathMetrics() {
 	metricOvsDpFlowsTotal.Reset()
 	metricOvsDpFlowsLookupHit.Reset()
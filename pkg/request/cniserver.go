@@ -48,6 +48,14 @@ type CniRequest struct {
 	VhostUserSocketConsumption string `json:"vhost_user_socket_consumption"`
 }
 
+// CniGCRequest is the cniserver request format of the CNI GC command
+type CniGCRequest struct {
+	CniType  string `json:"cni_type"`
+	Provider string `json:"provider"`
+	// ValidAttachments are the attachments still known to the container runtime
+	ValidAttachments []types.GCAttachment `json:"valid_attachments"`
+}
+
 // CniResponse is the cniserver response format
 type CniResponse struct {
 	IPs        []IPConfig `json:"ips"`
@@ -83,3 +91,39 @@ func (csc CniServerClient) Del(podRequest CniRequest) error {
 	}
 	return nil
 }
+
+// Check pod request
+func (csc CniServerClient) Check(podRequest CniRequest) error {
+	res, body, errors := csc.Post("http://dummy/api/v1/check").Send(podRequest).End()
+	if len(errors) != 0 {
+		return errors[0]
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("check pod network return %d %s", res.StatusCode, body)
+	}
+	return nil
+}
+
+// GC request
+func (csc CniServerClient) GC(gcRequest CniGCRequest) error {
+	res, body, errors := csc.Post("http://dummy/api/v1/gc").Send(gcRequest).End()
+	if len(errors) != 0 {
+		return errors[0]
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("garbage collection return %d %s", res.StatusCode, body)
+	}
+	return nil
+}
+
+// Status request
+func (csc CniServerClient) Status() error {
+	res, body, errors := csc.Get("http://dummy/api/v1/status").End()
+	if len(errors) != 0 {
+		return errors[0]
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("get status return %d %s", res.StatusCode, body)
+	}
+	return nil
+}
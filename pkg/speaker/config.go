@@ -59,6 +59,8 @@ type Configuration struct {
 	HoldTime                    float64
 	BgpServer                   *gobgp.BgpServer
 	AnnounceClusterIP           bool
+	AnnounceExternalIP          bool
+	AnnounceLoadBalancerIP      bool
 	GracefulRestart             bool
 	GracefulRestartDeferralTime time.Duration
 	GracefulRestartTime         time.Duration
@@ -67,6 +69,7 @@ type Configuration struct {
 	ExtendedNexthop             bool
 	NatGwMode                   bool
 	EnableMetrics               bool
+	EnableStatusReport          bool
 
 	// BFD (Bidirectional Forwarding Detection) configuration
 	EnableBFD              bool
@@ -89,6 +92,8 @@ func ParseFlags() (*Configuration, error) {
 		argGracefulRestartDeferralTime = pflag.Duration("graceful-restart-deferral-time", DefaultGracefulRestartDeferralTime, "BGP Graceful restart deferral time according to RFC4724 4.1, maximum 18h.")
 		argGracefulRestart             = pflag.BoolP("graceful-restart", "", false, "Enables the BGP Graceful Restart so that routes are preserved on unexpected restarts")
 		argAnnounceClusterIP           = pflag.BoolP("announce-cluster-ip", "", false, "The Cluster IP of the service to announce to the BGP peers.")
+		argAnnounceExternalIP          = pflag.BoolP("announce-external-ip", "", false, "Announce the external IPs (spec.externalIPs) of the service to the BGP peers. With externalTrafficPolicy=Local, only nodes hosting ready endpoints announce them.")
+		argAnnounceLoadBalancerIP      = pflag.BoolP("announce-lb-ip", "", false, "Announce the LoadBalancer ingress IPs (status.loadBalancer.ingress) of the service to the BGP peers. With externalTrafficPolicy=Local, only nodes hosting ready endpoints announce them.")
 		argGrpcHost                    = pflag.IP("grpc-host", net.IP{127, 0, 0, 1}, "The host address for grpc to listen")
 		argGrpcPort                    = pflag.Int32("grpc-port", DefaultBGPGrpcPort, "The port for grpc to listen")
 		argClusterAs                   = pflag.Uint32("cluster-as", 0, "The AS number of the local BGP speaker (required)")
@@ -109,6 +114,7 @@ func ParseFlags() (*Configuration, error) {
 		argExtendedNexthop             = pflag.BoolP("extended-nexthop", "", false, "Announce IPv4/IPv6 prefixes to every neighbor, no matter their AFI")
 		argNatGwMode                   = pflag.BoolP("nat-gw-mode", "", false, "Make the BGP speaker announce EIPs from inside a NAT gateway, Pod IP/Service/Subnet announcements will be disabled")
 		argEnableMetrics               = pflag.BoolP("enable-metrics", "", true, "Whether to support metrics query")
+		argEnableStatusReport          = pflag.BoolP("enable-status-report", "", false, "Report BGP neighbor state and announced prefixes in a BgpSpeakerStatus object named after the node. Not supported in NAT gateway mode")
 		argLogPerm                     = pflag.String("log-perm", "640", "The permission for the log file")
 		argEnableBFD                   = pflag.BoolP("enable-bfd", "", false, "Enable BFD (Bidirectional Forwarding Detection) for fast failure detection")
 		argBFDMinTX                    = pflag.Uint32("bfd-min-tx", 1000, "BFD minimum transmit interval in milliseconds (max 4294967)")
@@ -158,6 +164,8 @@ func ParseFlags() (*Configuration, error) {
 
 	config := &Configuration{
 		AnnounceClusterIP:          *argAnnounceClusterIP,
+		AnnounceExternalIP:         *argAnnounceExternalIP,
+		AnnounceLoadBalancerIP:     *argAnnounceLoadBalancerIP,
 		GrpcHost:                   *argGrpcHost,
 		GrpcPort:                   *argGrpcPort,
 		ClusterAs:                  *argClusterAs,
@@ -185,6 +193,7 @@ func ParseFlags() (*Configuration, error) {
 		ExtendedNexthop:             *argExtendedNexthop,
 		NatGwMode:                   *argNatGwMode,
 		EnableMetrics:               *argEnableMetrics,
+		EnableStatusReport:          *argEnableStatusReport && !*argNatGwMode,
 		LogPerm:                     *argLogPerm,
 		EnableBFD:                   *argEnableBFD,
 		BFDMinTX:                    *argBFDMinTX,
@@ -11,6 +11,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	listerv1 "k8s.io/client-go/listers/core/v1"
+	discoverylisterv1 "k8s.io/client-go/listers/discovery/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
@@ -35,6 +36,9 @@ type Controller struct {
 	servicesLister listerv1.ServiceLister
 	servicesSynced cache.InformerSynced
 
+	endpointSlicesLister discoverylisterv1.EndpointSliceLister
+	endpointSlicesSynced cache.InformerSynced
+
 	eipLister kubeovnlister.IptablesEIPLister
 	eipSynced cache.InformerSynced
 
@@ -61,6 +65,12 @@ type Controller struct {
 	// window to a concurrent Prometheus scrape (metric flapping).
 	lastBGPPeers map[string]string
 	lastBFDPeers map[string]struct{}
+
+	// speakerStatus caches the BgpSpeakerStatus object last written by reportStatus,
+	// lastError / lastErrorTime keep the error of the last report, cleared once a report succeeds.
+	speakerStatus *kubeovnv1.BgpSpeakerStatus
+	lastError     string
+	lastErrorTime metav1.Time
 }
 
 func NewController(config *Configuration) *Controller {
@@ -114,6 +124,13 @@ func NewController(config *Configuration) *Controller {
 		recorder:               recorder,
 	}
 
+	// endpoint slices are only needed to check local endpoints of external and LoadBalancer IPs
+	if config.AnnounceExternalIP || config.AnnounceLoadBalancerIP {
+		endpointSliceInformer := informerFactory.Discovery().V1().EndpointSlices()
+		controller.endpointSlicesLister = endpointSliceInformer.Lister()
+		controller.endpointSlicesSynced = endpointSliceInformer.Informer().HasSynced
+	}
+
 	if config.EnableMetrics {
 		registerSpeakerMetrics()
 	}
@@ -127,7 +144,11 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 	c.podInformerFactory.Start(stopCh)
 	c.kubeovnInformerFactory.Start(stopCh)
 
-	if !cache.WaitForCacheSync(stopCh, c.podsSynced, c.subnetSynced, c.servicesSynced, c.eipSynced) {
+	cacheSyncs := []cache.InformerSynced{c.podsSynced, c.subnetSynced, c.servicesSynced, c.eipSynced}
+	if c.endpointSlicesSynced != nil {
+		cacheSyncs = append(cacheSyncs, c.endpointSlicesSynced)
+	}
+	if !cache.WaitForCacheSync(stopCh, cacheSyncs...) {
 		util.LogFatalAndExit(nil, "failed to wait for caches to sync")
 		return
 	}
@@ -140,13 +161,13 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 }
 
 func (c *Controller) Reconcile() {
+	var err error
 	if c.config.NatGwMode {
-		err := c.syncEIPRoutes()
-		if err != nil {
+		if err = c.syncEIPRoutes(); err != nil {
 			klog.Errorf("failed to reconcile EIPs: %s", err.Error())
 		}
-	} else {
-		c.syncSubnetRoutes()
+	} else if err = c.syncSubnetRoutes(); err != nil {
+		klog.Errorf("failed to reconcile subnet routes: %s", err.Error())
 	}
 
 	c.logBFDStatus()
@@ -154,4 +175,8 @@ func (c *Controller) Reconcile() {
 	if c.config.EnableMetrics {
 		c.collectMetrics()
 	}
+
+	if c.config.EnableStatusReport {
+		c.reportStatus(err)
+	}
 }
@@ -0,0 +1,119 @@
+package speaker
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/set"
+
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+// serviceAnnounceOptions selects which kinds of service IPs are announced via BGP
+type serviceAnnounceOptions struct {
+	clusterIP      bool
+	externalIP     bool
+	loadBalancerIP bool
+}
+
+func (o serviceAnnounceOptions) enabled() bool {
+	return o.clusterIP || o.externalIP || o.loadBalancerIP
+}
+
+// syncServiceRoutes collects the IPs of services marked for BGP advertisement into bgpExpected
+func (c *Controller) syncServiceRoutes(bgpExpected prefixMap) error {
+	opts := serviceAnnounceOptions{
+		clusterIP:      c.config.AnnounceClusterIP,
+		externalIP:     c.config.AnnounceExternalIP,
+		loadBalancerIP: c.config.AnnounceLoadBalancerIP,
+	}
+	if !opts.enabled() {
+		return nil
+	}
+
+	services, err := c.servicesLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list services: %w", err)
+	}
+
+	var localEndpoints set.Set[string]
+	if opts.externalIP || opts.loadBalancerIP {
+		endpointSlices, err := c.endpointSlicesLister.List(labels.Everything())
+		if err != nil {
+			return fmt.Errorf("failed to list endpoint slices: %w", err)
+		}
+		localEndpoints = servicesWithLocalEndpoints(endpointSlices, c.config.NodeName)
+	}
+
+	collectServiceExpectedPrefixes(services, localEndpoints, opts, bgpExpected)
+	return nil
+}
+
+// collectServiceExpectedPrefixes iterates over services and collects IPs that should be announced via BGP.
+// ClusterIPs are announced from every speaker. External and LoadBalancer IPs of services with
+// externalTrafficPolicy=Local are only announced when the service has a ready endpoint on this node,
+// so that traffic never enters the cluster through a node that would drop it.
+func collectServiceExpectedPrefixes(services []*corev1.Service, localEndpoints set.Set[string], opts serviceAnnounceOptions, bgpExpected prefixMap) {
+	for _, svc := range services {
+		if svc.Annotations[util.BgpAnnotation] != "true" {
+			continue
+		}
+
+		if opts.clusterIP && isClusterIPService(svc) {
+			for _, clusterIP := range svc.Spec.ClusterIPs {
+				addExpectedPrefix(clusterIP, bgpExpected)
+			}
+		}
+
+		if !opts.externalIP && !opts.loadBalancerIP {
+			continue
+		}
+		if svc.Spec.ExternalTrafficPolicy == corev1.ServiceExternalTrafficPolicyLocal &&
+			!localEndpoints.Has(svc.Namespace+"/"+svc.Name) {
+			klog.V(5).Infof("skip announcing external IPs of service %s/%s: no ready endpoint on this node", svc.Namespace, svc.Name)
+			continue
+		}
+
+		if opts.externalIP {
+			for _, externalIP := range svc.Spec.ExternalIPs {
+				addExpectedPrefix(externalIP, bgpExpected)
+			}
+		}
+		if opts.loadBalancerIP && svc.Spec.Type == corev1.ServiceTypeLoadBalancer {
+			for _, ingress := range svc.Status.LoadBalancer.Ingress {
+				if ingress.IP != "" {
+					addExpectedPrefix(ingress.IP, bgpExpected)
+				}
+			}
+		}
+	}
+}
+
+// servicesWithLocalEndpoints returns the keys (namespace/name) of services having at least one ready endpoint
+// hosted on the given node
+func servicesWithLocalEndpoints(endpointSlices []*discoveryv1.EndpointSlice, nodeName string) set.Set[string] {
+	services := set.New[string]()
+	for _, endpointSlice := range endpointSlices {
+		svcName := endpointSlice.Labels[discoveryv1.LabelServiceName]
+		if svcName == "" {
+			continue
+		}
+		key := endpointSlice.Namespace + "/" + svcName
+		if services.Has(key) {
+			continue
+		}
+		for _, endpoint := range endpointSlice.Endpoints {
+			// a nil ready condition must be interpreted as ready
+			if endpoint.NodeName == nil || *endpoint.NodeName != nodeName ||
+				(endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready) {
+				continue
+			}
+			services.Insert(key)
+			break
+		}
+	}
+	return services
+}
@@ -0,0 +1,144 @@
+package speaker
+
+import (
+	"testing"
+
+	"github.com/osrg/gobgp/v4/api"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+func TestCollectServiceExpectedPrefixes(t *testing.T) {
+	newService := func(name string, svcType corev1.ServiceType, etp corev1.ServiceExternalTrafficPolicy, bgp bool) *corev1.Service {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: corev1.ServiceSpec{
+				Type:                  svcType,
+				ClusterIP:             "10.96.0.10",
+				ClusterIPs:            []string{"10.96.0.10", "fd00:10:96::10"},
+				ExternalIPs:           []string{"172.18.0.100"},
+				ExternalTrafficPolicy: etp,
+			},
+			Status: corev1.ServiceStatus{
+				LoadBalancer: corev1.LoadBalancerStatus{
+					Ingress: []corev1.LoadBalancerIngress{{IP: "172.19.0.100"}, {Hostname: "lb.example.com"}},
+				},
+			},
+		}
+		if bgp {
+			svc.Annotations = map[string]string{util.BgpAnnotation: "true"}
+		}
+		return svc
+	}
+
+	all := serviceAnnounceOptions{clusterIP: true, externalIP: true, loadBalancerIP: true}
+
+	tests := []struct {
+		name           string
+		services       []*corev1.Service
+		localEndpoints set.Set[string]
+		opts           serviceAnnounceOptions
+		expectedV4     []string
+		expectedV6     []string
+	}{
+		{
+			name:       "service without bgp annotation is not announced",
+			services:   []*corev1.Service{newService("svc", corev1.ServiceTypeLoadBalancer, corev1.ServiceExternalTrafficPolicyCluster, false)},
+			opts:       all,
+			expectedV4: nil,
+		},
+		{
+			name:       "cluster ip service announces cluster and external ips",
+			services:   []*corev1.Service{newService("svc", corev1.ServiceTypeClusterIP, corev1.ServiceExternalTrafficPolicyCluster, true)},
+			opts:       all,
+			expectedV4: []string{"10.96.0.10/32", "172.18.0.100/32"},
+			expectedV6: []string{"fd00:10:96::10/128"},
+		},
+		{
+			name:       "load balancer service with cluster policy announces external and ingress ips",
+			services:   []*corev1.Service{newService("svc", corev1.ServiceTypeLoadBalancer, corev1.ServiceExternalTrafficPolicyCluster, true)},
+			opts:       serviceAnnounceOptions{externalIP: true, loadBalancerIP: true},
+			expectedV4: []string{"172.18.0.100/32", "172.19.0.100/32"},
+		},
+		{
+			name:       "load balancer ip only",
+			services:   []*corev1.Service{newService("svc", corev1.ServiceTypeLoadBalancer, corev1.ServiceExternalTrafficPolicyCluster, true)},
+			opts:       serviceAnnounceOptions{loadBalancerIP: true},
+			expectedV4: []string{"172.19.0.100/32"},
+		},
+		{
+			name:       "local policy without local endpoints is not announced",
+			services:   []*corev1.Service{newService("svc", corev1.ServiceTypeLoadBalancer, corev1.ServiceExternalTrafficPolicyLocal, true)},
+			opts:       serviceAnnounceOptions{externalIP: true, loadBalancerIP: true},
+			expectedV4: nil,
+		},
+		{
+			name:           "local policy with local endpoints is announced",
+			services:       []*corev1.Service{newService("svc", corev1.ServiceTypeLoadBalancer, corev1.ServiceExternalTrafficPolicyLocal, true)},
+			localEndpoints: set.New("default/svc"),
+			opts:           serviceAnnounceOptions{externalIP: true, loadBalancerIP: true},
+			expectedV4:     []string{"172.18.0.100/32", "172.19.0.100/32"},
+		},
+		{
+			name:       "local policy does not affect cluster ips",
+			services:   []*corev1.Service{newService("svc", corev1.ServiceTypeClusterIP, corev1.ServiceExternalTrafficPolicyLocal, true)},
+			opts:       all,
+			expectedV4: []string{"10.96.0.10/32"},
+			expectedV6: []string{"fd00:10:96::10/128"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bgpExpected := make(prefixMap)
+			collectServiceExpectedPrefixes(tt.services, tt.localEndpoints, tt.opts, bgpExpected)
+
+			var gotV4, gotV6 []string
+			if s := bgpExpected[api.Family_AFI_IP]; s != nil {
+				gotV4 = s.SortedList()
+			}
+			if s := bgpExpected[api.Family_AFI_IP6]; s != nil {
+				gotV6 = s.SortedList()
+			}
+			require.Equal(t, tt.expectedV4, gotV4)
+			require.Equal(t, tt.expectedV6, gotV6)
+		})
+	}
+}
+
+func TestServicesWithLocalEndpoints(t *testing.T) {
+	newEndpointSlice := func(name, service string, endpoints ...discoveryv1.Endpoint) *discoveryv1.EndpointSlice {
+		eps := &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Endpoints:  endpoints,
+		}
+		if service != "" {
+			eps.Labels = map[string]string{discoveryv1.LabelServiceName: service}
+		}
+		return eps
+	}
+	newEndpoint := func(nodeName string, ready *bool) discoveryv1.Endpoint {
+		return discoveryv1.Endpoint{
+			Addresses:  []string{"10.16.0.2"},
+			NodeName:   ptr.To(nodeName),
+			Conditions: discoveryv1.EndpointConditions{Ready: ready},
+		}
+	}
+
+	slices := []*discoveryv1.EndpointSlice{
+		newEndpointSlice("local-ready", "svc1", newEndpoint("node2", ptr.To(true)), newEndpoint("node1", ptr.To(true))),
+		newEndpointSlice("local-nil-ready", "svc2", newEndpoint("node1", nil)),
+		newEndpointSlice("local-not-ready", "svc3", newEndpoint("node1", ptr.To(false))),
+		newEndpointSlice("remote-ready", "svc4", newEndpoint("node2", ptr.To(true))),
+		newEndpointSlice("no-service", "", newEndpoint("node1", ptr.To(true))),
+		newEndpointSlice("no-node", "svc5", discoveryv1.Endpoint{Addresses: []string{"10.16.0.3"}}),
+	}
+
+	require.Equal(t, []string{"default/svc1", "default/svc2"}, servicesWithLocalEndpoints(slices, "node1").SortedList())
+}
@@ -0,0 +1,236 @@
+package speaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/osrg/gobgp/v4/api"
+	"github.com/osrg/gobgp/v4/pkg/apiutil"
+	"github.com/osrg/gobgp/v4/pkg/packet/bgp"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/set"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	"github.com/kubeovn/kube-ovn/pkg/util"
+)
+
+const (
+	// statusReportInterval is the maximum age of the reported status when nothing has changed,
+	// so that a stale lastUpdateTime tells users the speaker is no longer running
+	statusReportInterval = time.Minute
+	// maxReportedPrefixes bounds the announced prefix list written to the status object
+	maxReportedPrefixes = 1000
+)
+
+// bgpSessionStateString converts a BGP session state enum to a human-readable string.
+func bgpSessionStateString(state api.PeerState_SessionState) string {
+	switch state {
+	case api.PeerState_SESSION_STATE_IDLE:
+		return "Idle"
+	case api.PeerState_SESSION_STATE_CONNECT:
+		return "Connect"
+	case api.PeerState_SESSION_STATE_ACTIVE:
+		return "Active"
+	case api.PeerState_SESSION_STATE_OPENSENT:
+		return "OpenSent"
+	case api.PeerState_SESSION_STATE_OPENCONFIRM:
+		return "OpenConfirm"
+	case api.PeerState_SESSION_STATE_ESTABLISHED:
+		return "Established"
+	default:
+		return "Unknown"
+	}
+}
+
+// newBgpNeighborStatus converts a GoBGP peer into the neighbor status reported in BgpSpeakerStatus
+func newBgpNeighborStatus(peer *api.Peer, bfdStates map[string]string) kubeovnv1.BgpNeighborStatus {
+	status := kubeovnv1.BgpNeighborStatus{
+		Address:  peer.Conf.NeighborAddress,
+		PeerASN:  peer.Conf.PeerAsn,
+		State:    bgpSessionStateString(peer.State.SessionState),
+		Flaps:    peer.State.Flops,
+		BFDState: bfdStates[peer.Conf.NeighborAddress],
+	}
+	if peer.Transport != nil {
+		status.LocalAddress = peer.Transport.LocalAddress
+	}
+
+	if peer.State.SessionState == api.PeerState_SESSION_STATE_ESTABLISHED {
+		if peer.Timers != nil && peer.Timers.State != nil && peer.Timers.State.Uptime != nil {
+			status.EstablishedTime = metav1.NewTime(peer.Timers.State.Uptime.AsTime())
+		}
+	} else if peer.State.DisconnectReason != api.PeerState_DISCONNECT_REASON_UNSPECIFIED {
+		status.DisconnectReason = strings.TrimPrefix(peer.State.DisconnectReason.String(), "DISCONNECT_REASON_")
+		if peer.State.DisconnectMessage != "" {
+			status.DisconnectReason += ": " + peer.State.DisconnectMessage
+		}
+	}
+
+	for _, afiSafi := range peer.AfiSafis {
+		if afiSafi == nil || afiSafi.State == nil {
+			continue
+		}
+		status.ReceivedPrefixes += afiSafi.State.Received
+		status.AcceptedPrefixes += afiSafi.State.Accepted
+		status.AdvertisedPrefixes += afiSafi.State.Advertised
+	}
+	return status
+}
+
+// collectSpeakerStatus queries the GoBGP server and builds the status reported in BgpSpeakerStatus
+func (c *Controller) collectSpeakerStatus() (kubeovnv1.BgpSpeakerStatusInfo, error) {
+	status := kubeovnv1.BgpSpeakerStatusInfo{
+		NodeName: c.config.NodeName,
+		RouterID: c.config.RouterID.String(),
+		LocalASN: c.config.ClusterAs,
+	}
+
+	bfdStates := make(map[string]string)
+	if c.config.EnableBFD {
+		ctx, cancel := context.WithTimeout(context.Background(), metricsCollectTimeout)
+		c.config.BgpServer.ListBfdPeer(ctx, func(addr string, state *api.BfdPeerState) {
+			if state != nil {
+				bfdStates[addr] = bfdSessionStateString(state.SessionState)
+			}
+		})
+		cancel()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), metricsCollectTimeout)
+	defer cancel()
+	if err := c.config.BgpServer.ListPeer(ctx, &api.ListPeerRequest{EnableAdvertised: true}, func(peer *api.Peer) {
+		if peer == nil || peer.Conf == nil || peer.State == nil {
+			return
+		}
+		neighbor := newBgpNeighborStatus(peer, bfdStates)
+		if peer.State.SessionState == api.PeerState_SESSION_STATE_ESTABLISHED {
+			status.EstablishedNeighbors++
+		}
+		status.Neighbors = append(status.Neighbors, neighbor)
+	}); err != nil {
+		return status, fmt.Errorf("failed to list BGP peers: %w", err)
+	}
+	status.TotalNeighbors = len(status.Neighbors)
+	slices.SortFunc(status.Neighbors, func(a, b kubeovnv1.BgpNeighborStatus) int {
+		return strings.Compare(a.Address, b.Address)
+	})
+
+	announced := set.New[string]()
+	for _, afi := range []api.Family_Afi{api.Family_AFI_IP, api.Family_AFI_IP6} {
+		listPathRequest := apiutil.ListPathRequest{
+			TableType: api.TableType_TABLE_TYPE_GLOBAL,
+			Family:    apiutil.ToFamily(&api.Family{Afi: afi, Safi: api.Family_SAFI_UNICAST}),
+		}
+		if err := c.config.BgpServer.ListPath(listPathRequest, func(prefix bgp.NLRI, paths []*apiutil.Path) {
+			// paths learned from peers carry the peer ASN, locally originated ones don't
+			if slices.ContainsFunc(paths, func(path *apiutil.Path) bool { return path.PeerASN == 0 }) {
+				announced.Insert(prefix.String())
+			}
+		}); err != nil {
+			return status, fmt.Errorf("failed to list announced %s routes: %w", afi, err)
+		}
+	}
+	status.AnnouncedPrefixCount = announced.Len()
+	status.AnnouncedPrefixes = announced.SortedList()
+	if len(status.AnnouncedPrefixes) > maxReportedPrefixes {
+		status.AnnouncedPrefixes = status.AnnouncedPrefixes[:maxReportedPrefixes]
+	}
+
+	return status, nil
+}
+
+// reportStatus writes the current BGP speaker state into the BgpSpeakerStatus object named after the node.
+// reconcileErr is the error returned by the last route reconciliation, if any.
+func (c *Controller) reportStatus(reconcileErr error) {
+	status, err := c.collectSpeakerStatus()
+	if err != nil {
+		klog.Errorf("failed to collect BGP speaker status: %v", err)
+	}
+	c.setLastError(errors.Join(reconcileErr, err))
+	status.LastError = c.lastError
+	status.LastErrorTime = c.lastErrorTime
+
+	if err = c.updateSpeakerStatus(status); err != nil {
+		klog.Errorf("failed to update BGP speaker status %s: %v", c.config.NodeName, err)
+		// force a refresh from the API server on the next report
+		c.speakerStatus = nil
+	}
+}
+
+// setLastError records the error of the current report, a successful report clears the previous error
+func (c *Controller) setLastError(err error) {
+	if err == nil {
+		c.lastError, c.lastErrorTime = "", metav1.Time{}
+		return
+	}
+	if c.lastError != err.Error() {
+		c.lastErrorTime = metav1.Now()
+	}
+	c.lastError = err.Error()
+}
+
+// speakerStatusChanged returns whether the reported status differs from the new one, ignoring the update time
+func speakerStatusChanged(old, new kubeovnv1.BgpSpeakerStatusInfo) bool {
+	old.LastUpdateTime, new.LastUpdateTime = metav1.Time{}, metav1.Time{}
+	return !equality.Semantic.DeepEqual(old, new)
+}
+
+func (c *Controller) updateSpeakerStatus(status kubeovnv1.BgpSpeakerStatusInfo) error {
+	client := c.config.KubeOvnClient.KubeovnV1().BgpSpeakerStatuses()
+	if c.speakerStatus == nil {
+		speakerStatus, err := client.Get(context.Background(), c.config.NodeName, metav1.GetOptions{})
+		if err != nil {
+			if !k8serrors.IsNotFound(err) {
+				return err
+			}
+			if speakerStatus, err = c.createSpeakerStatus(); err != nil {
+				return err
+			}
+		}
+		c.speakerStatus = speakerStatus
+	}
+
+	if !speakerStatusChanged(c.speakerStatus.Status, status) &&
+		time.Since(c.speakerStatus.Status.LastUpdateTime.Time) < statusReportInterval {
+		return nil
+	}
+
+	speakerStatus := c.speakerStatus.DeepCopy()
+	speakerStatus.Status = status
+	speakerStatus.Status.LastUpdateTime = metav1.Now()
+	speakerStatus, err := client.UpdateStatus(context.Background(), speakerStatus, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	c.speakerStatus = speakerStatus
+	return nil
+}
+
+// createSpeakerStatus creates the BgpSpeakerStatus object of this node.
+// The object is owned by the node so that it is garbage collected when the node is deleted.
+func (c *Controller) createSpeakerStatus() (*kubeovnv1.BgpSpeakerStatus, error) {
+	node, err := c.config.KubeClient.CoreV1().Nodes().Get(context.Background(), c.config.NodeName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node %s: %w", c.config.NodeName, err)
+	}
+
+	speakerStatus := &kubeovnv1.BgpSpeakerStatus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: c.config.NodeName,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "v1",
+				Kind:       util.KindNode,
+				Name:       node.Name,
+				UID:        node.UID,
+			}},
+		},
+	}
+	return c.config.KubeOvnClient.KubeovnV1().BgpSpeakerStatuses().Create(context.Background(), speakerStatus, metav1.CreateOptions{})
+}
@@ -0,0 +1,105 @@
+package speaker
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/osrg/gobgp/v4/api"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+)
+
+func TestBgpSessionStateString(t *testing.T) {
+	require.Equal(t, "Established", bgpSessionStateString(api.PeerState_SESSION_STATE_ESTABLISHED))
+	require.Equal(t, "Active", bgpSessionStateString(api.PeerState_SESSION_STATE_ACTIVE))
+	require.Equal(t, "Idle", bgpSessionStateString(api.PeerState_SESSION_STATE_IDLE))
+	require.Equal(t, "Unknown", bgpSessionStateString(api.PeerState_SESSION_STATE_UNSPECIFIED))
+}
+
+func TestNewBgpNeighborStatus(t *testing.T) {
+	uptime := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("established peer", func(t *testing.T) {
+		peer := &api.Peer{
+			Conf:      &api.PeerConf{NeighborAddress: "10.0.0.1", PeerAsn: 65001},
+			State:     &api.PeerState{SessionState: api.PeerState_SESSION_STATE_ESTABLISHED, Flops: 2},
+			Transport: &api.Transport{LocalAddress: "10.0.0.2"},
+			Timers:    &api.Timers{State: &api.TimersState{Uptime: timestamppb.New(uptime)}},
+			AfiSafis: []*api.AfiSafi{
+				{State: &api.AfiSafiState{Received: 3, Accepted: 2, Advertised: 5}},
+				{State: &api.AfiSafiState{Received: 1, Accepted: 1, Advertised: 4}},
+				nil,
+			},
+		}
+
+		status := newBgpNeighborStatus(peer, map[string]string{"10.0.0.1": "UP"})
+		require.Equal(t, kubeovnv1.BgpNeighborStatus{
+			Address:            "10.0.0.1",
+			PeerASN:            65001,
+			LocalAddress:       "10.0.0.2",
+			State:              "Established",
+			EstablishedTime:    metav1.NewTime(uptime),
+			Flaps:              2,
+			ReceivedPrefixes:   4,
+			AcceptedPrefixes:   3,
+			AdvertisedPrefixes: 9,
+			BFDState:           "UP",
+		}, status)
+	})
+
+	t.Run("disconnected peer", func(t *testing.T) {
+		peer := &api.Peer{
+			Conf: &api.PeerConf{NeighborAddress: "fd00::1", PeerAsn: 65001},
+			State: &api.PeerState{
+				SessionState:      api.PeerState_SESSION_STATE_ACTIVE,
+				DisconnectReason:  api.PeerState_DISCONNECT_REASON_HOLD_TIMER_EXPIRED,
+				DisconnectMessage: "no keepalive",
+			},
+			Timers: &api.Timers{State: &api.TimersState{Uptime: timestamppb.New(uptime)}},
+		}
+
+		status := newBgpNeighborStatus(peer, nil)
+		require.Equal(t, "Active", status.State)
+		require.True(t, status.EstablishedTime.IsZero())
+		require.Equal(t, "HOLD_TIMER_EXPIRED: no keepalive", status.DisconnectReason)
+		require.Empty(t, status.BFDState)
+	})
+}
+
+func TestSpeakerStatusChanged(t *testing.T) {
+	status := kubeovnv1.BgpSpeakerStatusInfo{
+		NodeName:             "node1",
+		TotalNeighbors:       1,
+		EstablishedNeighbors: 1,
+		Neighbors:            []kubeovnv1.BgpNeighborStatus{{Address: "10.0.0.1", State: "Established"}},
+		AnnouncedPrefixCount: 1,
+		AnnouncedPrefixes:    []string{"10.16.0.0/16"},
+		LastUpdateTime:       metav1.NewTime(time.Now().Add(-time.Hour)),
+	}
+
+	updated := *status.DeepCopy()
+	updated.LastUpdateTime = metav1.Now()
+	require.False(t, speakerStatusChanged(status, updated))
+
+	updated.Neighbors[0].State = "Active"
+	require.True(t, speakerStatusChanged(status, updated))
+
+	updated = *status.DeepCopy()
+	updated.LastError = "failed to reconcile routes"
+	require.True(t, speakerStatusChanged(status, updated))
+}
+
+func TestSetLastError(t *testing.T) {
+	c := &Controller{}
+	c.setLastError(errors.New("failed to list subnets"))
+	require.Equal(t, "failed to list subnets", c.lastError)
+	require.False(t, c.lastErrorTime.IsZero())
+
+	c.setLastError(nil)
+	require.Empty(t, c.lastError)
+	require.True(t, c.lastErrorTime.IsZero())
+}
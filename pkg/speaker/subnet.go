@@ -27,33 +27,20 @@ const (
 	announcePolicyLocal = "local"
 )
 
-func (c *Controller) syncSubnetRoutes() {
+func (c *Controller) syncSubnetRoutes() error {
 	bgpExpected := make(prefixMap)
 
 	subnets, err := c.subnetsLister.List(labels.Everything())
 	if err != nil {
-		klog.Errorf("failed to list subnets, %v", err)
-		return
+		return fmt.Errorf("failed to list subnets: %w", err)
 	}
 	pods, err := c.podsLister.List(labels.Everything())
 	if err != nil {
-		klog.Errorf("failed to list pods, %v", err)
-		return
+		return fmt.Errorf("failed to list pods: %w", err)
 	}
 
-	if c.config.AnnounceClusterIP {
-		services, err := c.servicesLister.List(labels.Everything())
-		if err != nil {
-			klog.Errorf("failed to list services, %v", err)
-			return
-		}
-		for _, svc := range services {
-			if svc.Annotations != nil && svc.Annotations[util.BgpAnnotation] == "true" && isClusterIPService(svc) {
-				for _, clusterIP := range svc.Spec.ClusterIPs {
-					addExpectedPrefix(clusterIP, bgpExpected)
-				}
-			}
-		}
+	if err = c.syncServiceRoutes(bgpExpected); err != nil {
+		return fmt.Errorf("failed to collect service routes: %w", err)
 	}
 
 	subnetByName := make(map[string]*kubeovnv1.Subnet, len(subnets))
@@ -93,9 +80,10 @@ func (c *Controller) syncSubnetRoutes() {
 
 	collectPodExpectedPrefixes(pods, subnetByName, c.config.NodeName, bgpExpected)
 
-	if err := c.reconcileRoutes(bgpExpected); err != nil {
-		klog.Errorf("failed to reconcile routes: %s", err.Error())
+	if err = c.reconcileRoutes(bgpExpected); err != nil {
+		return fmt.Errorf("failed to reconcile routes: %w", err)
 	}
+	return nil
 }
 
 // collectPodExpectedPrefixes iterates over pods and collects IPs that should be announced via BGP.
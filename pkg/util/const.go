@@ -40,6 +40,7 @@ const (
 	VpcNatGatewayInitAnnotation             = "ovn.kubernetes.io/vpc_nat_gw_init"
 	VpcNatGatewayContainerRestartAnnotation = "ovn.kubernetes.io/vpc_nat_gw_container_restarted"
 	VpcNatGatewayActivatedAnnotation        = "ovn.kubernetes.io/vpc_nat_gw_activated"
+	VpcNatGatewayAgentAnnotation            = "ovn.kubernetes.io/vpc_nat_gw_agent"
 	VpcEipsAnnotation                       = "ovn.kubernetes.io/vpc_eips"
 	VpcFloatingIPMd5Annotation              = "ovn.kubernetes.io/vpc_floating_ips"
 	VpcDnatMd5Annotation                    = "ovn.kubernetes.io/vpc_dnat_md5"
@@ -138,9 +139,11 @@ const (
 	NetworkPolicyForAnnotation         = "ovn.kubernetes.io/network_policy_for"
 	ACLActionsLogAnnotation            = "ovn.kubernetes.io/log_acl_actions"
 	ACLLogMeterAnnotation              = "ovn.kubernetes.io/acl_log_meter_rate"
+	ACLStatelessAnnotation             = "ovn.kubernetes.io/acl_stateless"
 
-	VpcEgressGatewayLabel  = "ovn.kubernetes.io/vpc-egress-gateway"
-	GenerateHashAnnotation = "ovn.kubernetes.io/generate-hash"
+	VpcEgressGatewayLabel             = "ovn.kubernetes.io/vpc-egress-gateway"
+	VpcEgressGatewayPinningAnnotation = "ovn.kubernetes.io/vpc_egress_gateway_pinning"
+	GenerateHashAnnotation            = "ovn.kubernetes.io/generate-hash"
 
 	ServiceExternalIPFromSubnetAnnotation = "ovn.kubernetes.io/service_external_ip_from_subnet"
 	ServiceHealthCheck                    = "ovn.kubernetes.io/service_health_check"
@@ -259,20 +262,22 @@ const (
 	OvnFip      = "ovn"
 	IptablesFip = "iptables"
 
-	GatewayRouterPolicyPriority      = 29000
-	EgressGatewayDropPolicyPriority  = 29090
-	EgressGatewayPolicyPriority      = 29100
-	EgressGatewayLocalPolicyPriority = 29150
-	NatGatewayDropPolicyPriority     = 29190
-	NatGatewayPolicyPriority         = 29200
-	NorthGatewayRoutePolicyPriority  = 29250
-	U2OSubnetPolicyPriority          = 29400
-	OvnICPolicyPriority              = 29500
-	NodeRouterPolicyPriority         = 30000
-	U2OPhysicalGatewayPolicyPriority = 30050
-	U2OSameSubnetPolicyPriority      = 30060
-	NodeLocalDNSPolicyPriority       = 30100
-	SubnetRouterPolicyPriority       = 31000
+	GatewayRouterPolicyPriority              = 29000
+	EgressGatewayDropPolicyPriority          = 29090
+	EgressGatewayPolicyPriority              = 29100
+	EgressGatewayPinnedStandbyPolicyPriority = 29110
+	EgressGatewayPinnedPolicyPriority        = 29120
+	EgressGatewayLocalPolicyPriority         = 29150
+	NatGatewayDropPolicyPriority             = 29190
+	NatGatewayPolicyPriority                 = 29200
+	NorthGatewayRoutePolicyPriority          = 29250
+	U2OSubnetPolicyPriority                  = 29400
+	OvnICPolicyPriority                      = 29500
+	NodeRouterPolicyPriority                 = 30000
+	U2OPhysicalGatewayPolicyPriority         = 30050
+	U2OSameSubnetPolicyPriority              = 30060
+	NodeLocalDNSPolicyPriority               = 30100
+	SubnetRouterPolicyPriority               = 31000
 
 	OffloadType = "offload-port"
 	DpdkType    = "dpdk-port"
@@ -420,8 +425,9 @@ const (
 
 // Readonly kinds of Kubernetes objects
 var (
-	KindNode = ObjectKind[*corev1.Node]()
-	KindPod  = ObjectKind[*corev1.Pod]()
+	KindNode    = ObjectKind[*corev1.Node]()
+	KindPod     = ObjectKind[*corev1.Pod]()
+	KindService = ObjectKind[*corev1.Service]()
 
 	KindDeployment  = ObjectKind[*appsv1.Deployment]()
 	KindDaemonSet   = ObjectKind[*appsv1.DaemonSet]()
@@ -438,6 +444,7 @@ var (
 	KindOvnFip           = ObjectKind[*kubeovnv1.OvnFip]()
 	KindOvnDnatRule      = ObjectKind[*kubeovnv1.OvnDnatRule]()
 	KindOvnSnatRule      = ObjectKind[*kubeovnv1.OvnSnatRule]()
+	KindSecurityGroup    = ObjectKind[*kubeovnv1.SecurityGroup]()
 	KindSubnet           = ObjectKind[*kubeovnv1.Subnet]()
 	KindVip              = ObjectKind[*kubeovnv1.Vip]()
 	KindVpc              = ObjectKind[*kubeovnv1.Vpc]()
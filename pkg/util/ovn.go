@@ -1,5 +1,18 @@
 package util
 
+import "strings"
+
 func NodeLspName(node string) string {
 	return NodeLspPrefix + node
 }
+
+// VpcTransitSwitchName returns the name of the transit switch dedicated to the custom vpc
+func VpcTransitSwitchName(vpc string) string {
+	return InterconnectionSwitch + "-" + vpc
+}
+
+// IsDefaultTransitSwitch returns whether the transit switch is one of ts, ts1, ts2... shared by the default vpc
+func IsDefaultTransitSwitch(name string) bool {
+	suffix, ok := strings.CutPrefix(name, InterconnectionSwitch)
+	return ok && strings.Trim(suffix, "0123456789") == ""
+}
@@ -36,3 +36,25 @@ func TestNodeLspName(t *testing.T) {
 		})
 	}
 }
+
+func TestIsDefaultTransitSwitch(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected bool
+	}{
+		{name: "ts", expected: true},
+		{name: "ts1", expected: true},
+		{name: "ts12", expected: true},
+		{name: VpcTransitSwitchName("vpc1"), expected: false},
+		{name: VpcTransitSwitchName("1"), expected: false},
+		{name: "join", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if result := IsDefaultTransitSwitch(test.name); result != test.expected {
+				t.Errorf("got %v, but expected %v", result, test.expected)
+			}
+		})
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/klog/v2"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
 )
 
 type KVPatch map[string]any
@@ -18,7 +20,7 @@ type patchClient[T metav1.Object] interface {
 	Patch(ctx context.Context, name string, patchType types.PatchType, patch []byte, opt metav1.PatchOptions, subresources ...string) (T, error)
 }
 
-func patchMetaKVs[T metav1.Object](cs patchClient[T], name, field string, patch KVPatch) error {
+func patchMetaKVs[T metav1.Object](ctx context.Context, cs patchClient[T], name, field string, patch KVPatch) error {
 	obj := map[string]map[string]KVPatch{"metadata": {field: patch}}
 	patchData, err := json.Marshal(obj)
 	if err != nil {
@@ -26,7 +28,7 @@ func patchMetaKVs[T metav1.Object](cs patchClient[T], name, field string, patch
 		return err
 	}
 
-	_, err = cs.Patch(context.Background(), name, types.MergePatchType, patchData, metav1.PatchOptions{})
+	_, err = cs.Patch(ctx, name, types.MergePatchType, patchData, metav1.PatchOptions{})
 	if err != nil {
 		klog.Errorf("failed to patch resource %s with json merge patch %q: %v", name, string(patchData), err)
 		return err
@@ -35,11 +37,33 @@ func patchMetaKVs[T metav1.Object](cs patchClient[T], name, field string, patch
 }
 
 func PatchLabels[T metav1.Object](cs patchClient[T], name string, patch KVPatch) error {
-	return patchMetaKVs(cs, name, "labels", patch)
+	return patchMetaKVs(context.Background(), cs, name, "labels", patch)
 }
 
 func PatchAnnotations[T metav1.Object](cs patchClient[T], name string, patch KVPatch) error {
-	return patchMetaKVs(cs, name, "annotations", patch)
+	return PatchAnnotationsWithContext(context.Background(), cs, name, patch)
+}
+
+// PatchAnnotationsWithContext is PatchAnnotations sending the request with ctx, e.g. to trace it in the span of ctx
+func PatchAnnotationsWithContext[T metav1.Object](ctx context.Context, cs patchClient[T], name string, patch KVPatch) error {
+	return patchMetaKVs(ctx, cs, name, "annotations", patch)
+}
+
+// PatchIPTimeline sets the timestamps of the pod network setup phases in the status of the ip CR,
+// the phases with a nil timestamp are cleared
+func PatchIPTimeline(cs patchClient[*kubeovnv1.IP], name string, phases map[kubeovnv1.IPTimelinePhase]*metav1.MicroTime) error {
+	obj := map[string]map[string]map[kubeovnv1.IPTimelinePhase]*metav1.MicroTime{"status": {"timeline": phases}}
+	patchData, err := json.Marshal(obj)
+	if err != nil {
+		klog.Errorf("failed to marshal timeline patch %#v: %v", phases, err)
+		return err
+	}
+
+	if _, err = cs.Patch(context.Background(), name, types.MergePatchType, patchData, metav1.PatchOptions{}, "status"); err != nil {
+		klog.Errorf("failed to patch timeline of ip %s with json merge patch %q: %v", name, string(patchData), err)
+		return err
+	}
+	return nil
 }
 
 func GenerateStrategicMergePatchPayload(original, modified runtime.Object) ([]byte, error) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -14,6 +15,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+	kubeovnfake "github.com/kubeovn/kube-ovn/pkg/client/clientset/versioned/fake"
 )
 
 func TestPatchAnnotations(t *testing.T) {
@@ -318,3 +322,35 @@ func TestFailedGenerateStrategicMergePatchPayload(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, got)
 }
+
+func TestPatchIPTimeline(t *testing.T) {
+	client := kubeovnfake.NewSimpleClientset()
+	ipClient := client.KubeovnV1().IPs()
+	_, err := ipClient.Create(context.Background(), &kubeovnv1.IP{ObjectMeta: metav1.ObjectMeta{Name: "pod1.default"}}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	allocated := metav1.NewMicroTime(time.Date(2024, 1, 1, 0, 0, 0, 1000, time.UTC))
+	portBound := metav1.NewMicroTime(allocated.Add(time.Second))
+	err = PatchIPTimeline(ipClient, "pod1.default", map[kubeovnv1.IPTimelinePhase]*metav1.MicroTime{
+		kubeovnv1.IPTimelineAllocated: &allocated,
+		kubeovnv1.IPTimelinePortBound: &portBound,
+	})
+	require.NoError(t, err)
+	ip, err := ipClient.Get(context.Background(), "pod1.default", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, ip.Status.Timeline.Allocated)
+	require.True(t, allocated.Equal(ip.Status.Timeline.Allocated))
+	require.NotNil(t, ip.Status.Timeline.PortBound)
+	require.True(t, portBound.Equal(ip.Status.Timeline.PortBound))
+
+	// clear a phase and keep the others
+	err = PatchIPTimeline(ipClient, "pod1.default", map[kubeovnv1.IPTimelinePhase]*metav1.MicroTime{kubeovnv1.IPTimelinePortBound: nil})
+	require.NoError(t, err)
+	ip, err = ipClient.Get(context.Background(), "pod1.default", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, ip.Status.Timeline.Allocated)
+	require.Nil(t, ip.Status.Timeline.PortBound)
+
+	err = PatchIPTimeline(ipClient, "pod2.default", map[kubeovnv1.IPTimelinePhase]*metav1.MicroTime{kubeovnv1.IPTimelineAllocated: &allocated})
+	require.Error(t, err)
+}
@@ -0,0 +1,86 @@
+package util
+
+import (
+	"fmt"
+	"net/netip"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+)
+
+const (
+	// SnatPortBlockDefaultPortRangeStart is the first external port allocated when the port range is not set
+	SnatPortBlockDefaultPortRangeStart = 1024
+	// SnatPortBlockDefaultPortRangeEnd is the last external port allocated when the port range is not set
+	SnatPortBlockDefaultPortRangeEnd = 65535
+)
+
+// SnatPortBlockMapping is the external port block assigned to an internal address by a SNAT rule
+type SnatPortBlockMapping struct {
+	InternalIP string `json:"internalIP"`
+	ExternalIP string `json:"externalIP"`
+	PortStart  int32  `json:"portStart"`
+	PortEnd    int32  `json:"portEnd"`
+}
+
+// PortRange returns the port range of the mapping in the "<start>-<end>" form
+func (m SnatPortBlockMapping) PortRange() string {
+	return fmt.Sprintf("%d-%d", m.PortStart, m.PortEnd)
+}
+
+// SnatPortBlockRange returns the external port range of the port block allocation with the defaults applied
+func SnatPortBlockRange(portBlock *kubeovnv1.SnatPortBlock) (start, end int32) {
+	start, end = portBlock.PortRangeStart, portBlock.PortRangeEnd
+	if start == 0 {
+		start = SnatPortBlockDefaultPortRangeStart
+	}
+	if end == 0 {
+		end = SnatPortBlockDefaultPortRangeEnd
+	}
+	return start, end
+}
+
+// GenSnatPortBlockMappings returns the port block of every address of the IPv4 internal CIDR.
+// Address i of the CIDR is assigned the ports [start + i*blockSize, start + (i+1)*blockSize - 1].
+func GenSnatPortBlockMappings(externalIP, internalCIDR string, portBlock *kubeovnv1.SnatPortBlock) ([]SnatPortBlockMapping, error) {
+	if portBlock == nil {
+		return nil, nil
+	}
+	prefix, err := netip.ParsePrefix(internalCIDR)
+	if err != nil {
+		addr, addrErr := netip.ParseAddr(internalCIDR)
+		if addrErr != nil {
+			return nil, fmt.Errorf("invalid internal cidr %q: %w", internalCIDR, err)
+		}
+		prefix = netip.PrefixFrom(addr, addr.BitLen())
+	}
+	if !prefix.Addr().Is4() {
+		return nil, fmt.Errorf("port block allocation only supports IPv4 internal cidr, got %q", internalCIDR)
+	}
+	if portBlock.BlockSize <= 0 {
+		return nil, fmt.Errorf("invalid port block size %d", portBlock.BlockSize)
+	}
+	start, end := SnatPortBlockRange(portBlock)
+	if start > end {
+		return nil, fmt.Errorf("invalid port range %d-%d", start, end)
+	}
+
+	count := int64(1) << (32 - prefix.Bits())
+	if capacity := int64(end-start+1) / int64(portBlock.BlockSize); count > capacity {
+		return nil, fmt.Errorf("port range %d-%d only has %d blocks of %d ports, internal cidr %s has %d addresses",
+			start, end, capacity, portBlock.BlockSize, internalCIDR, count)
+	}
+
+	mappings := make([]SnatPortBlockMapping, 0, count)
+	addr := prefix.Masked().Addr()
+	for i := range int32(count) {
+		portStart := start + i*portBlock.BlockSize
+		mappings = append(mappings, SnatPortBlockMapping{
+			InternalIP: addr.String(),
+			ExternalIP: externalIP,
+			PortStart:  portStart,
+			PortEnd:    portStart + portBlock.BlockSize - 1,
+		})
+		addr = addr.Next()
+	}
+	return mappings, nil
+}
@@ -0,0 +1,37 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kubeovnv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+)
+
+func TestGenSnatPortBlockMappings(t *testing.T) {
+	mappings, err := GenSnatPortBlockMappings("172.18.0.10", "10.0.0.5/30", &kubeovnv1.SnatPortBlock{BlockSize: 1000})
+	require.NoError(t, err)
+	require.Equal(t, []SnatPortBlockMapping{
+		{InternalIP: "10.0.0.4", ExternalIP: "172.18.0.10", PortStart: 1024, PortEnd: 2023},
+		{InternalIP: "10.0.0.5", ExternalIP: "172.18.0.10", PortStart: 2024, PortEnd: 3023},
+		{InternalIP: "10.0.0.6", ExternalIP: "172.18.0.10", PortStart: 3024, PortEnd: 4023},
+		{InternalIP: "10.0.0.7", ExternalIP: "172.18.0.10", PortStart: 4024, PortEnd: 5023},
+	}, mappings)
+	require.Equal(t, "2024-3023", mappings[1].PortRange())
+
+	// a single address gets the first block of the range
+	mappings, err = GenSnatPortBlockMappings("172.18.0.10", "10.0.0.5", &kubeovnv1.SnatPortBlock{BlockSize: 100, PortRangeStart: 20000, PortRangeEnd: 20099})
+	require.NoError(t, err)
+	require.Equal(t, []SnatPortBlockMapping{{InternalIP: "10.0.0.5", ExternalIP: "172.18.0.10", PortStart: 20000, PortEnd: 20099}}, mappings)
+
+	// the port range must hold a block for every address
+	_, err = GenSnatPortBlockMappings("172.18.0.10", "10.0.0.0/24", &kubeovnv1.SnatPortBlock{BlockSize: 256})
+	require.ErrorContains(t, err, "only has 252 blocks")
+
+	_, err = GenSnatPortBlockMappings("fd00::10", "fd00::/120", &kubeovnv1.SnatPortBlock{BlockSize: 64})
+	require.Error(t, err)
+
+	mappings, err = GenSnatPortBlockMappings("172.18.0.10", "10.0.0.0/24", nil)
+	require.NoError(t, err)
+	require.Nil(t, mappings)
+}
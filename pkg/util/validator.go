@@ -612,5 +612,18 @@ func ValidateVpc(vpc *kubeovnv1.Vpc) error {
 		}
 	}
 
+	if vpc.Spec.Interconnection.IsEnabled() {
+		if vpc.Name == DefaultVpc {
+			return fmt.Errorf("interconnection of vpc %s is configured by %s", DefaultVpc, InterconnectionConfig)
+		}
+		if vpc.Spec.Interconnection.Subnet == "" {
+			return errors.New("subnet of the transit switch is required when interconnection is enabled")
+		}
+		if err := CheckCidrs(vpc.Spec.Interconnection.Subnet); err != nil {
+			klog.Error(err)
+			return fmt.Errorf("invalid transit switch cidr %s", vpc.Spec.Interconnection.Subnet)
+		}
+	}
+
 	return nil
 }
@@ -1588,6 +1588,47 @@ func TestValidateVpc(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid interconnection",
+			vpc: &kubeovnv1.Vpc{
+				ObjectMeta: metav1.ObjectMeta{Name: "vpc1"},
+				Spec: kubeovnv1.VpcSpec{
+					Interconnection: &kubeovnv1.VpcInterconnection{Enabled: true, Subnet: "169.254.200.0/24"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "interconnection without subnet",
+			vpc: &kubeovnv1.Vpc{
+				ObjectMeta: metav1.ObjectMeta{Name: "vpc1"},
+				Spec: kubeovnv1.VpcSpec{
+					Interconnection: &kubeovnv1.VpcInterconnection{Enabled: true},
+				},
+			},
+			wantErr: true,
+			errMsg:  "subnet of the transit switch is required when interconnection is enabled",
+		},
+		{
+			name: "interconnection with invalid subnet",
+			vpc: &kubeovnv1.Vpc{
+				ObjectMeta: metav1.ObjectMeta{Name: "vpc1"},
+				Spec: kubeovnv1.VpcSpec{
+					Interconnection: &kubeovnv1.VpcInterconnection{Enabled: true, Subnet: "169.254.200.0"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "interconnection of default vpc",
+			vpc: &kubeovnv1.Vpc{
+				ObjectMeta: metav1.ObjectMeta{Name: DefaultVpc},
+				Spec: kubeovnv1.VpcSpec{
+					Interconnection: &kubeovnv1.VpcInterconnection{Enabled: true, Subnet: "169.254.200.0/24"},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -41,6 +41,12 @@ func GenNatGwNameWithPrefix(prefix, name string) string {
 	return fmt.Sprintf("%s-%s", prefix, name)
 }
 
+// GenNatGwRulesConfigMapName returns the name of the ConfigMap holding the desired rules
+// of a NAT gateway running in agent mode
+func GenNatGwRulesConfigMapName(name string) string {
+	return GenNatGwName(name) + "-rules"
+}
+
 // GenNatGwPodName returns the full name of the NAT gateway pod within a StatefulSet
 func GenNatGwPodName(name string) string {
 	return GenNatGwPodNameWithPrefix(VpcNatGwNamePrefix, name)
@@ -324,13 +324,34 @@ func (c *conntrackCollector) resetDomainMetrics() {
 	c.metrics.accounting.WithLabelValues(c.identity.labels()...).Set(0)
 }
 
-func writeFlowLogs(ctx context.Context, writer io.Writer, identity observerIdentity, metrics *observerMetrics, queue <-chan flowRecord) {
+// writeFlowLogs writes the records to stdout unless disabled and hands them to the configured sinks.
+func writeFlowLogs(ctx context.Context, writer, diagnostics io.Writer, identity observerIdentity, metrics *observerMetrics, settings func() *runtimeSettings, queue <-chan flowRecord) {
 	encoder := json.NewEncoder(writer)
+	sinks := newFlowSinkManager(identity, metrics, diagnostics)
+	defer sinks.stop()
+	var applied *runtimeSettings
+	syncSinks := func() *runtimeSettings {
+		if current := settings(); current != applied {
+			sinks.sync(ctx, current.config.Observability.Conntrack.Log)
+			applied = current
+		}
+		return applied
+	}
+	syncSinks()
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-ticker.C:
+			syncSinks()
 		case record := <-queue:
+			current := syncSinks()
+			sinks.publish(record)
+			if current.config.Observability.Conntrack.Log.DisableStdout {
+				continue
+			}
 			if err := encoder.Encode(record); err != nil {
 				metrics.logDrops.WithLabelValues(append(identity.labels(), "write_error")...).Inc()
 				continue
@@ -16,6 +16,9 @@ type observerMetrics struct {
 	cacheEvictions    *prometheus.CounterVec
 	logRecords        *prometheus.CounterVec
 	logDrops          *prometheus.CounterVec
+	sinkRecords       *prometheus.CounterVec
+	sinkDrops         *prometheus.CounterVec
+	sinkQueueLength   *prometheus.GaugeVec
 	accounting        *prometheus.GaugeVec
 	interfaceCounters map[string]*prometheus.CounterVec
 	activeFlows       *prometheus.GaugeVec
@@ -36,6 +39,10 @@ func newObserverMetrics() *observerMetrics {
 	m.cacheEvictions = prometheus.NewCounterVec(prometheus.CounterOpts{Name: "kube_ovn_vpc_egress_gateway_observability_conntrack_cache_evictions_total", Help: "Conntrack cache evictions."}, identityLabels)
 	m.logRecords = prometheus.NewCounterVec(prometheus.CounterOpts{Name: "kube_ovn_vpc_egress_gateway_observability_log_records_total", Help: "Flow log records written."}, append(identityLabels, "event"))
 	m.logDrops = prometheus.NewCounterVec(prometheus.CounterOpts{Name: "kube_ovn_vpc_egress_gateway_observability_log_drops_total", Help: "Flow log records dropped."}, append(identityLabels, "reason"))
+	sinkLabels := append(identityLabels, "sink", "type")
+	m.sinkRecords = prometheus.NewCounterVec(prometheus.CounterOpts{Name: "kube_ovn_vpc_egress_gateway_observability_sink_records_total", Help: "Flow log records exported to external sinks."}, sinkLabels)
+	m.sinkDrops = prometheus.NewCounterVec(prometheus.CounterOpts{Name: "kube_ovn_vpc_egress_gateway_observability_sink_drops_total", Help: "Flow log records dropped by external sinks."}, append(sinkLabels, "reason"))
+	m.sinkQueueLength = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "kube_ovn_vpc_egress_gateway_observability_sink_queue_length", Help: "Flow log records buffered for external sinks."}, sinkLabels)
 	m.accounting = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "kube_ovn_vpc_egress_gateway_observability_conntrack_accounting_available", Help: "Whether conntrack accounting counters are available."}, identityLabels)
 
 	interfaceLabels := append(identityLabels, "interface", "type")
@@ -57,7 +64,7 @@ func newObserverMetrics() *observerMetrics {
 	m.packets = prometheus.NewCounterVec(prometheus.CounterOpts{Name: "kube_ovn_vpc_egress_gateway_conntrack_nat_packets_total", Help: "Packets observed in NAT conntrack flows when accounting is available."}, directionLabels)
 	m.bytes = prometheus.NewCounterVec(prometheus.CounterOpts{Name: "kube_ovn_vpc_egress_gateway_conntrack_nat_bytes_total", Help: "Bytes observed in NAT conntrack flows when accounting is available."}, directionLabels)
 
-	collectors := []prometheus.Collector{m.collectorUp, m.configReloads, m.conntrackEvents, m.errors, m.cacheEntries, m.cacheCapacity, m.cacheEvictions, m.logRecords, m.logDrops, m.accounting, m.activeFlows, m.startedFlows, m.endedFlows, m.packets, m.bytes}
+	collectors := []prometheus.Collector{m.collectorUp, m.configReloads, m.conntrackEvents, m.errors, m.cacheEntries, m.cacheCapacity, m.cacheEvictions, m.logRecords, m.logDrops, m.sinkRecords, m.sinkDrops, m.sinkQueueLength, m.accounting, m.activeFlows, m.startedFlows, m.endedFlows, m.packets, m.bytes}
 	for _, collector := range m.interfaceCounters {
 		collectors = append(collectors, collector)
 	}
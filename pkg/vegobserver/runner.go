@@ -48,6 +48,9 @@ func Run(ctx context.Context, options Options) error {
 	if err != nil {
 		return err
 	}
+	if err = validateSinks(config.Observability.Conntrack.Log.Sinks); err != nil {
+		return err
+	}
 	identity := observerIdentity{namespace: config.Namespace, name: config.Name, pod: options.Pod, node: options.Node}
 	labels := identity.labels()
 	metrics := newObserverMetrics()
@@ -56,7 +59,7 @@ func Run(ctx context.Context, options Options) error {
 	metrics.configReloads.WithLabelValues(append(labels, "success")...).Inc()
 
 	logQueue := make(chan flowRecord, DefaultLogQueue)
-	go writeFlowLogs(ctx, options.Stdout, identity, metrics, logQueue)
+	go writeFlowLogs(ctx, options.Stdout, options.Stderr, identity, metrics, settings.Load, logQueue)
 	go reloadConfig(ctx, options.ConfigPath, identity, metrics, settings, options.Stderr)
 	conntrackCollector := newConntrackCollector(settings.Load, identity, metrics, logQueue)
 	go conntrackCollector.run(ctx, options.Stderr)
@@ -163,6 +166,11 @@ func reloadConfig(ctx context.Context, path string, identity observerIdentity, m
 				writeDiagnostic(metrics, identity, diagnostics, "config", "reload observer filters: %v\n", err)
 				continue
 			}
+			if err = validateSinks(config.Observability.Conntrack.Log.Sinks); err != nil {
+				metrics.configReloads.WithLabelValues(append(labels, "error")...).Inc()
+				writeDiagnostic(metrics, identity, diagnostics, "config", "reload observer sinks: %v\n", err)
+				continue
+			}
 			settings.Store(&runtimeSettings{config: config, filters: filters, limiter: newLimiter(config.Observability.Conntrack.Log.RateLimit)})
 			currentHash = nextHash
 			metrics.configReloads.WithLabelValues(append(labels, "success")...).Inc()
@@ -0,0 +1,328 @@
+package vegobserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"sync"
+	"time"
+
+	apiv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+)
+
+const (
+	DefaultSinkMaxRecords    = 100
+	DefaultSinkFlushInterval = time.Second
+	DefaultSinkQueueSize     = 4096
+
+	sinkExportAttempts = 3
+	sinkRetryBackoff   = 200 * time.Millisecond
+	sinkIOTimeout      = 5 * time.Second
+	sinkStopTimeout    = 2 * time.Second
+)
+
+// flowExporter sends a batch of flow records to an external collector.
+// Implementations must not retain the batch after export returns.
+type flowExporter interface {
+	export(ctx context.Context, records []flowRecord) error
+	Close() error
+}
+
+type sinkBatchSettings struct {
+	maxRecords    int
+	flushInterval time.Duration
+	queueSize     int
+}
+
+func batchSettings(config apiv1.VpcEgressGatewayFlowLogSinkBatch) sinkBatchSettings {
+	settings := sinkBatchSettings{maxRecords: DefaultSinkMaxRecords, flushInterval: DefaultSinkFlushInterval, queueSize: DefaultSinkQueueSize}
+	if config.MaxRecords > 0 {
+		settings.maxRecords = int(config.MaxRecords)
+	}
+	if config.FlushIntervalMilliseconds > 0 {
+		settings.flushInterval = time.Duration(config.FlushIntervalMilliseconds) * time.Millisecond
+	}
+	if config.QueueSize > 0 {
+		settings.queueSize = int(config.QueueSize)
+	}
+	return settings
+}
+
+func sinkTransport(spec apiv1.VpcEgressGatewayFlowLogSink) string {
+	if spec.Transport != "" {
+		return spec.Transport
+	}
+	if spec.Type == apiv1.ObservabilitySinkSyslog {
+		return apiv1.ObservabilitySinkTransportTCP
+	}
+	return apiv1.ObservabilitySinkTransportUDP
+}
+
+// newFlowExporter validates the sink and creates its exporter. Exporters connect lazily,
+// so an unreachable collector does not prevent the sink from being started.
+func newFlowExporter(spec apiv1.VpcEgressGatewayFlowLogSink, identity observerIdentity) (flowExporter, error) {
+	if spec.Name == "" {
+		return nil, errors.New("sink name is required")
+	}
+	switch spec.Type {
+	case apiv1.ObservabilitySinkIPFIX, apiv1.ObservabilitySinkNetFlowV9, apiv1.ObservabilitySinkSyslog:
+		transport := sinkTransport(spec)
+		if transport != apiv1.ObservabilitySinkTransportUDP && transport != apiv1.ObservabilitySinkTransportTCP {
+			return nil, fmt.Errorf("sink %s: unsupported transport %q", spec.Name, transport)
+		}
+		if spec.Type == apiv1.ObservabilitySinkNetFlowV9 && transport != apiv1.ObservabilitySinkTransportUDP {
+			return nil, fmt.Errorf("sink %s: netflowV9 only supports the udp transport", spec.Name)
+		}
+		if _, _, err := net.SplitHostPort(spec.Endpoint); err != nil {
+			return nil, fmt.Errorf("sink %s: invalid endpoint %q: %w", spec.Name, spec.Endpoint, err)
+		}
+		connection := &sinkConnection{network: transport, address: spec.Endpoint}
+		if spec.Type == apiv1.ObservabilitySinkSyslog {
+			return &syslogExporter{connection: connection, hostname: identity.pod}, nil
+		}
+		return newIPFIXExporter(connection, spec.Type == apiv1.ObservabilitySinkNetFlowV9, identity), nil
+	case apiv1.ObservabilitySinkOTLP:
+		if spec.Transport != "" {
+			return nil, fmt.Errorf("sink %s: otlp sinks do not support the transport field", spec.Name)
+		}
+		exporter, err := newOTLPExporter(spec.Endpoint, identity)
+		if err != nil {
+			return nil, fmt.Errorf("sink %s: %w", spec.Name, err)
+		}
+		return exporter, nil
+	default:
+		return nil, fmt.Errorf("sink %s: unsupported type %q", spec.Name, spec.Type)
+	}
+}
+
+func validateSinks(sinks []apiv1.VpcEgressGatewayFlowLogSink) error {
+	names := make(map[string]bool, len(sinks))
+	for _, spec := range sinks {
+		if names[spec.Name] {
+			return fmt.Errorf("duplicate sink name %q", spec.Name)
+		}
+		names[spec.Name] = true
+		exporter, err := newFlowExporter(spec, observerIdentity{})
+		if err != nil {
+			return err
+		}
+		if err = exporter.Close(); err != nil {
+			return fmt.Errorf("sink %s: %w", spec.Name, err)
+		}
+	}
+	return nil
+}
+
+// flowSink buffers records for one exporter and exports them in batches. The queue is
+// bounded, so a slow or unreachable collector drops records instead of blocking flow logging.
+type flowSink struct {
+	spec     apiv1.VpcEgressGatewayFlowLogSink
+	settings sinkBatchSettings
+	exporter flowExporter
+	labels   []string
+	metrics  *observerMetrics
+	identity observerIdentity
+	queue    chan flowRecord
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+func startFlowSink(ctx context.Context, spec apiv1.VpcEgressGatewayFlowLogSink, exporter flowExporter, identity observerIdentity, metrics *observerMetrics, diagnostics io.Writer) *flowSink {
+	settings := batchSettings(spec.Batch)
+	ctx, cancel := context.WithCancel(ctx)
+	sink := &flowSink{
+		spec: spec, settings: settings, exporter: exporter,
+		labels: append(identity.labels(), spec.Name, spec.Type), metrics: metrics, identity: identity,
+		queue: make(chan flowRecord, settings.queueSize), cancel: cancel, done: make(chan struct{}),
+	}
+	go sink.run(ctx, diagnostics)
+	return sink
+}
+
+// offer queues the record without blocking.
+func (s *flowSink) offer(record flowRecord) {
+	select {
+	case s.queue <- record:
+	default:
+		s.metrics.sinkDrops.WithLabelValues(append(s.labels, "queue_full")...).Inc()
+	}
+}
+
+// stop flushes the buffered records within a short timeout and closes the exporter.
+func (s *flowSink) stop() {
+	s.cancel()
+	<-s.done
+}
+
+func (s *flowSink) run(ctx context.Context, diagnostics io.Writer) {
+	defer close(s.done)
+	batch := make([]flowRecord, 0, s.settings.maxRecords)
+	timer := time.NewTimer(s.settings.flushInterval)
+	timer.Stop()
+	defer timer.Stop()
+	flush := func(ctx context.Context) {
+		if len(batch) != 0 {
+			s.exportBatch(ctx, batch, diagnostics)
+			batch = batch[:0]
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			stopContext, cancel := context.WithTimeout(context.Background(), sinkStopTimeout)
+			for drained := false; !drained; {
+				select {
+				case record := <-s.queue:
+					if batch = append(batch, record); len(batch) >= s.settings.maxRecords {
+						flush(stopContext)
+					}
+				default:
+					drained = true
+				}
+			}
+			flush(stopContext)
+			cancel()
+			if err := s.exporter.Close(); err != nil {
+				s.metrics.errors.WithLabelValues(append(s.identity.labels(), "sink", "close")...).Inc()
+			}
+			s.metrics.sinkQueueLength.DeleteLabelValues(s.labels...)
+			return
+		case record := <-s.queue:
+			batch = append(batch, record)
+			if len(batch) == 1 {
+				timer.Reset(s.settings.flushInterval)
+			}
+			if len(batch) >= s.settings.maxRecords {
+				timer.Stop()
+				flush(ctx)
+			}
+		case <-timer.C:
+			flush(ctx)
+		}
+		s.metrics.sinkQueueLength.WithLabelValues(s.labels...).Set(float64(len(s.queue)))
+	}
+}
+
+func (s *flowSink) exportBatch(ctx context.Context, batch []flowRecord, diagnostics io.Writer) {
+	var err error
+	for attempt := range sinkExportAttempts {
+		if attempt != 0 && !waitContext(ctx, sinkRetryBackoff<<(attempt-1)) {
+			break
+		}
+		if err = s.exporter.export(ctx, batch); err == nil {
+			s.metrics.sinkRecords.WithLabelValues(s.labels...).Add(float64(len(batch)))
+			return
+		}
+		s.metrics.errors.WithLabelValues(append(s.identity.labels(), "sink", "export")...).Inc()
+	}
+	s.metrics.sinkDrops.WithLabelValues(append(s.labels, "export_error")...).Add(float64(len(batch)))
+	writeDiagnostic(s.metrics, s.identity, diagnostics, "sink", "export %d flow records to %s sink %s: %v\n", len(batch), s.spec.Type, s.spec.Name, err)
+}
+
+// flowSinkManager keeps the running sinks in sync with the flow log configuration.
+// Sinks are keyed by name and restarted only when their specification changes.
+// Replaced sinks are stopped in the background, so that flushing them does not block flow logging.
+type flowSinkManager struct {
+	identity    observerIdentity
+	metrics     *observerMetrics
+	diagnostics io.Writer
+	sinks       map[string]*flowSink
+	stopping    sync.WaitGroup
+}
+
+func newFlowSinkManager(identity observerIdentity, metrics *observerMetrics, diagnostics io.Writer) *flowSinkManager {
+	return &flowSinkManager{identity: identity, metrics: metrics, diagnostics: diagnostics, sinks: make(map[string]*flowSink)}
+}
+
+func (m *flowSinkManager) sync(ctx context.Context, config apiv1.VpcEgressGatewayConntrackLog) {
+	desired := make(map[string]apiv1.VpcEgressGatewayFlowLogSink, len(config.Sinks))
+	if config.Enabled {
+		for _, spec := range config.Sinks {
+			desired[spec.Name] = spec
+		}
+	}
+	for name, sink := range m.sinks {
+		if spec, ok := desired[name]; !ok || !reflect.DeepEqual(spec, sink.spec) {
+			m.stopping.Go(sink.stop)
+			delete(m.sinks, name)
+		}
+	}
+	for name, spec := range desired {
+		if m.sinks[name] != nil {
+			continue
+		}
+		exporter, err := newFlowExporter(spec, m.identity)
+		if err != nil {
+			m.metrics.errors.WithLabelValues(append(m.identity.labels(), "sink", "create")...).Inc()
+			writeDiagnostic(m.metrics, m.identity, m.diagnostics, "sink", "create flow log sink: %v\n", err)
+			continue
+		}
+		m.sinks[name] = startFlowSink(ctx, spec, exporter, m.identity, m.metrics, m.diagnostics)
+	}
+}
+
+func (m *flowSinkManager) publish(record flowRecord) {
+	for _, sink := range m.sinks {
+		sink.offer(record)
+	}
+}
+
+// stop stops all sinks and waits for them, including the sinks being stopped in the background.
+func (m *flowSinkManager) stop() {
+	for name, sink := range m.sinks {
+		m.stopping.Go(sink.stop)
+		delete(m.sinks, name)
+	}
+	m.stopping.Wait()
+}
+
+// sinkConnection is a lazily dialed connection to a UDP or TCP collector which is
+// re-established after a write error.
+type sinkConnection struct {
+	network string
+	address string
+	conn    net.Conn
+}
+
+func (c *sinkConnection) stream() bool {
+	return c.network == apiv1.ObservabilitySinkTransportTCP
+}
+
+// write sends every payload as one datagram for UDP, or in sequence on the stream for TCP.
+func (c *sinkConnection) write(ctx context.Context, payloads [][]byte) error {
+	if c.conn == nil {
+		dialer := net.Dialer{Timeout: sinkIOTimeout}
+		conn, err := dialer.DialContext(ctx, c.network, c.address)
+		if err != nil {
+			return fmt.Errorf("dial %s collector %s: %w", c.network, c.address, err)
+		}
+		c.conn = conn
+	}
+	deadline := time.Now().Add(sinkIOTimeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := c.conn.SetWriteDeadline(deadline); err != nil {
+		_ = c.Close()
+		return fmt.Errorf("set write deadline: %w", err)
+	}
+	for _, payload := range payloads {
+		if _, err := c.conn.Write(payload); err != nil {
+			_ = c.Close()
+			return fmt.Errorf("write to %s collector %s: %w", c.network, c.address, err)
+		}
+	}
+	return nil
+}
+
+func (c *sinkConnection) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+	err := c.conn.Close()
+	c.conn = nil
+	return err
+}
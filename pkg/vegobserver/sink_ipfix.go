@@ -0,0 +1,258 @@
+package vegobserver
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/fnv"
+	"net/netip"
+	"time"
+
+	apiv1 "github.com/kubeovn/kube-ovn/pkg/apis/kubeovn/v1"
+)
+
+const (
+	ipfixVersion     = 10
+	netflowV9Version = 9
+
+	ipfixHeaderLength     = 16
+	netflowV9HeaderLength = 20
+	// IPFIX template set ID, NetFlow v9 uses template flowset ID 0
+	ipfixTemplateSetID     = 2
+	netflowV9TemplateSetID = 0
+
+	ipfixTemplateIPv4 = 256
+	ipfixTemplateIPv6 = 257
+
+	// keep UDP messages below the common path MTU
+	ipfixMaxDatagramLength = 1400
+	ipfixMaxStreamLength   = 65535
+
+	ipfixNatEventCreate = 1
+	ipfixNatEventDelete = 2
+)
+
+type ipfixField struct {
+	id     uint16
+	length uint16
+}
+
+// IANA IPFIX information elements of the exported NAT flow records.
+// NetFlow v9 collectors share the numbering of the elements below 32768.
+func ipfixTemplateFields(ipv6 bool) []ipfixField {
+	addressLength := uint16(4)
+	sourceAddress, destinationAddress, postNATSourceAddress, postNATDestinationAddress := uint16(8), uint16(12), uint16(225), uint16(226)
+	if ipv6 {
+		addressLength = 16
+		sourceAddress, destinationAddress, postNATSourceAddress, postNATDestinationAddress = 27, 28, 281, 282
+	}
+	return []ipfixField{
+		{323, 8}, // observationTimeMilliseconds
+		{230, 1}, // natEvent
+		{4, 1},   // protocolIdentifier
+		{sourceAddress, addressLength},
+		{7, 2}, // sourceTransportPort
+		{destinationAddress, addressLength},
+		{11, 2}, // destinationTransportPort
+		{postNATSourceAddress, addressLength},
+		{227, 2}, // postNAPTSourceTransportPort
+		{postNATDestinationAddress, addressLength},
+		{228, 2}, // postNAPTDestinationTransportPort
+		{298, 8}, // initiatorPackets
+		{231, 8}, // initiatorOctets
+		{299, 8}, // responderPackets
+		{232, 8}, // responderOctets
+	}
+}
+
+func ipfixRecordLength(ipv6 bool) int {
+	length := 0
+	for _, field := range ipfixTemplateFields(ipv6) {
+		length += int(field.length)
+	}
+	return length
+}
+
+func padLength(length int) int {
+	return (4 - length%4) % 4
+}
+
+// ipfixEncoder encodes flow records as IPFIX (RFC 7011) or NetFlow v9 (RFC 3954) messages.
+// Every message carries the templates, so collectors can decode it regardless of the
+// messages lost before it.
+type ipfixEncoder struct {
+	netflowV9       bool
+	domainID        uint32
+	maxLength       int
+	started         time.Time
+	sequence        uint32
+	templateRecords []byte
+}
+
+func newIPFIXEncoder(netflowV9 bool, domainID uint32, maxLength int, started time.Time) *ipfixEncoder {
+	e := &ipfixEncoder{netflowV9: netflowV9, domainID: domainID, maxLength: maxLength, started: started}
+	for _, template := range []struct {
+		id   uint16
+		ipv6 bool
+	}{{ipfixTemplateIPv4, false}, {ipfixTemplateIPv6, true}} {
+		fields := ipfixTemplateFields(template.ipv6)
+		e.templateRecords = binary.BigEndian.AppendUint16(e.templateRecords, template.id)
+		e.templateRecords = binary.BigEndian.AppendUint16(e.templateRecords, uint16(len(fields)))
+		for _, field := range fields {
+			e.templateRecords = binary.BigEndian.AppendUint16(e.templateRecords, field.id)
+			e.templateRecords = binary.BigEndian.AppendUint16(e.templateRecords, field.length)
+		}
+	}
+	return e
+}
+
+func (e *ipfixEncoder) headerLength() int {
+	if e.netflowV9 {
+		return netflowV9HeaderLength
+	}
+	return ipfixHeaderLength
+}
+
+// encode returns the messages of the records, one data set per message, and the sequence following them.
+// The encoder sequence is not changed, so that a failed export is retried with the same sequence numbers.
+func (e *ipfixEncoder) encode(records []flowRecord, now time.Time) ([][]byte, uint32) {
+	var ipv4, ipv6 []flowRecord
+	for _, record := range records {
+		if record.AddressFamily == apiv1.ObservabilityAddressFamilyIPv6 {
+			ipv6 = append(ipv6, record)
+		} else {
+			ipv4 = append(ipv4, record)
+		}
+	}
+
+	var messages [][]byte
+	sequence := e.sequence
+	templateSetLength := 4 + len(e.templateRecords)
+	for _, group := range []struct {
+		template uint16
+		ipv6     bool
+		records  []flowRecord
+	}{{ipfixTemplateIPv4, false, ipv4}, {ipfixTemplateIPv6, true, ipv6}} {
+		recordLength := ipfixRecordLength(group.ipv6)
+		// reserve 3 bytes for the data set padding
+		perMessage := max(1, (e.maxLength-e.headerLength()-templateSetLength-4-3)/recordLength)
+		for records := group.records; len(records) != 0; {
+			count := min(perMessage, len(records))
+			var message []byte
+			message, sequence = e.message(group.template, group.ipv6, records[:count], sequence, now)
+			messages = append(messages, message)
+			records = records[count:]
+		}
+	}
+	return messages, sequence
+}
+
+func (e *ipfixEncoder) message(template uint16, ipv6 bool, records []flowRecord, sequence uint32, now time.Time) ([]byte, uint32) {
+	message := make([]byte, e.headerLength())
+
+	templateSetID := uint16(ipfixTemplateSetID)
+	if e.netflowV9 {
+		templateSetID = netflowV9TemplateSetID
+	}
+	message = binary.BigEndian.AppendUint16(message, templateSetID)
+	message = binary.BigEndian.AppendUint16(message, uint16(4+len(e.templateRecords)))
+	message = append(message, e.templateRecords...)
+
+	dataSetStart := len(message)
+	message = binary.BigEndian.AppendUint16(message, template)
+	message = binary.BigEndian.AppendUint16(message, 0)
+	for _, record := range records {
+		message = appendIPFIXRecord(message, record, ipv6)
+	}
+	message = append(message, make([]byte, padLength(len(message)-dataSetStart))...)
+	binary.BigEndian.PutUint16(message[dataSetStart+2:], uint16(len(message)-dataSetStart))
+
+	header := message[:e.headerLength()]
+	if e.netflowV9 {
+		// the count includes the two template records, the sequence counts export packets
+		binary.BigEndian.PutUint16(header[0:], netflowV9Version)
+		binary.BigEndian.PutUint16(header[2:], uint16(len(records)+2))
+		binary.BigEndian.PutUint32(header[4:], uint32(now.Sub(e.started).Milliseconds()))
+		binary.BigEndian.PutUint32(header[8:], uint32(now.Unix()))
+		binary.BigEndian.PutUint32(header[12:], sequence)
+		binary.BigEndian.PutUint32(header[16:], e.domainID)
+		return message, sequence + 1
+	} else {
+		// the sequence counts the data records sent before this message
+		binary.BigEndian.PutUint16(header[0:], ipfixVersion)
+		binary.BigEndian.PutUint16(header[2:], uint16(len(message)))
+		binary.BigEndian.PutUint32(header[4:], uint32(now.Unix()))
+		binary.BigEndian.PutUint32(header[8:], sequence)
+		binary.BigEndian.PutUint32(header[12:], e.domainID)
+		return message, sequence + uint32(len(records))
+	}
+}
+
+func appendIPFIXRecord(data []byte, record flowRecord, ipv6 bool) []byte {
+	natEvent := uint8(ipfixNatEventCreate)
+	if record.Event == apiv1.ObservabilityEventEnd {
+		natEvent = ipfixNatEventDelete
+	}
+	data = binary.BigEndian.AppendUint64(data, uint64(record.Timestamp.UnixMilli()))
+	data = append(data, natEvent, record.ProtocolNumber)
+	for _, tuple := range []flowTuple{record.Original, record.Translated} {
+		data = appendIPFIXAddress(data, tuple.SourceIP, ipv6)
+		data = binary.BigEndian.AppendUint16(data, tuple.SourcePort)
+		data = appendIPFIXAddress(data, tuple.DestinationIP, ipv6)
+		data = binary.BigEndian.AppendUint16(data, tuple.DestinationPort)
+	}
+	var counters flowCounters
+	if record.Counters != nil {
+		counters = *record.Counters
+	}
+	for _, counter := range []*uint64{counters.OriginalPackets, counters.OriginalBytes, counters.ReplyPackets, counters.ReplyBytes} {
+		var value uint64
+		if counter != nil {
+			value = *counter
+		}
+		data = binary.BigEndian.AppendUint64(data, value)
+	}
+	return data
+}
+
+func appendIPFIXAddress(data []byte, address string, ipv6 bool) []byte {
+	ip, _ := netip.ParseAddr(address)
+	if ipv6 {
+		bytes := ip.As16()
+		return append(data, bytes[:]...)
+	}
+	if !ip.Is4() {
+		return append(data, 0, 0, 0, 0)
+	}
+	bytes := ip.As4()
+	return append(data, bytes[:]...)
+}
+
+type ipfixExporter struct {
+	connection *sinkConnection
+	encoder    *ipfixEncoder
+}
+
+func newIPFIXExporter(connection *sinkConnection, netflowV9 bool, identity observerIdentity) *ipfixExporter {
+	// the observation domain (source ID in NetFlow v9) identifies the gateway replica
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(identity.namespace + "/" + identity.name + "/" + identity.pod))
+	maxLength := ipfixMaxDatagramLength
+	if connection.stream() {
+		maxLength = ipfixMaxStreamLength
+	}
+	return &ipfixExporter{connection: connection, encoder: newIPFIXEncoder(netflowV9, h.Sum32(), maxLength, time.Now())}
+}
+
+func (e *ipfixExporter) export(ctx context.Context, records []flowRecord) error {
+	messages, sequence := e.encoder.encode(records, time.Now())
+	if err := e.connection.write(ctx, messages); err != nil {
+		return err
+	}
+	// the sequence only advances once the messages are sent
+	e.encoder.sequence = sequence
+	return nil
+}
+
+func (e *ipfixExporter) Close() error {
+	return e.connection.Close()
+}
@@ -0,0 +1,116 @@
+package vegobserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	collogsv1 "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+	logsv1 "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcev1 "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	otlpLogsPath     = "/v1/logs"
+	otlpScopeName    = "github.com/kubeovn/kube-ovn/pkg/vegobserver"
+	otlpServiceName  = "kube-ovn-vpc-egress-gateway"
+	otlpFlowEventKey = "event.name"
+)
+
+// otlpExporter sends records as OTLP log records over plain HTTP with protobuf encoding.
+// The log body is the JSON flow record, identical to the stdout flow log. TLS and authentication
+// are not supported, they are left to a collector reachable over a trusted network.
+type otlpExporter struct {
+	endpoint string
+	client   *http.Client
+	resource *resourcev1.Resource
+}
+
+func newOTLPExporter(endpoint string, identity observerIdentity) (*otlpExporter, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+	}
+	if u.Scheme == "https" {
+		return nil, fmt.Errorf("invalid endpoint %q: https is not supported, otlp sinks have no TLS options", endpoint)
+	}
+	if u.Scheme != "http" || u.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint %q: an http URL is required", endpoint)
+	}
+	if u.User != nil {
+		return nil, fmt.Errorf("invalid endpoint %q: credentials are not supported, otlp sinks have no authentication options", endpoint)
+	}
+	if !strings.HasSuffix(u.Path, otlpLogsPath) {
+		u.Path = strings.TrimSuffix(u.Path, "/") + otlpLogsPath
+	}
+	return &otlpExporter{
+		endpoint: u.String(),
+		client:   &http.Client{Timeout: sinkIOTimeout},
+		resource: &resourcev1.Resource{Attributes: []*commonv1.KeyValue{
+			otlpStringAttribute("service.name", otlpServiceName),
+			otlpStringAttribute("k8s.namespace.name", identity.namespace),
+			otlpStringAttribute("k8s.pod.name", identity.pod),
+			otlpStringAttribute("k8s.node.name", identity.node),
+			otlpStringAttribute("kube_ovn.vpc_egress_gateway.name", identity.name),
+		}},
+	}, nil
+}
+
+func otlpStringAttribute(key, value string) *commonv1.KeyValue {
+	return &commonv1.KeyValue{Key: key, Value: &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: value}}}
+}
+
+func (e *otlpExporter) export(ctx context.Context, records []flowRecord) error {
+	observed := uint64(time.Now().UnixNano())
+	logRecords := make([]*logsv1.LogRecord, 0, len(records))
+	for _, record := range records {
+		data, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("encode flow record: %w", err)
+		}
+		logRecords = append(logRecords, &logsv1.LogRecord{
+			TimeUnixNano:         uint64(record.Timestamp.UnixNano()),
+			ObservedTimeUnixNano: observed,
+			SeverityNumber:       logsv1.SeverityNumber_SEVERITY_NUMBER_INFO,
+			SeverityText:         "INFO",
+			Body:                 &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: string(data)}},
+			Attributes:           []*commonv1.KeyValue{otlpStringAttribute(otlpFlowEventKey, "flow."+record.Event)},
+		})
+	}
+	data, err := proto.Marshal(&collogsv1.ExportLogsServiceRequest{ResourceLogs: []*logsv1.ResourceLogs{{
+		Resource:  e.resource,
+		ScopeLogs: []*logsv1.ScopeLogs{{Scope: &commonv1.InstrumentationScope{Name: otlpScopeName}, LogRecords: logRecords}},
+	}}})
+	if err != nil {
+		return fmt.Errorf("encode OTLP export request: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("create OTLP export request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/x-protobuf")
+	response, err := e.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("send OTLP export request: %w", err)
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 64*1024))
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("OTLP endpoint %s returned %s", e.endpoint, response.Status)
+	}
+	return nil
+}
+
+func (e *otlpExporter) Close() error {
+	e.client.CloseIdleConnections()
+	return nil
+}
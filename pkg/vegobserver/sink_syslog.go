@@ -0,0 +1,55 @@
+package vegobserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	// facility local0 (16) and severity informational (6)
+	syslogPriority = 16*8 + 6
+	syslogAppName  = "kube-ovn-veg"
+	syslogMsgID    = "flow"
+)
+
+// syslogExporter sends every record as an RFC 5424 message with the JSON record as the message body.
+// TCP messages are framed by octet counting (RFC 6587) and UDP messages are sent one per datagram.
+type syslogExporter struct {
+	connection *sinkConnection
+	hostname   string
+}
+
+func (e *syslogExporter) export(ctx context.Context, records []flowRecord) error {
+	hostname := e.hostname
+	if hostname == "" {
+		hostname = "-"
+	}
+	stream := e.connection.stream()
+	payloads := make([][]byte, 0, len(records))
+	var buffer []byte
+	for _, record := range records {
+		data, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("encode flow record: %w", err)
+		}
+		message := fmt.Appendf(nil, "<%d>1 %s %s %s - %s - %s", syslogPriority, record.Timestamp.UTC().Format(time.RFC3339Nano), hostname, syslogAppName, syslogMsgID, data)
+		if !stream {
+			payloads = append(payloads, message)
+			continue
+		}
+		buffer = strconv.AppendInt(buffer, int64(len(message)), 10)
+		buffer = append(buffer, ' ')
+		buffer = append(buffer, message...)
+	}
+	if stream {
+		payloads = append(payloads, buffer)
+	}
+	return e.connection.write(ctx, payloads)
+}
+
+func (e *syslogExporter) Close() error {
+	return e.connection.Close()
+}